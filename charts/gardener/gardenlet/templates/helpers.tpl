@@ -241,6 +241,14 @@ controllers:
     {{- if .Values.config.controllers.seedCare.conditionThresholds }}
 {{ toYaml .Values.config.controllers.seedCare.conditionThresholds | indent 4 }}
     {{- end }}
+  {{- if .Values.config.controllers.seedUtilization }}
+  seedUtilization:
+    syncPeriod: {{ required ".Values.config.controllers.seedUtilization.syncPeriod is required" .Values.config.controllers.seedUtilization.syncPeriod }}
+  {{- end }}
+  {{- if .Values.config.controllers.shootRestore }}
+  shootRestore:
+    concurrentSyncs: {{ required ".Values.config.controllers.shootRestore.concurrentSyncs is required" .Values.config.controllers.shootRestore.concurrentSyncs }}
+  {{- end }}
   {{- if .Values.config.controllers.shootState }}
   shootState:
     concurrentSyncs: {{ required ".Values.config.controllers.shootState.concurrentSyncs is required" .Values.config.controllers.shootState.concurrentSyncs }}
@@ -330,6 +338,10 @@ debugging:
   enableProfiling: {{ .Values.config.debugging.enableProfiling | default false }}
   enableContentionProfiling: {{ .Values.config.debugging.enableContentionProfiling | default false }}
 {{- end }}
+{{- if .Values.config.tracing }}
+tracing:
+{{ toYaml .Values.config.tracing | indent 2 }}
+{{- end }}
 {{- if .Values.config.featureGates }}
 featureGates:
 {{ toYaml .Values.config.featureGates | indent 2 }}
@@ -158,6 +158,11 @@ func getGardenletClusterRole(labels map[string]string) *rbacv1.ClusterRole {
 				Resources: []string{"pods/exec"},
 				Verbs:     []string{"create"},
 			},
+			{
+				APIGroups: []string{"metrics.k8s.io"},
+				Resources: []string{"pods"},
+				Verbs:     []string{"list"},
+			},
 			{
 				APIGroups: []string{""},
 				Resources: []string{"configmaps", "namespaces", "secrets", "serviceaccounts", "services"},
@@ -171,7 +176,7 @@ func getGardenletClusterRole(labels map[string]string) *rbacv1.ClusterRole {
 			{
 				APIGroups:     []string{""},
 				Resources:     []string{"persistentvolumeclaims"},
-				ResourceNames: []string{"vali-vali-0"},
+				ResourceNames: []string{"vali-vali-0", "main-etcd-etcd-main-0", "main-etcd-etcd-main-1", "main-etcd-etcd-main-2"},
 				Verbs:         []string{"delete"},
 			},
 			{
@@ -772,6 +777,11 @@ func ComputeExpectedGardenletConfiguration(
 					},
 				},
 			},
+			SeedUtilization: &gardenletconfigv1alpha1.SeedUtilizationControllerConfiguration{
+				SyncPeriod: &metav1.Duration{
+					Duration: 1 * time.Minute,
+				},
+			},
 			ShootState: &gardenletconfigv1alpha1.ShootStateControllerConfiguration{
 				ConcurrentSyncs: &five,
 				SyncPeriod:      &metav1.Duration{Duration: 6 * time.Hour},
@@ -80,6 +80,10 @@ config.yaml: |
     enableProfiling: {{ .Values.config.debugging.enableProfiling }}
     enableContentionProfiling: {{ .Values.config.debugging.enableContentionProfiling }}
   {{- end }}
+  {{- if .Values.config.tracing }}
+  tracing:
+{{ toYaml .Values.config.tracing | indent 4 }}
+  {{- end }}
   featureGates:
 {{ toYaml .Values.config.featureGates | indent 4 }}
   controllers:
@@ -436,6 +436,7 @@ func (o *Options) ApplyTo(config *apiserver.Config, kubeClient kubernetes.Interf
 	// Store core API resources that are already included in the v1 version in the new version.
 	resourceEncodingConfig.SetResourceEncoding(core.Resource("controllerdeployments"), gardencorev1.SchemeGroupVersion, core.SchemeGroupVersion)
 	resourceEncodingConfig.SetResourceEncoding(operations.Resource("bastions"), operationsv1alpha1.SchemeGroupVersion, operations.SchemeGroupVersion)
+	resourceEncodingConfig.SetResourceEncoding(operations.Resource("shootrestores"), operationsv1alpha1.SchemeGroupVersion, operations.SchemeGroupVersion)
 
 	storageFactory := &storage.GardenerStorageFactory{
 		DefaultStorageFactory: serverstorage.NewDefaultStorageFactory(
@@ -7,6 +7,7 @@ package app
 import (
 	"context"
 	"fmt"
+	"maps"
 	"net"
 	"net/http"
 	"os"
@@ -38,6 +39,7 @@ import (
 	operatorclient "github.com/gardener/gardener/pkg/operator/client"
 	"github.com/gardener/gardener/pkg/operator/controller"
 	"github.com/gardener/gardener/pkg/operator/webhook"
+	"github.com/gardener/gardener/pkg/utils/tracing"
 )
 
 // Name is a const for the name of this component.
@@ -83,12 +85,29 @@ func run(ctx context.Context, cancel context.CancelFunc, log logr.Logger, cfg *o
 
 	var extraHandlers map[string]http.Handler
 	if cfg.Debugging != nil && ptr.Deref(cfg.Debugging.EnableProfiling, false) {
-		extraHandlers = routes.ProfilingHandlers
+		extraHandlers = maps.Clone(routes.ProfilingHandlers)
+		maps.Copy(extraHandlers, routes.FlowHandlers)
 		if ptr.Deref(cfg.Debugging.EnableContentionProfiling, false) {
 			goruntime.SetBlockProfileRate(1)
 		}
 	}
 
+	if cfg.Tracing != nil {
+		log.Info("Setting up tracing", "endpoint", cfg.Tracing.Endpoint)
+		shutdownTracing, err := tracing.Setup(ctx, Name, cfg.Tracing.Endpoint, ptr.Deref(cfg.Tracing.Insecure, false))
+		if err != nil {
+			return fmt.Errorf("failed setting up tracing: %w", err)
+		}
+		defer func() {
+			shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer shutdownCancel()
+
+			if err := shutdownTracing(shutdownCtx); err != nil {
+				log.Error(err, "Failed flushing traces")
+			}
+		}()
+	}
+
 	log.Info("Setting up manager")
 	mgr, err := manager.New(restConfig, manager.Options{
 		Logger:                  log,
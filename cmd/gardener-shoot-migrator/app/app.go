@@ -0,0 +1,41 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/gardener/gardener/pkg/shootmigrator/cmd"
+	"github.com/gardener/gardener/pkg/shootmigrator/cmd/export"
+	importcmd "github.com/gardener/gardener/pkg/shootmigrator/cmd/import"
+)
+
+// Name is a const for the name of this component.
+const Name = "gardener-shoot-migrator"
+
+// NewCommand creates a new cobra.Command for running gardener-shoot-migrator.
+func NewCommand() *cobra.Command {
+	opts := &cmd.Options{
+		IOStreams: genericiooptions.IOStreams{In: os.Stdin, Out: os.Stdout, ErrOut: os.Stderr},
+	}
+
+	cmd := &cobra.Command{
+		Use:   Name,
+		Short: Name + " migrates shoot clusters between Gardener landscapes.",
+	}
+
+	// don't output usage on further errors raised during execution
+	cmd.SilenceUsage = true
+
+	cmd.AddCommand(
+		export.NewCommand(opts),
+		importcmd.NewCommand(opts),
+	)
+
+	return cmd
+}
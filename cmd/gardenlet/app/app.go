@@ -7,6 +7,7 @@ package app
 import (
 	"context"
 	"fmt"
+	"maps"
 	"net"
 	"net/http"
 	"os"
@@ -72,6 +73,7 @@ import (
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	"github.com/gardener/gardener/pkg/utils/gardener/gardenlet"
 	"github.com/gardener/gardener/pkg/utils/retry"
+	"github.com/gardener/gardener/pkg/utils/tracing"
 )
 
 // Name is a const for the name of this component.
@@ -120,12 +122,29 @@ func run(ctx context.Context, cancel context.CancelFunc, log logr.Logger, cfg *g
 
 	var extraHandlers map[string]http.Handler
 	if cfg.Debugging != nil && ptr.Deref(cfg.Debugging.EnableProfiling, false) {
-		extraHandlers = routes.ProfilingHandlers
+		extraHandlers = maps.Clone(routes.ProfilingHandlers)
+		maps.Copy(extraHandlers, routes.FlowHandlers)
 		if ptr.Deref(cfg.Debugging.EnableContentionProfiling, false) {
 			goruntime.SetBlockProfileRate(1)
 		}
 	}
 
+	if cfg.Tracing != nil {
+		log.Info("Setting up tracing", "endpoint", cfg.Tracing.Endpoint)
+		shutdownTracing, err := tracing.Setup(ctx, Name, cfg.Tracing.Endpoint, ptr.Deref(cfg.Tracing.Insecure, false))
+		if err != nil {
+			return fmt.Errorf("failed setting up tracing: %w", err)
+		}
+		defer func() {
+			shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer shutdownCancel()
+
+			if err := shutdownTracing(shutdownCtx); err != nil {
+				log.Error(err, "Failed flushing traces")
+			}
+		}()
+	}
+
 	log.Info("Setting up manager")
 	mgr, err := manager.New(runtimeRESTConfig, manager.Options{
 		Logger:                  log,
@@ -290,6 +309,9 @@ func (g *garden) Start(ctx context.Context) error {
 					&operationsv1alpha1.Bastion{}: {
 						Field: fields.SelectorFromSet(fields.Set{operations.BastionSeedName: g.config.SeedConfig.Name}),
 					},
+					&operationsv1alpha1.ShootRestore{}: {
+						Field: fields.SelectorFromSet(fields.Set{operations.ShootRestoreSeedName: g.config.SeedConfig.Name}),
+					},
 					// Gardenlet should watch secrets/serviceAccounts only in the seed namespace of the seed it is responsible for.
 					&corev1.Secret{}: {
 						Namespaces: map[string]cache.Config{seedNamespace: {}},
@@ -881,6 +903,8 @@ func addAllFieldIndexes(ctx context.Context, i client.FieldIndexer) error {
 			indexer.AddBackupBucketSeedName,
 			indexer.AddBackupEntrySeedName,
 			indexer.AddControllerInstallationSeedRefName,
+			// operations API group
+			indexer.AddShootRestoreShootName,
 		)
 	}
 
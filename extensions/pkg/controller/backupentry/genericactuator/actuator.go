@@ -47,7 +47,29 @@ func NewActuator(mgr manager.Manager, backupEntryDelegate BackupEntryDelegate) b
 
 // Reconcile reconciles the update of a BackupEntry.
 func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, be *extensionsv1alpha1.BackupEntry) error {
-	return a.deployEtcdBackupSecret(ctx, log, be)
+	if err := a.deployEtcdBackupSecret(ctx, log, be); err != nil {
+		return err
+	}
+
+	return a.reportUsage(ctx, log, be)
+}
+
+func (a *actuator) reportUsage(ctx context.Context, log logr.Logger, be *extensionsv1alpha1.BackupEntry) error {
+	usageReporter, ok := a.backupEntryDelegate.(BackupEntryUsageReporter)
+	if !ok {
+		return nil
+	}
+
+	usage, err := usageReporter.GetUsage(ctx, log, be)
+	if err != nil {
+		// The usage is informational only, hence failing to determine it must not block the reconciliation.
+		log.Error(err, "Failed to determine usage of BackupEntry")
+		return nil
+	}
+
+	patch := client.MergeFrom(be.DeepCopy())
+	be.Status.Usage = usage
+	return a.client.Status().Patch(ctx, be, patch)
 }
 
 func (a *actuator) deployEtcdBackupSecret(ctx context.Context, log logr.Logger, be *extensionsv1alpha1.BackupEntry) error {
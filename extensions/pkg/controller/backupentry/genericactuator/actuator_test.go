@@ -6,6 +6,7 @@ package genericactuator_test
 
 import (
 	"context"
+	"errors"
 	"maps"
 
 	"github.com/go-logr/logr"
@@ -23,7 +24,9 @@ import (
 	"github.com/gardener/gardener/extensions/pkg/controller/backupentry"
 	"github.com/gardener/gardener/extensions/pkg/controller/backupentry/genericactuator"
 	extensionsmockgenericactuator "github.com/gardener/gardener/extensions/pkg/controller/backupentry/genericactuator/mock"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/logger"
 	"github.com/gardener/gardener/pkg/utils/test"
 	. "github.com/gardener/gardener/pkg/utils/test/matchers"
@@ -292,6 +295,56 @@ var _ = Describe("Actuator", func() {
 				Expect(fakeClient.Get(ctx, etcdBackupSecretKey, &corev1.Secret{})).To(BeNotFoundError())
 			})
 		})
+
+		Context("usage reporting", func() {
+			var usage *gardencorev1beta1.BackupEntryUsage
+
+			BeforeEach(func() {
+				usage = &gardencorev1beta1.BackupEntryUsage{
+					SizeBytes:      1024,
+					ObjectCount:    3,
+					LastUpdateTime: metav1.Now().Rfc3339Copy(),
+				}
+
+				fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithObjects(seedNamespace, backupEntrySecret, backupEntry).WithStatusSubresource(backupEntry).Build()
+				mgr.Client = fakeClient
+				backupEntryDelegate.EXPECT().GetETCDSecretData(ctx, gomock.AssignableToTypeOf(logr.Logger{}), gomock.Any(), backupProviderSecretData).Return(etcdBackupSecretData, nil)
+			})
+
+			It("should report the usage if the delegate supports it", func() {
+				a = genericactuator.NewActuator(mgr, &usageReportingDelegate{
+					BackupEntryDelegate: backupEntryDelegate,
+					getUsage: func() (*gardencorev1beta1.BackupEntryUsage, error) {
+						return usage, nil
+					},
+				})
+				Expect(a.Reconcile(ctx, log, backupEntry)).To(Succeed())
+
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(backupEntry), backupEntry)).To(Succeed())
+				Expect(backupEntry.Status.Usage).To(Equal(usage))
+			})
+
+			It("should not fail if the usage cannot be determined", func() {
+				a = genericactuator.NewActuator(mgr, &usageReportingDelegate{
+					BackupEntryDelegate: backupEntryDelegate,
+					getUsage: func() (*gardencorev1beta1.BackupEntryUsage, error) {
+						return nil, errors.New("fake")
+					},
+				})
+				Expect(a.Reconcile(ctx, log, backupEntry)).To(Succeed())
+
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(backupEntry), backupEntry)).To(Succeed())
+				Expect(backupEntry.Status.Usage).To(BeNil())
+			})
+
+			It("should not report any usage if the delegate does not support it", func() {
+				a = genericactuator.NewActuator(mgr, backupEntryDelegate)
+				Expect(a.Reconcile(ctx, log, backupEntry)).To(Succeed())
+
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(backupEntry), backupEntry)).To(Succeed())
+				Expect(backupEntry.Status.Usage).To(BeNil())
+			})
+		})
 	})
 
 	Context("#Delete", func() {
@@ -342,3 +395,12 @@ var _ = Describe("Actuator", func() {
 		})
 	})
 })
+
+type usageReportingDelegate struct {
+	genericactuator.BackupEntryDelegate
+	getUsage func() (*gardencorev1beta1.BackupEntryUsage, error)
+}
+
+func (d *usageReportingDelegate) GetUsage(_ context.Context, _ logr.Logger, _ *extensionsv1alpha1.BackupEntry) (*gardencorev1beta1.BackupEntryUsage, error) {
+	return d.getUsage()
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/go-logr/logr"
 
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 )
 
@@ -19,3 +20,10 @@ type BackupEntryDelegate interface {
 	// GetETCDSecretData returns the updated secret data as per provider requirement.
 	GetETCDSecretData(context.Context, logr.Logger, *extensionsv1alpha1.BackupEntry, map[string][]byte) (map[string][]byte, error)
 }
+
+// BackupEntryUsageReporter can optionally be implemented by a BackupEntryDelegate if it is able to determine the
+// storage used by the backups of a BackupEntry. The reported usage is written to the status of the BackupEntry.
+type BackupEntryUsageReporter interface {
+	// GetUsage returns the size and the number of objects of the backups of the BackupEntry.
+	GetUsage(context.Context, logr.Logger, *extensionsv1alpha1.BackupEntry) (*gardencorev1beta1.BackupEntryUsage, error)
+}
@@ -62,6 +62,8 @@ func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	}
 
 	operationType := v1beta1helper.ComputeOperationType(be.ObjectMeta, be.Status.LastOperation)
+	ctx, span := extensionscontroller.StartSpan(ctx, extensionsv1alpha1.BackupEntryResource, be, operationType)
+	defer span.End()
 
 	switch {
 	case extensionscontroller.ShouldSkipOperation(operationType, be):
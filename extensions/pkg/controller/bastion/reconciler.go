@@ -71,6 +71,8 @@ func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	}
 
 	operationType := v1beta1helper.ComputeOperationType(bastion.ObjectMeta, bastion.Status.LastOperation)
+	ctx, span := extensionscontroller.StartSpan(ctx, extensionsv1alpha1.BastionResource, bastion, operationType)
+	defer span.End()
 
 	switch {
 	case bastion.DeletionTimestamp != nil:
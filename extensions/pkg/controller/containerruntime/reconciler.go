@@ -73,6 +73,8 @@ func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	}
 
 	operationType := v1beta1helper.ComputeOperationType(cr.ObjectMeta, cr.Status.LastOperation)
+	ctx, span := extensionscontroller.StartSpan(ctx, extensionsv1alpha1.ContainerRuntimeResource, cr, operationType)
+	defer span.End()
 
 	switch {
 	case extensionscontroller.ShouldSkipOperation(operationType, cr):
@@ -74,6 +74,8 @@ func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	}
 
 	operationType := v1beta1helper.ComputeOperationType(cp.ObjectMeta, cp.Status.LastOperation)
+	ctx, span := extensionscontroller.StartSpan(ctx, extensionsv1alpha1.ControlPlaneResource, cp, operationType)
+	defer span.End()
 
 	switch {
 	case extensionscontroller.ShouldSkipOperation(operationType, cp):
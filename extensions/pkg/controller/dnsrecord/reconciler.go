@@ -79,6 +79,8 @@ func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	}
 
 	operationType := v1beta1helper.ComputeOperationType(dns.ObjectMeta, dns.Status.LastOperation)
+	ctx, span := extensionscontroller.StartSpan(ctx, extensionsv1alpha1.DNSRecordResource, dns, operationType)
+	defer span.End()
 
 	switch {
 	case extensionscontroller.ShouldSkipOperation(operationType, dns):
@@ -88,6 +88,8 @@ func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	}
 
 	operationType := v1beta1helper.ComputeOperationType(ex.ObjectMeta, ex.Status.LastOperation)
+	ctx, span := extensionscontroller.StartSpan(ctx, extensionsv1alpha1.ExtensionResource, ex, operationType)
+	defer span.End()
 
 	switch {
 	case extensionscontroller.ShouldSkipOperation(operationType, ex):
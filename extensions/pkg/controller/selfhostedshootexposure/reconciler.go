@@ -65,6 +65,8 @@ func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	}
 
 	operationType := v1beta1helper.ComputeOperationType(selfHostedShootExposure.ObjectMeta, selfHostedShootExposure.Status.LastOperation)
+	ctx, span := extensionscontroller.StartSpan(ctx, extensionsv1alpha1.SelfHostedShootExposureResource, selfHostedShootExposure, operationType)
+	defer span.End()
 
 	switch {
 	case selfHostedShootExposure.DeletionTimestamp != nil:
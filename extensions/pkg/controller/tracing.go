@@ -0,0 +1,32 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils/tracing"
+)
+
+// StartSpan starts a span for the given operation on the extension object of the given kind. If gardenlet propagated
+// the trace context of the flow which requested the operation in the annotations of the object, the span continues
+// this trace. The caller must end the returned span.
+func StartSpan(ctx context.Context, kind string, obj extensionsv1alpha1.Object, operationType gardencorev1beta1.LastOperationType) (context.Context, trace.Span) {
+	return tracing.Tracer().Start(
+		tracing.ContextFromAnnotations(ctx, obj),
+		string(operationType)+" "+kind,
+		trace.WithAttributes(
+			attribute.String("gardener.extension.kind", kind),
+			attribute.String("gardener.extension.type", obj.GetExtensionSpec().GetExtensionType()),
+			attribute.String("gardener.extension.namespace", obj.GetNamespace()),
+			attribute.String("gardener.extension.name", obj.GetName()),
+		),
+	)
+}
@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace/noop"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	. "github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+var _ = Describe("Tracing", func() {
+	Describe("#StartSpan", func() {
+		var (
+			ctx            = context.Background()
+			spanRecorder   *tracetest.SpanRecorder
+			infrastructure *extensionsv1alpha1.Infrastructure
+		)
+
+		BeforeEach(func() {
+			spanRecorder = tracetest.NewSpanRecorder()
+			otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spanRecorder)))
+			DeferCleanup(func() { otel.SetTracerProvider(noop.NewTracerProvider()) })
+
+			infrastructure = &extensionsv1alpha1.Infrastructure{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "shoot--foo--bar"},
+				Spec:       extensionsv1alpha1.InfrastructureSpec{DefaultSpec: extensionsv1alpha1.DefaultSpec{Type: "local"}},
+			}
+		})
+
+		It("should start a new trace if the object does not contain a trace context", func() {
+			_, span := StartSpan(ctx, extensionsv1alpha1.InfrastructureResource, infrastructure, gardencorev1beta1.LastOperationTypeReconcile)
+			span.End()
+
+			spans := spanRecorder.Ended()
+			Expect(spans).To(HaveLen(1))
+			Expect(spans[0].Name()).To(Equal("Reconcile Infrastructure"))
+			Expect(spans[0].Parent().IsValid()).To(BeFalse())
+			Expect(spans[0].Attributes()).To(ConsistOf(
+				attribute.String("gardener.extension.kind", "Infrastructure"),
+				attribute.String("gardener.extension.type", "local"),
+				attribute.String("gardener.extension.namespace", "shoot--foo--bar"),
+				attribute.String("gardener.extension.name", "foo"),
+			))
+		})
+
+		It("should continue the trace propagated in the annotations", func() {
+			infrastructure.Annotations = map[string]string{"gardener.cloud/traceparent": "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"}
+
+			_, span := StartSpan(ctx, extensionsv1alpha1.InfrastructureResource, infrastructure, gardencorev1beta1.LastOperationTypeReconcile)
+			span.End()
+
+			spans := spanRecorder.Ended()
+			Expect(spans).To(HaveLen(1))
+			Expect(spans[0].SpanContext().TraceID().String()).To(Equal("4bf92f3577b34da6a3ce929d0e0e4736"))
+			Expect(spans[0].Parent().SpanID().String()).To(Equal("00f067aa0ba902b7"))
+			Expect(spans[0].Parent().IsRemote()).To(BeTrue())
+		})
+	})
+})
@@ -14,10 +14,12 @@ import (
 	"github.com/spf13/cobra/doc"
 
 	gardenadm "github.com/gardener/gardener/cmd/gardenadm/app"
+	shootmigrator "github.com/gardener/gardener/cmd/gardener-shoot-migrator/app"
 )
 
 var commands = map[string]*cobra.Command{
-	"gardenadm": gardenadm.NewCommand(),
+	"gardenadm":               gardenadm.NewCommand(),
+	"gardener-shoot-migrator": shootmigrator.NewCommand(),
 }
 
 func main() {
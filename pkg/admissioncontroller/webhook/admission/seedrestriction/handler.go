@@ -182,13 +182,30 @@ func (h *Handler) admitBackupEntry(ctx context.Context, seedName string, request
 
 func (h *Handler) admitSourceBackupEntry(ctx context.Context, backupEntry *gardencorev1beta1.BackupEntry) admission.Response {
 	// The source BackupEntry is created during the restore phase of control plane migration
-	// so allow creations only if the shoot that owns the BackupEntry is currently being restored.
+	// so allow creations only if the shoot that owns the BackupEntry is currently being restored or created from the
+	// backup of another shoot.
 	shootName := gardenerutils.GetShootNameFromOwnerReferences(backupEntry)
 	shoot := &gardencorev1beta1.Shoot{}
 	if err := h.Client.Get(ctx, client.ObjectKey{Namespace: backupEntry.Namespace, Name: shootName}, shoot); err != nil {
 		return admission.Errored(http.StatusInternalServerError, err)
 	}
 
+	// The source BackupEntry is also created when a shoot is created from the backup of another shoot. In this case, it
+	// must point to the bucket of the source shoot's BackupEntry.
+	if shoot.Spec.Source != nil && shoot.Status.LastOperation != nil && shoot.Status.LastOperation.Type == gardencorev1beta1.LastOperationTypeCreate &&
+		shoot.Status.LastOperation.State == gardencorev1beta1.LastOperationStateProcessing {
+		sourceShootBackupEntry, err := gardenerutils.GetBackupEntryOfShoot(ctx, h.Client, shoot.Spec.Source.Namespace, shoot.Spec.Source.Name)
+		if err != nil {
+			return admission.Errored(http.StatusForbidden, err)
+		}
+
+		if backupEntry.Spec.BucketName != sourceShootBackupEntry.Spec.BucketName {
+			return admission.Errored(http.StatusForbidden, fmt.Errorf("bucket of source BackupEntry must equal bucket of BackupEntry %s of source shoot", sourceShootBackupEntry.Name))
+		}
+
+		return admission.Allowed("")
+	}
+
 	if shoot.Status.LastOperation == nil || shoot.Status.LastOperation.Type != gardencorev1beta1.LastOperationTypeRestore ||
 		shoot.Status.LastOperation.State != gardencorev1beta1.LastOperationStateProcessing {
 		return admission.Errored(http.StatusForbidden, fmt.Errorf("creation of source BackupEntry is only allowed during shoot Restore operation (shoot: %s)", shootName))
@@ -685,6 +685,64 @@ var _ = Describe("handler", func() {
 
 							Expect(handler.Handle(ctx, request)).To(Equal(responseAllowed))
 						})
+
+						Context("shoot is created from the backup of a source shoot", func() {
+							var sourceShootBackupEntry *gardencorev1beta1.BackupEntry
+
+							BeforeEach(func() {
+								shoot.Spec.Source = &gardencorev1beta1.ShootSource{Namespace: "garden-other", Name: "source"}
+								shoot.Status.LastOperation.Type = gardencorev1beta1.LastOperationTypeCreate
+
+								sourceShootBackupEntry = &gardencorev1beta1.BackupEntry{
+									ObjectMeta: metav1.ObjectMeta{
+										Name:            "shoot--other--source--1234",
+										Namespace:       "garden-other",
+										OwnerReferences: []metav1.OwnerReference{{Name: "source", Kind: "Shoot"}},
+									},
+									Spec: gardencorev1beta1.BackupEntrySpec{
+										BucketName: bucketName,
+										SeedName:   new("some-different-seedname"),
+									},
+								}
+							})
+
+							It("should allow creation of source BackupEntry if it points to the bucket of the source shoot", func() {
+								Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+								Expect(fakeClient.Create(ctx, sourceShootBackupEntry)).To(Succeed())
+
+								Expect(handler.Handle(ctx, request)).To(Equal(responseAllowed))
+							})
+
+							It("should forbid the request because the source BackupEntry points to a different bucket", func() {
+								sourceShootBackupEntry.Spec.BucketName = "some-different-bucket"
+								Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+								Expect(fakeClient.Create(ctx, sourceShootBackupEntry)).To(Succeed())
+
+								Expect(handler.Handle(ctx, request)).To(Equal(admission.Response{
+									AdmissionResponse: admissionv1.AdmissionResponse{
+										Allowed: false,
+										Result: &metav1.Status{
+											Code:    int32(http.StatusForbidden),
+											Message: "bucket of source BackupEntry must equal bucket of BackupEntry shoot--other--source--1234 of source shoot",
+										},
+									},
+								}))
+							})
+
+							It("should forbid the request because the source shoot has no BackupEntry", func() {
+								Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+
+								Expect(handler.Handle(ctx, request)).To(Equal(admission.Response{
+									AdmissionResponse: admissionv1.AdmissionResponse{
+										Allowed: false,
+										Result: &metav1.Status{
+											Code:    int32(http.StatusForbidden),
+											Message: "no BackupEntry found for shoot garden-other/source",
+										},
+									},
+								}))
+							})
+						})
 					})
 				})
 			})
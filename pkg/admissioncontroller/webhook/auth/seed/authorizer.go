@@ -83,6 +83,7 @@ var (
 	seedResource                      = gardencorev1beta1.Resource("seeds")
 	serviceAccountResource            = corev1.Resource("serviceaccounts")
 	shootResource                     = gardencorev1beta1.Resource("shoots")
+	shootRestoreResource              = operationsv1alpha1.Resource("shootrestores")
 	shootStateResource                = gardencorev1beta1.Resource("shootstates")
 	credentialsBindingResource        = securityv1alpha1.Resource("credentialsbindings")
 	workloadIdentityResource          = securityv1alpha1.Resource("workloadidentities")
@@ -228,6 +229,12 @@ func (a *authorizer) Authorize(_ context.Context, attrs auth.Attributes) (auth.D
 			return a.authorizeServiceAccount(requestAuthorizer, attrs)
 		case shootResource:
 			return a.authorizeShoot(requestAuthorizer, attrs)
+		case shootRestoreResource:
+			return requestAuthorizer.Check(graph.VertexTypeShootRestore, attrs,
+				authwebhook.WithAllowedVerbs("get", "list", "watch", "update", "patch"),
+				authwebhook.WithAllowedSubresources("status"),
+				authwebhook.WithFieldSelectors(map[string]string{operations.ShootRestoreSeedName: seedName}),
+			)
 		case shootStateResource:
 			return requestAuthorizer.Check(graph.VertexTypeShootState, attrs,
 				authwebhook.WithAllowedVerbs("get", "update", "patch", "delete", "list", "watch"),
@@ -339,8 +346,9 @@ func (a *authorizer) authorizeShoot(requestAuthorizer *authwebhook.RequestAuthor
 		return auth.DecisionAllow, "", nil
 	}
 
+	// The custom "mark-exported" verb is required for setting the exported annotation at the end of the export flow.
 	return requestAuthorizer.Check(graph.VertexTypeShoot, attrs,
-		authwebhook.WithAllowedVerbs("get", "list", "watch", "update", "patch"),
+		authwebhook.WithAllowedVerbs("get", "list", "watch", "update", "patch", "mark-exported"),
 		authwebhook.WithAllowedSubresources("status", "finalizers"),
 		authwebhook.WithLabelSelectors(map[string]string{v1beta1constants.LabelPrefixSeedName + requestAuthorizer.ToName: "true"}),
 	)
@@ -10,6 +10,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
+	schedulervalidation "github.com/gardener/gardener/pkg/api/config/scheduler/v1alpha1/validation"
 	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/controllermanager/v1alpha1"
 	"github.com/gardener/gardener/pkg/logger"
 	validationutils "github.com/gardener/gardener/pkg/utils/validation"
@@ -35,6 +36,11 @@ func ValidateControllerManagerConfiguration(conf *controllermanagerconfigv1alpha
 	}
 
 	allErrs = append(allErrs, validateControllerManagerControllerConfiguration(conf.Controllers, field.NewPath("controllers"))...)
+
+	if conf.ShootScheduler != nil {
+		allErrs = append(allErrs, schedulervalidation.ValidateShootSchedulerConfiguration(conf.ShootScheduler, field.NewPath("shootScheduler"))...)
+	}
+
 	return allErrs
 }
 
@@ -46,6 +52,10 @@ func validateControllerManagerControllerConfiguration(conf controllermanagerconf
 		allErrs = append(allErrs, validateProjectControllerConfiguration(conf.Project, projectFldPath)...)
 	}
 
+	if conf.SeedRebalancer != nil {
+		allErrs = append(allErrs, validateSeedRebalancerControllerConfiguration(conf.SeedRebalancer, fldPath.Child("seedRebalancer"))...)
+	}
+
 	shootStateFldPath := fldPath.Child("shootState")
 	if conf.ShootState != nil {
 		allErrs = append(allErrs, validateShootStateControllerConfiguration(conf.ShootState, shootStateFldPath)...)
@@ -70,6 +80,26 @@ func validateProjectQuotaConfiguration(conf controllermanagerconfigv1alpha1.Quot
 	return allErrs
 }
 
+func validateSeedRebalancerControllerConfiguration(conf *controllermanagerconfigv1alpha1.SeedRebalancerControllerConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if conf.MaxMigrations != nil && *conf.MaxMigrations <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxMigrations"), *conf.MaxMigrations, "must be greater than 0"))
+	}
+	if conf.MinScoreImprovement != nil {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(*conf.MinScoreImprovement, fldPath.Child("minScoreImprovement"))...)
+	}
+	if conf.MaxConcurrentMigrations != nil && *conf.MaxConcurrentMigrations <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxConcurrentMigrations"), *conf.MaxConcurrentMigrations, "must be greater than 0"))
+	}
+	if conf.ProposalTTL != nil && conf.ProposalTTL.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("proposalTTL"), conf.ProposalTTL.Duration.String(), "must be greater than 0"))
+	}
+	if conf.MaxRetainedProposals != nil {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(*conf.MaxRetainedProposals), fldPath.Child("maxRetainedProposals"))...)
+	}
+	return allErrs
+}
+
 func validateShootStateControllerConfiguration(conf *controllermanagerconfigv1alpha1.ShootStateControllerConfiguration, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	if conf.ConcurrentSyncs != nil {
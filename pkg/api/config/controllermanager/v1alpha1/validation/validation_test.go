@@ -94,6 +94,27 @@ var _ = Describe("#ValidateControllerManagerConfiguration", func() {
 		})
 	})
 
+	Context("shoot scheduler configuration", func() {
+		BeforeEach(func() {
+			controllermanagerconfigv1alpha1.SetObjectDefaults_ControllerManagerConfiguration(conf)
+		})
+
+		It("should allow the default shoot scheduler configuration", func() {
+			Expect(ValidateControllerManagerConfiguration(conf)).To(BeEmpty())
+		})
+
+		It("should forbid unsupported strategies", func() {
+			conf.ShootScheduler.Strategy = "Foo"
+
+			Expect(ValidateControllerManagerConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("shootScheduler.strategy"),
+				})),
+			))
+		})
+	})
+
 	Context("ProjectControllerConfiguration", func() {
 		Context("ProjectQuotaConfiguration", func() {
 			BeforeEach(func() {
@@ -156,6 +177,48 @@ var _ = Describe("#ValidateControllerManagerConfiguration", func() {
 		})
 	})
 
+	Context("SeedRebalancerControllerConfiguration", func() {
+		BeforeEach(func() {
+			conf.Controllers.SeedRebalancer = &controllermanagerconfigv1alpha1.SeedRebalancerControllerConfiguration{}
+			controllermanagerconfigv1alpha1.SetObjectDefaults_ControllerManagerConfiguration(conf)
+		})
+
+		It("should allow the default configuration", func() {
+			Expect(ValidateControllerManagerConfiguration(conf)).To(BeEmpty())
+		})
+
+		It("should forbid invalid values", func() {
+			conf.Controllers.SeedRebalancer.MaxMigrations = new(0)
+			conf.Controllers.SeedRebalancer.MinScoreImprovement = new(int64(-1))
+			conf.Controllers.SeedRebalancer.MaxConcurrentMigrations = new(-1)
+			conf.Controllers.SeedRebalancer.ProposalTTL = &metav1.Duration{}
+			conf.Controllers.SeedRebalancer.MaxRetainedProposals = new(-1)
+
+			Expect(ValidateControllerManagerConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.seedRebalancer.maxMigrations"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.seedRebalancer.minScoreImprovement"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.seedRebalancer.maxConcurrentMigrations"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.seedRebalancer.proposalTTL"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.seedRebalancer.maxRetainedProposals"),
+				})),
+			))
+		})
+	})
+
 	Context("ShootStateControllerConfiguration", func() {
 		Context("ConcurrentSyncs", func() {
 			var (
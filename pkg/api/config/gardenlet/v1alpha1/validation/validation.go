@@ -119,6 +119,10 @@ func ValidateGardenletConfiguration(cfg *gardenletconfigv1alpha1.GardenletConfig
 		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(ptr.Deref(nodeTolerationCfg.DefaultUnreachableTolerationSeconds, 0), nodeTolerationConfigPath.Child("defaultUnreachableTolerationSeconds"))...)
 	}
 
+	if cfg.Tracing != nil {
+		allErrs = append(allErrs, validationutils.ValidateHostPort(cfg.Tracing.Endpoint, fldPath.Child("tracing", "endpoint"))...)
+	}
+
 	return allErrs
 }
 
@@ -799,6 +799,25 @@ var _ = Describe("GardenletConfiguration", func() {
 				)
 			})
 		})
+
+		Context("tracing", func() {
+			It("should pass with valid tracing configuration", func() {
+				cfg.Tracing = &gardenletconfigv1alpha1.TracingConfiguration{Endpoint: "otel-collector.observability.svc:4317"}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(BeEmpty())
+			})
+
+			It("should fail with invalid endpoint", func() {
+				cfg.Tracing = &gardenletconfigv1alpha1.TracingConfiguration{Endpoint: "otel-collector"}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("tracing.endpoint"),
+					})),
+				))
+			})
+		})
 	})
 
 	Describe("#ValidateGardenletConfigurationUpdate", func() {
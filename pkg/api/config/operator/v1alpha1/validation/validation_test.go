@@ -291,4 +291,23 @@ var _ = Describe("#ValidateOperatorConfiguration", func() {
 			)
 		})
 	})
+
+	Context("tracing", func() {
+		It("should pass with valid tracing configuration", func() {
+			conf.Tracing = &operatorconfigv1alpha1.TracingConfiguration{Endpoint: "otel-collector.observability.svc:4317"}
+
+			Expect(ValidateOperatorConfiguration(conf)).To(BeEmpty())
+		})
+
+		It("should fail with missing endpoint", func() {
+			conf.Tracing = &operatorconfigv1alpha1.TracingConfiguration{}
+
+			Expect(ValidateOperatorConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("tracing.endpoint"),
+				})),
+			))
+		})
+	})
 })
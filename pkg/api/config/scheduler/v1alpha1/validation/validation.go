@@ -5,11 +5,13 @@
 package validation
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/scheduler/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/gardener/gardener/pkg/logger"
 	validationutils "github.com/gardener/gardener/pkg/utils/validation"
 )
@@ -49,13 +51,29 @@ func validateSchedulerControllerConfiguration(schedulers schedulerconfigv1alpha1
 	}
 
 	if schedulers.Shoot != nil {
-		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(schedulers.Shoot.ConcurrentSyncs), fldPath.Child("shoot", "concurrentSyncs"))...)
-		allErrs = append(allErrs, validateStrategy(schedulers.Shoot.Strategy, fldPath.Child("shoot", "strategy"))...)
+		allErrs = append(allErrs, ValidateShootSchedulerConfiguration(schedulers.Shoot, fldPath.Child("shoot"))...)
 	}
 
 	return allErrs
 }
 
+// ValidateShootSchedulerConfiguration validates the shoot scheduler configuration.
+func ValidateShootSchedulerConfiguration(config *schedulerconfigv1alpha1.ShootSchedulerConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(config.ConcurrentSyncs), fldPath.Child("concurrentSyncs"))...)
+	allErrs = append(allErrs, validateStrategy(config.Strategy, fldPath.Child("strategy"))...)
+
+	if config.Plugins != nil {
+		allErrs = append(allErrs, validatePluginSet(config.Plugins.Filter, false, fldPath.Child("plugins", "filter"))...)
+		allErrs = append(allErrs, validatePluginSet(config.Plugins.Score, true, fldPath.Child("plugins", "score"))...)
+	}
+
+	allErrs = append(allErrs, validateUtilizationThresholds(config.UtilizationThresholds, fldPath.Child("utilizationThresholds"))...)
+
+	return allErrs
+}
+
 func validateStrategy(strategy schedulerconfigv1alpha1.CandidateDeterminationStrategy, fldPath *field.Path) field.ErrorList {
 	var (
 		allErrs             = field.ErrorList{}
@@ -74,3 +92,65 @@ func validateStrategy(strategy schedulerconfigv1alpha1.CandidateDeterminationStr
 
 	return allErrs
 }
+
+func validatePluginSet(pluginSet schedulerconfigv1alpha1.PluginSet, weighted bool, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		enabled = sets.New[string]()
+	)
+
+	for i, plugin := range pluginSet.Enabled {
+		idxPath := fldPath.Child("enabled").Index(i)
+
+		if plugin.Name == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "plugin name must not be empty"))
+		} else if plugin.Name == "*" {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), plugin.Name, "wildcard is only allowed for disabled plugins"))
+		} else if enabled.Has(plugin.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), plugin.Name))
+		}
+		enabled.Insert(plugin.Name)
+
+		if plugin.Weight != nil {
+			if !weighted {
+				allErrs = append(allErrs, field.Forbidden(idxPath.Child("weight"), "weight is only supported for score plugins"))
+			} else if *plugin.Weight <= 0 {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("weight"), *plugin.Weight, "weight must be positive"))
+			}
+		}
+	}
+
+	for i, plugin := range pluginSet.Disabled {
+		if plugin.Name == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("disabled").Index(i).Child("name"), "plugin name must not be empty"))
+		}
+	}
+
+	return allErrs
+}
+
+var supportedUtilizationResources = sets.New(corev1.ResourceCPU, corev1.ResourceMemory, gardencorev1beta1.ResourceEtcdVolume)
+
+func validateUtilizationThresholds(thresholds []schedulerconfigv1alpha1.SeedUtilizationThreshold, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs   = field.ErrorList{}
+		resources = sets.New[corev1.ResourceName]()
+	)
+
+	for i, threshold := range thresholds {
+		idxPath := fldPath.Index(i)
+
+		if !supportedUtilizationResources.Has(threshold.Resource) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("resource"), threshold.Resource, sets.List(supportedUtilizationResources)))
+		} else if resources.Has(threshold.Resource) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("resource"), threshold.Resource))
+		}
+		resources.Insert(threshold.Resource)
+
+		if threshold.Percentage <= 0 || threshold.Percentage > 100 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("percentage"), threshold.Percentage, "percentage must be in the range (0, 100]"))
+		}
+	}
+
+	return allErrs
+}
@@ -160,5 +160,100 @@ var _ = Describe("#ValidateConfiguration", func() {
 				"Field": Equal("schedulers.shoot.concurrentSyncs"),
 			}))))
 		})
+
+		It("should pass because the configured plugins are valid", func() {
+			configuration := conf.DeepCopy()
+			configuration.Schedulers.Shoot.Plugins = &schedulerconfigv1alpha1.Plugins{
+				Filter: schedulerconfigv1alpha1.PluginSet{
+					Enabled:  []schedulerconfigv1alpha1.Plugin{{Name: "Foo"}},
+					Disabled: []schedulerconfigv1alpha1.Plugin{{Name: "SameRegion"}},
+				},
+				Score: schedulerconfigv1alpha1.PluginSet{
+					Enabled:  []schedulerconfigv1alpha1.Plugin{{Name: "MinimalDistance", Weight: new(int32(2))}},
+					Disabled: []schedulerconfigv1alpha1.Plugin{{Name: "*"}},
+				},
+			}
+
+			Expect(ValidateConfiguration(configuration)).To(BeEmpty())
+		})
+
+		It("should fail because the configured plugins are invalid", func() {
+			configuration := conf.DeepCopy()
+			configuration.Schedulers.Shoot.Plugins = &schedulerconfigv1alpha1.Plugins{
+				Filter: schedulerconfigv1alpha1.PluginSet{
+					Enabled:  []schedulerconfigv1alpha1.Plugin{{Name: "Foo", Weight: new(int32(1))}, {Name: "*"}},
+					Disabled: []schedulerconfigv1alpha1.Plugin{{Name: ""}},
+				},
+				Score: schedulerconfigv1alpha1.PluginSet{
+					Enabled: []schedulerconfigv1alpha1.Plugin{{Name: "Bar", Weight: new(int32(0))}, {Name: "Bar"}, {Name: ""}},
+				},
+			}
+
+			Expect(ValidateConfiguration(configuration)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("schedulers.shoot.plugins.filter.enabled[0].weight"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("schedulers.shoot.plugins.filter.enabled[1].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("schedulers.shoot.plugins.filter.disabled[0].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("schedulers.shoot.plugins.score.enabled[0].weight"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("schedulers.shoot.plugins.score.enabled[1].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("schedulers.shoot.plugins.score.enabled[2].name"),
+				})),
+			))
+		})
+
+		It("should pass because the configured utilization thresholds are valid", func() {
+			configuration := conf.DeepCopy()
+			configuration.Schedulers.Shoot.UtilizationThresholds = []schedulerconfigv1alpha1.SeedUtilizationThreshold{
+				{Resource: "cpu", Percentage: 80},
+				{Resource: "memory", Percentage: 100},
+				{Resource: "etcd-volume", Percentage: 90},
+			}
+
+			Expect(ValidateConfiguration(configuration)).To(BeEmpty())
+		})
+
+		It("should fail because the configured utilization thresholds are invalid", func() {
+			configuration := conf.DeepCopy()
+			configuration.Schedulers.Shoot.UtilizationThresholds = []schedulerconfigv1alpha1.SeedUtilizationThreshold{
+				{Resource: "cpu", Percentage: 0},
+				{Resource: "cpu", Percentage: 80},
+				{Resource: "gpu", Percentage: 101},
+			}
+
+			Expect(ValidateConfiguration(configuration)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("schedulers.shoot.utilizationThresholds[0].percentage"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("schedulers.shoot.utilizationThresholds[1].resource"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("schedulers.shoot.utilizationThresholds[2].resource"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("schedulers.shoot.utilizationThresholds[2].percentage"),
+				})),
+			))
+		})
 	})
 })
@@ -65,6 +65,13 @@ func ShootNeedsForceDeletion(shoot *core.Shoot) bool {
 	return forceDelete
 }
 
+// IsShootPendingDeletion returns true if the shoot has been deleted but its actual deletion is delayed by the shoot
+// deletion grace period of its project.
+func IsShootPendingDeletion(shoot *core.Shoot) bool {
+	_, ok := shoot.Annotations[v1beta1constants.ShootPendingDeletionUntil]
+	return ok
+}
+
 // IsHAControlPlaneConfigured returns true if HA configuration for the shoot control plane has been set.
 func IsHAControlPlaneConfigured(shoot *core.Shoot) bool {
 	return shoot.Spec.ControlPlane != nil && shoot.Spec.ControlPlane.HighAvailability != nil
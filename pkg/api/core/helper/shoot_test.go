@@ -114,6 +114,19 @@ var _ = Describe("Helper", func() {
 			BeTrue()),
 	)
 
+	DescribeTable("#IsShootPendingDeletion",
+		func(shoot *core.Shoot, match gomegatypes.GomegaMatcher) {
+			Expect(IsShootPendingDeletion(shoot)).To(match)
+		},
+
+		Entry("no pending-deletion annotation present",
+			&core.Shoot{},
+			BeFalse()),
+		Entry("pending-deletion annotation present",
+			&core.Shoot{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{v1beta1constants.ShootPendingDeletionUntil: "2026-01-01T00:00:00Z"}}},
+			BeTrue()),
+	)
+
 	Describe("#IsHAControlPlaneConfigured", func() {
 		var shoot *core.Shoot
 
@@ -6,6 +6,7 @@ package helper
 
 import (
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 )
 
 // BackupBucketIsErroneous returns `true` if the given BackupBucket has a last error.
@@ -21,3 +22,9 @@ func BackupBucketIsErroneous(bb *gardencorev1beta1.BackupBucket) (bool, string)
 	}
 	return true, lastErr.Description
 }
+
+// IsBackupBucketImported returns true if the BackupBucket was imported together with a shoot from another garden
+// landscape, i.e., if the bucket is managed by the other garden.
+func IsBackupBucketImported(bb *gardencorev1beta1.BackupBucket) bool {
+	return bb.Annotations[v1beta1constants.BackupBucketImported] == "true"
+}
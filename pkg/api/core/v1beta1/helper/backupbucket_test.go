@@ -8,6 +8,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	gomegatypes "github.com/onsi/gomega/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	. "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
@@ -29,4 +30,15 @@ var _ = Describe("Helper", func() {
 			Equal("foo"),
 		),
 	)
+
+	DescribeTable("#IsBackupBucketImported",
+		func(annotations map[string]string, matcher gomegatypes.GomegaMatcher) {
+			Expect(IsBackupBucketImported(&gardencorev1beta1.BackupBucket{ObjectMeta: metav1.ObjectMeta{Annotations: annotations}})).To(matcher)
+		},
+
+		Entry("W/o annotations", nil, BeFalse()),
+		Entry("W/o imported annotation", map[string]string{"foo": "bar"}, BeFalse()),
+		Entry("W/ imported annotation", map[string]string{"backupbucket.gardener.cloud/imported": "true"}, BeTrue()),
+		Entry("W/ imported annotation set to false", map[string]string{"backupbucket.gardener.cloud/imported": "false"}, BeFalse()),
+	)
 })
@@ -185,3 +185,29 @@ func HasShootReconciliationsDisabledAnnotation(seed *gardencorev1beta1.Seed) boo
 	value, ok := seed.Annotations[v1beta1constants.AnnotationEmergencyStopShootReconciliations]
 	return ok && value == "true"
 }
+
+// HasSeedDrainAnnotation returns true if the given seed is cordoned and its shoots shall be migrated to other seeds.
+func HasSeedDrainAnnotation(seed *gardencorev1beta1.Seed) bool {
+	if seed == nil {
+		return false
+	}
+	return seed.Annotations[v1beta1constants.AnnotationSeedDrain] == "true"
+}
+
+// ControlPlaneMigrationPossible returns true if shoot control planes can be migrated from the source to the target seed,
+// i.e., both seeds have backups configured and use the same internal domain.
+func ControlPlaneMigrationPossible(source, target *gardencorev1beta1.Seed) bool {
+	if source.Spec.Backup == nil || target.Spec.Backup == nil {
+		return false
+	}
+
+	var sourceDomain, targetDomain string
+	if source.Spec.DNS.Internal != nil {
+		sourceDomain = source.Spec.DNS.Internal.Domain
+	}
+	if target.Spec.DNS.Internal != nil {
+		targetDomain = target.Spec.DNS.Internal.Domain
+	}
+
+	return sourceDomain == targetDomain
+}
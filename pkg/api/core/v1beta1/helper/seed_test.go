@@ -12,6 +12,7 @@ import (
 	gomegatypes "github.com/onsi/gomega/types"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	. "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
@@ -409,4 +410,35 @@ var _ = Describe("Helper", func() {
 			false,
 		),
 	)
+
+	DescribeTable("#HasSeedDrainAnnotation",
+		func(seed *gardencorev1beta1.Seed, expected bool) {
+			Expect(HasSeedDrainAnnotation(seed)).To(Equal(expected))
+		},
+
+		Entry("no seed", nil, false),
+		Entry("no annotation", &gardencorev1beta1.Seed{}, false),
+		Entry("annotation with other value", &gardencorev1beta1.Seed{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{"seed.gardener.cloud/drain": "false"}}}, false),
+		Entry("annotation set to true", &gardencorev1beta1.Seed{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{"seed.gardener.cloud/drain": "true"}}}, true),
+	)
+
+	DescribeTable("#ControlPlaneMigrationPossible",
+		func(source, target *gardencorev1beta1.Seed, expected bool) {
+			Expect(ControlPlaneMigrationPossible(source, target)).To(Equal(expected))
+		},
+
+		Entry("no backup on source seed", &gardencorev1beta1.Seed{}, &gardencorev1beta1.Seed{Spec: gardencorev1beta1.SeedSpec{Backup: &gardencorev1beta1.Backup{}}}, false),
+		Entry("no backup on target seed", &gardencorev1beta1.Seed{Spec: gardencorev1beta1.SeedSpec{Backup: &gardencorev1beta1.Backup{}}}, &gardencorev1beta1.Seed{}, false),
+		Entry("backups and no internal domains", &gardencorev1beta1.Seed{Spec: gardencorev1beta1.SeedSpec{Backup: &gardencorev1beta1.Backup{}}}, &gardencorev1beta1.Seed{Spec: gardencorev1beta1.SeedSpec{Backup: &gardencorev1beta1.Backup{}}}, true),
+		Entry("backups and same internal domains",
+			&gardencorev1beta1.Seed{Spec: gardencorev1beta1.SeedSpec{Backup: &gardencorev1beta1.Backup{}, DNS: gardencorev1beta1.SeedDNS{Internal: &gardencorev1beta1.SeedDNSProviderConfig{Domain: "example.com"}}}},
+			&gardencorev1beta1.Seed{Spec: gardencorev1beta1.SeedSpec{Backup: &gardencorev1beta1.Backup{}, DNS: gardencorev1beta1.SeedDNS{Internal: &gardencorev1beta1.SeedDNSProviderConfig{Domain: "example.com"}}}},
+			true,
+		),
+		Entry("backups and different internal domains",
+			&gardencorev1beta1.Seed{Spec: gardencorev1beta1.SeedSpec{Backup: &gardencorev1beta1.Backup{}, DNS: gardencorev1beta1.SeedDNS{Internal: &gardencorev1beta1.SeedDNSProviderConfig{Domain: "example.com"}}}},
+			&gardencorev1beta1.Seed{Spec: gardencorev1beta1.SeedSpec{Backup: &gardencorev1beta1.Backup{}, DNS: gardencorev1beta1.SeedDNS{Internal: &gardencorev1beta1.SeedDNSProviderConfig{Domain: "other.example.com"}}}},
+			false,
+		),
+	)
 })
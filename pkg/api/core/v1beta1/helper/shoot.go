@@ -8,11 +8,13 @@ import (
 	"fmt"
 	"slices"
 	"strconv"
+	"time"
 
 	"github.com/Masterminds/semver/v3"
 	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/ptr"
 
@@ -599,6 +601,34 @@ func ShouldPrepareShootForMigration(shoot *gardencorev1beta1.Shoot) bool {
 	return shoot.Status.SeedName != nil && shoot.Spec.SeedName != nil && *shoot.Spec.SeedName != *shoot.Status.SeedName
 }
 
+// ShouldPrepareShootForExport determines whether the controller should prepare the shoot control plane for the export
+// to another garden landscape.
+func ShouldPrepareShootForExport(shoot *gardencorev1beta1.Shoot) bool {
+	return shoot.Annotations[v1beta1constants.GardenerOperation] == v1beta1constants.ShootOperationExport && !IsShootExported(shoot)
+}
+
+// IsShootExported returns true if the state of the shoot was exported for a migration to another garden landscape.
+func IsShootExported(shoot *gardencorev1beta1.Shoot) bool {
+	_, ok := shoot.Annotations[v1beta1constants.ShootExported]
+	return ok
+}
+
+// IsShootPendingDeletion returns true if the shoot has been deleted but its actual deletion is delayed by the shoot
+// deletion grace period of its project.
+func IsShootPendingDeletion(shoot *gardencorev1beta1.Shoot) bool {
+	_, ok := shoot.Annotations[v1beta1constants.ShootPendingDeletionUntil]
+	return ok
+}
+
+// GetShootPendingDeletionUntil returns the time after which the shoot pending deletion is actually deleted.
+func GetShootPendingDeletionUntil(shoot *gardencorev1beta1.Shoot) (time.Time, error) {
+	value, ok := shoot.Annotations[v1beta1constants.ShootPendingDeletionUntil]
+	if !ok {
+		return time.Time{}, fmt.Errorf("shoot is not pending deletion, annotation %s is missing", v1beta1constants.ShootPendingDeletionUntil)
+	}
+	return time.Parse(time.RFC3339, value)
+}
+
 // LastInitiationTimeForWorkerPool returns the last initiation time for the worker pool when found in the given list of
 // pending workers rollouts. If the worker pool is not found in the list, the global last initiation time is returned.
 func LastInitiationTimeForWorkerPool(name string, pendingWorkersRollout []gardencorev1beta1.PendingWorkersRollout, globalLastInitiationTime *metav1.Time) *metav1.Time {
@@ -792,3 +822,109 @@ func RemoveOperation(operations []string, operationsToRemove ...string) []string
 		return slices.Contains(operationsToRemove, operation)
 	})
 }
+
+// SeedNamesSatisfyingShootAffinity returns the names of the seeds the given shoot can be placed on with respect to its
+// shoot affinity terms. Each term only constrains the placement if it selects at least one other shoot in the same
+// project which is already scheduled. If the placement is not constrained at all, nil is returned.
+func SeedNamesSatisfyingShootAffinity(shoot *gardencorev1beta1.Shoot, shoots []*gardencorev1beta1.Shoot) (sets.Set[string], error) {
+	if shoot.Spec.Affinity == nil {
+		return nil, nil
+	}
+
+	var seedNames sets.Set[string]
+	for _, term := range shoot.Spec.Affinity.ShootAffinity {
+		selectedShoots, err := shootsSelectedByAffinityTerm(shoot, term, shoots)
+		if err != nil {
+			return nil, err
+		}
+
+		termSeedNames := sets.New[string]()
+		for _, selectedShoot := range selectedShoots {
+			if selectedShoot.Spec.SeedName != nil {
+				termSeedNames.Insert(*selectedShoot.Spec.SeedName)
+			}
+		}
+
+		if termSeedNames.Len() == 0 {
+			continue
+		}
+
+		if seedNames == nil {
+			seedNames = termSeedNames
+		} else {
+			seedNames = seedNames.Intersection(termSeedNames)
+		}
+	}
+
+	return seedNames, nil
+}
+
+// SeedNamesViolatingShootAntiAffinity returns the names of the seeds the given shoot must not be placed on. These are
+// the seeds hosting shoots selected by the shoot's anti-affinity terms as well as the seeds hosting shoots whose own
+// anti-affinity terms select the given shoot. Shoots which are currently being migrated count for both their source
+// and their target seed.
+func SeedNamesViolatingShootAntiAffinity(shoot *gardencorev1beta1.Shoot, shoots []*gardencorev1beta1.Shoot) (sets.Set[string], error) {
+	seedNames := sets.New[string]()
+
+	insertSeedNames := func(s *gardencorev1beta1.Shoot) {
+		if s.Spec.SeedName != nil {
+			seedNames.Insert(*s.Spec.SeedName)
+		}
+		if s.Status.SeedName != nil {
+			seedNames.Insert(*s.Status.SeedName)
+		}
+	}
+
+	if shoot.Spec.Affinity != nil {
+		for _, term := range shoot.Spec.Affinity.ShootAntiAffinity {
+			selectedShoots, err := shootsSelectedByAffinityTerm(shoot, term, shoots)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, selectedShoot := range selectedShoots {
+				insertSeedNames(selectedShoot)
+			}
+		}
+	}
+
+	for _, other := range shoots {
+		if other.Spec.Affinity == nil || other.Namespace != shoot.Namespace || other.Name == shoot.Name {
+			continue
+		}
+
+		for _, term := range other.Spec.Affinity.ShootAntiAffinity {
+			selector, err := metav1.LabelSelectorAsSelector(&term.LabelSelector)
+			if err != nil {
+				return nil, fmt.Errorf("failed parsing anti-affinity label selector of shoot %s: %w", objectKey(other.Namespace, other.Name), err)
+			}
+
+			if selector.Matches(labels.Set(shoot.Labels)) {
+				insertSeedNames(other)
+				break
+			}
+		}
+	}
+
+	return seedNames, nil
+}
+
+func shootsSelectedByAffinityTerm(shoot *gardencorev1beta1.Shoot, term gardencorev1beta1.ShootAffinityTerm, shoots []*gardencorev1beta1.Shoot) ([]*gardencorev1beta1.Shoot, error) {
+	selector, err := metav1.LabelSelectorAsSelector(&term.LabelSelector)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing affinity label selector of shoot %s: %w", objectKey(shoot.Namespace, shoot.Name), err)
+	}
+
+	var selectedShoots []*gardencorev1beta1.Shoot
+	for _, other := range shoots {
+		if other.Namespace != shoot.Namespace || other.Name == shoot.Name {
+			continue
+		}
+
+		if selector.Matches(labels.Set(other.Labels)) {
+			selectedShoots = append(selectedShoots, other)
+		}
+	}
+
+	return selectedShoots, nil
+}
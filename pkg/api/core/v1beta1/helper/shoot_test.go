@@ -1598,6 +1598,76 @@ var _ = Describe("Helper", func() {
 		})
 	})
 
+	Describe("#ShouldPrepareShootForExport", func() {
+		var shoot *gardencorev1beta1.Shoot
+
+		BeforeEach(func() {
+			shoot = &gardencorev1beta1.Shoot{}
+		})
+
+		It("should return false if the export operation is not requested", func() {
+			Expect(ShouldPrepareShootForExport(shoot)).To(BeFalse())
+
+			metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.GardenerOperation, v1beta1constants.ShootOperationReconcile)
+			Expect(ShouldPrepareShootForExport(shoot)).To(BeFalse())
+		})
+
+		It("should return true if the export operation is requested", func() {
+			metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.GardenerOperation, v1beta1constants.ShootOperationExport)
+			Expect(ShouldPrepareShootForExport(shoot)).To(BeTrue())
+		})
+
+		It("should return false if the shoot was already exported", func() {
+			metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.GardenerOperation, v1beta1constants.ShootOperationExport)
+			metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.ShootExported, "true")
+			Expect(ShouldPrepareShootForExport(shoot)).To(BeFalse())
+		})
+	})
+
+	Describe("#IsShootExported", func() {
+		It("should return false if the annotation is not set", func() {
+			Expect(IsShootExported(&gardencorev1beta1.Shoot{})).To(BeFalse())
+		})
+
+		It("should return true if the annotation is set", func() {
+			shoot := &gardencorev1beta1.Shoot{}
+			metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.ShootExported, "true")
+			Expect(IsShootExported(shoot)).To(BeTrue())
+		})
+	})
+
+	Describe("#IsShootPendingDeletion", func() {
+		It("should return false if the annotation is not set", func() {
+			Expect(IsShootPendingDeletion(&gardencorev1beta1.Shoot{})).To(BeFalse())
+		})
+
+		It("should return true if the annotation is set", func() {
+			shoot := &gardencorev1beta1.Shoot{}
+			metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.ShootPendingDeletionUntil, "2026-01-01T00:00:00Z")
+			Expect(IsShootPendingDeletion(shoot)).To(BeTrue())
+		})
+	})
+
+	Describe("#GetShootPendingDeletionUntil", func() {
+		It("should fail if the annotation is not set", func() {
+			_, err := GetShootPendingDeletionUntil(&gardencorev1beta1.Shoot{})
+			Expect(err).To(MatchError(ContainSubstring("is not pending deletion")))
+		})
+
+		It("should fail if the annotation cannot be parsed", func() {
+			shoot := &gardencorev1beta1.Shoot{}
+			metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.ShootPendingDeletionUntil, "tomorrow")
+			_, err := GetShootPendingDeletionUntil(shoot)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should return the time from the annotation", func() {
+			shoot := &gardencorev1beta1.Shoot{}
+			metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.ShootPendingDeletionUntil, "2026-01-01T00:00:00Z")
+			Expect(GetShootPendingDeletionUntil(shoot)).To(Equal(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)))
+		})
+	})
+
 	Describe("#LastInitiationTimeForWorkerPool", func() {
 		var (
 			poolName                 = "pool"
@@ -1968,4 +2038,97 @@ var _ = Describe("Helper", func() {
 			[]string{"rotate-ca-start", "", ""},
 			[]string{"rotate-ca-start"}),
 	)
+
+	Describe("#ShootAffinity", func() {
+		var (
+			shoot  *gardencorev1beta1.Shoot
+			shoots []*gardencorev1beta1.Shoot
+		)
+
+		newShoot := func(namespace, name string, labels map[string]string, specSeedName, statusSeedName *string) *gardencorev1beta1.Shoot {
+			return &gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name, Labels: labels},
+				Spec:       gardencorev1beta1.ShootSpec{SeedName: specSeedName},
+				Status:     gardencorev1beta1.ShootStatus{SeedName: statusSeedName},
+			}
+		}
+
+		BeforeEach(func() {
+			shoot = &gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "garden-dev", Name: "shoot", Labels: map[string]string{"app": "db"}},
+			}
+			shoots = []*gardencorev1beta1.Shoot{
+				newShoot("garden-dev", "shoot", map[string]string{"app": "db"}, new("seed-0"), new("seed-0")),
+				newShoot("garden-dev", "db-replica", map[string]string{"app": "db"}, new("seed-1"), new("seed-1")),
+				newShoot("garden-dev", "frontend", map[string]string{"app": "frontend"}, new("seed-2"), new("seed-3")),
+				newShoot("garden-dev", "unscheduled", map[string]string{"app": "backend"}, nil, nil),
+				newShoot("garden-other", "db", map[string]string{"app": "db"}, new("seed-4"), new("seed-4")),
+			}
+		})
+
+		Describe("#SeedNamesSatisfyingShootAffinity", func() {
+			It("should return nil if no affinity is configured", func() {
+				Expect(SeedNamesSatisfyingShootAffinity(shoot, shoots)).To(BeNil())
+			})
+
+			It("should return the seeds of the selected shoots in the same project", func() {
+				shoot.Spec.Affinity = &gardencorev1beta1.Affinity{ShootAffinity: []gardencorev1beta1.ShootAffinityTerm{
+					{LabelSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}}},
+				}}
+
+				Expect(SeedNamesSatisfyingShootAffinity(shoot, shoots)).To(Equal(sets.New("seed-1")))
+			})
+
+			It("should intersect the seeds of multiple terms", func() {
+				shoot.Spec.Affinity = &gardencorev1beta1.Affinity{ShootAffinity: []gardencorev1beta1.ShootAffinityTerm{
+					{LabelSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}}},
+					{LabelSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "frontend"}}},
+				}}
+
+				Expect(SeedNamesSatisfyingShootAffinity(shoot, shoots)).To(BeEmpty())
+			})
+
+			It("should ignore terms not selecting any scheduled shoot", func() {
+				shoot.Spec.Affinity = &gardencorev1beta1.Affinity{ShootAffinity: []gardencorev1beta1.ShootAffinityTerm{
+					{LabelSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "backend"}}},
+				}}
+
+				Expect(SeedNamesSatisfyingShootAffinity(shoot, shoots)).To(BeNil())
+			})
+
+			It("should return an error for invalid selectors", func() {
+				shoot.Spec.Affinity = &gardencorev1beta1.Affinity{ShootAffinity: []gardencorev1beta1.ShootAffinityTerm{
+					{LabelSelector: metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "app", Operator: "Unknown"}}}},
+				}}
+
+				_, err := SeedNamesSatisfyingShootAffinity(shoot, shoots)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+
+		Describe("#SeedNamesViolatingShootAntiAffinity", func() {
+			It("should return an empty set if no anti-affinity is configured", func() {
+				Expect(SeedNamesViolatingShootAntiAffinity(shoot, shoots)).To(BeEmpty())
+			})
+
+			It("should return the source and target seeds of the selected shoots", func() {
+				shoot.Spec.Affinity = &gardencorev1beta1.Affinity{ShootAntiAffinity: []gardencorev1beta1.ShootAffinityTerm{
+					{LabelSelector: metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "app", Operator: metav1.LabelSelectorOpIn, Values: []string{"db", "frontend"}}}}},
+				}}
+
+				Expect(SeedNamesViolatingShootAntiAffinity(shoot, shoots)).To(Equal(sets.New("seed-1", "seed-2", "seed-3")))
+			})
+
+			It("should consider the anti-affinity terms of other shoots in the same project", func() {
+				shoots[2].Spec.Affinity = &gardencorev1beta1.Affinity{ShootAntiAffinity: []gardencorev1beta1.ShootAffinityTerm{
+					{LabelSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}}},
+				}}
+				shoots[4].Spec.Affinity = &gardencorev1beta1.Affinity{ShootAntiAffinity: []gardencorev1beta1.ShootAffinityTerm{
+					{LabelSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}}},
+				}}
+
+				Expect(SeedNamesViolatingShootAntiAffinity(shoot, shoots)).To(Equal(sets.New("seed-2", "seed-3")))
+			})
+		})
+	})
 })
@@ -9,6 +9,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	"github.com/gardener/gardener/pkg/apis/core"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 )
 
 // ValidateBackupBucket validates a BackupBucket object.
@@ -26,6 +27,7 @@ func ValidateBackupBucketUpdate(newBackupBucket, oldBackupBucket *core.BackupBuc
 	allErrs := field.ErrorList{}
 
 	allErrs = append(allErrs, apivalidation.ValidateObjectMetaUpdate(&newBackupBucket.ObjectMeta, &oldBackupBucket.ObjectMeta, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newBackupBucket.Annotations[v1beta1constants.BackupBucketImported], oldBackupBucket.Annotations[v1beta1constants.BackupBucketImported], field.NewPath("metadata.annotations"))...)
 	allErrs = append(allErrs, ValidateBackupBucketSpecUpdate(&newBackupBucket.Spec, &oldBackupBucket.Spec, field.NewPath("spec"))...)
 	allErrs = append(allErrs, ValidateBackupBucket(newBackupBucket)...)
 
@@ -66,6 +68,28 @@ func ValidateBackupBucketSpec(spec *core.BackupBucketSpec, fldPath *field.Path)
 
 	allErrs = append(allErrs, validateCredentials(spec, fldPath)...)
 
+	if spec.Retention != nil {
+		allErrs = append(allErrs, validateBackupRetention(spec.Retention, fldPath.Child("retention"))...)
+	}
+
+	return allErrs
+}
+
+func validateBackupRetention(retention *core.BackupRetention, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if retention.DeletedEntriesGracePeriod != nil && retention.DeletedEntriesGracePeriod.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("deletedEntriesGracePeriod"), retention.DeletedEntriesGracePeriod.Duration.String(), "must not be negative"))
+	}
+
+	if retention.MaxFullSnapshots != nil && *retention.MaxFullSnapshots < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxFullSnapshots"), *retention.MaxFullSnapshots, "must be at least 1"))
+	}
+
+	if retention.ColdStorageTransitionPeriod != nil && retention.ColdStorageTransitionPeriod.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("coldStorageTransitionPeriod"), retention.ColdStorageTransitionPeriod.Duration.String(), "must be positive"))
+	}
+
 	return allErrs
 }
 
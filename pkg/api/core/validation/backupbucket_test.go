@@ -5,6 +5,8 @@
 package validation_test
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
@@ -174,6 +176,39 @@ var _ = Describe("validation", func() {
 			))
 		})
 
+		It("should allow a valid retention policy", func() {
+			backupBucket.Spec.Retention = &core.BackupRetention{
+				DeletedEntriesGracePeriod:   &metav1.Duration{Duration: 72 * time.Hour},
+				MaxFullSnapshots:            new(int32(3)),
+				ColdStorageTransitionPeriod: &metav1.Duration{Duration: 30 * 24 * time.Hour},
+			}
+
+			Expect(ValidateBackupBucket(backupBucket)).To(BeEmpty())
+		})
+
+		It("should forbid an invalid retention policy", func() {
+			backupBucket.Spec.Retention = &core.BackupRetention{
+				DeletedEntriesGracePeriod:   &metav1.Duration{Duration: -time.Hour},
+				MaxFullSnapshots:            new(int32(0)),
+				ColdStorageTransitionPeriod: &metav1.Duration{Duration: -time.Hour},
+			}
+
+			Expect(ValidateBackupBucket(backupBucket)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.retention.deletedEntriesGracePeriod"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.retention.maxFullSnapshots"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.retention.coldStorageTransitionPeriod"),
+				})),
+			))
+		})
+
 		It("should forbid updating some keys", func() {
 			newBackupBucket := prepareBackupBucketForUpdate(backupBucket)
 			newBackupBucket.Spec.Provider.Type = "another-type"
@@ -193,6 +228,29 @@ var _ = Describe("validation", func() {
 			))
 		})
 
+		It("should forbid adding or removing the imported annotation", func() {
+			newBackupBucket := prepareBackupBucketForUpdate(backupBucket)
+			metav1.SetMetaDataAnnotation(&newBackupBucket.ObjectMeta, "backupbucket.gardener.cloud/imported", "true")
+
+			Expect(ValidateBackupBucketUpdate(newBackupBucket, backupBucket)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("metadata.annotations"),
+				})),
+			))
+
+			oldBackupBucket := newBackupBucket
+			newBackupBucket = prepareBackupBucketForUpdate(oldBackupBucket)
+			delete(newBackupBucket.Annotations, "backupbucket.gardener.cloud/imported")
+
+			Expect(ValidateBackupBucketUpdate(newBackupBucket, oldBackupBucket)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("metadata.annotations"),
+				})),
+			))
+		})
+
 		Context("backup credentialsRef", func() {
 			It("should require credentialsRef to be set", func() {
 				backupBucket.Spec.CredentialsRef = nil
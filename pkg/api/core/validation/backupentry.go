@@ -74,9 +74,15 @@ func ValidateBackupEntrySpecUpdate(_, _ *core.BackupEntrySpec, _ *field.Path) fi
 }
 
 // ValidateBackupEntryStatusUpdate validates the status field of a BackupEntry object.
-func ValidateBackupEntryStatusUpdate(_, _ *core.BackupEntry) field.ErrorList {
+func ValidateBackupEntryStatusUpdate(newBackupEntry, _ *core.BackupEntry) field.ErrorList {
 	allErrs := field.ErrorList{}
 
+	if usage := newBackupEntry.Status.Usage; usage != nil {
+		fldPath := field.NewPath("status", "usage")
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(usage.SizeBytes, fldPath.Child("sizeBytes"))...)
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(usage.ObjectCount, fldPath.Child("objectCount"))...)
+	}
+
 	return allErrs
 }
 
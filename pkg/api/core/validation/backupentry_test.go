@@ -158,6 +158,31 @@ var _ = Describe("validation", func() {
 			Expect(errorList).To(BeEmpty())
 		})
 	})
+
+	Context("#ValidateBackupEntryStatusUpdate", func() {
+		It("should allow a valid usage", func() {
+			newBackupEntry := prepareBackupEntryForUpdate(backupEntry)
+			newBackupEntry.Status.Usage = &core.BackupEntryUsage{SizeBytes: 1024, ObjectCount: 2}
+
+			Expect(ValidateBackupEntryStatusUpdate(newBackupEntry, backupEntry)).To(BeEmpty())
+		})
+
+		It("should forbid a negative usage", func() {
+			newBackupEntry := prepareBackupEntryForUpdate(backupEntry)
+			newBackupEntry.Status.Usage = &core.BackupEntryUsage{SizeBytes: -1, ObjectCount: -1}
+
+			Expect(ValidateBackupEntryStatusUpdate(newBackupEntry, backupEntry)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("status.usage.sizeBytes"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("status.usage.objectCount"),
+				})),
+			))
+		})
+	})
 })
 
 func prepareBackupEntryForUpdate(obj *core.BackupEntry) *core.BackupEntry {
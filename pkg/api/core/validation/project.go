@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"slices"
 	"strings"
+	"time"
 
 	rbacv1 "k8s.io/api/rbac/v1"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
@@ -155,6 +156,14 @@ func ValidateProjectSpec(projectSpec *core.ProjectSpec, opts projectValidationOp
 
 	allErrs = append(allErrs, validateDualApprovalForDeletion(projectSpec.DualApprovalForDeletion, fldPath.Child("dualApprovalForDeletion"))...)
 
+	if projectSpec.ShootDeletion != nil && projectSpec.ShootDeletion.GracePeriod.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("shootDeletion", "gracePeriod"), projectSpec.ShootDeletion.GracePeriod.Duration.String(), "must be positive"))
+	}
+
+	allErrs = append(allErrs, validateMaintenanceFreezes(projectSpec.MaintenanceFreezes, fldPath.Child("maintenanceFreezes"))...)
+	allErrs = append(allErrs, ValidateAutoUpdatePolicy(projectSpec.AutoUpdatePolicy, fldPath.Child("autoUpdatePolicy"))...)
+	allErrs = append(allErrs, validateHibernationCalendars(projectSpec.HibernationCalendars, fldPath.Child("hibernationCalendars"))...)
+
 	return allErrs
 }
 
@@ -357,6 +366,68 @@ func validateDualApprovalForDeletion(dualApproval []core.DualApprovalForDeletion
 	return allErrs
 }
 
+func validateMaintenanceFreezes(freezes []core.MaintenanceFreeze, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs field.ErrorList
+		names   = sets.New[string]()
+	)
+
+	for i, freeze := range freezes {
+		idxPath := fldPath.Index(i)
+
+		if len(freeze.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "cannot be empty"))
+		} else {
+			if names.Has(freeze.Name) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), freeze.Name))
+			}
+			names.Insert(freeze.Name)
+		}
+
+		if !freeze.End.After(freeze.Begin.Time) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("end"), freeze.End.String(), "must be after the begin of the maintenance freeze"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateHibernationCalendars(calendars []core.HibernationCalendar, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs field.ErrorList
+		names   = sets.New[string]()
+	)
+
+	for i, calendar := range calendars {
+		idxPath := fldPath.Index(i)
+
+		if len(calendar.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "cannot be empty"))
+		} else {
+			if names.Has(calendar.Name) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), calendar.Name))
+			}
+			names.Insert(calendar.Name)
+		}
+
+		if len(calendar.Dates) == 0 && calendar.ResourceRef == nil {
+			allErrs = append(allErrs, field.Required(idxPath, "either dates or a resourceRef must be specified"))
+		}
+
+		for j, date := range calendar.Dates {
+			if _, err := time.Parse(time.DateOnly, date); err != nil {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("dates").Index(j), date, "must be a date in the format YYYY-MM-DD"))
+			}
+		}
+
+		if calendar.ResourceRef != nil {
+			allErrs = append(allErrs, validateCrossVersionObjectReference(*calendar.ResourceRef, idxPath.Child("resourceRef"), false)...)
+		}
+	}
+
+	return allErrs
+}
+
 // ValidateProjectStatusUpdate validates the status field of a Project object.
 func ValidateProjectStatusUpdate(newProject, oldProject *core.Project) field.ErrorList {
 	allErrs := field.ErrorList{}
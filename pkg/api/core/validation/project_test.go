@@ -7,11 +7,13 @@ package validation_test
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
 	gomegatypes "github.com/onsi/gomega/types"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -624,6 +626,199 @@ var _ = Describe("Project Validation Tests", func() {
 			})
 		})
 
+		Context("shoot deletion config", func() {
+			It("should forbid non-positive grace periods", func() {
+				project.Spec.ShootDeletion = &core.ProjectShootDeletion{GracePeriod: metav1.Duration{}}
+
+				Expect(ValidateProject(project)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.shootDeletion.gracePeriod"),
+					})),
+				))
+			})
+
+			It("should allow positive grace periods", func() {
+				project.Spec.ShootDeletion = &core.ProjectShootDeletion{GracePeriod: metav1.Duration{Duration: 72 * time.Hour}}
+
+				Expect(ValidateProject(project)).To(BeEmpty())
+			})
+		})
+
+		Context("maintenance freezes", func() {
+			var begin = metav1.NewTime(time.Date(2025, 12, 20, 0, 0, 0, 0, time.UTC))
+
+			It("should allow valid maintenance freezes", func() {
+				project.Spec.MaintenanceFreezes = []core.MaintenanceFreeze{
+					{Name: "year-end", Begin: begin, End: metav1.NewTime(begin.AddDate(0, 0, 14))},
+					{Name: "release", Begin: begin, End: metav1.NewTime(begin.AddDate(0, 0, 1))},
+				}
+
+				Expect(ValidateProject(project)).To(BeEmpty())
+			})
+
+			It("should forbid empty and duplicate names", func() {
+				project.Spec.MaintenanceFreezes = []core.MaintenanceFreeze{
+					{Begin: begin, End: metav1.NewTime(begin.AddDate(0, 0, 1))},
+					{Name: "year-end", Begin: begin, End: metav1.NewTime(begin.AddDate(0, 0, 1))},
+					{Name: "year-end", Begin: begin, End: metav1.NewTime(begin.AddDate(0, 0, 1))},
+				}
+
+				Expect(ValidateProject(project)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.maintenanceFreezes[0].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("spec.maintenanceFreezes[2].name"),
+					})),
+				))
+			})
+
+			It("should forbid maintenance freezes which do not end after they begin", func() {
+				project.Spec.MaintenanceFreezes = []core.MaintenanceFreeze{
+					{Name: "empty", Begin: begin, End: begin},
+					{Name: "inverted", Begin: begin, End: metav1.NewTime(begin.AddDate(0, 0, -1))},
+				}
+
+				Expect(ValidateProject(project)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.maintenanceFreezes[0].end"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.maintenanceFreezes[1].end"),
+					})),
+				))
+			})
+		})
+
+		Context("auto-update policy", func() {
+			It("should allow a policy with a channel or a soak time", func() {
+				project.Spec.AutoUpdatePolicy = &core.AutoUpdatePolicy{Channel: new(core.UpdateChannelStable)}
+				Expect(ValidateProject(project)).To(BeEmpty())
+
+				project.Spec.AutoUpdatePolicy = &core.AutoUpdatePolicy{SoakTime: &metav1.Duration{Duration: 72 * time.Hour}}
+				Expect(ValidateProject(project)).To(BeEmpty())
+			})
+
+			It("should forbid setting both a channel and a soak time", func() {
+				project.Spec.AutoUpdatePolicy = &core.AutoUpdatePolicy{
+					Channel:  new(core.UpdateChannelEarly),
+					SoakTime: &metav1.Duration{Duration: time.Hour},
+				}
+
+				Expect(ValidateProject(project)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.autoUpdatePolicy"),
+					})),
+				))
+			})
+
+			It("should forbid an empty policy", func() {
+				project.Spec.AutoUpdatePolicy = &core.AutoUpdatePolicy{}
+
+				Expect(ValidateProject(project)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.autoUpdatePolicy"),
+					})),
+				))
+			})
+
+			It("should forbid unsupported channels and negative soak times", func() {
+				project.Spec.AutoUpdatePolicy = &core.AutoUpdatePolicy{Channel: new(core.UpdateChannel("nightly"))}
+				Expect(ValidateProject(project)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("spec.autoUpdatePolicy.channel"),
+					})),
+				))
+
+				project.Spec.AutoUpdatePolicy = &core.AutoUpdatePolicy{SoakTime: &metav1.Duration{Duration: -time.Hour}}
+				Expect(ValidateProject(project)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.autoUpdatePolicy.soakTime"),
+					})),
+				))
+			})
+		})
+
+		Context("hibernation calendars", func() {
+			It("should allow valid hibernation calendars", func() {
+				project.Spec.HibernationCalendars = []core.HibernationCalendar{
+					{Name: "holidays", Dates: []string{"2025-12-24", "2025-12-25"}},
+					{Name: "shutdown", ResourceRef: &autoscalingv1.CrossVersionObjectReference{APIVersion: "v1", Kind: "ConfigMap", Name: "shutdown-days"}},
+				}
+
+				Expect(ValidateProject(project)).To(BeEmpty())
+			})
+
+			It("should forbid empty and duplicate names", func() {
+				project.Spec.HibernationCalendars = []core.HibernationCalendar{
+					{Dates: []string{"2025-12-24"}},
+					{Name: "holidays", Dates: []string{"2025-12-24"}},
+					{Name: "holidays", Dates: []string{"2025-12-25"}},
+				}
+
+				Expect(ValidateProject(project)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.hibernationCalendars[0].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("spec.hibernationCalendars[2].name"),
+					})),
+				))
+			})
+
+			It("should forbid calendars without dates and resource reference", func() {
+				project.Spec.HibernationCalendars = []core.HibernationCalendar{{Name: "holidays"}}
+
+				Expect(ValidateProject(project)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.hibernationCalendars[0]"),
+					})),
+				))
+			})
+
+			It("should forbid invalid dates", func() {
+				project.Spec.HibernationCalendars = []core.HibernationCalendar{
+					{Name: "holidays", Dates: []string{"2025-12-24", "24.12.2025", "2025-02-30"}},
+				}
+
+				Expect(ValidateProject(project)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.hibernationCalendars[0].dates[1]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.hibernationCalendars[0].dates[2]"),
+					})),
+				))
+			})
+
+			It("should forbid references to resources other than ConfigMaps and Secrets", func() {
+				project.Spec.HibernationCalendars = []core.HibernationCalendar{
+					{Name: "holidays", ResourceRef: &autoscalingv1.CrossVersionObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Name: "foo"}},
+				}
+
+				Expect(ValidateProject(project)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("spec.hibernationCalendars[0].resourceRef"),
+					})),
+				))
+			})
+		})
+
 		DescribeTable("namespace immutability",
 			func(old, new *string, matcher gomegatypes.GomegaMatcher) {
 				project.Spec.Namespace = old
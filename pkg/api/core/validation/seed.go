@@ -288,6 +288,32 @@ func validateSeedBackup(seedBackup *core.Backup, seedProviderType string, fldPat
 		allErrs = append(allErrs, ValidateCredentialsRef(*seedBackup.CredentialsRef, fldPath.Child("credentialsRef"))...)
 	}
 
+	if seedBackup.Validation != nil {
+		allErrs = append(allErrs, validateBackupValidation(seedBackup.Validation, fldPath.Child("validation"))...)
+	}
+
+	if seedBackup.Retention != nil {
+		allErrs = append(allErrs, validateBackupRetention(seedBackup.Retention, fldPath.Child("retention"))...)
+	}
+
+	return allErrs
+}
+
+func validateBackupValidation(validation *core.BackupValidation, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if validation.Interval != nil && validation.Interval.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("interval"), validation.Interval.Duration.String(), "must be positive"))
+	}
+
+	if validation.SamplingPercentage != nil && (*validation.SamplingPercentage < 1 || *validation.SamplingPercentage > 100) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("samplingPercentage"), *validation.SamplingPercentage, "must be between 1 and 100"))
+	}
+
+	if validation.Concurrency != nil && *validation.Concurrency < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("concurrency"), *validation.Concurrency, "must be at least 1"))
+	}
+
 	return allErrs
 }
 
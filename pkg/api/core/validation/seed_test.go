@@ -7,6 +7,7 @@ package validation_test
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -706,6 +707,76 @@ var _ = Describe("Seed Validation Tests", func() {
 			})
 		})
 
+		Context("backup validation", func() {
+			It("should allow valid backup validation settings", func() {
+				seed.Spec.Backup.Validation = &core.BackupValidation{
+					Interval:           &metav1.Duration{Duration: 24 * time.Hour},
+					SamplingPercentage: new(int32(10)),
+					Concurrency:        new(int32(2)),
+				}
+
+				Expect(ValidateSeed(seed)).To(BeEmpty())
+			})
+
+			It("should forbid invalid backup validation settings", func() {
+				seed.Spec.Backup.Validation = &core.BackupValidation{
+					Interval:           &metav1.Duration{},
+					SamplingPercentage: new(int32(101)),
+					Concurrency:        new(int32(0)),
+				}
+
+				Expect(ValidateSeed(seed)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.backup.validation.interval"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.backup.validation.samplingPercentage"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.backup.validation.concurrency"),
+					})),
+				))
+			})
+		})
+
+		Context("backup retention", func() {
+			It("should allow valid retention settings", func() {
+				seed.Spec.Backup.Retention = &core.BackupRetention{
+					DeletedEntriesGracePeriod:   &metav1.Duration{},
+					MaxFullSnapshots:            new(int32(7)),
+					ColdStorageTransitionPeriod: &metav1.Duration{Duration: 30 * 24 * time.Hour},
+				}
+
+				Expect(ValidateSeed(seed)).To(BeEmpty())
+			})
+
+			It("should forbid invalid retention settings", func() {
+				seed.Spec.Backup.Retention = &core.BackupRetention{
+					DeletedEntriesGracePeriod:   &metav1.Duration{Duration: -time.Hour},
+					MaxFullSnapshots:            new(int32(0)),
+					ColdStorageTransitionPeriod: &metav1.Duration{},
+				}
+
+				Expect(ValidateSeed(seed)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.backup.retention.deletedEntriesGracePeriod"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.backup.retention.maxFullSnapshots"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.backup.retention.coldStorageTransitionPeriod"),
+					})),
+				))
+			})
+		})
+
 		Context("networks", func() {
 			It("should forbid specifying unsupported IP family", func() {
 				seed.Spec.Networks.IPFamilies = []core.IPFamily{"IPv5"}
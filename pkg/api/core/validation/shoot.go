@@ -68,6 +68,9 @@ var (
 		v1beta1constants.ShootOperationMaintain,
 		v1beta1constants.ShootOperationRetry,
 		v1beta1constants.ShootOperationForceInPlaceUpdate,
+		v1beta1constants.ShootOperationDryRun,
+		v1beta1constants.ShootOperationExport,
+		v1beta1constants.ShootOperationUndelete,
 	).Union(availableShootMaintenanceOperations)
 	availableShootMaintenanceOperations = sets.New(
 		v1beta1constants.GardenerOperationReconcile,
@@ -158,6 +161,7 @@ var (
 		v1beta1constants.ReferenceProtectionFinalizerName,
 	)
 	availableUpdateStrategies = sets.New(core.AutoRollingUpdate, core.AutoInPlaceUpdate, core.ManualInPlaceUpdate)
+	availableUpdateChannels   = sets.New(core.UpdateChannelEarly, core.UpdateChannelStable)
 
 	availableEncryptionAtRestProviders = sets.New(
 		core.EncryptionProviderTypeAESCBC,
@@ -370,6 +374,8 @@ func ValidateShootSpec(meta metav1.ObjectMeta, spec *core.ShootSpec, opts shootV
 	if spec.SeedSelector != nil {
 		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(&spec.SeedSelector.LabelSelector, metav1validation.LabelSelectorValidationOptions{}, fldPath.Child("seedSelector"))...)
 	}
+	allErrs = append(allErrs, validateAffinity(spec.Affinity, fldPath.Child("affinity"))...)
+	allErrs = append(allErrs, validateSource(meta, spec, fldPath.Child("source"), inTemplate)...)
 	if purpose := spec.Purpose; purpose != nil {
 		allowedShootPurposes := availableShootPurposes
 		if meta.Namespace == v1beta1constants.GardenNamespace || inTemplate {
@@ -408,6 +414,61 @@ func ValidateShootSpec(meta metav1.ObjectMeta, spec *core.ShootSpec, opts shootV
 	return allErrs
 }
 
+func validateAffinity(affinity *core.Affinity, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if affinity == nil {
+		return allErrs
+	}
+
+	for i, term := range affinity.ShootAffinity {
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(&term.LabelSelector, metav1validation.LabelSelectorValidationOptions{}, fldPath.Child("shootAffinity").Index(i).Child("labelSelector"))...)
+	}
+	for i, term := range affinity.ShootAntiAffinity {
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(&term.LabelSelector, metav1validation.LabelSelectorValidationOptions{}, fldPath.Child("shootAntiAffinity").Index(i).Child("labelSelector"))...)
+	}
+
+	return allErrs
+}
+
+func validateSource(meta metav1.ObjectMeta, spec *core.ShootSpec, fldPath *field.Path, inTemplate bool) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	source := spec.Source
+	if source == nil {
+		return allErrs
+	}
+
+	if inTemplate {
+		return append(allErrs, field.Forbidden(fldPath, "shoot templates must not reference a source shoot"))
+	}
+	if helper.IsShootSelfHosted(spec.Provider.Workers) {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "self-hosted shoots cannot be created from a source shoot"))
+	}
+
+	if len(source.Namespace) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("namespace"), "must specify the namespace of the source shoot"))
+	}
+	if len(source.Name) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("name"), "must specify the name of the source shoot"))
+	}
+	if source.Namespace == meta.Namespace && source.Name == meta.Name {
+		allErrs = append(allErrs, field.Invalid(fldPath, source, "shoot must not reference itself as source"))
+	}
+
+	if source.TargetTime != nil {
+		if source.TargetTime.After(time.Now()) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("targetTime"), source.TargetTime, "target time must not be in the future"))
+		} else {
+			// Neither etcd-backup-restore nor the EtcdCopyBackupsTask of etcd-druid can restore or copy the snapshots up
+			// to a given time, hence the target time is rejected until this is supported.
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("targetTime"), "copying the etcd of the source shoot at a point in time is not supported yet"))
+		}
+	}
+
+	return allErrs
+}
+
 // ValidateShootSpecUpdate validates the specification of a Shoot object.
 func ValidateShootSpecUpdate(newSpec, oldSpec *core.ShootSpec, newObjectMeta metav1.ObjectMeta, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -418,6 +479,7 @@ func ValidateShootSpecUpdate(newSpec, oldSpec *core.ShootSpec, newObjectMeta met
 	}
 
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.Region, oldSpec.Region, fldPath.Child("region"))...)
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.Source, oldSpec.Source, fldPath.Child("source"))...)
 	allErrs = append(allErrs, ValidateCloudProfileReference(newSpec.CloudProfile, newSpec.CloudProfileName, newSpec.Kubernetes.Version, fldPath)...)
 
 	if oldSpec.CredentialsBindingName != nil && len(ptr.Deref(newSpec.CredentialsBindingName, "")) == 0 {
@@ -1767,6 +1829,10 @@ func validateHibernationUpdate(new, old *core.Shoot) field.ErrorList {
 		}
 	}
 
+	if helper.IsShootPendingDeletion(new) && !hibernationEnabledInNew {
+		allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("shoot cannot be woken up while it is pending deletion, revert the deletion with the %s=%s annotation first", v1beta1constants.GardenerOperation, v1beta1constants.ShootOperationUndelete)))
+	}
+
 	return allErrs
 }
 
@@ -2121,6 +2187,7 @@ func validateMaintenance(maintenance *core.Maintenance, fldPath *field.Path, wor
 		if workerless && maintenance.AutoUpdate.MachineImageVersion != nil {
 			allErrs = append(allErrs, field.Forbidden(fldPath.Child("autoUpdate", "machineImageVersion"), workerlessErrorMsg))
 		}
+		allErrs = append(allErrs, ValidateAutoUpdatePolicy(maintenance.AutoUpdate.Policy, fldPath.Child("autoUpdate", "policy"))...)
 	}
 
 	if maintenance.AutoRotation != nil && maintenance.AutoRotation.Credentials != nil {
@@ -2163,6 +2230,32 @@ func validateMaintenance(maintenance *core.Maintenance, fldPath *field.Path, wor
 	return allErrs
 }
 
+// ValidateAutoUpdatePolicy validates the given auto-update policy.
+func ValidateAutoUpdatePolicy(policy *core.AutoUpdatePolicy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if policy == nil {
+		return allErrs
+	}
+
+	switch {
+	case policy.Channel != nil && policy.SoakTime != nil:
+		allErrs = append(allErrs, field.Forbidden(fldPath, "channel and soakTime are mutually exclusive"))
+	case policy.Channel == nil && policy.SoakTime == nil:
+		allErrs = append(allErrs, field.Required(fldPath, "either channel or soakTime must be set"))
+	}
+
+	if policy.Channel != nil && !availableUpdateChannels.Has(*policy.Channel) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("channel"), *policy.Channel, sets.List(availableUpdateChannels)))
+	}
+
+	if policy.SoakTime != nil && policy.SoakTime.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("soakTime"), *policy.SoakTime, "can not be negative"))
+	}
+
+	return allErrs
+}
+
 func validateProvider(shootNamespace string, provider core.Provider, kubernetes core.Kubernetes, networking *core.Networking, workerless bool, fldPath *field.Path, inTemplate bool) field.ErrorList {
 	var (
 		allErrs = field.ErrorList{}
@@ -2895,6 +2988,11 @@ func ValidateHibernation(annotations map[string]string, hibernation *core.Hibern
 
 	allErrs = append(allErrs, ValidateHibernationSchedules(hibernation.Schedules, fldPath.Child("schedules"))...)
 
+	// Shorter timeouts would not leave enough room for the activity measurements of the gardenlet.
+	if hibernation.Inactivity != nil && hibernation.Inactivity.Timeout.Duration < time.Hour {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("inactivity", "timeout"), hibernation.Inactivity.Timeout.Duration.String(), "must be at least 1h"))
+	}
+
 	return allErrs
 }
 
@@ -3367,6 +3465,19 @@ func validateShootOperationContext(operation string, shoot *core.Shoot, fldPath
 		if helper.GetShootETCDEncryptionKeyRotationPhase(shoot.Status.Credentials) != core.RotationPrepared {
 			allErrs = append(allErrs, field.Forbidden(fldPath, "cannot complete ETCD encryption key rotation if .status.credentials.rotation.etcdEncryptionKey.phase is not 'Prepared'"))
 		}
+
+	case v1beta1constants.ShootOperationExport:
+		if !isShootReadyForRotationStart(shoot.Status.LastOperation) {
+			allErrs = append(allErrs, field.Forbidden(fldPath, "cannot export shoot if it was not yet created successfully or is not ready for reconciliation"))
+		}
+		if helper.IsShootSelfHosted(shoot.Spec.Provider.Workers) {
+			allErrs = append(allErrs, field.Forbidden(fldPath, "cannot export self-hosted shoot"))
+		}
+
+	case v1beta1constants.ShootOperationUndelete:
+		if !helper.IsShootPendingDeletion(shoot) {
+			allErrs = append(allErrs, field.Forbidden(fldPath, "cannot undelete shoot if it is not pending deletion"))
+		}
 	}
 
 	if strings.HasPrefix(operation, v1beta1constants.OperationRotateRolloutWorkers) {
@@ -875,6 +875,101 @@ var _ = Describe("Shoot Validation Tests", func() {
 			))
 		})
 
+		Context("affinity", func() {
+			It("should allow valid affinity terms", func() {
+				shoot.Spec.Affinity = &core.Affinity{
+					ShootAffinity:     []core.ShootAffinityTerm{{LabelSelector: metav1.LabelSelector{MatchLabels: map[string]string{"group": "foo"}}}},
+					ShootAntiAffinity: []core.ShootAffinityTerm{{LabelSelector: metav1.LabelSelector{MatchLabels: map[string]string{"pair": "bar"}}}},
+				}
+
+				Expect(ValidateShoot(shoot)).To(BeEmpty())
+			})
+
+			It("should forbid invalid label selectors", func() {
+				shoot.Spec.Affinity = &core.Affinity{
+					ShootAffinity: []core.ShootAffinityTerm{{LabelSelector: metav1.LabelSelector{MatchLabels: map[string]string{"foo": "no/slash/allowed"}}}},
+					ShootAntiAffinity: []core.ShootAffinityTerm{{LabelSelector: metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+						{Key: "foo", Operator: "Unknown"},
+					}}}},
+				}
+
+				Expect(ValidateShoot(shoot)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.affinity.shootAffinity[0].labelSelector.matchLabels"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.affinity.shootAntiAffinity[0].labelSelector.matchExpressions[0].operator"),
+					})),
+				))
+			})
+		})
+
+		Context("source", func() {
+			It("should allow referencing another shoot", func() {
+				shoot.Spec.Source = &core.ShootSource{Namespace: "garden-other", Name: "prod"}
+
+				Expect(ValidateShoot(shoot)).To(BeEmpty())
+			})
+
+			It("should forbid incomplete or self references", func() {
+				shoot.Spec.Source = &core.ShootSource{Namespace: shoot.Namespace}
+
+				Expect(ValidateShoot(shoot)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.source.name"),
+					})),
+				))
+
+				shoot.Spec.Source.Name = shoot.Name
+
+				Expect(ValidateShoot(shoot)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.source"),
+					})),
+				))
+			})
+
+			It("should forbid target times in the future", func() {
+				shoot.Spec.Source = &core.ShootSource{Namespace: "garden-other", Name: "prod", TargetTime: &metav1.Time{Time: time.Now().Add(time.Hour)}}
+
+				Expect(ValidateShoot(shoot)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.source.targetTime"),
+					})),
+				))
+			})
+
+			It("should forbid target times because copying a point in time is not supported yet", func() {
+				shoot.Spec.Source = &core.ShootSource{Namespace: "garden-other", Name: "prod", TargetTime: &metav1.Time{Time: time.Now().Add(-time.Hour)}}
+
+				Expect(ValidateShoot(shoot)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeForbidden),
+						"Field":  Equal("spec.source.targetTime"),
+						"Detail": Equal("copying the etcd of the source shoot at a point in time is not supported yet"),
+					})),
+				))
+			})
+
+			It("should forbid changing the source", func() {
+				shoot.Spec.Source = &core.ShootSource{Namespace: "garden-other", Name: "prod"}
+				newShoot := prepareShootForUpdate(shoot)
+				newShoot.Spec.Source = nil
+
+				Expect(ValidateShootUpdate(newShoot, shoot)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.source"),
+					})),
+				))
+			})
+		})
+
 		Context("SecretBindingName/CredentialsBinding validation", func() {
 			It("should forbid adding secretBindingName in case of workerless shoot", func() {
 				shoot.Spec.Provider.Workers = nil
@@ -5400,6 +5495,24 @@ var _ = Describe("Shoot Validation Tests", func() {
 				}))))
 			})
 
+			It("should allow a valid auto-update policy", func() {
+				shoot.Spec.Maintenance.AutoUpdate.Policy = &core.AutoUpdatePolicy{Channel: new(core.UpdateChannelEarly)}
+
+				Expect(ValidateShoot(shoot)).To(BeEmpty())
+			})
+
+			It("should forbid an auto-update policy with both a channel and a soak time", func() {
+				shoot.Spec.Maintenance.AutoUpdate.Policy = &core.AutoUpdatePolicy{
+					Channel:  new(core.UpdateChannelStable),
+					SoakTime: &metav1.Duration{Duration: 24 * time.Hour},
+				}
+
+				Expect(ValidateShoot(shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.maintenance.autoUpdate.policy"),
+				}))))
+			})
+
 			DescribeTable("observability credentials auto-rotation period", func(rotationPeriod *metav1.Duration, isAllowed bool) {
 				shoot.Spec.Maintenance.AutoRotation = &core.MaintenanceAutoRotation{
 					Credentials: &core.MaintenanceCredentialsAutoRotation{
@@ -7482,6 +7595,20 @@ var _ = Describe("Shoot Validation Tests", func() {
 				Expect(ValidateShootUpdate(newShoot, shoot)).To(BeEmpty())
 			})
 
+			It("should forbid waking up the shoot while it is pending deletion", func() {
+				shoot.Spec.Hibernation = &core.Hibernation{Enabled: new(true)}
+				metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "shoot.gardener.cloud/pending-deletion-until", "2026-01-01T00:00:00Z")
+
+				newShoot := prepareShootForUpdate(shoot)
+				newShoot.Spec.Hibernation = &core.Hibernation{Enabled: new(false)}
+
+				Expect(ValidateShootUpdate(newShoot, shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeForbidden),
+					"Field":  Equal("spec.hibernation.enabled"),
+					"Detail": ContainSubstring("shoot cannot be woken up while it is pending deletion"),
+				}))))
+			})
+
 			Context("multiple operations", func() {
 				BeforeEach(func() {
 					shoot.Status.LastOperation = &core.LastOperation{
@@ -7502,6 +7629,8 @@ var _ = Describe("Shoot Validation Tests", func() {
 					},
 
 					Entry("single gardener operation", "rotate-ssh-keypair"),
+					Entry("dry-run operation", "dry-run"),
+					Entry("export operation", "export"),
 					Entry("two parallel operations", "rotate-ssh-keypair;rotate-observability-credentials"),
 					Entry("three parallel operations", "rotate-ssh-keypair;rotate-observability-credentials;rotate-ca-start"),
 					Entry("operations with spaces", " rotate-ssh-keypair ; rotate-observability-credentials "),
@@ -7520,8 +7649,28 @@ var _ = Describe("Shoot Validation Tests", func() {
 
 					Entry("retry with other operation", "retry;rotate-ssh-keypair", "retry"),
 					Entry("maintain with other operation", "rotate-ssh-keypair; maintain", "maintain"),
+					Entry("dry-run with other operation", "dry-run;rotate-ssh-keypair", "dry-run"),
 				)
 
+				It("should forbid exporting a shoot which was not yet created successfully", func() {
+					shoot.Status.LastOperation.State = core.LastOperationStateProcessing
+					metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "gardener.cloud/operation", "export")
+					Expect(ValidateShoot(shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeForbidden),
+						"Field":  Equal("metadata.annotations[gardener.cloud/operation]"),
+						"Detail": Equal("cannot export shoot if it was not yet created successfully or is not ready for reconciliation"),
+					}))))
+				})
+
+				It("should forbid undeleting a shoot which is not pending deletion", func() {
+					metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "gardener.cloud/operation", "undelete")
+					Expect(ValidateShoot(shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeForbidden),
+						"Field":  Equal("metadata.annotations[gardener.cloud/operation]"),
+						"Detail": Equal("cannot undelete shoot if it is not pending deletion"),
+					}))))
+				})
+
 				It("should return an error on first not allowed to be run in parallel operation", func() {
 					metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "gardener.cloud/operation", "retry;reconcile;maintain")
 					Expect(ValidateShoot(shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
@@ -10111,6 +10260,26 @@ var _ = Describe("Shoot Validation Tests", func() {
 		})
 	})
 
+	Describe("#ValidateHibernation", func() {
+		It("should allow inactivity timeouts of at least one hour", func() {
+			hibernation := &core.Hibernation{Inactivity: &core.HibernationInactivity{Timeout: metav1.Duration{Duration: time.Hour}}}
+
+			Expect(ValidateHibernation(nil, hibernation, field.NewPath("hibernation"))).To(BeEmpty())
+		})
+
+		It("should forbid inactivity timeouts shorter than one hour", func() {
+			hibernation := &core.Hibernation{Inactivity: &core.HibernationInactivity{Timeout: metav1.Duration{Duration: 30 * time.Minute}}}
+
+			Expect(ValidateHibernation(nil, hibernation, field.NewPath("hibernation"))).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("hibernation.inactivity.timeout"),
+					"Detail": Equal("must be at least 1h"),
+				})),
+			))
+		})
+	})
+
 	Describe("#ValidateHibernationSchedules", func() {
 		DescribeTable("validate hibernation schedules",
 			func(schedules []core.HibernationSchedule, matcher gomegatypes.GomegaMatcher) {
@@ -30,3 +30,20 @@ func AddBastionShootName(ctx context.Context, indexer client.FieldIndexer) error
 	}
 	return nil
 }
+
+// ShootRestoreShootNameIndexerFunc extracts the .spec.shootRef.name field of a ShootRestore.
+func ShootRestoreShootNameIndexerFunc(obj client.Object) []string {
+	shootRestore, ok := obj.(*operationsv1alpha1.ShootRestore)
+	if !ok {
+		return []string{""}
+	}
+	return []string{shootRestore.Spec.ShootRef.Name}
+}
+
+// AddShootRestoreShootName adds an index for operations.ShootRestoreShootName to the given indexer.
+func AddShootRestoreShootName(ctx context.Context, indexer client.FieldIndexer) error {
+	if err := indexer.IndexField(ctx, &operationsv1alpha1.ShootRestore{}, operations.ShootRestoreShootName, ShootRestoreShootNameIndexerFunc); err != nil {
+		return fmt.Errorf("failed to add indexer for %s to ShootRestore Informer: %w", operations.ShootRestoreShootName, err)
+	}
+	return nil
+}
@@ -38,4 +38,19 @@ var _ = Describe("Operations", func() {
 		Entry("Bastion w/o shootRef", &operationsv1alpha1.Bastion{}, ConsistOf("")),
 		Entry("Bastion w/ shootRef", &operationsv1alpha1.Bastion{Spec: operationsv1alpha1.BastionSpec{ShootRef: corev1.LocalObjectReference{Name: "shoot"}}}, ConsistOf("shoot")),
 	)
+
+	DescribeTable("#AddShootRestoreShootName",
+		func(obj client.Object, matcher gomegatypes.GomegaMatcher) {
+			Expect(AddShootRestoreShootName(context.TODO(), indexer)).To(Succeed())
+
+			Expect(indexer.obj).To(Equal(&operationsv1alpha1.ShootRestore{}))
+			Expect(indexer.field).To(Equal("spec.shootRef.name"))
+			Expect(indexer.extractValue).NotTo(BeNil())
+			Expect(indexer.extractValue(obj)).To(matcher)
+		},
+
+		Entry("no ShootRestore", &corev1.Secret{}, ConsistOf("")),
+		Entry("ShootRestore w/o shootRef", &operationsv1alpha1.ShootRestore{}, ConsistOf("")),
+		Entry("ShootRestore w/ shootRef", &operationsv1alpha1.ShootRestore{Spec: operationsv1alpha1.ShootRestoreSpec{ShootRef: corev1.LocalObjectReference{Name: "shoot"}}}, ConsistOf("shoot")),
+	)
 })
@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/gardener/pkg/apis/operations"
+)
+
+var availableSeedRebalanceMigrationPhases = sets.New(
+	operations.SeedRebalanceMigrationPending,
+	operations.SeedRebalanceMigrationMigrating,
+	operations.SeedRebalanceMigrationSucceeded,
+	operations.SeedRebalanceMigrationFailed,
+	operations.SeedRebalanceMigrationSkipped,
+)
+
+// ValidateSeedRebalanceProposal validates a SeedRebalanceProposal object.
+func ValidateSeedRebalanceProposal(proposal *operations.SeedRebalanceProposal) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMeta(&proposal.ObjectMeta, false, apivalidation.NameIsDNSSubdomain, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, ValidateSeedRebalanceProposalSpec(&proposal.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+// ValidateSeedRebalanceProposalUpdate validates a SeedRebalanceProposal object before an update.
+func ValidateSeedRebalanceProposalUpdate(newProposal, oldProposal *operations.SeedRebalanceProposal) field.ErrorList {
+	allErrs := field.ErrorList{}
+	fldPath := field.NewPath("spec")
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaUpdate(&newProposal.ObjectMeta, &oldProposal.ObjectMeta, field.NewPath("metadata"))...)
+
+	if !apiequality.Semantic.DeepEqual(newProposal.Spec.Migrations, oldProposal.Spec.Migrations) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("migrations"), "field is immutable"))
+	}
+	if oldProposal.Spec.Approved && !newProposal.Spec.Approved {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("approved"), "approval cannot be revoked"))
+	}
+
+	allErrs = append(allErrs, ValidateSeedRebalanceProposal(newProposal)...)
+
+	return allErrs
+}
+
+// ValidateSeedRebalanceProposalSpec validates the specification of a SeedRebalanceProposal object.
+func ValidateSeedRebalanceProposalSpec(spec *operations.SeedRebalanceProposalSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(spec.Migrations) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("migrations"), "at least one migration must be proposed"))
+	}
+
+	shoots := sets.New[string]()
+	for i, migration := range spec.Migrations {
+		idxPath := fldPath.Child("migrations").Index(i)
+
+		if len(migration.ShootNamespace) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("shootNamespace"), "shoot namespace must not be empty"))
+		}
+		if len(migration.ShootName) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("shootName"), "shoot name must not be empty"))
+		}
+		if len(migration.SourceSeedName) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("sourceSeedName"), "source seed name must not be empty"))
+		}
+		if len(migration.TargetSeedName) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("targetSeedName"), "target seed name must not be empty"))
+		} else if migration.TargetSeedName == migration.SourceSeedName {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("targetSeedName"), migration.TargetSeedName, "target seed must differ from source seed"))
+		}
+
+		key := migration.ShootNamespace + "/" + migration.ShootName
+		if shoots.Has(key) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, key))
+		}
+		shoots.Insert(key)
+	}
+
+	return allErrs
+}
+
+// ValidateSeedRebalanceProposalStatusUpdate validates the status field of a SeedRebalanceProposal object.
+func ValidateSeedRebalanceProposalStatusUpdate(newProposal, _ *operations.SeedRebalanceProposal) field.ErrorList {
+	allErrs := field.ErrorList{}
+	fldPath := field.NewPath("status")
+
+	for i, migration := range newProposal.Status.Migrations {
+		if !availableSeedRebalanceMigrationPhases.Has(migration.Phase) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("migrations").Index(i).Child("phase"), migration.Phase, sets.List(availableSeedRebalanceMigrationPhases)))
+		}
+	}
+
+	return allErrs
+}
@@ -0,0 +1,135 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	. "github.com/gardener/gardener/pkg/api/operations/validation"
+	"github.com/gardener/gardener/pkg/apis/operations"
+)
+
+var _ = Describe("SeedRebalanceProposal validation", func() {
+	var proposal *operations.SeedRebalanceProposal
+
+	BeforeEach(func() {
+		proposal = &operations.SeedRebalanceProposal{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "rebalance-abcde",
+				ResourceVersion: "1",
+			},
+			Spec: operations.SeedRebalanceProposalSpec{
+				Migrations: []operations.SeedRebalanceMigration{{
+					ShootNamespace: "garden-dev",
+					ShootName:      "foo",
+					SourceSeedName: "seed-1",
+					TargetSeedName: "seed-2",
+				}},
+			},
+		}
+	})
+
+	Describe("#ValidateSeedRebalanceProposal", func() {
+		It("should not return any errors", func() {
+			Expect(ValidateSeedRebalanceProposal(proposal)).To(BeEmpty())
+		})
+
+		It("should forbid proposals without migrations", func() {
+			proposal.Spec.Migrations = nil
+
+			Expect(ValidateSeedRebalanceProposal(proposal)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.migrations"),
+			}))))
+		})
+
+		It("should forbid incomplete migrations", func() {
+			proposal.Spec.Migrations[0] = operations.SeedRebalanceMigration{}
+
+			Expect(ValidateSeedRebalanceProposal(proposal)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.migrations[0].shootNamespace"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.migrations[0].shootName"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.migrations[0].sourceSeedName"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.migrations[0].targetSeedName"),
+				})),
+			))
+		})
+
+		It("should forbid migrations to the source seed", func() {
+			proposal.Spec.Migrations[0].TargetSeedName = "seed-1"
+
+			Expect(ValidateSeedRebalanceProposal(proposal)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.migrations[0].targetSeedName"),
+			}))))
+		})
+
+		It("should forbid multiple migrations of the same shoot", func() {
+			proposal.Spec.Migrations = append(proposal.Spec.Migrations, proposal.Spec.Migrations[0])
+
+			Expect(ValidateSeedRebalanceProposal(proposal)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("spec.migrations[1]"),
+			}))))
+		})
+	})
+
+	Describe("#ValidateSeedRebalanceProposalUpdate", func() {
+		It("should allow approving the proposal", func() {
+			newProposal := proposal.DeepCopy()
+			newProposal.Spec.Approved = true
+
+			Expect(ValidateSeedRebalanceProposalUpdate(newProposal, proposal)).To(BeEmpty())
+		})
+
+		It("should forbid revoking the approval", func() {
+			proposal.Spec.Approved = true
+			newProposal := proposal.DeepCopy()
+			newProposal.Spec.Approved = false
+
+			Expect(ValidateSeedRebalanceProposalUpdate(newProposal, proposal)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("spec.approved"),
+			}))))
+		})
+
+		It("should forbid changing the migrations", func() {
+			newProposal := proposal.DeepCopy()
+			newProposal.Spec.Migrations[0].TargetSeedName = "seed-3"
+
+			Expect(ValidateSeedRebalanceProposalUpdate(newProposal, proposal)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("spec.migrations"),
+			}))))
+		})
+	})
+
+	Describe("#ValidateSeedRebalanceProposalStatusUpdate", func() {
+		It("should forbid unknown migration phases", func() {
+			newProposal := proposal.DeepCopy()
+			newProposal.Status.Migrations = []operations.SeedRebalanceMigrationStatus{{ShootNamespace: "garden-dev", ShootName: "foo", Phase: "Foo"}}
+
+			Expect(ValidateSeedRebalanceProposalStatusUpdate(newProposal, proposal)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("status.migrations[0].phase"),
+			}))))
+		})
+	})
+})
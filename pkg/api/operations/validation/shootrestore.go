@@ -0,0 +1,106 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"time"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/apis/operations"
+)
+
+var availableShootRestorePhases = sets.New(
+	operations.ShootRestorePending,
+	operations.ShootRestoreRestoring,
+	operations.ShootRestoreSucceeded,
+	operations.ShootRestoreFailed,
+)
+
+// ValidateShootRestore validates a ShootRestore object.
+func ValidateShootRestore(shootRestore *operations.ShootRestore) field.ErrorList {
+	allErrs := validateShootRestore(shootRestore)
+
+	// Neither etcd-backup-restore nor the EtcdCopyBackupsTask of etcd-druid can restore or copy the snapshots up to a
+	// given time. Restoring the latest snapshots would not revert the changes which the restoration is meant to undo but
+	// only cause a downtime of the control plane, hence new ShootRestores are rejected until this is supported.
+	allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "targetTime"), "restoring the etcd at a point in time is not supported yet"))
+
+	return allErrs
+}
+
+func validateShootRestore(shootRestore *operations.ShootRestore) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMeta(&shootRestore.ObjectMeta, true, apivalidation.NameIsDNSLabel, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, ValidateShootRestoreSpec(&shootRestore.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+// ValidateShootRestoreUpdate validates a ShootRestore object before an update.
+func ValidateShootRestoreUpdate(newShootRestore, oldShootRestore *operations.ShootRestore) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaUpdate(&newShootRestore.ObjectMeta, &oldShootRestore.ObjectMeta, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newShootRestore.Annotations[v1beta1constants.GardenCreatedBy], oldShootRestore.Annotations[v1beta1constants.GardenCreatedBy], field.NewPath("metadata.annotations"))...)
+
+	allErrs = append(allErrs, ValidateShootRestoreSpecUpdate(&newShootRestore.Spec, &oldShootRestore.Spec, field.NewPath("spec"))...)
+	allErrs = append(allErrs, validateShootRestore(newShootRestore)...)
+
+	return allErrs
+}
+
+// ValidateShootRestoreSpec validates the specification of a ShootRestore object.
+func ValidateShootRestoreSpec(spec *operations.ShootRestoreSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(spec.ShootRef.Name) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("shootRef.name"), "shoot reference must not be empty"))
+	}
+
+	if spec.TargetTime == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("targetTime"), "target time must be set"))
+	} else if spec.TargetTime.After(time.Now()) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("targetTime"), spec.TargetTime, "target time must not be in the future"))
+	}
+
+	return allErrs
+}
+
+// ValidateShootRestoreSpecUpdate validates the specification of a ShootRestore object before an update.
+func ValidateShootRestoreSpecUpdate(newSpec, oldSpec *operations.ShootRestoreSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.ShootRef.Name, oldSpec.ShootRef.Name, fldPath.Child("shootRef.name"))...)
+	if oldSpec.SeedName != nil {
+		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.SeedName, oldSpec.SeedName, fldPath.Child("seedName"))...)
+	}
+	if !apiequality.Semantic.DeepEqual(newSpec.TargetTime, oldSpec.TargetTime) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("targetTime"), "field is immutable"))
+	}
+
+	return allErrs
+}
+
+// ValidateShootRestoreStatusUpdate validates the status field of a ShootRestore object.
+func ValidateShootRestoreStatusUpdate(newShootRestore, _ *operations.ShootRestore) field.ErrorList {
+	allErrs := field.ErrorList{}
+	fldPath := field.NewPath("status")
+
+	if phase := newShootRestore.Status.Phase; len(phase) > 0 && !availableShootRestorePhases.Has(phase) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("phase"), phase, sets.List(availableShootRestorePhases)))
+	}
+
+	if startTime, completionTime := newShootRestore.Status.StartTime, newShootRestore.Status.CompletionTime; startTime != nil && completionTime != nil && completionTime.Before(startTime) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("completionTime"), completionTime, "completion time must not be before start time"))
+	}
+
+	return allErrs
+}
@@ -0,0 +1,159 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	. "github.com/gardener/gardener/pkg/api/operations/validation"
+	"github.com/gardener/gardener/pkg/apis/operations"
+)
+
+var _ = Describe("ShootRestore validation", func() {
+	var shootRestore *operations.ShootRestore
+
+	BeforeEach(func() {
+		shootRestore = &operations.ShootRestore{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "restore",
+				Namespace:       "garden-dev",
+				ResourceVersion: "1",
+			},
+			Spec: operations.ShootRestoreSpec{
+				ShootRef:   corev1.LocalObjectReference{Name: "foo"},
+				TargetTime: &metav1.Time{Time: time.Now().Add(-time.Hour)},
+			},
+		}
+	})
+
+	Describe("#ValidateShootRestore", func() {
+		It("should forbid creating ShootRestores because restoring a point in time is not supported yet", func() {
+			Expect(ValidateShootRestore(shootRestore)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeForbidden),
+				"Field":  Equal("spec.targetTime"),
+				"Detail": Equal("restoring the etcd at a point in time is not supported yet"),
+			}))))
+		})
+	})
+
+	Describe("#ValidateShootRestoreSpec", func() {
+		var fldPath *field.Path
+
+		BeforeEach(func() {
+			fldPath = field.NewPath("spec")
+		})
+
+		It("should not return any errors", func() {
+			Expect(ValidateShootRestoreSpec(&shootRestore.Spec, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid restores without shoot reference", func() {
+			shootRestore.Spec.ShootRef.Name = ""
+
+			Expect(ValidateShootRestoreSpec(&shootRestore.Spec, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.shootRef.name"),
+			}))))
+		})
+
+		It("should forbid restores without target time", func() {
+			shootRestore.Spec.TargetTime = nil
+
+			Expect(ValidateShootRestoreSpec(&shootRestore.Spec, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.targetTime"),
+			}))))
+		})
+
+		It("should forbid target times in the future", func() {
+			shootRestore.Spec.TargetTime = &metav1.Time{Time: time.Now().Add(time.Hour)}
+
+			Expect(ValidateShootRestoreSpec(&shootRestore.Spec, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.targetTime"),
+			}))))
+		})
+	})
+
+	Describe("#ValidateShootRestoreUpdate", func() {
+		var newShootRestore *operations.ShootRestore
+
+		BeforeEach(func() {
+			shootRestore.Spec.SeedName = new("seed")
+			newShootRestore = shootRestore.DeepCopy()
+		})
+
+		It("should allow updating the status related metadata", func() {
+			newShootRestore.Labels = map[string]string{"foo": "bar"}
+
+			Expect(ValidateShootRestoreUpdate(newShootRestore, shootRestore)).To(BeEmpty())
+		})
+
+		It("should forbid changing the specification", func() {
+			newShootRestore.Spec.ShootRef.Name = "bar"
+			newShootRestore.Spec.SeedName = new("other-seed")
+			newShootRestore.Spec.TargetTime = &metav1.Time{Time: shootRestore.Spec.TargetTime.Add(-time.Minute)}
+
+			Expect(ValidateShootRestoreUpdate(newShootRestore, shootRestore)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.shootRef.name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.seedName"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.targetTime"),
+				})),
+			))
+		})
+
+		It("should allow setting the seed name", func() {
+			shootRestore.Spec.SeedName = nil
+
+			Expect(ValidateShootRestoreUpdate(newShootRestore, shootRestore)).To(BeEmpty())
+		})
+	})
+
+	Describe("#ValidateShootRestoreStatusUpdate", func() {
+		It("should allow valid status", func() {
+			shootRestore.Status = operations.ShootRestoreStatus{
+				Phase:          operations.ShootRestoreSucceeded,
+				StartTime:      &metav1.Time{Time: time.Now().Add(-time.Minute)},
+				CompletionTime: &metav1.Time{Time: time.Now()},
+			}
+
+			Expect(ValidateShootRestoreStatusUpdate(shootRestore, shootRestore)).To(BeEmpty())
+		})
+
+		It("should forbid invalid status", func() {
+			shootRestore.Status = operations.ShootRestoreStatus{
+				Phase:          "Foo",
+				StartTime:      &metav1.Time{Time: time.Now()},
+				CompletionTime: &metav1.Time{Time: time.Now().Add(-time.Minute)},
+			}
+
+			Expect(ValidateShootRestoreStatusUpdate(shootRestore, shootRestore)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("status.phase"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("status.completionTime"),
+				})),
+			))
+		})
+	})
+})
@@ -158,6 +158,15 @@ func validateRollingUpdateStrategy(rus *seedmanagement.RollingUpdateStrategy, fl
 		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(*rus.Partition), fldPath.Child("partition"))...)
 	}
 
+	// Ensure maxUnavailable is positive if specified
+	if rus.MaxUnavailable != nil && *rus.MaxUnavailable < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxUnavailable"), *rus.MaxUnavailable, "must be at least 1"))
+	}
+
+	if healthGate := rus.HealthGate; healthGate != nil && healthGate.SoakDuration != nil && healthGate.SoakDuration.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("healthGate", "soakDuration"), healthGate.SoakDuration.Duration.String(), "must not be negative"))
+	}
+
 	return allErrs
 }
 
@@ -287,6 +296,7 @@ func validatePendingReplica(pendingReplica *seedmanagement.PendingReplica, name
 		string(seedmanagement.ManagedSeedDeletingReason),
 		string(seedmanagement.SeedNotReadyReason),
 		string(seedmanagement.ShootNotHealthyReason),
+		string(seedmanagement.SeedSoakingReason),
 	}
 	if !slices.Contains(validValues, string(pendingReplica.Reason)) {
 		allErrs = append(allErrs, field.NotSupported(fldPath.Child("reason"), pendingReplica.Reason, validValues))
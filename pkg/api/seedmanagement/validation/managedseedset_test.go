@@ -5,6 +5,8 @@
 package validation_test
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
@@ -193,6 +195,35 @@ var _ = Describe("ManagedSeedSet Validation Tests", func() {
 			))
 		})
 
+		It("should forbid invalid updateStrategy.rollingUpdate.maxUnavailable and healthGate.soakDuration", func() {
+			managedSeedSet.Spec.UpdateStrategy.RollingUpdate.MaxUnavailable = new(int32(0))
+			managedSeedSet.Spec.UpdateStrategy.RollingUpdate.HealthGate = &seedmanagement.RollingUpdateHealthGate{
+				SoakDuration: &metav1.Duration{Duration: -time.Minute},
+			}
+
+			errorList := ValidateManagedSeedSet(managedSeedSet)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.updateStrategy.rollingUpdate.maxUnavailable"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.updateStrategy.rollingUpdate.healthGate.soakDuration"),
+				})),
+			))
+		})
+
+		It("should allow a health gate", func() {
+			managedSeedSet.Spec.UpdateStrategy.RollingUpdate.MaxUnavailable = new(int32(2))
+			managedSeedSet.Spec.UpdateStrategy.RollingUpdate.HealthGate = &seedmanagement.RollingUpdateHealthGate{
+				SoakDuration: &metav1.Duration{Duration: time.Hour},
+			}
+
+			Expect(ValidateManagedSeedSet(managedSeedSet)).To(BeEmpty())
+		})
+
 		It("should forbid empty selector", func() {
 			managedSeedSet.Spec.Selector = metav1.LabelSelector{}
 
@@ -563,6 +594,15 @@ var _ = Describe("ManagedSeedSet Validation Tests", func() {
 			))
 		})
 
+		It("should allow a soaking pending replica", func() {
+			newManagedSeedSet.Status.PendingReplica = &seedmanagement.PendingReplica{
+				Name:   name + "-0",
+				Reason: seedmanagement.SeedSoakingReason,
+			}
+
+			Expect(ValidateManagedSeedSetStatusUpdate(newManagedSeedSet, managedSeedSet)).To(BeEmpty())
+		})
+
 		It("should forbid invalid pending replica", func() {
 			newManagedSeedSet.Status.PendingReplica = &seedmanagement.PendingReplica{
 				Name:    "foo",
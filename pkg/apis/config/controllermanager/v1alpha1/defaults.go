@@ -11,6 +11,7 @@ import (
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
 
 	"github.com/gardener/gardener/pkg/apis/config"
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/scheduler/v1alpha1"
 )
 
 // SetDefaults_ControllerManagerConfiguration sets defaults for the configuration of the Gardener controller manager.
@@ -25,6 +26,13 @@ func SetDefaults_ControllerManagerConfiguration(obj *ControllerManagerConfigurat
 	if obj.LeaderElection == nil {
 		obj.LeaderElection = &componentbaseconfigv1alpha1.LeaderElectionConfiguration{}
 	}
+
+	if obj.ShootScheduler == nil {
+		obj.ShootScheduler = &schedulerconfigv1alpha1.ShootSchedulerConfiguration{}
+	}
+	if obj.ShootScheduler.Strategy == "" {
+		obj.ShootScheduler.Strategy = schedulerconfigv1alpha1.Default
+	}
 }
 
 // SetDefaults_ClientConnectionConfiguration sets defaults for the garden client connection.
@@ -228,6 +236,44 @@ func SetDefaults_SeedReferenceControllerConfiguration(obj *SeedReferenceControll
 	}
 }
 
+// SetDefaults_SeedRebalancerControllerConfiguration sets defaults for the SeedRebalancerControllerConfiguration.
+func SetDefaults_SeedRebalancerControllerConfiguration(obj *SeedRebalancerControllerConfiguration) {
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: time.Hour}
+	}
+	if obj.MaxMigrations == nil {
+		obj.MaxMigrations = new(5)
+	}
+	if obj.MinScoreImprovement == nil {
+		obj.MinScoreImprovement = new(int64(20))
+	}
+	if obj.AutoExecute == nil {
+		obj.AutoExecute = new(false)
+	}
+	if obj.MaxConcurrentMigrations == nil {
+		obj.MaxConcurrentMigrations = new(1)
+	}
+	if obj.ProposalTTL == nil {
+		obj.ProposalTTL = &metav1.Duration{Duration: 24 * time.Hour}
+	}
+	if obj.MaxRetainedProposals == nil {
+		obj.MaxRetainedProposals = new(10)
+	}
+}
+
+// SetDefaults_SeedDrainControllerConfiguration sets defaults for the SeedDrainControllerConfiguration.
+func SetDefaults_SeedDrainControllerConfiguration(obj *SeedDrainControllerConfiguration) {
+	if obj.ConcurrentSyncs == nil {
+		obj.ConcurrentSyncs = new(DefaultControllerConcurrentSyncs)
+	}
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: time.Minute}
+	}
+	if obj.BatchSize == nil {
+		obj.BatchSize = new(3)
+	}
+}
+
 // SetDefaults_ShootHibernationControllerConfiguration sets defaults for the ShootHibernationControllerConfiguration.
 func SetDefaults_ShootHibernationControllerConfiguration(obj *ShootHibernationControllerConfiguration) {
 	if obj.ConcurrentSyncs == nil {
@@ -246,6 +292,12 @@ func SetDefaults_ShootMaintenanceControllerConfiguration(obj *ShootMaintenanceCo
 	if obj.EnableShootControlPlaneRestarter == nil {
 		obj.EnableShootControlPlaneRestarter = new(true)
 	}
+	if obj.UpdateChannels == nil {
+		obj.UpdateChannels = map[string]metav1.Duration{
+			"early":  {},
+			"stable": {Duration: 7 * 24 * time.Hour},
+		}
+	}
 }
 
 // SetDefaults_ShootQuotaControllerConfiguration sets defaults for the ShootQuotaControllerConfiguration.
@@ -298,6 +350,29 @@ func SetDefaults_ShootMigrationControllerConfiguration(obj *ShootMigrationContro
 	}
 }
 
+// SetDefaults_ShootEvacuationConfiguration sets defaults for the ShootEvacuationConfiguration.
+func SetDefaults_ShootEvacuationConfiguration(obj *ShootEvacuationConfiguration) {
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: time.Minute}
+	}
+	if obj.OutageDuration == nil {
+		obj.OutageDuration = &metav1.Duration{Duration: time.Hour}
+	}
+	if obj.MaxBackupAge == nil {
+		obj.MaxBackupAge = &metav1.Duration{Duration: time.Hour}
+	}
+	if obj.MaxConcurrentEvacuations == nil {
+		obj.MaxConcurrentEvacuations = new(5)
+	}
+}
+
+// SetDefaults_ShootSoftDeletionControllerConfiguration sets defaults for the ShootSoftDeletionControllerConfiguration.
+func SetDefaults_ShootSoftDeletionControllerConfiguration(obj *ShootSoftDeletionControllerConfiguration) {
+	if obj.ConcurrentSyncs == nil {
+		obj.ConcurrentSyncs = new(DefaultControllerConcurrentSyncs)
+	}
+}
+
 // SetDefaults_ManagedSeedSetControllerConfiguration sets defaults for the ManagedSeedSetControllerConfiguration.
 func SetDefaults_ManagedSeedSetControllerConfiguration(obj *ManagedSeedSetControllerConfiguration) {
 	if obj.ConcurrentSyncs == nil {
@@ -362,6 +437,9 @@ func SetDefaults_ControllerManagerControllerConfiguration(obj *ControllerManager
 	if obj.SeedReference == nil {
 		obj.SeedReference = &SeedReferenceControllerConfiguration{}
 	}
+	if obj.SeedDrain == nil {
+		obj.SeedDrain = &SeedDrainControllerConfiguration{}
+	}
 	if obj.ShootQuota == nil {
 		obj.ShootQuota = &ShootQuotaControllerConfiguration{}
 	}
@@ -380,6 +458,9 @@ func SetDefaults_ControllerManagerControllerConfiguration(obj *ControllerManager
 	if obj.ShootMigration == nil {
 		obj.ShootMigration = &ShootMigrationControllerConfiguration{}
 	}
+	if obj.ShootSoftDeletion == nil {
+		obj.ShootSoftDeletion = &ShootSoftDeletionControllerConfiguration{}
+	}
 
 	if obj.ManagedSeedSet == nil {
 		obj.ManagedSeedSet = &ManagedSeedSetControllerConfiguration{
@@ -14,6 +14,7 @@ import (
 
 	"github.com/gardener/gardener/pkg/apis/config"
 	. "github.com/gardener/gardener/pkg/apis/config/controllermanager/v1alpha1"
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/scheduler/v1alpha1"
 )
 
 var _ = Describe("Defaults", func() {
@@ -29,17 +30,20 @@ var _ = Describe("Defaults", func() {
 
 			Expect(obj.LogLevel).To(Equal(config.LogLevelInfo))
 			Expect(obj.LogFormat).To(Equal(config.LogFormatJSON))
+			Expect(obj.ShootScheduler).To(Equal(&schedulerconfigv1alpha1.ShootSchedulerConfiguration{Strategy: schedulerconfigv1alpha1.Default}))
 		})
 
 		It("should not default fields that are set", func() {
 			obj = &ControllerManagerConfiguration{
-				LogLevel:  "warning",
-				LogFormat: "md",
+				LogLevel:       "warning",
+				LogFormat:      "md",
+				ShootScheduler: &schedulerconfigv1alpha1.ShootSchedulerConfiguration{Strategy: schedulerconfigv1alpha1.MinimalDistance},
 			}
 			SetObjectDefaults_ControllerManagerConfiguration(obj)
 
 			Expect(obj.LogLevel).To(Equal("warning"))
 			Expect(obj.LogFormat).To(Equal("md"))
+			Expect(obj.ShootScheduler.Strategy).To(Equal(schedulerconfigv1alpha1.MinimalDistance))
 		})
 	})
 
@@ -574,6 +578,83 @@ var _ = Describe("Defaults", func() {
 		})
 	})
 
+	Describe("SeedRebalancerControllerConfiguration defaulting", func() {
+		It("should default SeedRebalancerControllerConfiguration correctly if set", func() {
+			obj = &ControllerManagerConfiguration{
+				Controllers: ControllerManagerControllerConfiguration{
+					SeedRebalancer: &SeedRebalancerControllerConfiguration{},
+				},
+			}
+			expected := &SeedRebalancerControllerConfiguration{
+				SyncPeriod:              &metav1.Duration{Duration: time.Hour},
+				MaxMigrations:           new(5),
+				MinScoreImprovement:     new(int64(20)),
+				AutoExecute:             new(false),
+				MaxConcurrentMigrations: new(1),
+				ProposalTTL:             &metav1.Duration{Duration: 24 * time.Hour},
+				MaxRetainedProposals:    new(10),
+			}
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.SeedRebalancer).To(Equal(expected))
+		})
+
+		It("should not default SeedRebalancerControllerConfiguration if not set", func() {
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.SeedRebalancer).To(BeNil())
+		})
+
+		It("should not default fields that are set", func() {
+			obj = &ControllerManagerConfiguration{
+				Controllers: ControllerManagerControllerConfiguration{
+					SeedRebalancer: &SeedRebalancerControllerConfiguration{
+						SyncPeriod:              &metav1.Duration{Duration: 2 * time.Hour},
+						MaxMigrations:           new(10),
+						MinScoreImprovement:     new(int64(50)),
+						AutoExecute:             new(true),
+						MaxConcurrentMigrations: new(3),
+						ProposalTTL:             &metav1.Duration{Duration: time.Hour},
+						MaxRetainedProposals:    new(0),
+					},
+				},
+			}
+			expected := obj.Controllers.SeedRebalancer.DeepCopy()
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.SeedRebalancer).To(Equal(expected))
+		})
+	})
+
+	Describe("SeedDrainControllerConfiguration defaulting", func() {
+		It("should default SeedDrainControllerConfiguration correctly", func() {
+			expected := &SeedDrainControllerConfiguration{
+				ConcurrentSyncs: new(DefaultControllerConcurrentSyncs),
+				SyncPeriod:      &metav1.Duration{Duration: time.Minute},
+				BatchSize:       new(3),
+			}
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.SeedDrain).To(Equal(expected))
+		})
+
+		It("should not default fields that are set", func() {
+			obj = &ControllerManagerConfiguration{
+				Controllers: ControllerManagerControllerConfiguration{
+					SeedDrain: &SeedDrainControllerConfiguration{
+						ConcurrentSyncs: new(10),
+						SyncPeriod:      &metav1.Duration{Duration: 5 * time.Minute},
+						BatchSize:       new(1),
+					},
+				},
+			}
+			expected := obj.Controllers.SeedDrain.DeepCopy()
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.SeedDrain).To(Equal(expected))
+		})
+	})
+
 	Describe("ShootHibernationControllerConfiguration defaulting", func() {
 		It("should default ShootHibernationControllerConfiguration correctly", func() {
 			expected := &ShootHibernationControllerConfiguration{
@@ -606,6 +687,10 @@ var _ = Describe("Defaults", func() {
 			expected := &ShootMaintenanceControllerConfiguration{
 				ConcurrentSyncs:                  new(DefaultControllerConcurrentSyncs),
 				EnableShootControlPlaneRestarter: new(true),
+				UpdateChannels: map[string]metav1.Duration{
+					"early":  {},
+					"stable": {Duration: 7 * 24 * time.Hour},
+				},
 			}
 			SetObjectDefaults_ControllerManagerConfiguration(obj)
 
@@ -618,6 +703,7 @@ var _ = Describe("Defaults", func() {
 					ShootMaintenance: ShootMaintenanceControllerConfiguration{
 						ConcurrentSyncs:                  new(10),
 						EnableShootControlPlaneRestarter: new(false),
+						UpdateChannels:                   map[string]metav1.Duration{"stable": {Duration: time.Hour}},
 					},
 				},
 			}
@@ -796,6 +882,68 @@ var _ = Describe("Defaults", func() {
 
 			Expect(obj.Controllers.ShootMigration).To(Equal(expected))
 		})
+
+		It("should default the evacuation configuration if set", func() {
+			obj = &ControllerManagerConfiguration{
+				Controllers: ControllerManagerControllerConfiguration{
+					ShootMigration: &ShootMigrationControllerConfiguration{
+						Evacuation: &ShootEvacuationConfiguration{},
+					},
+				},
+			}
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ShootMigration.Evacuation).To(Equal(&ShootEvacuationConfiguration{
+				SyncPeriod:               &metav1.Duration{Duration: time.Minute},
+				OutageDuration:           &metav1.Duration{Duration: time.Hour},
+				MaxBackupAge:             &metav1.Duration{Duration: time.Hour},
+				MaxConcurrentEvacuations: new(5),
+			}))
+		})
+
+		It("should not default evacuation fields that are set", func() {
+			obj = &ControllerManagerConfiguration{
+				Controllers: ControllerManagerControllerConfiguration{
+					ShootMigration: &ShootMigrationControllerConfiguration{
+						Evacuation: &ShootEvacuationConfiguration{
+							SyncPeriod:               &metav1.Duration{Duration: 2 * time.Minute},
+							OutageDuration:           &metav1.Duration{Duration: 3 * time.Hour},
+							MaxBackupAge:             &metav1.Duration{Duration: 30 * time.Minute},
+							MaxConcurrentEvacuations: new(1),
+						},
+					},
+				},
+			}
+			expected := obj.Controllers.ShootMigration.Evacuation.DeepCopy()
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ShootMigration.Evacuation).To(Equal(expected))
+		})
+	})
+
+	Describe("ShootSoftDeletionControllerConfiguration defaulting", func() {
+		It("should default ShootSoftDeletionControllerConfiguration correctly", func() {
+			expected := &ShootSoftDeletionControllerConfiguration{
+				ConcurrentSyncs: new(DefaultControllerConcurrentSyncs),
+			}
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ShootSoftDeletion).To(Equal(expected))
+		})
+
+		It("should not default fields that are set", func() {
+			obj = &ControllerManagerConfiguration{
+				Controllers: ControllerManagerControllerConfiguration{
+					ShootSoftDeletion: &ShootSoftDeletionControllerConfiguration{
+						ConcurrentSyncs: new(10),
+					},
+				},
+			}
+			expected := obj.Controllers.ShootSoftDeletion.DeepCopy()
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ShootSoftDeletion).To(Equal(expected))
+		})
 	})
 
 	Describe("ManagedSeedSetControllerConfiguration defaulting", func() {
@@ -8,6 +8,8 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
+
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/scheduler/v1alpha1"
 )
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -21,6 +23,11 @@ type ControllerManagerConfiguration struct {
 	GardenClientConnection componentbaseconfigv1alpha1.ClientConnectionConfiguration `json:"gardenClientConnection"`
 	// Controllers defines the configuration of the controllers.
 	Controllers ControllerManagerControllerConfiguration `json:"controllers"`
+	// ShootScheduler is the configuration of the shoot scheduler of the gardener-scheduler. Controllers which determine
+	// seeds for shoots (seed rebalancer, seed drain, shoot evacuation) use it to make the same decisions as the
+	// gardener-scheduler, hence it must match the configuration of the gardener-scheduler.
+	// +optional
+	ShootScheduler *schedulerconfigv1alpha1.ShootSchedulerConfiguration `json:"shootScheduler,omitempty"`
 	// LeaderElection defines the configuration of leader election client.
 	// +optional
 	LeaderElection *componentbaseconfigv1alpha1.LeaderElectionConfiguration `json:"leaderElection,omitempty"`
@@ -91,6 +98,13 @@ type ControllerManagerControllerConfiguration struct {
 	// SeedReference defines the configuration of the SeedReference controller. If unspecified, it is defaulted with `concurrentSyncs=5`.
 	// +optional
 	SeedReference *SeedReferenceControllerConfiguration `json:"seedReference,omitempty"`
+	// SeedRebalancer defines the configuration of the SeedRebalancer controller. If unset, the controller will be
+	// disabled.
+	// +optional
+	SeedRebalancer *SeedRebalancerControllerConfiguration `json:"seedRebalancer,omitempty"`
+	// SeedDrain defines the configuration of the SeedDrain controller.
+	// +optional
+	SeedDrain *SeedDrainControllerConfiguration `json:"seedDrain,omitempty"`
 	// ShootMaintenance defines the configuration of the ShootMaintenance controller.
 	ShootMaintenance ShootMaintenanceControllerConfiguration `json:"shootMaintenance"`
 	// ShootQuota defines the configuration of the ShootQuota controller.
@@ -113,6 +127,9 @@ type ControllerManagerControllerConfiguration struct {
 	// ShootMigration defines the configuration of the ShootMigration controller. If unspecified, it is defaulted with `concurrentSyncs=5`.
 	// +optional
 	ShootMigration *ShootMigrationControllerConfiguration `json:"shootMigration,omitempty"`
+	// ShootSoftDeletion defines the configuration of the ShootSoftDeletion controller. If unspecified, it is defaulted with `concurrentSyncs=5`.
+	// +optional
+	ShootSoftDeletion *ShootSoftDeletionControllerConfiguration `json:"shootSoftDeletion,omitempty"`
 	// ManagedSeedSet defines the configuration of the ManagedSeedSet controller.
 	// +optional
 	ManagedSeedSet *ManagedSeedSetControllerConfiguration `json:"managedSeedSet,omitempty"`
@@ -324,6 +341,53 @@ type SeedReferenceControllerConfiguration struct {
 	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
 }
 
+// SeedRebalancerControllerConfiguration defines the configuration of the
+// SeedRebalancer controller.
+type SeedRebalancerControllerConfiguration struct {
+	// SyncPeriod is the duration how often the placement of the shoot control planes is evaluated (defaults to `1h`).
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// MaxMigrations is the maximum number of control plane migrations a single proposal may contain (defaults to `5`).
+	// +optional
+	MaxMigrations *int `json:"maxMigrations,omitempty"`
+	// MinScoreImprovement is the minimum difference between the scheduler scores of the target and the current seed
+	// for a migration to be proposed (defaults to `20`).
+	// +optional
+	MinScoreImprovement *int64 `json:"minScoreImprovement,omitempty"`
+	// AutoExecute controls whether proposals are executed without approval. Migrations are then only started within
+	// the maintenance time window of the respective shoot (defaults to `false`).
+	// +optional
+	AutoExecute *bool `json:"autoExecute,omitempty"`
+	// MaxConcurrentMigrations is the maximum number of control plane migrations started by the controller which may be
+	// in progress at the same time (defaults to `1`).
+	// +optional
+	MaxConcurrentMigrations *int `json:"maxConcurrentMigrations,omitempty"`
+	// ProposalTTL is the duration after which proposals expire if they were neither approved nor started. Expired
+	// proposals do not prevent new proposals from being created (defaults to `24h`).
+	// +optional
+	ProposalTTL *metav1.Duration `json:"proposalTTL,omitempty"`
+	// MaxRetainedProposals is the maximum number of completed or expired proposals which are kept. Older proposals are
+	// deleted (defaults to `10`).
+	// +optional
+	MaxRetainedProposals *int `json:"maxRetainedProposals,omitempty"`
+}
+
+// SeedDrainControllerConfiguration defines the configuration of the
+// SeedDrain controller.
+type SeedDrainControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// seeds.
+	// +optional
+	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+	// SyncPeriod is the duration how often the progress of a drain operation is evaluated (defaults to `1m`).
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// BatchSize is the maximum number of control plane migrations off a drained seed which may be in progress at the
+	// same time (defaults to `3`).
+	// +optional
+	BatchSize *int `json:"batchSize,omitempty"`
+}
+
 // ShootMaintenanceControllerConfiguration defines the configuration of the
 // ShootMaintenance controller.
 type ShootMaintenanceControllerConfiguration struct {
@@ -337,6 +401,25 @@ type ShootMaintenanceControllerConfiguration struct {
 	// EnableShootCoreAddonRestarter configures whether some core addons to be restarted during maintenance.
 	// +optional
 	EnableShootCoreAddonRestarter *bool `json:"enableShootCoreAddonRestarter"`
+	// MaintenanceFreezes are landscape-wide periods in which no automatic maintenance operations are performed for
+	// shoots. They apply in addition to the maintenance freezes configured in the projects.
+	// +optional
+	MaintenanceFreezes []MaintenanceFreeze `json:"maintenanceFreezes,omitempty"`
+	// UpdateChannels maps the names of the update channels which can be used in auto-update policies of shoots and
+	// projects to the minimum age of versions before they are picked by automatic updates.
+	// Defaults to "early" with no soak time and "stable" with a soak time of 7 days.
+	// +optional
+	UpdateChannels map[string]metav1.Duration `json:"updateChannels,omitempty"`
+}
+
+// MaintenanceFreeze is a period in which no automatic maintenance operations are performed for shoots.
+type MaintenanceFreeze struct {
+	// Name is the name of the maintenance freeze.
+	Name string `json:"name"`
+	// Begin is the time when the maintenance freeze begins.
+	Begin metav1.Time `json:"begin"`
+	// End is the time when the maintenance freeze ends.
+	End metav1.Time `json:"end"`
 }
 
 // ShootQuotaControllerConfiguration defines the configuration of the
@@ -417,6 +500,41 @@ type ShootMigrationControllerConfiguration struct {
 	// events.
 	// +optional
 	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+	// Evacuation contains the configuration for the automatic evacuation of shoot control planes from unhealthy seeds.
+	// If unset, control planes are not evacuated automatically. Evacuations must additionally be enabled per seed via the
+	// `seed.gardener.cloud/evacuation` annotation.
+	// +optional
+	Evacuation *ShootEvacuationConfiguration `json:"evacuation,omitempty"`
+}
+
+// ShootEvacuationConfiguration contains the configuration for the automatic evacuation of shoot control planes from
+// unhealthy seeds.
+type ShootEvacuationConfiguration struct {
+	// SyncPeriod is the duration how often unhealthy seeds are checked for control planes to evacuate (defaults to `1m`).
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// OutageDuration is the duration for which the `GardenletReady` condition of a seed must be `False` or `Unknown` or
+	// its `SeedSystemComponentsHealthy` condition must be `False` before its control planes are evacuated (defaults to
+	// `1h`).
+	// +optional
+	OutageDuration *metav1.Duration `json:"outageDuration,omitempty"`
+	// MaxBackupAge is the maximum age of the newest backup of a shoot at the time the outage of its seed began. Control
+	// planes with older or unknown backups are not evacuated (defaults to `1h`).
+	// +optional
+	MaxBackupAge *metav1.Duration `json:"maxBackupAge,omitempty"`
+	// MaxConcurrentEvacuations is the maximum number of control planes across all seeds which are evacuated at the same
+	// time (defaults to `5`).
+	// +optional
+	MaxConcurrentEvacuations *int `json:"maxConcurrentEvacuations,omitempty"`
+}
+
+// ShootSoftDeletionControllerConfiguration defines the configuration of the
+// ShootSoftDeletion controller.
+type ShootSoftDeletionControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	// +optional
+	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
 }
 
 // ManagedSeedSetControllerConfiguration defines the configuration of the
@@ -13,6 +13,8 @@ import (
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	configv1alpha1 "k8s.io/component-base/config/v1alpha1"
+
+	schedulerv1alpha1 "github.com/gardener/gardener/pkg/apis/config/scheduler/v1alpha1"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -127,6 +129,11 @@ func (in *ControllerManagerConfiguration) DeepCopyInto(out *ControllerManagerCon
 	out.TypeMeta = in.TypeMeta
 	out.GardenClientConnection = in.GardenClientConnection
 	in.Controllers.DeepCopyInto(&out.Controllers)
+	if in.ShootScheduler != nil {
+		in, out := &in.ShootScheduler, &out.ShootScheduler
+		*out = new(schedulerv1alpha1.ShootSchedulerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.LeaderElection != nil {
 		in, out := &in.LeaderElection, &out.LeaderElection
 		*out = new(configv1alpha1.LeaderElectionConfiguration)
@@ -249,6 +256,16 @@ func (in *ControllerManagerControllerConfiguration) DeepCopyInto(out *Controller
 		*out = new(SeedReferenceControllerConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SeedRebalancer != nil {
+		in, out := &in.SeedRebalancer, &out.SeedRebalancer
+		*out = new(SeedRebalancerControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SeedDrain != nil {
+		in, out := &in.SeedDrain, &out.SeedDrain
+		*out = new(SeedDrainControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	in.ShootMaintenance.DeepCopyInto(&out.ShootMaintenance)
 	if in.ShootQuota != nil {
 		in, out := &in.ShootQuota, &out.ShootQuota
@@ -281,6 +298,11 @@ func (in *ControllerManagerControllerConfiguration) DeepCopyInto(out *Controller
 		*out = new(ShootMigrationControllerConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ShootSoftDeletion != nil {
+		in, out := &in.ShootSoftDeletion, &out.ShootSoftDeletion
+		*out = new(ShootSoftDeletionControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ManagedSeedSet != nil {
 		in, out := &in.ManagedSeedSet, &out.ManagedSeedSet
 		*out = new(ManagedSeedSetControllerConfiguration)
@@ -393,6 +415,24 @@ func (in *ExposureClassControllerConfiguration) DeepCopy() *ExposureClassControl
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceFreeze) DeepCopyInto(out *MaintenanceFreeze) {
+	*out = *in
+	in.Begin.DeepCopyInto(&out.Begin)
+	in.End.DeepCopyInto(&out.End)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceFreeze.
+func (in *MaintenanceFreeze) DeepCopy() *MaintenanceFreeze {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceFreeze)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ManagedSeedSetControllerConfiguration) DeepCopyInto(out *ManagedSeedSetControllerConfiguration) {
 	*out = *in
@@ -620,6 +660,37 @@ func (in *SeedControllerConfiguration) DeepCopy() *SeedControllerConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedDrainControllerConfiguration) DeepCopyInto(out *SeedDrainControllerConfiguration) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
+	}
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.BatchSize != nil {
+		in, out := &in.BatchSize, &out.BatchSize
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedDrainControllerConfiguration.
+func (in *SeedDrainControllerConfiguration) DeepCopy() *SeedDrainControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedDrainControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SeedExtensionsCheckControllerConfiguration) DeepCopyInto(out *SeedExtensionsCheckControllerConfiguration) {
 	*out = *in
@@ -651,6 +722,57 @@ func (in *SeedExtensionsCheckControllerConfiguration) DeepCopy() *SeedExtensions
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedRebalancerControllerConfiguration) DeepCopyInto(out *SeedRebalancerControllerConfiguration) {
+	*out = *in
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxMigrations != nil {
+		in, out := &in.MaxMigrations, &out.MaxMigrations
+		*out = new(int)
+		**out = **in
+	}
+	if in.MinScoreImprovement != nil {
+		in, out := &in.MinScoreImprovement, &out.MinScoreImprovement
+		*out = new(int64)
+		**out = **in
+	}
+	if in.AutoExecute != nil {
+		in, out := &in.AutoExecute, &out.AutoExecute
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MaxConcurrentMigrations != nil {
+		in, out := &in.MaxConcurrentMigrations, &out.MaxConcurrentMigrations
+		*out = new(int)
+		**out = **in
+	}
+	if in.ProposalTTL != nil {
+		in, out := &in.ProposalTTL, &out.ProposalTTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxRetainedProposals != nil {
+		in, out := &in.MaxRetainedProposals, &out.MaxRetainedProposals
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedRebalancerControllerConfiguration.
+func (in *SeedRebalancerControllerConfiguration) DeepCopy() *SeedRebalancerControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedRebalancerControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SeedReferenceControllerConfiguration) DeepCopyInto(out *SeedReferenceControllerConfiguration) {
 	*out = *in
@@ -735,6 +857,42 @@ func (in *ShootConditionsControllerConfiguration) DeepCopy() *ShootConditionsCon
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootEvacuationConfiguration) DeepCopyInto(out *ShootEvacuationConfiguration) {
+	*out = *in
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.OutageDuration != nil {
+		in, out := &in.OutageDuration, &out.OutageDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxBackupAge != nil {
+		in, out := &in.MaxBackupAge, &out.MaxBackupAge
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxConcurrentEvacuations != nil {
+		in, out := &in.MaxConcurrentEvacuations, &out.MaxConcurrentEvacuations
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootEvacuationConfiguration.
+func (in *ShootEvacuationConfiguration) DeepCopy() *ShootEvacuationConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootEvacuationConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootHibernationControllerConfiguration) DeepCopyInto(out *ShootHibernationControllerConfiguration) {
 	*out = *in
@@ -779,6 +937,20 @@ func (in *ShootMaintenanceControllerConfiguration) DeepCopyInto(out *ShootMainte
 		*out = new(bool)
 		**out = **in
 	}
+	if in.MaintenanceFreezes != nil {
+		in, out := &in.MaintenanceFreezes, &out.MaintenanceFreezes
+		*out = make([]MaintenanceFreeze, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.UpdateChannels != nil {
+		in, out := &in.UpdateChannels, &out.UpdateChannels
+		*out = make(map[string]metav1.Duration, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
@@ -800,6 +972,11 @@ func (in *ShootMigrationControllerConfiguration) DeepCopyInto(out *ShootMigratio
 		*out = new(int)
 		**out = **in
 	}
+	if in.Evacuation != nil {
+		in, out := &in.Evacuation, &out.Evacuation
+		*out = new(ShootEvacuationConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -891,6 +1068,27 @@ func (in *ShootRetryControllerConfiguration) DeepCopy() *ShootRetryControllerCon
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootSoftDeletionControllerConfiguration) DeepCopyInto(out *ShootSoftDeletionControllerConfiguration) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootSoftDeletionControllerConfiguration.
+func (in *ShootSoftDeletionControllerConfiguration) DeepCopy() *ShootSoftDeletionControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootSoftDeletionControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootStateControllerConfiguration) DeepCopyInto(out *ShootStateControllerConfiguration) {
 	*out = *in
@@ -75,6 +75,12 @@ func SetObjectDefaults_ControllerManagerConfiguration(in *ControllerManagerConfi
 	if in.Controllers.SeedReference != nil {
 		SetDefaults_SeedReferenceControllerConfiguration(in.Controllers.SeedReference)
 	}
+	if in.Controllers.SeedRebalancer != nil {
+		SetDefaults_SeedRebalancerControllerConfiguration(in.Controllers.SeedRebalancer)
+	}
+	if in.Controllers.SeedDrain != nil {
+		SetDefaults_SeedDrainControllerConfiguration(in.Controllers.SeedDrain)
+	}
 	SetDefaults_ShootMaintenanceControllerConfiguration(&in.Controllers.ShootMaintenance)
 	if in.Controllers.ShootQuota != nil {
 		SetDefaults_ShootQuotaControllerConfiguration(in.Controllers.ShootQuota)
@@ -94,6 +100,12 @@ func SetObjectDefaults_ControllerManagerConfiguration(in *ControllerManagerConfi
 	}
 	if in.Controllers.ShootMigration != nil {
 		SetDefaults_ShootMigrationControllerConfiguration(in.Controllers.ShootMigration)
+		if in.Controllers.ShootMigration.Evacuation != nil {
+			SetDefaults_ShootEvacuationConfiguration(in.Controllers.ShootMigration.Evacuation)
+		}
+	}
+	if in.Controllers.ShootSoftDeletion != nil {
+		SetDefaults_ShootSoftDeletionControllerConfiguration(in.Controllers.ShootSoftDeletion)
 	}
 	if in.Controllers.ManagedSeedSet != nil {
 		SetDefaults_ManagedSeedSetControllerConfiguration(in.Controllers.ManagedSeedSet)
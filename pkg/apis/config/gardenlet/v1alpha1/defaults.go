@@ -161,6 +161,12 @@ func SetDefaults_GardenletControllerConfiguration(obj *GardenletControllerConfig
 	if obj.SeedCare == nil {
 		obj.SeedCare = &SeedCareControllerConfiguration{}
 	}
+	if obj.SeedUtilization == nil {
+		obj.SeedUtilization = &SeedUtilizationControllerConfiguration{}
+	}
+	if obj.ShootRestore == nil {
+		obj.ShootRestore = &ShootRestoreControllerConfiguration{}
+	}
 	if obj.ShootState == nil {
 		obj.ShootState = &ShootStateControllerConfiguration{}
 	}
@@ -315,6 +321,13 @@ func SetDefaults_SeedCareControllerConfiguration(obj *SeedCareControllerConfigur
 	}
 }
 
+// SetDefaults_SeedUtilizationControllerConfiguration sets defaults for the seed utilization controller.
+func SetDefaults_SeedUtilizationControllerConfiguration(obj *SeedUtilizationControllerConfiguration) {
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: time.Minute}
+	}
+}
+
 // SetDefaults_ShootControllerConfiguration sets defaults for the shoot controller.
 func SetDefaults_ShootControllerConfiguration(obj *ShootControllerConfiguration) {
 	if obj.ConcurrentSyncs == nil {
@@ -373,6 +386,13 @@ func SetDefaults_StaleExtensionHealthChecks(obj *StaleExtensionHealthChecks) {
 	}
 }
 
+// SetDefaults_ShootRestoreControllerConfiguration sets defaults for the shoot restore controller.
+func SetDefaults_ShootRestoreControllerConfiguration(obj *ShootRestoreControllerConfiguration) {
+	if obj.ConcurrentSyncs == nil {
+		obj.ConcurrentSyncs = new(5)
+	}
+}
+
 // SetDefaults_ShootStateControllerConfiguration sets defaults for the shoot state controller.
 func SetDefaults_ShootStateControllerConfiguration(obj *ShootStateControllerConfiguration) {
 	if obj.ConcurrentSyncs == nil {
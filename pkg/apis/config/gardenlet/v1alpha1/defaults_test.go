@@ -44,6 +44,8 @@ var _ = Describe("Defaults", func() {
 			Expect(obj.Controllers.Shoot).NotTo(BeNil())
 			Expect(obj.Controllers.ShootCare).NotTo(BeNil())
 			Expect(obj.Controllers.SeedCare).NotTo(BeNil())
+			Expect(obj.Controllers.SeedUtilization).NotTo(BeNil())
+			Expect(obj.Controllers.ShootRestore).NotTo(BeNil())
 			Expect(obj.Controllers.ShootState).NotTo(BeNil())
 			Expect(obj.Controllers.ManagedSeed).NotTo(BeNil())
 			Expect(obj.LeaderElection).NotTo(BeNil())
@@ -355,6 +357,24 @@ var _ = Describe("Defaults", func() {
 		})
 	})
 
+	Describe("SeedUtilizationControllerConfiguration defaulting", func() {
+		It("should default the seed utilization controller configuration", func() {
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.SeedUtilization.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: time.Minute})))
+		})
+
+		It("should not overwrite already set values for the seed utilization controller configuration", func() {
+			syncPeriod := metav1.Duration{Duration: 5 * time.Minute}
+			obj.Controllers = &GardenletControllerConfiguration{
+				SeedUtilization: &SeedUtilizationControllerConfiguration{SyncPeriod: &syncPeriod},
+			}
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.SeedUtilization.SyncPeriod).To(PointTo(Equal(syncPeriod)))
+		})
+	})
+
 	Describe("ShootControllerConfiguration defaulting", func() {
 		It("should default the shoot controller configuration", func() {
 			SetObjectDefaults_GardenletConfiguration(obj)
@@ -438,6 +458,23 @@ var _ = Describe("Defaults", func() {
 		})
 	})
 
+	Describe("ShootRestoreControllerConfiguration defaulting", func() {
+		It("should default the shoot restore controller configuration", func() {
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.ShootRestore.ConcurrentSyncs).To(PointTo(Equal(5)))
+		})
+
+		It("should not overwrite already set values for the shoot restore controller configuration", func() {
+			obj.Controllers = &GardenletControllerConfiguration{
+				ShootRestore: &ShootRestoreControllerConfiguration{ConcurrentSyncs: new(10)},
+			}
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.ShootRestore.ConcurrentSyncs).To(PointTo(Equal(10)))
+		})
+	})
+
 	Describe("ShootStateControllerConfiguration defaulting", func() {
 		It("should default the shoot state controller configuration", func() {
 			SetObjectDefaults_GardenletConfiguration(obj)
@@ -92,6 +92,10 @@ type GardenletConfiguration struct {
 	// NodeToleration contains optional settings for default tolerations.
 	// +optional
 	NodeToleration *NodeToleration `json:"nodeToleration,omitempty"`
+	// Tracing contains configuration for exporting traces of the reconciliation flows to an OpenTelemetry collector.
+	// If it is not set, no traces are exported.
+	// +optional
+	Tracing *TracingConfiguration `json:"tracing,omitempty"`
 }
 
 // GardenClientConnection specifies the kubeconfig file and the client connection settings
@@ -181,12 +185,18 @@ type GardenletControllerConfiguration struct {
 	// SeedCare defines the configuration of the SeedCare controller.
 	// +optional
 	SeedCare *SeedCareControllerConfiguration `json:"seedCare,omitempty"`
+	// SeedUtilization defines the configuration of the SeedUtilization controller.
+	// +optional
+	SeedUtilization *SeedUtilizationControllerConfiguration `json:"seedUtilization,omitempty"`
 	// Shoot defines the configuration of the Shoot controller.
 	// +optional
 	Shoot *ShootControllerConfiguration `json:"shoot,omitempty"`
 	// ShootCare defines the configuration of the ShootCare controller.
 	// +optional
 	ShootCare *ShootCareControllerConfiguration `json:"shootCare,omitempty"`
+	// ShootRestore defines the configuration of the ShootRestore controller.
+	// +optional
+	ShootRestore *ShootRestoreControllerConfiguration `json:"shootRestore,omitempty"`
 	// ShootState defines the configuration of the ShootState controller.
 	// +optional
 	ShootState *ShootStateControllerConfiguration `json:"shootState,omitempty"`
@@ -365,6 +375,23 @@ type SeedCareControllerConfiguration struct {
 	ConditionThresholds []ConditionThreshold `json:"conditionThresholds,omitempty"`
 }
 
+// SeedUtilizationControllerConfiguration defines the configuration of the SeedUtilization
+// controller.
+type SeedUtilizationControllerConfiguration struct {
+	// SyncPeriod is the duration how often the resource requests and usage of the shoot control planes hosted by the
+	// seed are aggregated and reported in the Seed status.
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+}
+
+// ShootRestoreControllerConfiguration defines the configuration of the ShootRestore
+// controller.
+type ShootRestoreControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on events.
+	// +optional
+	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+}
+
 // ShootStateControllerConfiguration defines the configuration of the ShootState controller.
 type ShootStateControllerConfiguration struct {
 	// ConcurrentSyncs is the number of workers used for the controller to work on events.
@@ -771,3 +798,13 @@ type NodeToleration struct {
 	// +optional
 	DefaultUnreachableTolerationSeconds *int64 `json:"defaultUnreachableTolerationSeconds,omitempty"`
 }
+
+// TracingConfiguration contains configuration for exporting traces of the reconciliation flows via OTLP.
+type TracingConfiguration struct {
+	// Endpoint is the address of the OTLP/gRPC endpoint of the OpenTelemetry collector, e.g.
+	// `otel-collector.observability.svc:4317`.
+	Endpoint string `json:"endpoint"`
+	// Insecure disables transport security for the connection to the collector.
+	// +optional
+	Insecure *bool `json:"insecure,omitempty"`
+}
@@ -517,6 +517,11 @@ func (in *GardenletConfiguration) DeepCopyInto(out *GardenletConfiguration) {
 		*out = new(NodeToleration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Tracing != nil {
+		in, out := &in.Tracing, &out.Tracing
+		*out = new(TracingConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -586,6 +591,11 @@ func (in *GardenletControllerConfiguration) DeepCopyInto(out *GardenletControlle
 		*out = new(SeedCareControllerConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SeedUtilization != nil {
+		in, out := &in.SeedUtilization, &out.SeedUtilization
+		*out = new(SeedUtilizationControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Shoot != nil {
 		in, out := &in.Shoot, &out.Shoot
 		*out = new(ShootControllerConfiguration)
@@ -596,6 +606,11 @@ func (in *GardenletControllerConfiguration) DeepCopyInto(out *GardenletControlle
 		*out = new(ShootCareControllerConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ShootRestore != nil {
+		in, out := &in.ShootRestore, &out.ShootRestore
+		*out = new(ShootRestoreControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ShootState != nil {
 		in, out := &in.ShootState, &out.ShootState
 		*out = new(ShootStateControllerConfiguration)
@@ -1082,6 +1097,27 @@ func (in *SeedControllerConfiguration) DeepCopy() *SeedControllerConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedUtilizationControllerConfiguration) DeepCopyInto(out *SeedUtilizationControllerConfiguration) {
+	*out = *in
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedUtilizationControllerConfiguration.
+func (in *SeedUtilizationControllerConfiguration) DeepCopy() *SeedUtilizationControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedUtilizationControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Server) DeepCopyInto(out *Server) {
 	*out = *in
@@ -1313,6 +1349,27 @@ func (in *ShootNodeLogging) DeepCopy() *ShootNodeLogging {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootRestoreControllerConfiguration) DeepCopyInto(out *ShootRestoreControllerConfiguration) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootRestoreControllerConfiguration.
+func (in *ShootRestoreControllerConfiguration) DeepCopy() *ShootRestoreControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootRestoreControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootStateControllerConfiguration) DeepCopyInto(out *ShootStateControllerConfiguration) {
 	*out = *in
@@ -1428,6 +1485,27 @@ func (in *TokenRequestorWorkloadIdentityControllerConfiguration) DeepCopy() *Tok
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TracingConfiguration) DeepCopyInto(out *TracingConfiguration) {
+	*out = *in
+	if in.Insecure != nil {
+		in, out := &in.Insecure, &out.Insecure
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TracingConfiguration.
+func (in *TracingConfiguration) DeepCopy() *TracingConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(TracingConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VPAEvictionRequirementsControllerConfiguration) DeepCopyInto(out *VPAEvictionRequirementsControllerConfiguration) {
 	*out = *in
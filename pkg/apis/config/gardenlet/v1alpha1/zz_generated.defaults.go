@@ -65,6 +65,9 @@ func SetObjectDefaults_GardenletConfiguration(in *GardenletConfiguration) {
 		if in.Controllers.SeedCare != nil {
 			SetDefaults_SeedCareControllerConfiguration(in.Controllers.SeedCare)
 		}
+		if in.Controllers.SeedUtilization != nil {
+			SetDefaults_SeedUtilizationControllerConfiguration(in.Controllers.SeedUtilization)
+		}
 		if in.Controllers.Shoot != nil {
 			SetDefaults_ShootControllerConfiguration(in.Controllers.Shoot)
 		}
@@ -74,6 +77,9 @@ func SetObjectDefaults_GardenletConfiguration(in *GardenletConfiguration) {
 				SetDefaults_StaleExtensionHealthChecks(in.Controllers.ShootCare.StaleExtensionHealthChecks)
 			}
 		}
+		if in.Controllers.ShootRestore != nil {
+			SetDefaults_ShootRestoreControllerConfiguration(in.Controllers.ShootRestore)
+		}
 		if in.Controllers.ShootState != nil {
 			SetDefaults_ShootStateControllerConfiguration(in.Controllers.ShootState)
 		}
@@ -44,6 +44,10 @@ type OperatorConfiguration struct {
 	// NodeToleration contains optional settings for default tolerations.
 	// +optional
 	NodeToleration *NodeTolerationConfiguration `json:"nodeToleration,omitempty"`
+	// Tracing contains configuration for exporting traces of the reconciliation flows to an OpenTelemetry collector.
+	// If it is not set, no traces are exported.
+	// +optional
+	Tracing *TracingConfiguration `json:"tracing,omitempty"`
 }
 
 // ConditionThreshold defines the threshold of the given condition type.
@@ -193,6 +197,16 @@ type NodeTolerationConfiguration struct {
 	DefaultUnreachableTolerationSeconds *int64 `json:"defaultUnreachableTolerationSeconds,omitempty"`
 }
 
+// TracingConfiguration contains configuration for exporting traces of the reconciliation flows via OTLP.
+type TracingConfiguration struct {
+	// Endpoint is the address of the OTLP/gRPC endpoint of the OpenTelemetry collector, e.g.
+	// `otel-collector.observability.svc:4317`.
+	Endpoint string `json:"endpoint"`
+	// Insecure disables transport security for the connection to the collector.
+	// +optional
+	Insecure *bool `json:"insecure,omitempty"`
+}
+
 const (
 	// DefaultLockObjectNamespace is the default lock namespace for leader election.
 	DefaultLockObjectNamespace = "garden"
@@ -5,6 +5,7 @@
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
 )
@@ -86,6 +87,53 @@ type ShootSchedulerConfiguration struct {
 	ConcurrentSyncs int `json:"concurrentSyncs"`
 	// Strategy defines how seeds for shoots, that do not specify a seed explicitly, are being determined
 	Strategy CandidateDeterminationStrategy `json:"candidateDeterminationStrategy"`
+	// Plugins configures the filter and score plugins which are used to determine the seed for a shoot. Plugins which
+	// are enabled here are appended to the default plugins. Default plugins can be disabled by listing them in the
+	// respective 'disabled' list ('*' disables all default plugins of an extension point).
+	// +optional
+	Plugins *Plugins `json:"plugins,omitempty"`
+	// UtilizationThresholds are the maximum utilization percentages of seed resources (cpu, memory, etcd-volume). Seeds
+	// whose requested resources (as reported in their '.status.utilization') exceed one of the thresholds are not
+	// considered by the 'SeedUtilization' filter plugin.
+	// +optional
+	UtilizationThresholds []SeedUtilizationThreshold `json:"utilizationThresholds,omitempty"`
+}
+
+// SeedUtilizationThreshold is the maximum utilization percentage of a seed resource.
+type SeedUtilizationThreshold struct {
+	// Resource is the name of the resource, e.g. 'cpu', 'memory' or 'etcd-volume'.
+	Resource corev1.ResourceName `json:"resource"`
+	// Percentage is the maximum percentage of the allocatable resource which may be requested by shoot control planes.
+	Percentage int32 `json:"percentage"`
+}
+
+// Plugins contains the plugin sets for the extension points of the shoot scheduler.
+type Plugins struct {
+	// Filter is the set of plugins which filter out seeds that are not suitable for hosting the shoot.
+	// +optional
+	Filter PluginSet `json:"filter,omitempty"`
+	// Score is the set of plugins which rank the remaining seeds. The seed with the highest weighted total score wins.
+	// +optional
+	Score PluginSet `json:"score,omitempty"`
+}
+
+// PluginSet contains the plugins which should be enabled or disabled for an extension point.
+type PluginSet struct {
+	// Enabled is the list of plugins which should be enabled in addition to the default plugins.
+	// +optional
+	Enabled []Plugin `json:"enabled,omitempty"`
+	// Disabled is the list of default plugins which should be disabled. '*' disables all default plugins.
+	// +optional
+	Disabled []Plugin `json:"disabled,omitempty"`
+}
+
+// Plugin specifies a plugin name and its weight.
+type Plugin struct {
+	// Name is the name of the plugin.
+	Name string `json:"name"`
+	// Weight is the weight of the plugin. It is only used for score plugins and defaults to 1.
+	// +optional
+	Weight *int32 `json:"weight,omitempty"`
 }
 
 // ServerConfiguration contains details for the HTTP(S) servers.
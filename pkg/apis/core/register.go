@@ -57,6 +57,7 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ProjectList{},
 		&Quota{},
 		&QuotaList{},
+		&SchedulingExplanation{},
 		&SecretBinding{},
 		&SecretBindingList{},
 		&Seed{},
@@ -65,6 +66,7 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ShootStateList{},
 		&Shoot{},
 		&ShootList{},
+		&ShootWakeUp{},
 	)
 
 	return nil
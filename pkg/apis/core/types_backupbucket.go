@@ -55,6 +55,21 @@ type BackupBucketSpec struct {
 	// ShootRef is the reference of the Shoot this BackupBucket is associated with. Mutually exclusive with SeedName.
 	// This field is immutable.
 	ShootRef *corev1.ObjectReference
+	// Retention contains the retention and lifecycle policy for the backups in this bucket.
+	Retention *BackupRetention
+}
+
+// BackupRetention contains the retention and lifecycle policy for backups.
+type BackupRetention struct {
+	// DeletedEntriesGracePeriod is the duration for which the backups of deleted shoots are kept before they are
+	// removed from the bucket. If set, it takes precedence over the deletion grace period configured for the gardenlet.
+	DeletedEntriesGracePeriod *metav1.Duration
+	// MaxFullSnapshots is the maximum number of full snapshots which are kept for each etcd. Older full snapshots and
+	// their delta snapshots are garbage collected. If not set, snapshots are garbage collected exponentially.
+	MaxFullSnapshots *int32
+	// ColdStorageTransitionPeriod is the age after which backups are transitioned to a cheaper storage class of the
+	// object store. Whether and how this is supported depends on the provider.
+	ColdStorageTransitionPeriod *metav1.Duration
 }
 
 // BackupBucketStatus holds the most recently observed status of the Backup Bucket.
@@ -66,4 +66,26 @@ type BackupEntryStatus struct {
 	SeedName *string
 	// MigrationStartTime is the time when a migration to a different seed was initiated.
 	MigrationStartTime *metav1.Time
+	// Conditions represents the latest available observations of a BackupEntry's current state.
+	Conditions []Condition
+	// Usage contains information about the storage used by the backups of this BackupEntry.
+	Usage *BackupEntryUsage
 }
+
+// BackupEntryUsage contains information about the storage used by the backups of a BackupEntry.
+type BackupEntryUsage struct {
+	// SizeBytes is the total size of all objects of the BackupEntry in bytes.
+	SizeBytes int64
+	// ObjectCount is the number of objects of the BackupEntry.
+	ObjectCount int64
+	// LastUpdateTime is the time when the usage was determined.
+	LastUpdateTime metav1.Time
+	// LastBackupTime is the time when the newest object of the BackupEntry was written.
+	LastBackupTime *metav1.Time
+}
+
+const (
+	// BackupEntryRestorable is a constant for a condition type indicating whether the latest backup of the BackupEntry
+	// could be restored during the last backup validation.
+	BackupEntryRestorable ConditionType = "Restorable"
+)
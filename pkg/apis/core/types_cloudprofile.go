@@ -300,6 +300,8 @@ type ExpirableVersionStatus struct {
 	Version string
 	// Classification reflects the current state in the classification lifecycle.
 	Classification VersionClassification
+	// AddedTime is the time at which the version was first observed in the CloudProfile.
+	AddedTime *metav1.Time
 }
 
 // Limits configures operational limits for Shoot clusters using this CloudProfile.
@@ -5,6 +5,7 @@
 package core
 
 import (
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -63,6 +64,17 @@ type ProjectSpec struct {
 	Tolerations *ProjectTolerations
 	// DualApprovalForDeletion contains configuration for the dual approval concept for resource deletion.
 	DualApprovalForDeletion []DualApprovalForDeletion
+	// ShootDeletion contains configuration for the deletion of shoots in this project.
+	ShootDeletion *ProjectShootDeletion
+	// MaintenanceFreezes are periods in which no automatic maintenance operations are performed for the shoots in this
+	// project. Forceful updates of expired versions are still performed.
+	MaintenanceFreezes []MaintenanceFreeze
+	// AutoUpdatePolicy constrains which versions are picked by automatic updates for the shoots in this project which
+	// do not configure their own policy.
+	AutoUpdatePolicy *AutoUpdatePolicy
+	// HibernationCalendars are calendars of days (e.g., public holidays or company shutdown days) on which the shoots in
+	// this project are not woken up by their hibernation schedules.
+	HibernationCalendars []HibernationCalendar
 }
 
 // ProjectStatus holds the most recently observed status of the project.
@@ -109,6 +121,34 @@ type Toleration struct {
 	Value *string
 }
 
+// ProjectShootDeletion contains configuration for the deletion of shoots in a project.
+type ProjectShootDeletion struct {
+	// GracePeriod is the duration for which a deleted shoot is kept hibernated before it is actually deleted. During
+	// this period, the deletion can be reverted with the `undelete` operation.
+	GracePeriod metav1.Duration
+}
+
+// MaintenanceFreeze is a period in which no automatic maintenance operations are performed for shoots.
+type MaintenanceFreeze struct {
+	// Name is the name of the maintenance freeze.
+	Name string
+	// Begin is the time when the maintenance freeze begins.
+	Begin metav1.Time
+	// End is the time when the maintenance freeze ends.
+	End metav1.Time
+}
+
+// HibernationCalendar is a calendar of days on which shoots are not woken up by their hibernation schedules.
+type HibernationCalendar struct {
+	// Name is the name of the hibernation calendar.
+	Name string
+	// Dates is a list of calendar days in the format `YYYY-MM-DD`.
+	Dates []string
+	// ResourceRef references a ConfigMap or Secret in the project namespace which contains an iCalendar file (RFC 5545)
+	// in the `calendar.ics` data key. All days covered by the events of the file are calendar days.
+	ResourceRef *autoscalingv1.CrossVersionObjectReference
+}
+
 // DualApprovalForDeletion contains configuration for the dual approval concept for resource deletion.
 type DualApprovalForDeletion struct {
 	// Resource is the name of the resource this applies to.
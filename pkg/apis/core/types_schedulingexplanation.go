@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package core
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// SchedulingExplanation explains the scheduling decision for a Shoot.
+type SchedulingExplanation struct {
+	metav1.TypeMeta
+	// Standard object metadata.
+	metav1.ObjectMeta
+
+	// Status contains the result of the scheduling simulation.
+	Status SchedulingExplanationStatus
+}
+
+// SchedulingExplanationStatus contains the result of the scheduling simulation for a Shoot.
+type SchedulingExplanationStatus struct {
+	// SeedName is the name of the Seed the Shoot is currently scheduled to.
+	SeedName *string
+	// SelectedSeedName is the name of the Seed the scheduler would select for the Shoot.
+	SelectedSeedName *string
+	// Message describes why no Seed could be selected for the Shoot.
+	Message *string
+	// Seeds contains the scheduling results for all Seeds which were considered.
+	Seeds []SeedSchedulingResult
+}
+
+// SeedSchedulingResult contains the scheduling result for a single Seed.
+type SeedSchedulingResult struct {
+	// Name is the name of the Seed.
+	Name string
+	// RejectedBy is the name of the filter plugin which rejected the Seed.
+	RejectedBy *string
+	// Reason describes why the Seed was rejected.
+	Reason *string
+	// Scores contains the normalized scores of all score plugins. It is only set if the Seed passed all filters.
+	Scores []SchedulingPluginScore
+	// TotalScore is the weighted sum of all scores. It is only set if the Seed passed all filters.
+	TotalScore *int64
+}
+
+// SchedulingPluginScore is the normalized score a score plugin computed for a Seed.
+type SchedulingPluginScore struct {
+	// Name is the name of the score plugin.
+	Name string
+	// Weight is the weight of the score plugin.
+	Weight int32
+	// Score is the normalized score in the range of [0, 100].
+	Score int64
+}
@@ -99,6 +99,22 @@ type SeedStatus struct {
 	ClientCertificateExpirationTimestamp *metav1.Time
 	// LastOperation holds information about the last operation on the Seed.
 	LastOperation *LastOperation
+	// Utilization contains the aggregated resource requests and usage of the shoot control planes hosted by the seed.
+	Utilization *SeedUtilization
+}
+
+// SeedUtilization contains the aggregated resource requests and usage of the shoot control planes hosted by a seed.
+type SeedUtilization struct {
+	// Allocatable is the amount of resources (cpu, memory) of the seed's nodes that are available for pods and, if
+	// configured in the seed's allocatable resources, the amount of storage (etcd-volume) available for etcd volumes.
+	Allocatable corev1.ResourceList
+	// Requests is the sum of the resource requests of all shoot control planes hosted by the seed.
+	Requests corev1.ResourceList
+	// Usage is the sum of the actual resource usage of all shoot control planes hosted by the seed. It is only
+	// reported if the metrics API is available in the seed cluster.
+	Usage corev1.ResourceList
+	// LastUpdateTime is the time when the utilization was last computed.
+	LastUpdateTime *metav1.Time
 }
 
 // Backup contains the object store configuration for backups for shoot (currently only etcd).
@@ -115,6 +131,23 @@ type Backup struct {
 	// Supported referenced resources are v1.Secrets and
 	// security.gardener.cloud/v1alpha1.WorkloadIdentity
 	CredentialsRef *corev1.ObjectReference
+	// Validation contains the configuration for the periodic validation of the backups of the shoots on this seed. If
+	// set, the gardenlet regularly restores the latest backup of a sample of the BackupEntries into a temporary etcd and
+	// checks its consistency.
+	Validation *BackupValidation
+	// Retention contains the retention and lifecycle policy for the backups of the shoots on this seed.
+	Retention *BackupRetention
+}
+
+// BackupValidation contains the configuration for the periodic validation of backups by test restores.
+type BackupValidation struct {
+	// Interval is the interval in which a sample of the BackupEntries of the seed is validated.
+	Interval *metav1.Duration
+	// SamplingPercentage is the percentage of the BackupEntries of the seed which are validated in each interval.
+	// The BackupEntries whose last validation is the oldest are validated first.
+	SamplingPercentage *int32
+	// Concurrency is the maximum number of validations which are performed in parallel.
+	Concurrency *int32
 }
 
 // SeedDNS contains the external domain and configuration for the DNS provider
@@ -434,4 +467,6 @@ const (
 const (
 	// ResourceShoots is a resource constant for the number of shoots.
 	ResourceShoots corev1.ResourceName = "shoots"
+	// ResourceEtcdVolume is a resource constant for the storage of the etcd volumes of the shoot control planes.
+	ResourceEtcdVolume corev1.ResourceName = "etcd-volume"
 )
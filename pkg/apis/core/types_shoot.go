@@ -120,6 +120,11 @@ type ShootSpec struct {
 	CredentialsBindingName *string
 	// AccessRestrictions describe a list of access restrictions for this shoot cluster.
 	AccessRestrictions []AccessRestrictionWithOptions
+	// Affinity contains the scheduling constraints of the shoot's control plane in relation to other shoots.
+	Affinity *Affinity
+	// Source references an existing shoot whose etcd backup is used to seed the etcd of this shoot when it is created.
+	// This field is immutable.
+	Source *ShootSource
 }
 
 // ShootStatus holds the most recently observed status of the Shoot cluster.
@@ -174,6 +179,11 @@ type ShootStatus struct {
 	ManualWorkerPoolRollout *ManualWorkerPoolRollout
 	// LiveMigration contains information about an ongoing live control plane migration of the Shoot.
 	LiveMigration *LiveMigration
+	// MaintenancePreview contains the changes that the next maintenance of the Shoot is expected to perform.
+	MaintenancePreview *MaintenancePreview
+	// LastActivityTime is the last time at which user requests to the kube-apiserver of the Shoot were observed. It is
+	// also set when the Shoot is woken up.
+	LastActivityTime *metav1.Time
 }
 
 // LastMaintenance holds information about a maintenance operation on the Shoot.
@@ -188,6 +198,17 @@ type LastMaintenance struct {
 	FailureReason *string
 }
 
+// MaintenancePreview contains the changes that the next maintenance of the Shoot is expected to perform.
+type MaintenancePreview struct {
+	// NextMaintenanceTime is the begin of the next maintenance time window of the Shoot.
+	NextMaintenanceTime metav1.Time
+	// PlannedChanges are human-readable descriptions of the changes that the next maintenance is expected to perform,
+	// based on the current state of the Shoot and its CloudProfile.
+	PlannedChanges []string
+	// LastUpdateTime is the time when the preview was computed.
+	LastUpdateTime metav1.Time
+}
+
 // NetworkingStatus contains information about cluster networking such as CIDRs.
 type NetworkingStatus struct {
 	// Pods are the CIDRs of the pod network.
@@ -394,6 +415,35 @@ type ShootAdvertisedAddress struct {
 	Application *string
 }
 
+// ShootSource references an existing shoot whose etcd backup is used to seed the etcd of a new shoot.
+type ShootSource struct {
+	// Namespace is the namespace of the source shoot.
+	Namespace string
+	// Name is the name of the source shoot.
+	Name string
+	// TargetTime is the point in time whose state of the source shoot's etcd shall be copied. Only the snapshots which
+	// were taken at or before this time are copied. If not set, the latest snapshots are copied.
+	TargetTime *metav1.Time
+}
+
+// Affinity contains the scheduling constraints of a shoot's control plane in relation to other shoots.
+type Affinity struct {
+	// ShootAffinity contains terms selecting shoots in the same project whose control planes should run on the same
+	// seed as the control plane of this shoot. A term is only considered if at least one of the selected shoots is
+	// already scheduled. The terms are only honoured when the shoot is scheduled.
+	ShootAffinity []ShootAffinityTerm
+	// ShootAntiAffinity contains terms selecting shoots in the same project whose control planes must not run on the
+	// same seed as the control plane of this shoot. The terms are honoured when the shoot is scheduled or migrated to
+	// another seed.
+	ShootAntiAffinity []ShootAffinityTerm
+}
+
+// ShootAffinityTerm selects a group of shoots in the same project.
+type ShootAffinityTerm struct {
+	// LabelSelector is a label query over the shoots in the same project.
+	LabelSelector metav1.LabelSelector
+}
+
 // Addons is a collection of configuration for specific addons which are managed by the Gardener.
 type Addons struct {
 	// KubernetesDashboard holds configuration settings for the kubernetes dashboard addon.
@@ -508,6 +558,14 @@ type Hibernation struct {
 	Enabled *bool
 	// Schedules determine the hibernation schedules.
 	Schedules []HibernationSchedule
+	// Inactivity configures the automatic hibernation of the Shoot after a period without user activity.
+	Inactivity *HibernationInactivity
+}
+
+// HibernationInactivity configures the automatic hibernation of a Shoot after a period without user activity.
+type HibernationInactivity struct {
+	// Timeout is the duration without user requests to the kube-apiserver after which the Shoot is hibernated.
+	Timeout metav1.Duration
 }
 
 // HibernationSchedule determines the hibernation schedule of a Shoot.
@@ -1248,8 +1306,33 @@ type MaintenanceAutoUpdate struct {
 	KubernetesVersion bool
 	// MachineImageVersion indicates whether the machine image version may be automatically updated (default: true).
 	MachineImageVersion *bool
+	// Policy constrains which versions are picked by automatic updates. If not set, the auto-update policy of the
+	// project is used.
+	Policy *AutoUpdatePolicy
+}
+
+// AutoUpdatePolicy constrains which versions are picked by automatic updates of Kubernetes and machine image versions.
+type AutoUpdatePolicy struct {
+	// Channel is the update channel which determines the minimum age of versions before they are picked by automatic
+	// updates. The soak times of the channels are configured by the Gardener operator.
+	// Possible values are "early" and "stable". Mutually exclusive with SoakTime.
+	Channel *UpdateChannel
+	// SoakTime is the minimum duration since a version was added to the CloudProfile before it is picked by automatic
+	// updates. Mutually exclusive with Channel.
+	SoakTime *metav1.Duration
 }
 
+// UpdateChannel is a channel for automatic updates.
+type UpdateChannel string
+
+const (
+	// UpdateChannelEarly is the update channel for clusters which shall pick new versions early, e.g., development clusters.
+	UpdateChannelEarly UpdateChannel = "early"
+	// UpdateChannelStable is the update channel for clusters which shall only pick versions which have been available
+	// for a while, e.g., production clusters.
+	UpdateChannelStable UpdateChannel = "stable"
+)
+
 // MaintenanceAutoRotation contains information about which rotations should be automatically performed.
 type MaintenanceAutoRotation struct {
 	// Credentials contains information about which credentials should be automatically rotated.
@@ -0,0 +1,18 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package core
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ShootWakeUp is a request to wake up a hibernated Shoot.
+type ShootWakeUp struct {
+	metav1.TypeMeta
+	// Standard object metadata.
+	metav1.ObjectMeta
+}
@@ -248,6 +248,9 @@ const (
 	// GardenerTimestamp is a constant for an annotation on a resource that describes the timestamp when a reconciliation has been requested.
 	// It is only used to guarantee an update event for watching clients in case the operation-annotation is already present.
 	GardenerTimestamp = "gardener.cloud/timestamp"
+	// GardenerTraceParent is a constant for an annotation on a resource that contains the W3C trace context of the
+	// operation which requested its reconciliation. Extension controllers use it to continue the trace of the operation.
+	GardenerTraceParent = "gardener.cloud/traceparent"
 	// GardenerOperationMigrate is a constant for the value of the operation annotation describing a migration
 	// operation.
 	GardenerOperationMigrate = "migrate"
@@ -345,6 +348,26 @@ const (
 	// ignored completely. That means that the Shoot will never reach the reconciliation flow (independent of the operation (create/update/
 	// delete)).
 	ShootIgnore = "shoot.gardener.cloud/ignore"
+	// ShootExported is a constant for an annotation on a Shoot indicating that the state of the Shoot has been exported
+	// for migrating it to another garden. The control plane of such a Shoot has been removed from its seed, and the
+	// gardenlet does not act on it anymore. Deleting such a Shoot does not delete any of its infrastructure resources.
+	ShootExported = "shoot.gardener.cloud/exported"
+	// BackupBucketImported is a constant for an annotation on a BackupBucket indicating that it was imported together with
+	// a Shoot from another garden. The bucket is still managed by the other garden, hence an imported BackupBucket is not
+	// reconciled, and deleting it does not delete the bucket in the infrastructure. The annotation can only be set when
+	// the BackupBucket is created.
+	BackupBucketImported = "backupbucket.gardener.cloud/imported"
+	// ShootPendingDeletionUntil is a constant for an annotation on a Shoot indicating that the Shoot has been deleted in a
+	// project with a grace period for shoot deletions. Its value is the time (RFC3339) after which the Shoot is actually
+	// deleted. Until then, the Shoot is hibernated and its deletion can be reverted with the `undelete` operation.
+	ShootPendingDeletionUntil = "shoot.gardener.cloud/pending-deletion-until"
+	// ShootHibernatedForDeletion is a constant for an annotation on a Shoot indicating that the Shoot has been hibernated
+	// because it is pending deletion. It is woken up again when its deletion is reverted with the `undelete` operation.
+	ShootHibernatedForDeletion = "shoot.gardener.cloud/hibernated-for-deletion"
+	// ShootEvacuatedFromSeed is a constant for an annotation on a Shoot indicating that its control plane is being
+	// evacuated automatically from the unhealthy seed named in the value. It is removed once the control plane was
+	// successfully restored on the destination seed.
+	ShootEvacuatedFromSeed = "shoot.gardener.cloud/evacuated-from-seed"
 	// ShootNoCleanup is a constant for a label on a resource indicating that the Gardener cleaner should not delete this
 	// resource when cleaning a shoot during the deletion flow.
 	ShootNoCleanup = "shoot.gardener.cloud/no-cleanup"
@@ -413,6 +436,15 @@ const (
 	// ShootOperationForceInPlaceUpdate is a constant for the value of the operation annotation that must be set
 	// to forcibly trigger an in-place update when a previous update is still in progress.
 	ShootOperationForceInPlaceUpdate = "force-in-place-update"
+	// ShootOperationDryRun is a constant for an annotation on a Shoot indicating that the Shoot reconciliation shall be
+	// run in dry-run mode, i.e., the changes which would be applied are computed and stored but not applied.
+	ShootOperationDryRun = "dry-run"
+	// ShootOperationExport is a constant for an annotation on a Shoot indicating that the control plane of the Shoot shall
+	// be prepared for being exported to another garden, i.e., it is migrated away from its seed without a destination.
+	ShootOperationExport = "export"
+	// ShootOperationUndelete is a constant for an annotation on a Shoot indicating that the pending deletion of the Shoot
+	// shall be reverted.
+	ShootOperationUndelete = "undelete"
 	// OperationRotateCredentialsStart is a constant for an annotation indicating that the rotation of all credentials
 	// shall be started. This includes CAs, certificates, kubeconfigs, SSH keypairs, observability credentials, and
 	// ServiceAccount signing key.
@@ -947,8 +979,12 @@ const (
 	BackupSecretName string = "etcd-backup"
 	// DataKeyBackupBucketName is the name of a data key whose value contains the backup bucket name.
 	DataKeyBackupBucketName string = "bucketName"
+	// DataKeyHibernationCalendar is the name of a data key whose value contains an iCalendar file of a hibernation calendar.
+	DataKeyHibernationCalendar = "calendar.ics"
 	// BackupSourcePrefix is the prefix for names of resources related to source backupentries when copying backups.
 	BackupSourcePrefix = "source"
+	// BackupValidationPrefix is the prefix for names of resources related to the validation of backups by test restores.
+	BackupValidationPrefix = "validation"
 	// AnnotationBackupBucketGeneratedSecretNamespace is a constant for an annotation on an
 	// `extension.gardener.cloud/v1alpha1.BackupBucket` resource which indicates the namespace in which the generated
 	// secret should be created. If not specified, 'garden' should be used.
@@ -1138,6 +1174,19 @@ const (
 	// AnnotationEmergencyStopShootReconciliations is the key for the emergency switch annotation for the seed resource
 	// to temporarily pause further shoot reconciliations.
 	AnnotationEmergencyStopShootReconciliations = "shoot.gardener.cloud/emergency-stop-reconciliations"
+	// AnnotationSeedDrain is the key for an annotation on a seed resource which cordons the seed, i.e., no further
+	// shoots are scheduled onto it, and migrates the control planes of all its shoots to other seeds.
+	AnnotationSeedDrain = "seed.gardener.cloud/drain"
+	// AnnotationSeedEvacuation is the key for an annotation on a seed resource which allows the automatic evacuation of
+	// the control planes of its shoots during a long-lasting outage of the seed.
+	AnnotationSeedEvacuation = "seed.gardener.cloud/evacuation"
+	// SeedEvacuationEnabled is a value for the AnnotationSeedEvacuation annotation which allows evacuations via the
+	// regular `Migrate` operation executed by the gardenlet of the seed.
+	SeedEvacuationEnabled = "enabled"
+	// SeedEvacuationForced is a value for the AnnotationSeedEvacuation annotation which additionally allows forcing the
+	// restoration of control planes on other seeds if the gardenlet of the seed is unavailable. Operators must only set
+	// it if they made sure that the control planes in the seed cluster are no longer running.
+	SeedEvacuationForced = "forced"
 
 	// ConfigMapNameGardenerInfo is the name of the gardener-info ConfigMap.
 	ConfigMapNameGardenerInfo = "gardener-info"
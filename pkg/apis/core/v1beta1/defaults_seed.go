@@ -5,8 +5,11 @@
 package v1beta1
 
 import (
+	"time"
+
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 )
 
@@ -78,6 +81,21 @@ func SetDefaults_SeedSettingLoadBalancerServices(obj *SeedSettingLoadBalancerSer
 	}
 }
 
+// SetDefaults_BackupValidation sets defaults for BackupValidation objects.
+func SetDefaults_BackupValidation(obj *BackupValidation) {
+	if obj.Interval == nil {
+		obj.Interval = &metav1.Duration{Duration: 24 * time.Hour}
+	}
+
+	if obj.SamplingPercentage == nil {
+		obj.SamplingPercentage = new(int32(10))
+	}
+
+	if obj.Concurrency == nil {
+		obj.Concurrency = new(int32(1))
+	}
+}
+
 func setDefaults_ExcessCapacityReservationConfig(excessCapacityReservation *SeedSettingExcessCapacityReservation) {
 	excessCapacityReservation.Configs = []SeedSettingExcessCapacityReservationConfig{
 		// This roughly corresponds to a single, moderately large control-plane.
@@ -5,11 +5,14 @@
 package v1beta1_test
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	. "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 )
@@ -160,4 +163,32 @@ var _ = Describe("Seed defaulting", func() {
 			Expect(obj.Spec.Settings.DependencyWatchdog.Prober.Enabled).To(Equal(dwdProberEnabled))
 		})
 	})
+	Describe("BackupValidation defaulting", func() {
+		BeforeEach(func() {
+			obj.Spec.Backup = &Backup{Validation: &BackupValidation{}}
+		})
+
+		It("should default the backup validation settings", func() {
+			SetObjectDefaults_Seed(obj)
+
+			Expect(obj.Spec.Backup.Validation).To(Equal(&BackupValidation{
+				Interval:           &metav1.Duration{Duration: 24 * time.Hour},
+				SamplingPercentage: new(int32(10)),
+				Concurrency:        new(int32(1)),
+			}))
+		})
+
+		It("should not overwrite the already set values for the backup validation settings", func() {
+			validation := &BackupValidation{
+				Interval:           &metav1.Duration{Duration: time.Hour},
+				SamplingPercentage: new(int32(50)),
+				Concurrency:        new(int32(3)),
+			}
+			obj.Spec.Backup.Validation = validation.DeepCopy()
+
+			SetObjectDefaults_Seed(obj)
+
+			Expect(obj.Spec.Backup.Validation).To(Equal(validation))
+		})
+	})
 })
@@ -23,6 +23,8 @@ func (*Addons) ProtoMessage() {}
 
 func (*AdmissionPlugin) ProtoMessage() {}
 
+func (*Affinity) ProtoMessage() {}
+
 func (*Alerting) ProtoMessage() {}
 
 func (*AuditConfig) ProtoMessage() {}
@@ -31,6 +33,8 @@ func (*AuditPolicy) ProtoMessage() {}
 
 func (*AuthorizerKubeconfigReference) ProtoMessage() {}
 
+func (*AutoUpdatePolicy) ProtoMessage() {}
+
 func (*AvailabilityZone) ProtoMessage() {}
 
 func (*Backup) ProtoMessage() {}
@@ -53,6 +57,12 @@ func (*BackupEntrySpec) ProtoMessage() {}
 
 func (*BackupEntryStatus) ProtoMessage() {}
 
+func (*BackupEntryUsage) ProtoMessage() {}
+
+func (*BackupRetention) ProtoMessage() {}
+
+func (*BackupValidation) ProtoMessage() {}
+
 func (*Bastion) ProtoMessage() {}
 
 func (*BastionMachineImage) ProtoMessage() {}
@@ -177,6 +187,10 @@ func (*HelmControllerDeployment) ProtoMessage() {}
 
 func (*Hibernation) ProtoMessage() {}
 
+func (*HibernationCalendar) ProtoMessage() {}
+
+func (*HibernationInactivity) ProtoMessage() {}
+
 func (*HibernationSchedule) ProtoMessage() {}
 
 func (*HighAvailability) ProtoMessage() {}
@@ -261,6 +275,10 @@ func (*MaintenanceAutoUpdate) ProtoMessage() {}
 
 func (*MaintenanceCredentialsAutoRotation) ProtoMessage() {}
 
+func (*MaintenanceFreeze) ProtoMessage() {}
+
+func (*MaintenancePreview) ProtoMessage() {}
+
 func (*MaintenanceRotationConfig) ProtoMessage() {}
 
 func (*MaintenanceTimeWindow) ProtoMessage() {}
@@ -303,6 +321,8 @@ func (*ProjectList) ProtoMessage() {}
 
 func (*ProjectMember) ProtoMessage() {}
 
+func (*ProjectShootDeletion) ProtoMessage() {}
+
 func (*ProjectSpec) ProtoMessage() {}
 
 func (*ProjectStatus) ProtoMessage() {}
@@ -325,6 +345,12 @@ func (*ResourceWatchCacheSize) ProtoMessage() {}
 
 func (*SSHAccess) ProtoMessage() {}
 
+func (*SchedulingExplanation) ProtoMessage() {}
+
+func (*SchedulingExplanationStatus) ProtoMessage() {}
+
+func (*SchedulingPluginScore) ProtoMessage() {}
+
 func (*SecretBinding) ProtoMessage() {}
 
 func (*SecretBindingList) ProtoMessage() {}
@@ -345,6 +371,8 @@ func (*SeedNetworks) ProtoMessage() {}
 
 func (*SeedProvider) ProtoMessage() {}
 
+func (*SeedSchedulingResult) ProtoMessage() {}
+
 func (*SeedSelector) ProtoMessage() {}
 
 func (*SeedSettingDependencyWatchdog) ProtoMessage() {}
@@ -383,6 +411,8 @@ func (*SeedTaint) ProtoMessage() {}
 
 func (*SeedTemplate) ProtoMessage() {}
 
+func (*SeedUtilization) ProtoMessage() {}
+
 func (*SeedVolume) ProtoMessage() {}
 
 func (*SeedVolumeProvider) ProtoMessage() {}
@@ -395,6 +425,8 @@ func (*Shoot) ProtoMessage() {}
 
 func (*ShootAdvertisedAddress) ProtoMessage() {}
 
+func (*ShootAffinityTerm) ProtoMessage() {}
+
 func (*ShootCredentials) ProtoMessage() {}
 
 func (*ShootCredentialsRotation) ProtoMessage() {}
@@ -409,6 +441,8 @@ func (*ShootNetworks) ProtoMessage() {}
 
 func (*ShootSSHKeypairRotation) ProtoMessage() {}
 
+func (*ShootSource) ProtoMessage() {}
+
 func (*ShootSpec) ProtoMessage() {}
 
 func (*ShootState) ProtoMessage() {}
@@ -421,6 +455,8 @@ func (*ShootStatus) ProtoMessage() {}
 
 func (*ShootTemplate) ProtoMessage() {}
 
+func (*ShootWakeUp) ProtoMessage() {}
+
 func (*StructuredAuthentication) ProtoMessage() {}
 
 func (*StructuredAuthorization) ProtoMessage() {}
@@ -59,6 +59,7 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ProjectList{},
 		&Quota{},
 		&QuotaList{},
+		&SchedulingExplanation{},
 		&SecretBinding{},
 		&SecretBindingList{},
 		&Seed{},
@@ -67,6 +68,7 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ShootList{},
 		&ShootState{},
 		&ShootStateList{},
+		&ShootWakeUp{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 
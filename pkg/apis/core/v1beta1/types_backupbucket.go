@@ -64,6 +64,25 @@ type BackupBucketSpec struct {
 	// This field is immutable.
 	// +optional
 	ShootRef *corev1.ObjectReference `json:"shootRef,omitempty" protobuf:"bytes,6,opt,name=shootRef"`
+	// Retention contains the retention and lifecycle policy for the backups in this bucket.
+	// +optional
+	Retention *BackupRetention `json:"retention,omitempty" protobuf:"bytes,7,opt,name=retention"`
+}
+
+// BackupRetention contains the retention and lifecycle policy for backups.
+type BackupRetention struct {
+	// DeletedEntriesGracePeriod is the duration for which the backups of deleted shoots are kept before they are
+	// removed from the bucket. If set, it takes precedence over the deletion grace period configured for the gardenlet.
+	// +optional
+	DeletedEntriesGracePeriod *metav1.Duration `json:"deletedEntriesGracePeriod,omitempty" protobuf:"bytes,1,opt,name=deletedEntriesGracePeriod"`
+	// MaxFullSnapshots is the maximum number of full snapshots which are kept for each etcd. Older full snapshots and
+	// their delta snapshots are garbage collected. If not set, snapshots are garbage collected exponentially.
+	// +optional
+	MaxFullSnapshots *int32 `json:"maxFullSnapshots,omitempty" protobuf:"varint,2,opt,name=maxFullSnapshots"`
+	// ColdStorageTransitionPeriod is the age after which backups are transitioned to a cheaper storage class of the
+	// object store. Whether and how this is supported depends on the provider.
+	// +optional
+	ColdStorageTransitionPeriod *metav1.Duration `json:"coldStorageTransitionPeriod,omitempty" protobuf:"bytes,3,opt,name=coldStorageTransitionPeriod"`
 }
 
 // BackupBucketStatus holds the most recently observed status of the Backup Bucket.
@@ -76,4 +76,31 @@ type BackupEntryStatus struct {
 	// MigrationStartTime is the time when a migration to a different seed was initiated.
 	// +optional
 	MigrationStartTime *metav1.Time `json:"migrationStartTime,omitempty" protobuf:"bytes,5,opt,name=migrationStartTime"`
+	// Conditions represents the latest available observations of a BackupEntry's current state.
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +optional
+	Conditions []Condition `json:"conditions,omitempty" patchMergeKey:"type" patchStrategy:"merge" protobuf:"bytes,6,rep,name=conditions"`
+	// Usage contains information about the storage used by the backups of this BackupEntry.
+	// +optional
+	Usage *BackupEntryUsage `json:"usage,omitempty" protobuf:"bytes,7,opt,name=usage"`
+}
+
+// BackupEntryUsage contains information about the storage used by the backups of a BackupEntry.
+type BackupEntryUsage struct {
+	// SizeBytes is the total size of all objects of the BackupEntry in bytes.
+	SizeBytes int64 `json:"sizeBytes" protobuf:"varint,1,opt,name=sizeBytes"`
+	// ObjectCount is the number of objects of the BackupEntry.
+	ObjectCount int64 `json:"objectCount" protobuf:"varint,2,opt,name=objectCount"`
+	// LastUpdateTime is the time when the usage was determined.
+	LastUpdateTime metav1.Time `json:"lastUpdateTime" protobuf:"bytes,3,opt,name=lastUpdateTime"`
+	// LastBackupTime is the time when the newest object of the BackupEntry was written.
+	// +optional
+	LastBackupTime *metav1.Time `json:"lastBackupTime,omitempty" protobuf:"bytes,4,opt,name=lastBackupTime"`
 }
+
+const (
+	// BackupEntryRestorable is a constant for a condition type indicating whether the latest backup of the BackupEntry
+	// could be restored during the last backup validation.
+	BackupEntryRestorable ConditionType = "Restorable"
+)
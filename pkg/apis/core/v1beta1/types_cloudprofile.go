@@ -353,6 +353,9 @@ type ExpirableVersionStatus struct {
 	Version string `json:"version" protobuf:"bytes,1,opt,name=version"`
 	// Classification reflects the current state in the classification lifecycle.
 	Classification VersionClassification `json:"classification" protobuf:"bytes,2,opt,name=classification,casttype=VersionClassification"`
+	// AddedTime is the time at which the version was first observed in the CloudProfile.
+	// +optional
+	AddedTime *metav1.Time `json:"addedTime,omitempty" protobuf:"bytes,3,opt,name=addedTime"`
 }
 
 const (
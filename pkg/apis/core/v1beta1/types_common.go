@@ -147,6 +147,18 @@ const (
 	EventMigrationPrepared = "MigrationPrepared"
 	// EventMigrationPreparationFailed indicates that the Migration preparation failed.
 	EventMigrationPreparationFailed = "MigrationPreparationFailed"
+	// EventPlanned indicates that the changes of a Reconcile operation were computed in dry-run mode.
+	EventPlanned = "Planned"
+	// EventPlanError indicates that computing the changes of a Reconcile operation in dry-run mode failed.
+	EventPlanError = "PlanError"
+	// EventRestorePending indicates that the Restore operation is waiting for another operation to finish.
+	EventRestorePending = "RestorePending"
+	// EventRestoring indicates that the Restore operation started.
+	EventRestoring = "Restoring"
+	// EventRestored indicates that the Restore operation was successful.
+	EventRestored = "Restored"
+	// EventRestoreError indicates that the Restore operation failed.
+	EventRestoreError = "RestoreError"
 
 	// EventActionReconcile describes an event action for reconciliation.
 	EventActionReconcile = "Reconcile"
@@ -154,6 +166,8 @@ const (
 	EventActionDelete = "Delete"
 	// EventActionMigrate describes an event action for migration.
 	EventActionMigrate = "Migrate"
+	// EventActionRestore describes an event action for restoration.
+	EventActionRestore = "Restore"
 	// EventActionHealthCheck describes an event action for health checks.
 	EventActionHealthCheck = "HealthCheck"
 )
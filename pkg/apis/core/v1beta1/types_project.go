@@ -5,6 +5,7 @@
 package v1beta1
 
 import (
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -79,6 +80,21 @@ type ProjectSpec struct {
 	// DualApprovalForDeletion contains configuration for the dual approval concept for resource deletion.
 	// +optional
 	DualApprovalForDeletion []DualApprovalForDeletion `json:"dualApprovalForDeletion,omitempty" protobuf:"bytes,8,opt,name=dualApprovalForDeletion"`
+	// ShootDeletion contains configuration for the deletion of shoots in this project.
+	// +optional
+	ShootDeletion *ProjectShootDeletion `json:"shootDeletion,omitempty" protobuf:"bytes,9,opt,name=shootDeletion"`
+	// MaintenanceFreezes are periods in which no automatic maintenance operations are performed for the shoots in this
+	// project. Forceful updates of expired versions are still performed.
+	// +optional
+	MaintenanceFreezes []MaintenanceFreeze `json:"maintenanceFreezes,omitempty" protobuf:"bytes,10,rep,name=maintenanceFreezes"`
+	// AutoUpdatePolicy constrains which versions are picked by automatic updates for the shoots in this project which
+	// do not configure their own policy.
+	// +optional
+	AutoUpdatePolicy *AutoUpdatePolicy `json:"autoUpdatePolicy,omitempty" protobuf:"bytes,11,opt,name=autoUpdatePolicy"`
+	// HibernationCalendars are calendars of days (e.g., public holidays or company shutdown days) on which the shoots in
+	// this project are not woken up by their hibernation schedules.
+	// +optional
+	HibernationCalendars []HibernationCalendar `json:"hibernationCalendars,omitempty" protobuf:"bytes,12,rep,name=hibernationCalendars"`
 }
 
 // ProjectStatus holds the most recently observed status of the project.
@@ -145,6 +161,36 @@ type Toleration struct {
 	Value *string `json:"value,omitempty" protobuf:"bytes,2,opt,name=value"`
 }
 
+// ProjectShootDeletion contains configuration for the deletion of shoots in a project.
+type ProjectShootDeletion struct {
+	// GracePeriod is the duration for which a deleted shoot is kept hibernated before it is actually deleted. During
+	// this period, the deletion can be reverted with the `undelete` operation.
+	GracePeriod metav1.Duration `json:"gracePeriod" protobuf:"bytes,1,opt,name=gracePeriod"`
+}
+
+// MaintenanceFreeze is a period in which no automatic maintenance operations are performed for shoots.
+type MaintenanceFreeze struct {
+	// Name is the name of the maintenance freeze.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Begin is the time when the maintenance freeze begins.
+	Begin metav1.Time `json:"begin" protobuf:"bytes,2,opt,name=begin"`
+	// End is the time when the maintenance freeze ends.
+	End metav1.Time `json:"end" protobuf:"bytes,3,opt,name=end"`
+}
+
+// HibernationCalendar is a calendar of days on which shoots are not woken up by their hibernation schedules.
+type HibernationCalendar struct {
+	// Name is the name of the hibernation calendar.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Dates is a list of calendar days in the format `YYYY-MM-DD`.
+	// +optional
+	Dates []string `json:"dates,omitempty" protobuf:"bytes,2,rep,name=dates"`
+	// ResourceRef references a ConfigMap or Secret in the project namespace which contains an iCalendar file (RFC 5545)
+	// in the `calendar.ics` data key. All days covered by the events of the file are calendar days.
+	// +optional
+	ResourceRef *autoscalingv1.CrossVersionObjectReference `json:"resourceRef,omitempty" protobuf:"bytes,3,opt,name=resourceRef"`
+}
+
 // DualApprovalForDeletion contains configuration for the dual approval concept for resource deletion.
 type DualApprovalForDeletion struct {
 	// Resource is the name of the resource this applies to.
@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// SchedulingExplanation explains the scheduling decision for a Shoot. It is returned by the
+// `shoots/schedulingexplanation` subresource which runs the scheduling pipeline of the gardener-scheduler
+// without binding the Shoot to a Seed.
+type SchedulingExplanation struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object metadata.
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Status contains the result of the scheduling simulation.
+	Status SchedulingExplanationStatus `json:"status" protobuf:"bytes,2,opt,name=status"`
+}
+
+// SchedulingExplanationStatus contains the result of the scheduling simulation for a Shoot.
+type SchedulingExplanationStatus struct {
+	// SeedName is the name of the Seed the Shoot is currently scheduled to.
+	// +optional
+	SeedName *string `json:"seedName,omitempty" protobuf:"bytes,1,opt,name=seedName"`
+	// SelectedSeedName is the name of the Seed the scheduler would select for the Shoot.
+	// +optional
+	SelectedSeedName *string `json:"selectedSeedName,omitempty" protobuf:"bytes,2,opt,name=selectedSeedName"`
+	// Message describes why no Seed could be selected for the Shoot.
+	// +optional
+	Message *string `json:"message,omitempty" protobuf:"bytes,3,opt,name=message"`
+	// Seeds contains the scheduling results for all Seeds which were considered.
+	// +optional
+	Seeds []SeedSchedulingResult `json:"seeds,omitempty" protobuf:"bytes,4,rep,name=seeds"`
+}
+
+// SeedSchedulingResult contains the scheduling result for a single Seed.
+type SeedSchedulingResult struct {
+	// Name is the name of the Seed.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// RejectedBy is the name of the filter plugin which rejected the Seed.
+	// +optional
+	RejectedBy *string `json:"rejectedBy,omitempty" protobuf:"bytes,2,opt,name=rejectedBy"`
+	// Reason describes why the Seed was rejected.
+	// +optional
+	Reason *string `json:"reason,omitempty" protobuf:"bytes,3,opt,name=reason"`
+	// Scores contains the normalized scores of all score plugins. It is only set if the Seed passed all filters.
+	// +optional
+	Scores []SchedulingPluginScore `json:"scores,omitempty" protobuf:"bytes,4,rep,name=scores"`
+	// TotalScore is the weighted sum of all scores. It is only set if the Seed passed all filters.
+	// +optional
+	TotalScore *int64 `json:"totalScore,omitempty" protobuf:"varint,5,opt,name=totalScore"`
+}
+
+// SchedulingPluginScore is the normalized score a score plugin computed for a Seed.
+type SchedulingPluginScore struct {
+	// Name is the name of the score plugin.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Weight is the weight of the score plugin.
+	Weight int32 `json:"weight" protobuf:"varint,2,opt,name=weight"`
+	// Score is the normalized score in the range of [0, 100].
+	Score int64 `json:"score" protobuf:"varint,3,opt,name=score"`
+}
@@ -125,6 +125,27 @@ type SeedStatus struct {
 	// LastOperation holds information about the last operation on the Seed.
 	// +optional
 	LastOperation *LastOperation `json:"lastOperation,omitempty" protobuf:"bytes,9,opt,name=lastOperation"`
+	// Utilization contains the aggregated resource requests and usage of the shoot control planes hosted by the seed.
+	// +optional
+	Utilization *SeedUtilization `json:"utilization,omitempty" protobuf:"bytes,10,opt,name=utilization"`
+}
+
+// SeedUtilization contains the aggregated resource requests and usage of the shoot control planes hosted by a seed.
+type SeedUtilization struct {
+	// Allocatable is the amount of resources (cpu, memory) of the seed's nodes that are available for pods and, if
+	// configured in the seed's allocatable resources, the amount of storage (etcd-volume) available for etcd volumes.
+	// +optional
+	Allocatable corev1.ResourceList `json:"allocatable,omitempty" protobuf:"bytes,1,rep,name=allocatable"`
+	// Requests is the sum of the resource requests of all shoot control planes hosted by the seed.
+	// +optional
+	Requests corev1.ResourceList `json:"requests,omitempty" protobuf:"bytes,2,rep,name=requests"`
+	// Usage is the sum of the actual resource usage of all shoot control planes hosted by the seed. It is only
+	// reported if the metrics API is available in the seed cluster.
+	// +optional
+	Usage corev1.ResourceList `json:"usage,omitempty" protobuf:"bytes,3,rep,name=usage"`
+	// LastUpdateTime is the time when the utilization was last computed.
+	// +optional
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty" protobuf:"bytes,4,opt,name=lastUpdateTime"`
 }
 
 // Backup contains the object store configuration for backups for shoot (currently only etcd).
@@ -147,6 +168,31 @@ type Backup struct {
 	// security.gardener.cloud/v1alpha1.WorkloadIdentity
 	// +optional
 	CredentialsRef *corev1.ObjectReference `json:"credentialsRef,omitempty" protobuf:"bytes,5,opt,name=credentialsRef"`
+	// Validation contains the configuration for the periodic validation of the backups of the shoots on this seed. If
+	// set, the gardenlet regularly restores the latest backup of a sample of the BackupEntries into a temporary etcd and
+	// checks its consistency.
+	// +optional
+	Validation *BackupValidation `json:"validation,omitempty" protobuf:"bytes,6,opt,name=validation"`
+	// Retention contains the retention and lifecycle policy for the backups of the shoots on this seed.
+	// +optional
+	Retention *BackupRetention `json:"retention,omitempty" protobuf:"bytes,7,opt,name=retention"`
+}
+
+// BackupValidation contains the configuration for the periodic validation of backups by test restores.
+type BackupValidation struct {
+	// Interval is the interval in which a sample of the BackupEntries of the seed is validated.
+	// Defaults to `24h`.
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty" protobuf:"bytes,1,opt,name=interval"`
+	// SamplingPercentage is the percentage of the BackupEntries of the seed which are validated in each interval.
+	// The BackupEntries whose last validation is the oldest are validated first.
+	// Defaults to `10`.
+	// +optional
+	SamplingPercentage *int32 `json:"samplingPercentage,omitempty" protobuf:"varint,2,opt,name=samplingPercentage"`
+	// Concurrency is the maximum number of validations which are performed in parallel.
+	// Defaults to `1`.
+	// +optional
+	Concurrency *int32 `json:"concurrency,omitempty" protobuf:"varint,3,opt,name=concurrency"`
 }
 
 // SeedDNS contains DNS-relevant information about this seed cluster.
@@ -540,10 +586,15 @@ const (
 	SeedSystemComponentsHealthy ConditionType = "SeedSystemComponentsHealthy"
 	// SeedEmergencyStopShootReconciliations is a constant for a condition type indicating disabled shoot reconciliations.
 	SeedEmergencyStopShootReconciliations ConditionType = "EmergencyStopShootReconciliations"
+	// SeedDrained is a constant for a condition type indicating the progress of draining a seed, i.e., of migrating the
+	// control planes of all its shoots to other seeds.
+	SeedDrained ConditionType = "Drained"
 )
 
 // Resource constants for Gardener object types
 const (
 	// ResourceShoots is a resource constant for the number of shoots.
 	ResourceShoots corev1.ResourceName = "shoots"
+	// ResourceEtcdVolume is a resource constant for the storage of the etcd volumes of the shoot control planes.
+	ResourceEtcdVolume corev1.ResourceName = "etcd-volume"
 )
@@ -150,6 +150,13 @@ type ShootSpec struct {
 	// AccessRestrictions describe a list of access restrictions for this shoot cluster.
 	// +optional
 	AccessRestrictions []AccessRestrictionWithOptions `json:"accessRestrictions,omitempty" protobuf:"bytes,24,rep,name=accessRestrictions"`
+	// Affinity contains the scheduling constraints of the shoot's control plane in relation to other shoots.
+	// +optional
+	Affinity *Affinity `json:"affinity,omitempty" protobuf:"bytes,25,opt,name=affinity"`
+	// Source references an existing shoot whose etcd backup is used to seed the etcd of this shoot when it is created.
+	// This field is immutable.
+	// +optional
+	Source *ShootSource `json:"source,omitempty" protobuf:"bytes,26,opt,name=source"`
 }
 
 // ShootStatus holds the most recently observed status of the Shoot cluster.
@@ -231,6 +238,14 @@ type ShootStatus struct {
 	// LiveMigration contains information about an ongoing live control plane migration of the Shoot.
 	// +optional
 	LiveMigration *LiveMigration `json:"liveMigration,omitempty" protobuf:"bytes,22,opt,name=liveMigration"`
+	// MaintenancePreview contains the changes that the next maintenance of the Shoot is expected to perform. It is
+	// refreshed periodically by the maintenance controller.
+	// +optional
+	MaintenancePreview *MaintenancePreview `json:"maintenancePreview,omitempty" protobuf:"bytes,23,opt,name=maintenancePreview"`
+	// LastActivityTime is the last time at which user requests to the kube-apiserver of the Shoot were observed. It is
+	// also set when the Shoot is woken up.
+	// +optional
+	LastActivityTime *metav1.Time `json:"lastActivityTime,omitempty" protobuf:"bytes,24,opt,name=lastActivityTime"`
 }
 
 // LastMaintenance holds information about a maintenance operation on the Shoot.
@@ -246,6 +261,18 @@ type LastMaintenance struct {
 	FailureReason *string `json:"failureReason,omitempty" protobuf:"bytes,4,opt,name=failureReason"`
 }
 
+// MaintenancePreview contains the changes that the next maintenance of the Shoot is expected to perform.
+type MaintenancePreview struct {
+	// NextMaintenanceTime is the begin of the next maintenance time window of the Shoot.
+	NextMaintenanceTime metav1.Time `json:"nextMaintenanceTime" protobuf:"bytes,1,opt,name=nextMaintenanceTime"`
+	// PlannedChanges are human-readable descriptions of the changes that the next maintenance is expected to perform,
+	// based on the current state of the Shoot and its CloudProfile.
+	// +optional
+	PlannedChanges []string `json:"plannedChanges,omitempty" protobuf:"bytes,2,rep,name=plannedChanges"`
+	// LastUpdateTime is the time when the preview was computed.
+	LastUpdateTime metav1.Time `json:"lastUpdateTime" protobuf:"bytes,3,opt,name=lastUpdateTime"`
+}
+
 // NetworkingStatus contains information about cluster networking such as CIDRs.
 type NetworkingStatus struct {
 	// Pods are the CIDRs of the pod network.
@@ -498,6 +525,38 @@ type ShootAdvertisedAddress struct {
 	Application *string `json:"application,omitempty" protobuf:"bytes,3,opt,name=application"`
 }
 
+// ShootSource references an existing shoot whose etcd backup is used to seed the etcd of a new shoot.
+type ShootSource struct {
+	// Namespace is the namespace of the source shoot.
+	Namespace string `json:"namespace" protobuf:"bytes,1,opt,name=namespace"`
+	// Name is the name of the source shoot.
+	Name string `json:"name" protobuf:"bytes,2,opt,name=name"`
+	// TargetTime is the point in time whose state of the source shoot's etcd shall be copied. Only the snapshots which
+	// were taken at or before this time are copied. If not set, the latest snapshots are copied.
+	// +optional
+	TargetTime *metav1.Time `json:"targetTime,omitempty" protobuf:"bytes,3,opt,name=targetTime"`
+}
+
+// Affinity contains the scheduling constraints of a shoot's control plane in relation to other shoots.
+type Affinity struct {
+	// ShootAffinity contains terms selecting shoots in the same project whose control planes should run on the same
+	// seed as the control plane of this shoot. A term is only considered if at least one of the selected shoots is
+	// already scheduled. The terms are only honoured when the shoot is scheduled.
+	// +optional
+	ShootAffinity []ShootAffinityTerm `json:"shootAffinity,omitempty" protobuf:"bytes,1,rep,name=shootAffinity"`
+	// ShootAntiAffinity contains terms selecting shoots in the same project whose control planes must not run on the
+	// same seed as the control plane of this shoot. The terms are honoured when the shoot is scheduled or migrated to
+	// another seed.
+	// +optional
+	ShootAntiAffinity []ShootAffinityTerm `json:"shootAntiAffinity,omitempty" protobuf:"bytes,2,rep,name=shootAntiAffinity"`
+}
+
+// ShootAffinityTerm selects a group of shoots in the same project.
+type ShootAffinityTerm struct {
+	// LabelSelector is a label query over the shoots in the same project.
+	LabelSelector metav1.LabelSelector `json:"labelSelector" protobuf:"bytes,1,opt,name=labelSelector"`
+}
+
 // Addons is a collection of configuration for specific addons which are managed by the Gardener.
 type Addons struct {
 	// KubernetesDashboard holds configuration settings for the kubernetes dashboard addon.
@@ -631,6 +690,15 @@ type Hibernation struct {
 	// Schedules determine the hibernation schedules.
 	// +optional
 	Schedules []HibernationSchedule `json:"schedules,omitempty" protobuf:"bytes,2,rep,name=schedules"`
+	// Inactivity configures the automatic hibernation of the Shoot after a period without user activity.
+	// +optional
+	Inactivity *HibernationInactivity `json:"inactivity,omitempty" protobuf:"bytes,3,opt,name=inactivity"`
+}
+
+// HibernationInactivity configures the automatic hibernation of a Shoot after a period without user activity.
+type HibernationInactivity struct {
+	// Timeout is the duration without user requests to the kube-apiserver after which the Shoot is hibernated.
+	Timeout metav1.Duration `json:"timeout" protobuf:"bytes,1,opt,name=timeout"`
 }
 
 // HibernationSchedule determines the hibernation schedule of a Shoot.
@@ -1637,8 +1705,36 @@ type MaintenanceAutoUpdate struct {
 	// MachineImageVersion indicates whether the machine image version may be automatically updated (default: true).
 	// +optional
 	MachineImageVersion *bool `json:"machineImageVersion,omitempty" protobuf:"varint,2,opt,name=machineImageVersion"`
+	// Policy constrains which versions are picked by automatic updates. If not set, the auto-update policy of the
+	// project is used.
+	// +optional
+	Policy *AutoUpdatePolicy `json:"policy,omitempty" protobuf:"bytes,3,opt,name=policy"`
+}
+
+// AutoUpdatePolicy constrains which versions are picked by automatic updates of Kubernetes and machine image versions.
+type AutoUpdatePolicy struct {
+	// Channel is the update channel which determines the minimum age of versions before they are picked by automatic
+	// updates. The soak times of the channels are configured by the Gardener operator.
+	// Possible values are "early" and "stable". Mutually exclusive with SoakTime.
+	// +optional
+	Channel *UpdateChannel `json:"channel,omitempty" protobuf:"bytes,1,opt,name=channel,casttype=UpdateChannel"`
+	// SoakTime is the minimum duration since a version was added to the CloudProfile before it is picked by automatic
+	// updates. Mutually exclusive with Channel.
+	// +optional
+	SoakTime *metav1.Duration `json:"soakTime,omitempty" protobuf:"bytes,2,opt,name=soakTime"`
 }
 
+// UpdateChannel is a channel for automatic updates.
+type UpdateChannel string
+
+const (
+	// UpdateChannelEarly is the update channel for clusters which shall pick new versions early, e.g., development clusters.
+	UpdateChannelEarly UpdateChannel = "early"
+	// UpdateChannelStable is the update channel for clusters which shall only pick versions which have been available
+	// for a while, e.g., production clusters.
+	UpdateChannelStable UpdateChannel = "stable"
+)
+
 // MaintenanceAutoRotation contains information about which rotations should be automatically performed.
 type MaintenanceAutoRotation struct {
 	// Credentials contains information about which credentials should be automatically rotated.
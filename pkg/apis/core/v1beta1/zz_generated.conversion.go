@@ -102,6 +102,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*Affinity)(nil), (*core.Affinity)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_Affinity_To_core_Affinity(a.(*Affinity), b.(*core.Affinity), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.Affinity)(nil), (*Affinity)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_Affinity_To_v1beta1_Affinity(a.(*core.Affinity), b.(*Affinity), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Alerting)(nil), (*core.Alerting)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Alerting_To_core_Alerting(a.(*Alerting), b.(*core.Alerting), scope)
 	}); err != nil {
@@ -142,6 +152,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*AutoUpdatePolicy)(nil), (*core.AutoUpdatePolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AutoUpdatePolicy_To_core_AutoUpdatePolicy(a.(*AutoUpdatePolicy), b.(*core.AutoUpdatePolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.AutoUpdatePolicy)(nil), (*AutoUpdatePolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_AutoUpdatePolicy_To_v1beta1_AutoUpdatePolicy(a.(*core.AutoUpdatePolicy), b.(*AutoUpdatePolicy), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*AvailabilityZone)(nil), (*core.AvailabilityZone)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_AvailabilityZone_To_core_AvailabilityZone(a.(*AvailabilityZone), b.(*core.AvailabilityZone), scope)
 	}); err != nil {
@@ -252,6 +272,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*BackupEntryUsage)(nil), (*core.BackupEntryUsage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_BackupEntryUsage_To_core_BackupEntryUsage(a.(*BackupEntryUsage), b.(*core.BackupEntryUsage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.BackupEntryUsage)(nil), (*BackupEntryUsage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_BackupEntryUsage_To_v1beta1_BackupEntryUsage(a.(*core.BackupEntryUsage), b.(*BackupEntryUsage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BackupRetention)(nil), (*core.BackupRetention)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_BackupRetention_To_core_BackupRetention(a.(*BackupRetention), b.(*core.BackupRetention), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.BackupRetention)(nil), (*BackupRetention)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_BackupRetention_To_v1beta1_BackupRetention(a.(*core.BackupRetention), b.(*BackupRetention), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BackupValidation)(nil), (*core.BackupValidation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_BackupValidation_To_core_BackupValidation(a.(*BackupValidation), b.(*core.BackupValidation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.BackupValidation)(nil), (*BackupValidation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_BackupValidation_To_v1beta1_BackupValidation(a.(*core.BackupValidation), b.(*BackupValidation), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Bastion)(nil), (*core.Bastion)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Bastion_To_core_Bastion(a.(*Bastion), b.(*core.Bastion), scope)
 	}); err != nil {
@@ -842,6 +892,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*HibernationCalendar)(nil), (*core.HibernationCalendar)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_HibernationCalendar_To_core_HibernationCalendar(a.(*HibernationCalendar), b.(*core.HibernationCalendar), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.HibernationCalendar)(nil), (*HibernationCalendar)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_HibernationCalendar_To_v1beta1_HibernationCalendar(a.(*core.HibernationCalendar), b.(*HibernationCalendar), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*HibernationInactivity)(nil), (*core.HibernationInactivity)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_HibernationInactivity_To_core_HibernationInactivity(a.(*HibernationInactivity), b.(*core.HibernationInactivity), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.HibernationInactivity)(nil), (*HibernationInactivity)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_HibernationInactivity_To_v1beta1_HibernationInactivity(a.(*core.HibernationInactivity), b.(*HibernationInactivity), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*HibernationSchedule)(nil), (*core.HibernationSchedule)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_HibernationSchedule_To_core_HibernationSchedule(a.(*HibernationSchedule), b.(*core.HibernationSchedule), scope)
 	}); err != nil {
@@ -1257,6 +1327,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MaintenanceFreeze)(nil), (*core.MaintenanceFreeze)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MaintenanceFreeze_To_core_MaintenanceFreeze(a.(*MaintenanceFreeze), b.(*core.MaintenanceFreeze), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.MaintenanceFreeze)(nil), (*MaintenanceFreeze)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_MaintenanceFreeze_To_v1beta1_MaintenanceFreeze(a.(*core.MaintenanceFreeze), b.(*MaintenanceFreeze), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MaintenancePreview)(nil), (*core.MaintenancePreview)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MaintenancePreview_To_core_MaintenancePreview(a.(*MaintenancePreview), b.(*core.MaintenancePreview), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.MaintenancePreview)(nil), (*MaintenancePreview)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_MaintenancePreview_To_v1beta1_MaintenancePreview(a.(*core.MaintenancePreview), b.(*MaintenancePreview), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*MaintenanceRotationConfig)(nil), (*core.MaintenanceRotationConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_MaintenanceRotationConfig_To_core_MaintenanceRotationConfig(a.(*MaintenanceRotationConfig), b.(*core.MaintenanceRotationConfig), scope)
 	}); err != nil {
@@ -1457,6 +1547,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ProjectShootDeletion)(nil), (*core.ProjectShootDeletion)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ProjectShootDeletion_To_core_ProjectShootDeletion(a.(*ProjectShootDeletion), b.(*core.ProjectShootDeletion), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ProjectShootDeletion)(nil), (*ProjectShootDeletion)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ProjectShootDeletion_To_v1beta1_ProjectShootDeletion(a.(*core.ProjectShootDeletion), b.(*ProjectShootDeletion), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ProjectStatus)(nil), (*core.ProjectStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ProjectStatus_To_core_ProjectStatus(a.(*ProjectStatus), b.(*core.ProjectStatus), scope)
 	}); err != nil {
@@ -1557,6 +1657,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SchedulingExplanation)(nil), (*core.SchedulingExplanation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SchedulingExplanation_To_core_SchedulingExplanation(a.(*SchedulingExplanation), b.(*core.SchedulingExplanation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.SchedulingExplanation)(nil), (*SchedulingExplanation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_SchedulingExplanation_To_v1beta1_SchedulingExplanation(a.(*core.SchedulingExplanation), b.(*SchedulingExplanation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SchedulingExplanationStatus)(nil), (*core.SchedulingExplanationStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SchedulingExplanationStatus_To_core_SchedulingExplanationStatus(a.(*SchedulingExplanationStatus), b.(*core.SchedulingExplanationStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.SchedulingExplanationStatus)(nil), (*SchedulingExplanationStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_SchedulingExplanationStatus_To_v1beta1_SchedulingExplanationStatus(a.(*core.SchedulingExplanationStatus), b.(*SchedulingExplanationStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SchedulingPluginScore)(nil), (*core.SchedulingPluginScore)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SchedulingPluginScore_To_core_SchedulingPluginScore(a.(*SchedulingPluginScore), b.(*core.SchedulingPluginScore), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.SchedulingPluginScore)(nil), (*SchedulingPluginScore)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_SchedulingPluginScore_To_v1beta1_SchedulingPluginScore(a.(*core.SchedulingPluginScore), b.(*SchedulingPluginScore), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SecretBinding)(nil), (*core.SecretBinding)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_SecretBinding_To_core_SecretBinding(a.(*SecretBinding), b.(*core.SecretBinding), scope)
 	}); err != nil {
@@ -1657,6 +1787,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SeedSchedulingResult)(nil), (*core.SeedSchedulingResult)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SeedSchedulingResult_To_core_SeedSchedulingResult(a.(*SeedSchedulingResult), b.(*core.SeedSchedulingResult), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.SeedSchedulingResult)(nil), (*SeedSchedulingResult)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_SeedSchedulingResult_To_v1beta1_SeedSchedulingResult(a.(*core.SeedSchedulingResult), b.(*SeedSchedulingResult), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SeedSelector)(nil), (*core.SeedSelector)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_SeedSelector_To_core_SeedSelector(a.(*SeedSelector), b.(*core.SeedSelector), scope)
 	}); err != nil {
@@ -1847,6 +1987,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SeedUtilization)(nil), (*core.SeedUtilization)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SeedUtilization_To_core_SeedUtilization(a.(*SeedUtilization), b.(*core.SeedUtilization), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.SeedUtilization)(nil), (*SeedUtilization)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_SeedUtilization_To_v1beta1_SeedUtilization(a.(*core.SeedUtilization), b.(*SeedUtilization), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SeedVolume)(nil), (*core.SeedVolume)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_SeedVolume_To_core_SeedVolume(a.(*SeedVolume), b.(*core.SeedVolume), scope)
 	}); err != nil {
@@ -1907,6 +2057,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootAffinityTerm)(nil), (*core.ShootAffinityTerm)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootAffinityTerm_To_core_ShootAffinityTerm(a.(*ShootAffinityTerm), b.(*core.ShootAffinityTerm), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ShootAffinityTerm)(nil), (*ShootAffinityTerm)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ShootAffinityTerm_To_v1beta1_ShootAffinityTerm(a.(*core.ShootAffinityTerm), b.(*ShootAffinityTerm), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ShootCredentials)(nil), (*core.ShootCredentials)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ShootCredentials_To_core_ShootCredentials(a.(*ShootCredentials), b.(*core.ShootCredentials), scope)
 	}); err != nil {
@@ -1977,6 +2137,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootSource)(nil), (*core.ShootSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootSource_To_core_ShootSource(a.(*ShootSource), b.(*core.ShootSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ShootSource)(nil), (*ShootSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ShootSource_To_v1beta1_ShootSource(a.(*core.ShootSource), b.(*ShootSource), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ShootSpec)(nil), (*core.ShootSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ShootSpec_To_core_ShootSpec(a.(*ShootSpec), b.(*core.ShootSpec), scope)
 	}); err != nil {
@@ -2037,6 +2207,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootWakeUp)(nil), (*core.ShootWakeUp)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootWakeUp_To_core_ShootWakeUp(a.(*ShootWakeUp), b.(*core.ShootWakeUp), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ShootWakeUp)(nil), (*ShootWakeUp)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ShootWakeUp_To_v1beta1_ShootWakeUp(a.(*core.ShootWakeUp), b.(*ShootWakeUp), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*StructuredAuthentication)(nil), (*core.StructuredAuthentication)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_StructuredAuthentication_To_core_StructuredAuthentication(a.(*StructuredAuthentication), b.(*core.StructuredAuthentication), scope)
 	}); err != nil {
@@ -2373,6 +2553,28 @@ func Convert_core_AdmissionPlugin_To_v1beta1_AdmissionPlugin(in *core.AdmissionP
 	return autoConvert_core_AdmissionPlugin_To_v1beta1_AdmissionPlugin(in, out, s)
 }
 
+func autoConvert_v1beta1_Affinity_To_core_Affinity(in *Affinity, out *core.Affinity, s conversion.Scope) error {
+	out.ShootAffinity = *(*[]core.ShootAffinityTerm)(unsafe.Pointer(&in.ShootAffinity))
+	out.ShootAntiAffinity = *(*[]core.ShootAffinityTerm)(unsafe.Pointer(&in.ShootAntiAffinity))
+	return nil
+}
+
+// Convert_v1beta1_Affinity_To_core_Affinity is an autogenerated conversion function.
+func Convert_v1beta1_Affinity_To_core_Affinity(in *Affinity, out *core.Affinity, s conversion.Scope) error {
+	return autoConvert_v1beta1_Affinity_To_core_Affinity(in, out, s)
+}
+
+func autoConvert_core_Affinity_To_v1beta1_Affinity(in *core.Affinity, out *Affinity, s conversion.Scope) error {
+	out.ShootAffinity = *(*[]ShootAffinityTerm)(unsafe.Pointer(&in.ShootAffinity))
+	out.ShootAntiAffinity = *(*[]ShootAffinityTerm)(unsafe.Pointer(&in.ShootAntiAffinity))
+	return nil
+}
+
+// Convert_core_Affinity_To_v1beta1_Affinity is an autogenerated conversion function.
+func Convert_core_Affinity_To_v1beta1_Affinity(in *core.Affinity, out *Affinity, s conversion.Scope) error {
+	return autoConvert_core_Affinity_To_v1beta1_Affinity(in, out, s)
+}
+
 func autoConvert_v1beta1_Alerting_To_core_Alerting(in *Alerting, out *core.Alerting, s conversion.Scope) error {
 	out.EmailReceivers = *(*[]string)(unsafe.Pointer(&in.EmailReceivers))
 	return nil
@@ -2455,6 +2657,28 @@ func Convert_core_AuthorizerKubeconfigReference_To_v1beta1_AuthorizerKubeconfigR
 	return autoConvert_core_AuthorizerKubeconfigReference_To_v1beta1_AuthorizerKubeconfigReference(in, out, s)
 }
 
+func autoConvert_v1beta1_AutoUpdatePolicy_To_core_AutoUpdatePolicy(in *AutoUpdatePolicy, out *core.AutoUpdatePolicy, s conversion.Scope) error {
+	out.Channel = (*core.UpdateChannel)(unsafe.Pointer(in.Channel))
+	out.SoakTime = (*metav1.Duration)(unsafe.Pointer(in.SoakTime))
+	return nil
+}
+
+// Convert_v1beta1_AutoUpdatePolicy_To_core_AutoUpdatePolicy is an autogenerated conversion function.
+func Convert_v1beta1_AutoUpdatePolicy_To_core_AutoUpdatePolicy(in *AutoUpdatePolicy, out *core.AutoUpdatePolicy, s conversion.Scope) error {
+	return autoConvert_v1beta1_AutoUpdatePolicy_To_core_AutoUpdatePolicy(in, out, s)
+}
+
+func autoConvert_core_AutoUpdatePolicy_To_v1beta1_AutoUpdatePolicy(in *core.AutoUpdatePolicy, out *AutoUpdatePolicy, s conversion.Scope) error {
+	out.Channel = (*UpdateChannel)(unsafe.Pointer(in.Channel))
+	out.SoakTime = (*metav1.Duration)(unsafe.Pointer(in.SoakTime))
+	return nil
+}
+
+// Convert_core_AutoUpdatePolicy_To_v1beta1_AutoUpdatePolicy is an autogenerated conversion function.
+func Convert_core_AutoUpdatePolicy_To_v1beta1_AutoUpdatePolicy(in *core.AutoUpdatePolicy, out *AutoUpdatePolicy, s conversion.Scope) error {
+	return autoConvert_core_AutoUpdatePolicy_To_v1beta1_AutoUpdatePolicy(in, out, s)
+}
+
 func autoConvert_v1beta1_AvailabilityZone_To_core_AvailabilityZone(in *AvailabilityZone, out *core.AvailabilityZone, s conversion.Scope) error {
 	out.Name = in.Name
 	out.UnavailableMachineTypes = *(*[]string)(unsafe.Pointer(&in.UnavailableMachineTypes))
@@ -2484,6 +2708,8 @@ func autoConvert_v1beta1_Backup_To_core_Backup(in *Backup, out *core.Backup, s c
 	out.ProviderConfig = (*runtime.RawExtension)(unsafe.Pointer(in.ProviderConfig))
 	out.Region = (*string)(unsafe.Pointer(in.Region))
 	out.CredentialsRef = (*v1.ObjectReference)(unsafe.Pointer(in.CredentialsRef))
+	out.Validation = (*core.BackupValidation)(unsafe.Pointer(in.Validation))
+	out.Retention = (*core.BackupRetention)(unsafe.Pointer(in.Retention))
 	return nil
 }
 
@@ -2497,6 +2723,8 @@ func autoConvert_core_Backup_To_v1beta1_Backup(in *core.Backup, out *Backup, s c
 	out.ProviderConfig = (*runtime.RawExtension)(unsafe.Pointer(in.ProviderConfig))
 	out.Region = (*string)(unsafe.Pointer(in.Region))
 	out.CredentialsRef = (*v1.ObjectReference)(unsafe.Pointer(in.CredentialsRef))
+	out.Validation = (*BackupValidation)(unsafe.Pointer(in.Validation))
+	out.Retention = (*BackupRetention)(unsafe.Pointer(in.Retention))
 	return nil
 }
 
@@ -2589,6 +2817,7 @@ func autoConvert_v1beta1_BackupBucketSpec_To_core_BackupBucketSpec(in *BackupBuc
 	out.SeedName = (*string)(unsafe.Pointer(in.SeedName))
 	out.CredentialsRef = (*v1.ObjectReference)(unsafe.Pointer(in.CredentialsRef))
 	out.ShootRef = (*v1.ObjectReference)(unsafe.Pointer(in.ShootRef))
+	out.Retention = (*core.BackupRetention)(unsafe.Pointer(in.Retention))
 	return nil
 }
 
@@ -2605,6 +2834,7 @@ func autoConvert_core_BackupBucketSpec_To_v1beta1_BackupBucketSpec(in *core.Back
 	out.SeedName = (*string)(unsafe.Pointer(in.SeedName))
 	out.CredentialsRef = (*v1.ObjectReference)(unsafe.Pointer(in.CredentialsRef))
 	out.ShootRef = (*v1.ObjectReference)(unsafe.Pointer(in.ShootRef))
+	out.Retention = (*BackupRetention)(unsafe.Pointer(in.Retention))
 	return nil
 }
 
@@ -2725,6 +2955,8 @@ func autoConvert_v1beta1_BackupEntryStatus_To_core_BackupEntryStatus(in *BackupE
 	out.ObservedGeneration = in.ObservedGeneration
 	out.SeedName = (*string)(unsafe.Pointer(in.SeedName))
 	out.MigrationStartTime = (*metav1.Time)(unsafe.Pointer(in.MigrationStartTime))
+	out.Conditions = *(*[]core.Condition)(unsafe.Pointer(&in.Conditions))
+	out.Usage = (*core.BackupEntryUsage)(unsafe.Pointer(in.Usage))
 	return nil
 }
 
@@ -2739,6 +2971,8 @@ func autoConvert_core_BackupEntryStatus_To_v1beta1_BackupEntryStatus(in *core.Ba
 	out.ObservedGeneration = in.ObservedGeneration
 	out.SeedName = (*string)(unsafe.Pointer(in.SeedName))
 	out.MigrationStartTime = (*metav1.Time)(unsafe.Pointer(in.MigrationStartTime))
+	out.Conditions = *(*[]Condition)(unsafe.Pointer(&in.Conditions))
+	out.Usage = (*BackupEntryUsage)(unsafe.Pointer(in.Usage))
 	return nil
 }
 
@@ -2747,6 +2981,80 @@ func Convert_core_BackupEntryStatus_To_v1beta1_BackupEntryStatus(in *core.Backup
 	return autoConvert_core_BackupEntryStatus_To_v1beta1_BackupEntryStatus(in, out, s)
 }
 
+func autoConvert_v1beta1_BackupEntryUsage_To_core_BackupEntryUsage(in *BackupEntryUsage, out *core.BackupEntryUsage, s conversion.Scope) error {
+	out.SizeBytes = in.SizeBytes
+	out.ObjectCount = in.ObjectCount
+	out.LastUpdateTime = in.LastUpdateTime
+	out.LastBackupTime = (*metav1.Time)(unsafe.Pointer(in.LastBackupTime))
+	return nil
+}
+
+// Convert_v1beta1_BackupEntryUsage_To_core_BackupEntryUsage is an autogenerated conversion function.
+func Convert_v1beta1_BackupEntryUsage_To_core_BackupEntryUsage(in *BackupEntryUsage, out *core.BackupEntryUsage, s conversion.Scope) error {
+	return autoConvert_v1beta1_BackupEntryUsage_To_core_BackupEntryUsage(in, out, s)
+}
+
+func autoConvert_core_BackupEntryUsage_To_v1beta1_BackupEntryUsage(in *core.BackupEntryUsage, out *BackupEntryUsage, s conversion.Scope) error {
+	out.SizeBytes = in.SizeBytes
+	out.ObjectCount = in.ObjectCount
+	out.LastUpdateTime = in.LastUpdateTime
+	out.LastBackupTime = (*metav1.Time)(unsafe.Pointer(in.LastBackupTime))
+	return nil
+}
+
+// Convert_core_BackupEntryUsage_To_v1beta1_BackupEntryUsage is an autogenerated conversion function.
+func Convert_core_BackupEntryUsage_To_v1beta1_BackupEntryUsage(in *core.BackupEntryUsage, out *BackupEntryUsage, s conversion.Scope) error {
+	return autoConvert_core_BackupEntryUsage_To_v1beta1_BackupEntryUsage(in, out, s)
+}
+
+func autoConvert_v1beta1_BackupRetention_To_core_BackupRetention(in *BackupRetention, out *core.BackupRetention, s conversion.Scope) error {
+	out.DeletedEntriesGracePeriod = (*metav1.Duration)(unsafe.Pointer(in.DeletedEntriesGracePeriod))
+	out.MaxFullSnapshots = (*int32)(unsafe.Pointer(in.MaxFullSnapshots))
+	out.ColdStorageTransitionPeriod = (*metav1.Duration)(unsafe.Pointer(in.ColdStorageTransitionPeriod))
+	return nil
+}
+
+// Convert_v1beta1_BackupRetention_To_core_BackupRetention is an autogenerated conversion function.
+func Convert_v1beta1_BackupRetention_To_core_BackupRetention(in *BackupRetention, out *core.BackupRetention, s conversion.Scope) error {
+	return autoConvert_v1beta1_BackupRetention_To_core_BackupRetention(in, out, s)
+}
+
+func autoConvert_core_BackupRetention_To_v1beta1_BackupRetention(in *core.BackupRetention, out *BackupRetention, s conversion.Scope) error {
+	out.DeletedEntriesGracePeriod = (*metav1.Duration)(unsafe.Pointer(in.DeletedEntriesGracePeriod))
+	out.MaxFullSnapshots = (*int32)(unsafe.Pointer(in.MaxFullSnapshots))
+	out.ColdStorageTransitionPeriod = (*metav1.Duration)(unsafe.Pointer(in.ColdStorageTransitionPeriod))
+	return nil
+}
+
+// Convert_core_BackupRetention_To_v1beta1_BackupRetention is an autogenerated conversion function.
+func Convert_core_BackupRetention_To_v1beta1_BackupRetention(in *core.BackupRetention, out *BackupRetention, s conversion.Scope) error {
+	return autoConvert_core_BackupRetention_To_v1beta1_BackupRetention(in, out, s)
+}
+
+func autoConvert_v1beta1_BackupValidation_To_core_BackupValidation(in *BackupValidation, out *core.BackupValidation, s conversion.Scope) error {
+	out.Interval = (*metav1.Duration)(unsafe.Pointer(in.Interval))
+	out.SamplingPercentage = (*int32)(unsafe.Pointer(in.SamplingPercentage))
+	out.Concurrency = (*int32)(unsafe.Pointer(in.Concurrency))
+	return nil
+}
+
+// Convert_v1beta1_BackupValidation_To_core_BackupValidation is an autogenerated conversion function.
+func Convert_v1beta1_BackupValidation_To_core_BackupValidation(in *BackupValidation, out *core.BackupValidation, s conversion.Scope) error {
+	return autoConvert_v1beta1_BackupValidation_To_core_BackupValidation(in, out, s)
+}
+
+func autoConvert_core_BackupValidation_To_v1beta1_BackupValidation(in *core.BackupValidation, out *BackupValidation, s conversion.Scope) error {
+	out.Interval = (*metav1.Duration)(unsafe.Pointer(in.Interval))
+	out.SamplingPercentage = (*int32)(unsafe.Pointer(in.SamplingPercentage))
+	out.Concurrency = (*int32)(unsafe.Pointer(in.Concurrency))
+	return nil
+}
+
+// Convert_core_BackupValidation_To_v1beta1_BackupValidation is an autogenerated conversion function.
+func Convert_core_BackupValidation_To_v1beta1_BackupValidation(in *core.BackupValidation, out *BackupValidation, s conversion.Scope) error {
+	return autoConvert_core_BackupValidation_To_v1beta1_BackupValidation(in, out, s)
+}
+
 func autoConvert_v1beta1_Bastion_To_core_Bastion(in *Bastion, out *core.Bastion, s conversion.Scope) error {
 	out.MachineImage = (*core.BastionMachineImage)(unsafe.Pointer(in.MachineImage))
 	out.MachineType = (*core.BastionMachineType)(unsafe.Pointer(in.MachineType))
@@ -3969,6 +4277,7 @@ func Convert_core_ExpirableVersion_To_v1beta1_ExpirableVersion(in *core.Expirabl
 func autoConvert_v1beta1_ExpirableVersionStatus_To_core_ExpirableVersionStatus(in *ExpirableVersionStatus, out *core.ExpirableVersionStatus, s conversion.Scope) error {
 	out.Version = in.Version
 	out.Classification = core.VersionClassification(in.Classification)
+	out.AddedTime = (*metav1.Time)(unsafe.Pointer(in.AddedTime))
 	return nil
 }
 
@@ -3980,6 +4289,7 @@ func Convert_v1beta1_ExpirableVersionStatus_To_core_ExpirableVersionStatus(in *E
 func autoConvert_core_ExpirableVersionStatus_To_v1beta1_ExpirableVersionStatus(in *core.ExpirableVersionStatus, out *ExpirableVersionStatus, s conversion.Scope) error {
 	out.Version = in.Version
 	out.Classification = VersionClassification(in.Classification)
+	out.AddedTime = (*metav1.Time)(unsafe.Pointer(in.AddedTime))
 	return nil
 }
 
@@ -4239,6 +4549,7 @@ func autoConvert_core_HelmControllerDeployment_To_v1beta1_HelmControllerDeployme
 func autoConvert_v1beta1_Hibernation_To_core_Hibernation(in *Hibernation, out *core.Hibernation, s conversion.Scope) error {
 	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
 	out.Schedules = *(*[]core.HibernationSchedule)(unsafe.Pointer(&in.Schedules))
+	out.Inactivity = (*core.HibernationInactivity)(unsafe.Pointer(in.Inactivity))
 	return nil
 }
 
@@ -4250,6 +4561,7 @@ func Convert_v1beta1_Hibernation_To_core_Hibernation(in *Hibernation, out *core.
 func autoConvert_core_Hibernation_To_v1beta1_Hibernation(in *core.Hibernation, out *Hibernation, s conversion.Scope) error {
 	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
 	out.Schedules = *(*[]HibernationSchedule)(unsafe.Pointer(&in.Schedules))
+	out.Inactivity = (*HibernationInactivity)(unsafe.Pointer(in.Inactivity))
 	return nil
 }
 
@@ -4258,6 +4570,50 @@ func Convert_core_Hibernation_To_v1beta1_Hibernation(in *core.Hibernation, out *
 	return autoConvert_core_Hibernation_To_v1beta1_Hibernation(in, out, s)
 }
 
+func autoConvert_v1beta1_HibernationCalendar_To_core_HibernationCalendar(in *HibernationCalendar, out *core.HibernationCalendar, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Dates = *(*[]string)(unsafe.Pointer(&in.Dates))
+	out.ResourceRef = (*autoscalingv1.CrossVersionObjectReference)(unsafe.Pointer(in.ResourceRef))
+	return nil
+}
+
+// Convert_v1beta1_HibernationCalendar_To_core_HibernationCalendar is an autogenerated conversion function.
+func Convert_v1beta1_HibernationCalendar_To_core_HibernationCalendar(in *HibernationCalendar, out *core.HibernationCalendar, s conversion.Scope) error {
+	return autoConvert_v1beta1_HibernationCalendar_To_core_HibernationCalendar(in, out, s)
+}
+
+func autoConvert_core_HibernationCalendar_To_v1beta1_HibernationCalendar(in *core.HibernationCalendar, out *HibernationCalendar, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Dates = *(*[]string)(unsafe.Pointer(&in.Dates))
+	out.ResourceRef = (*autoscalingv1.CrossVersionObjectReference)(unsafe.Pointer(in.ResourceRef))
+	return nil
+}
+
+// Convert_core_HibernationCalendar_To_v1beta1_HibernationCalendar is an autogenerated conversion function.
+func Convert_core_HibernationCalendar_To_v1beta1_HibernationCalendar(in *core.HibernationCalendar, out *HibernationCalendar, s conversion.Scope) error {
+	return autoConvert_core_HibernationCalendar_To_v1beta1_HibernationCalendar(in, out, s)
+}
+
+func autoConvert_v1beta1_HibernationInactivity_To_core_HibernationInactivity(in *HibernationInactivity, out *core.HibernationInactivity, s conversion.Scope) error {
+	out.Timeout = in.Timeout
+	return nil
+}
+
+// Convert_v1beta1_HibernationInactivity_To_core_HibernationInactivity is an autogenerated conversion function.
+func Convert_v1beta1_HibernationInactivity_To_core_HibernationInactivity(in *HibernationInactivity, out *core.HibernationInactivity, s conversion.Scope) error {
+	return autoConvert_v1beta1_HibernationInactivity_To_core_HibernationInactivity(in, out, s)
+}
+
+func autoConvert_core_HibernationInactivity_To_v1beta1_HibernationInactivity(in *core.HibernationInactivity, out *HibernationInactivity, s conversion.Scope) error {
+	out.Timeout = in.Timeout
+	return nil
+}
+
+// Convert_core_HibernationInactivity_To_v1beta1_HibernationInactivity is an autogenerated conversion function.
+func Convert_core_HibernationInactivity_To_v1beta1_HibernationInactivity(in *core.HibernationInactivity, out *HibernationInactivity, s conversion.Scope) error {
+	return autoConvert_core_HibernationInactivity_To_v1beta1_HibernationInactivity(in, out, s)
+}
+
 func autoConvert_v1beta1_HibernationSchedule_To_core_HibernationSchedule(in *HibernationSchedule, out *core.HibernationSchedule, s conversion.Scope) error {
 	out.Start = (*string)(unsafe.Pointer(in.Start))
 	out.End = (*string)(unsafe.Pointer(in.End))
@@ -5473,6 +5829,7 @@ func Convert_core_MaintenanceAutoRotation_To_v1beta1_MaintenanceAutoRotation(in
 func autoConvert_v1beta1_MaintenanceAutoUpdate_To_core_MaintenanceAutoUpdate(in *MaintenanceAutoUpdate, out *core.MaintenanceAutoUpdate, s conversion.Scope) error {
 	out.KubernetesVersion = in.KubernetesVersion
 	out.MachineImageVersion = (*bool)(unsafe.Pointer(in.MachineImageVersion))
+	out.Policy = (*core.AutoUpdatePolicy)(unsafe.Pointer(in.Policy))
 	return nil
 }
 
@@ -5484,6 +5841,7 @@ func Convert_v1beta1_MaintenanceAutoUpdate_To_core_MaintenanceAutoUpdate(in *Mai
 func autoConvert_core_MaintenanceAutoUpdate_To_v1beta1_MaintenanceAutoUpdate(in *core.MaintenanceAutoUpdate, out *MaintenanceAutoUpdate, s conversion.Scope) error {
 	out.KubernetesVersion = in.KubernetesVersion
 	out.MachineImageVersion = (*bool)(unsafe.Pointer(in.MachineImageVersion))
+	out.Policy = (*AutoUpdatePolicy)(unsafe.Pointer(in.Policy))
 	return nil
 }
 
@@ -5516,6 +5874,54 @@ func Convert_core_MaintenanceCredentialsAutoRotation_To_v1beta1_MaintenanceCrede
 	return autoConvert_core_MaintenanceCredentialsAutoRotation_To_v1beta1_MaintenanceCredentialsAutoRotation(in, out, s)
 }
 
+func autoConvert_v1beta1_MaintenanceFreeze_To_core_MaintenanceFreeze(in *MaintenanceFreeze, out *core.MaintenanceFreeze, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Begin = in.Begin
+	out.End = in.End
+	return nil
+}
+
+// Convert_v1beta1_MaintenanceFreeze_To_core_MaintenanceFreeze is an autogenerated conversion function.
+func Convert_v1beta1_MaintenanceFreeze_To_core_MaintenanceFreeze(in *MaintenanceFreeze, out *core.MaintenanceFreeze, s conversion.Scope) error {
+	return autoConvert_v1beta1_MaintenanceFreeze_To_core_MaintenanceFreeze(in, out, s)
+}
+
+func autoConvert_core_MaintenanceFreeze_To_v1beta1_MaintenanceFreeze(in *core.MaintenanceFreeze, out *MaintenanceFreeze, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Begin = in.Begin
+	out.End = in.End
+	return nil
+}
+
+// Convert_core_MaintenanceFreeze_To_v1beta1_MaintenanceFreeze is an autogenerated conversion function.
+func Convert_core_MaintenanceFreeze_To_v1beta1_MaintenanceFreeze(in *core.MaintenanceFreeze, out *MaintenanceFreeze, s conversion.Scope) error {
+	return autoConvert_core_MaintenanceFreeze_To_v1beta1_MaintenanceFreeze(in, out, s)
+}
+
+func autoConvert_v1beta1_MaintenancePreview_To_core_MaintenancePreview(in *MaintenancePreview, out *core.MaintenancePreview, s conversion.Scope) error {
+	out.NextMaintenanceTime = in.NextMaintenanceTime
+	out.PlannedChanges = *(*[]string)(unsafe.Pointer(&in.PlannedChanges))
+	out.LastUpdateTime = in.LastUpdateTime
+	return nil
+}
+
+// Convert_v1beta1_MaintenancePreview_To_core_MaintenancePreview is an autogenerated conversion function.
+func Convert_v1beta1_MaintenancePreview_To_core_MaintenancePreview(in *MaintenancePreview, out *core.MaintenancePreview, s conversion.Scope) error {
+	return autoConvert_v1beta1_MaintenancePreview_To_core_MaintenancePreview(in, out, s)
+}
+
+func autoConvert_core_MaintenancePreview_To_v1beta1_MaintenancePreview(in *core.MaintenancePreview, out *MaintenancePreview, s conversion.Scope) error {
+	out.NextMaintenanceTime = in.NextMaintenanceTime
+	out.PlannedChanges = *(*[]string)(unsafe.Pointer(&in.PlannedChanges))
+	out.LastUpdateTime = in.LastUpdateTime
+	return nil
+}
+
+// Convert_core_MaintenancePreview_To_v1beta1_MaintenancePreview is an autogenerated conversion function.
+func Convert_core_MaintenancePreview_To_v1beta1_MaintenancePreview(in *core.MaintenancePreview, out *MaintenancePreview, s conversion.Scope) error {
+	return autoConvert_core_MaintenancePreview_To_v1beta1_MaintenancePreview(in, out, s)
+}
+
 func autoConvert_v1beta1_MaintenanceRotationConfig_To_core_MaintenanceRotationConfig(in *MaintenanceRotationConfig, out *core.MaintenanceRotationConfig, s conversion.Scope) error {
 	out.RotationPeriod = (*metav1.Duration)(unsafe.Pointer(in.RotationPeriod))
 	return nil
@@ -6053,6 +6459,26 @@ func autoConvert_core_ProjectMember_To_v1beta1_ProjectMember(in *core.ProjectMem
 	return nil
 }
 
+func autoConvert_v1beta1_ProjectShootDeletion_To_core_ProjectShootDeletion(in *ProjectShootDeletion, out *core.ProjectShootDeletion, s conversion.Scope) error {
+	out.GracePeriod = in.GracePeriod
+	return nil
+}
+
+// Convert_v1beta1_ProjectShootDeletion_To_core_ProjectShootDeletion is an autogenerated conversion function.
+func Convert_v1beta1_ProjectShootDeletion_To_core_ProjectShootDeletion(in *ProjectShootDeletion, out *core.ProjectShootDeletion, s conversion.Scope) error {
+	return autoConvert_v1beta1_ProjectShootDeletion_To_core_ProjectShootDeletion(in, out, s)
+}
+
+func autoConvert_core_ProjectShootDeletion_To_v1beta1_ProjectShootDeletion(in *core.ProjectShootDeletion, out *ProjectShootDeletion, s conversion.Scope) error {
+	out.GracePeriod = in.GracePeriod
+	return nil
+}
+
+// Convert_core_ProjectShootDeletion_To_v1beta1_ProjectShootDeletion is an autogenerated conversion function.
+func Convert_core_ProjectShootDeletion_To_v1beta1_ProjectShootDeletion(in *core.ProjectShootDeletion, out *ProjectShootDeletion, s conversion.Scope) error {
+	return autoConvert_core_ProjectShootDeletion_To_v1beta1_ProjectShootDeletion(in, out, s)
+}
+
 func autoConvert_v1beta1_ProjectSpec_To_core_ProjectSpec(in *ProjectSpec, out *core.ProjectSpec, s conversion.Scope) error {
 	out.CreatedBy = (*rbacv1.Subject)(unsafe.Pointer(in.CreatedBy))
 	out.Description = (*string)(unsafe.Pointer(in.Description))
@@ -6072,6 +6498,10 @@ func autoConvert_v1beta1_ProjectSpec_To_core_ProjectSpec(in *ProjectSpec, out *c
 	out.Namespace = (*string)(unsafe.Pointer(in.Namespace))
 	out.Tolerations = (*core.ProjectTolerations)(unsafe.Pointer(in.Tolerations))
 	out.DualApprovalForDeletion = *(*[]core.DualApprovalForDeletion)(unsafe.Pointer(&in.DualApprovalForDeletion))
+	out.ShootDeletion = (*core.ProjectShootDeletion)(unsafe.Pointer(in.ShootDeletion))
+	out.MaintenanceFreezes = *(*[]core.MaintenanceFreeze)(unsafe.Pointer(&in.MaintenanceFreezes))
+	out.AutoUpdatePolicy = (*core.AutoUpdatePolicy)(unsafe.Pointer(in.AutoUpdatePolicy))
+	out.HibernationCalendars = *(*[]core.HibernationCalendar)(unsafe.Pointer(&in.HibernationCalendars))
 	return nil
 }
 
@@ -6094,6 +6524,10 @@ func autoConvert_core_ProjectSpec_To_v1beta1_ProjectSpec(in *core.ProjectSpec, o
 	out.Namespace = (*string)(unsafe.Pointer(in.Namespace))
 	out.Tolerations = (*ProjectTolerations)(unsafe.Pointer(in.Tolerations))
 	out.DualApprovalForDeletion = *(*[]DualApprovalForDeletion)(unsafe.Pointer(&in.DualApprovalForDeletion))
+	out.ShootDeletion = (*ProjectShootDeletion)(unsafe.Pointer(in.ShootDeletion))
+	out.MaintenanceFreezes = *(*[]MaintenanceFreeze)(unsafe.Pointer(&in.MaintenanceFreezes))
+	out.AutoUpdatePolicy = (*AutoUpdatePolicy)(unsafe.Pointer(in.AutoUpdatePolicy))
+	out.HibernationCalendars = *(*[]HibernationCalendar)(unsafe.Pointer(&in.HibernationCalendars))
 	return nil
 }
 
@@ -6361,6 +6795,82 @@ func Convert_core_SSHAccess_To_v1beta1_SSHAccess(in *core.SSHAccess, out *SSHAcc
 	return autoConvert_core_SSHAccess_To_v1beta1_SSHAccess(in, out, s)
 }
 
+func autoConvert_v1beta1_SchedulingExplanation_To_core_SchedulingExplanation(in *SchedulingExplanation, out *core.SchedulingExplanation, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_SchedulingExplanationStatus_To_core_SchedulingExplanationStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_SchedulingExplanation_To_core_SchedulingExplanation is an autogenerated conversion function.
+func Convert_v1beta1_SchedulingExplanation_To_core_SchedulingExplanation(in *SchedulingExplanation, out *core.SchedulingExplanation, s conversion.Scope) error {
+	return autoConvert_v1beta1_SchedulingExplanation_To_core_SchedulingExplanation(in, out, s)
+}
+
+func autoConvert_core_SchedulingExplanation_To_v1beta1_SchedulingExplanation(in *core.SchedulingExplanation, out *SchedulingExplanation, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_core_SchedulingExplanationStatus_To_v1beta1_SchedulingExplanationStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_core_SchedulingExplanation_To_v1beta1_SchedulingExplanation is an autogenerated conversion function.
+func Convert_core_SchedulingExplanation_To_v1beta1_SchedulingExplanation(in *core.SchedulingExplanation, out *SchedulingExplanation, s conversion.Scope) error {
+	return autoConvert_core_SchedulingExplanation_To_v1beta1_SchedulingExplanation(in, out, s)
+}
+
+func autoConvert_v1beta1_SchedulingExplanationStatus_To_core_SchedulingExplanationStatus(in *SchedulingExplanationStatus, out *core.SchedulingExplanationStatus, s conversion.Scope) error {
+	out.SeedName = (*string)(unsafe.Pointer(in.SeedName))
+	out.SelectedSeedName = (*string)(unsafe.Pointer(in.SelectedSeedName))
+	out.Message = (*string)(unsafe.Pointer(in.Message))
+	out.Seeds = *(*[]core.SeedSchedulingResult)(unsafe.Pointer(&in.Seeds))
+	return nil
+}
+
+// Convert_v1beta1_SchedulingExplanationStatus_To_core_SchedulingExplanationStatus is an autogenerated conversion function.
+func Convert_v1beta1_SchedulingExplanationStatus_To_core_SchedulingExplanationStatus(in *SchedulingExplanationStatus, out *core.SchedulingExplanationStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_SchedulingExplanationStatus_To_core_SchedulingExplanationStatus(in, out, s)
+}
+
+func autoConvert_core_SchedulingExplanationStatus_To_v1beta1_SchedulingExplanationStatus(in *core.SchedulingExplanationStatus, out *SchedulingExplanationStatus, s conversion.Scope) error {
+	out.SeedName = (*string)(unsafe.Pointer(in.SeedName))
+	out.SelectedSeedName = (*string)(unsafe.Pointer(in.SelectedSeedName))
+	out.Message = (*string)(unsafe.Pointer(in.Message))
+	out.Seeds = *(*[]SeedSchedulingResult)(unsafe.Pointer(&in.Seeds))
+	return nil
+}
+
+// Convert_core_SchedulingExplanationStatus_To_v1beta1_SchedulingExplanationStatus is an autogenerated conversion function.
+func Convert_core_SchedulingExplanationStatus_To_v1beta1_SchedulingExplanationStatus(in *core.SchedulingExplanationStatus, out *SchedulingExplanationStatus, s conversion.Scope) error {
+	return autoConvert_core_SchedulingExplanationStatus_To_v1beta1_SchedulingExplanationStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_SchedulingPluginScore_To_core_SchedulingPluginScore(in *SchedulingPluginScore, out *core.SchedulingPluginScore, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Weight = in.Weight
+	out.Score = in.Score
+	return nil
+}
+
+// Convert_v1beta1_SchedulingPluginScore_To_core_SchedulingPluginScore is an autogenerated conversion function.
+func Convert_v1beta1_SchedulingPluginScore_To_core_SchedulingPluginScore(in *SchedulingPluginScore, out *core.SchedulingPluginScore, s conversion.Scope) error {
+	return autoConvert_v1beta1_SchedulingPluginScore_To_core_SchedulingPluginScore(in, out, s)
+}
+
+func autoConvert_core_SchedulingPluginScore_To_v1beta1_SchedulingPluginScore(in *core.SchedulingPluginScore, out *SchedulingPluginScore, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Weight = in.Weight
+	out.Score = in.Score
+	return nil
+}
+
+// Convert_core_SchedulingPluginScore_To_v1beta1_SchedulingPluginScore is an autogenerated conversion function.
+func Convert_core_SchedulingPluginScore_To_v1beta1_SchedulingPluginScore(in *core.SchedulingPluginScore, out *SchedulingPluginScore, s conversion.Scope) error {
+	return autoConvert_core_SchedulingPluginScore_To_v1beta1_SchedulingPluginScore(in, out, s)
+}
+
 func autoConvert_v1beta1_SecretBinding_To_core_SecretBinding(in *SecretBinding, out *core.SecretBinding, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	out.SecretRef = in.SecretRef
@@ -6631,6 +7141,34 @@ func Convert_core_SeedProvider_To_v1beta1_SeedProvider(in *core.SeedProvider, ou
 	return autoConvert_core_SeedProvider_To_v1beta1_SeedProvider(in, out, s)
 }
 
+func autoConvert_v1beta1_SeedSchedulingResult_To_core_SeedSchedulingResult(in *SeedSchedulingResult, out *core.SeedSchedulingResult, s conversion.Scope) error {
+	out.Name = in.Name
+	out.RejectedBy = (*string)(unsafe.Pointer(in.RejectedBy))
+	out.Reason = (*string)(unsafe.Pointer(in.Reason))
+	out.Scores = *(*[]core.SchedulingPluginScore)(unsafe.Pointer(&in.Scores))
+	out.TotalScore = (*int64)(unsafe.Pointer(in.TotalScore))
+	return nil
+}
+
+// Convert_v1beta1_SeedSchedulingResult_To_core_SeedSchedulingResult is an autogenerated conversion function.
+func Convert_v1beta1_SeedSchedulingResult_To_core_SeedSchedulingResult(in *SeedSchedulingResult, out *core.SeedSchedulingResult, s conversion.Scope) error {
+	return autoConvert_v1beta1_SeedSchedulingResult_To_core_SeedSchedulingResult(in, out, s)
+}
+
+func autoConvert_core_SeedSchedulingResult_To_v1beta1_SeedSchedulingResult(in *core.SeedSchedulingResult, out *SeedSchedulingResult, s conversion.Scope) error {
+	out.Name = in.Name
+	out.RejectedBy = (*string)(unsafe.Pointer(in.RejectedBy))
+	out.Reason = (*string)(unsafe.Pointer(in.Reason))
+	out.Scores = *(*[]SchedulingPluginScore)(unsafe.Pointer(&in.Scores))
+	out.TotalScore = (*int64)(unsafe.Pointer(in.TotalScore))
+	return nil
+}
+
+// Convert_core_SeedSchedulingResult_To_v1beta1_SeedSchedulingResult is an autogenerated conversion function.
+func Convert_core_SeedSchedulingResult_To_v1beta1_SeedSchedulingResult(in *core.SeedSchedulingResult, out *SeedSchedulingResult, s conversion.Scope) error {
+	return autoConvert_core_SeedSchedulingResult_To_v1beta1_SeedSchedulingResult(in, out, s)
+}
+
 func autoConvert_v1beta1_SeedSelector_To_core_SeedSelector(in *SeedSelector, out *core.SeedSelector, s conversion.Scope) error {
 	out.LabelSelector = in.LabelSelector
 	out.ProviderTypes = *(*[]string)(unsafe.Pointer(&in.ProviderTypes))
@@ -7053,6 +7591,7 @@ func autoConvert_v1beta1_SeedStatus_To_core_SeedStatus(in *SeedStatus, out *core
 	out.Allocatable = *(*v1.ResourceList)(unsafe.Pointer(&in.Allocatable))
 	out.ClientCertificateExpirationTimestamp = (*metav1.Time)(unsafe.Pointer(in.ClientCertificateExpirationTimestamp))
 	out.LastOperation = (*core.LastOperation)(unsafe.Pointer(in.LastOperation))
+	out.Utilization = (*core.SeedUtilization)(unsafe.Pointer(in.Utilization))
 	return nil
 }
 
@@ -7071,6 +7610,7 @@ func autoConvert_core_SeedStatus_To_v1beta1_SeedStatus(in *core.SeedStatus, out
 	out.Allocatable = *(*v1.ResourceList)(unsafe.Pointer(&in.Allocatable))
 	out.ClientCertificateExpirationTimestamp = (*metav1.Time)(unsafe.Pointer(in.ClientCertificateExpirationTimestamp))
 	out.LastOperation = (*LastOperation)(unsafe.Pointer(in.LastOperation))
+	out.Utilization = (*SeedUtilization)(unsafe.Pointer(in.Utilization))
 	return nil
 }
 
@@ -7127,6 +7667,32 @@ func Convert_core_SeedTemplate_To_v1beta1_SeedTemplate(in *core.SeedTemplate, ou
 	return autoConvert_core_SeedTemplate_To_v1beta1_SeedTemplate(in, out, s)
 }
 
+func autoConvert_v1beta1_SeedUtilization_To_core_SeedUtilization(in *SeedUtilization, out *core.SeedUtilization, s conversion.Scope) error {
+	out.Allocatable = *(*v1.ResourceList)(unsafe.Pointer(&in.Allocatable))
+	out.Requests = *(*v1.ResourceList)(unsafe.Pointer(&in.Requests))
+	out.Usage = *(*v1.ResourceList)(unsafe.Pointer(&in.Usage))
+	out.LastUpdateTime = (*metav1.Time)(unsafe.Pointer(in.LastUpdateTime))
+	return nil
+}
+
+// Convert_v1beta1_SeedUtilization_To_core_SeedUtilization is an autogenerated conversion function.
+func Convert_v1beta1_SeedUtilization_To_core_SeedUtilization(in *SeedUtilization, out *core.SeedUtilization, s conversion.Scope) error {
+	return autoConvert_v1beta1_SeedUtilization_To_core_SeedUtilization(in, out, s)
+}
+
+func autoConvert_core_SeedUtilization_To_v1beta1_SeedUtilization(in *core.SeedUtilization, out *SeedUtilization, s conversion.Scope) error {
+	out.Allocatable = *(*v1.ResourceList)(unsafe.Pointer(&in.Allocatable))
+	out.Requests = *(*v1.ResourceList)(unsafe.Pointer(&in.Requests))
+	out.Usage = *(*v1.ResourceList)(unsafe.Pointer(&in.Usage))
+	out.LastUpdateTime = (*metav1.Time)(unsafe.Pointer(in.LastUpdateTime))
+	return nil
+}
+
+// Convert_core_SeedUtilization_To_v1beta1_SeedUtilization is an autogenerated conversion function.
+func Convert_core_SeedUtilization_To_v1beta1_SeedUtilization(in *core.SeedUtilization, out *SeedUtilization, s conversion.Scope) error {
+	return autoConvert_core_SeedUtilization_To_v1beta1_SeedUtilization(in, out, s)
+}
+
 func autoConvert_v1beta1_SeedVolume_To_core_SeedVolume(in *SeedVolume, out *core.SeedVolume, s conversion.Scope) error {
 	out.MinimumSize = (*resource.Quantity)(unsafe.Pointer(in.MinimumSize))
 	out.Providers = *(*[]core.SeedVolumeProvider)(unsafe.Pointer(&in.Providers))
@@ -7283,6 +7849,26 @@ func Convert_core_ShootAdvertisedAddress_To_v1beta1_ShootAdvertisedAddress(in *c
 	return autoConvert_core_ShootAdvertisedAddress_To_v1beta1_ShootAdvertisedAddress(in, out, s)
 }
 
+func autoConvert_v1beta1_ShootAffinityTerm_To_core_ShootAffinityTerm(in *ShootAffinityTerm, out *core.ShootAffinityTerm, s conversion.Scope) error {
+	out.LabelSelector = in.LabelSelector
+	return nil
+}
+
+// Convert_v1beta1_ShootAffinityTerm_To_core_ShootAffinityTerm is an autogenerated conversion function.
+func Convert_v1beta1_ShootAffinityTerm_To_core_ShootAffinityTerm(in *ShootAffinityTerm, out *core.ShootAffinityTerm, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootAffinityTerm_To_core_ShootAffinityTerm(in, out, s)
+}
+
+func autoConvert_core_ShootAffinityTerm_To_v1beta1_ShootAffinityTerm(in *core.ShootAffinityTerm, out *ShootAffinityTerm, s conversion.Scope) error {
+	out.LabelSelector = in.LabelSelector
+	return nil
+}
+
+// Convert_core_ShootAffinityTerm_To_v1beta1_ShootAffinityTerm is an autogenerated conversion function.
+func Convert_core_ShootAffinityTerm_To_v1beta1_ShootAffinityTerm(in *core.ShootAffinityTerm, out *ShootAffinityTerm, s conversion.Scope) error {
+	return autoConvert_core_ShootAffinityTerm_To_v1beta1_ShootAffinityTerm(in, out, s)
+}
+
 func autoConvert_v1beta1_ShootCredentials_To_core_ShootCredentials(in *ShootCredentials, out *core.ShootCredentials, s conversion.Scope) error {
 	out.Rotation = (*core.ShootCredentialsRotation)(unsafe.Pointer(in.Rotation))
 	out.EncryptionAtRest = (*core.EncryptionAtRest)(unsafe.Pointer(in.EncryptionAtRest))
@@ -7469,6 +8055,30 @@ func Convert_core_ShootSSHKeypairRotation_To_v1beta1_ShootSSHKeypairRotation(in
 	return autoConvert_core_ShootSSHKeypairRotation_To_v1beta1_ShootSSHKeypairRotation(in, out, s)
 }
 
+func autoConvert_v1beta1_ShootSource_To_core_ShootSource(in *ShootSource, out *core.ShootSource, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.Name = in.Name
+	out.TargetTime = (*metav1.Time)(unsafe.Pointer(in.TargetTime))
+	return nil
+}
+
+// Convert_v1beta1_ShootSource_To_core_ShootSource is an autogenerated conversion function.
+func Convert_v1beta1_ShootSource_To_core_ShootSource(in *ShootSource, out *core.ShootSource, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootSource_To_core_ShootSource(in, out, s)
+}
+
+func autoConvert_core_ShootSource_To_v1beta1_ShootSource(in *core.ShootSource, out *ShootSource, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.Name = in.Name
+	out.TargetTime = (*metav1.Time)(unsafe.Pointer(in.TargetTime))
+	return nil
+}
+
+// Convert_core_ShootSource_To_v1beta1_ShootSource is an autogenerated conversion function.
+func Convert_core_ShootSource_To_v1beta1_ShootSource(in *core.ShootSource, out *ShootSource, s conversion.Scope) error {
+	return autoConvert_core_ShootSource_To_v1beta1_ShootSource(in, out, s)
+}
+
 func autoConvert_v1beta1_ShootSpec_To_core_ShootSpec(in *ShootSpec, out *core.ShootSpec, s conversion.Scope) error {
 	out.Addons = (*core.Addons)(unsafe.Pointer(in.Addons))
 	out.CloudProfileName = (*string)(unsafe.Pointer(in.CloudProfileName))
@@ -7498,6 +8108,8 @@ func autoConvert_v1beta1_ShootSpec_To_core_ShootSpec(in *ShootSpec, out *core.Sh
 	out.CloudProfile = (*core.CloudProfileReference)(unsafe.Pointer(in.CloudProfile))
 	out.CredentialsBindingName = (*string)(unsafe.Pointer(in.CredentialsBindingName))
 	out.AccessRestrictions = *(*[]core.AccessRestrictionWithOptions)(unsafe.Pointer(&in.AccessRestrictions))
+	out.Affinity = (*core.Affinity)(unsafe.Pointer(in.Affinity))
+	out.Source = (*core.ShootSource)(unsafe.Pointer(in.Source))
 	return nil
 }
 
@@ -7535,6 +8147,8 @@ func autoConvert_core_ShootSpec_To_v1beta1_ShootSpec(in *core.ShootSpec, out *Sh
 	out.CloudProfile = (*CloudProfileReference)(unsafe.Pointer(in.CloudProfile))
 	out.CredentialsBindingName = (*string)(unsafe.Pointer(in.CredentialsBindingName))
 	out.AccessRestrictions = *(*[]AccessRestrictionWithOptions)(unsafe.Pointer(&in.AccessRestrictions))
+	out.Affinity = (*Affinity)(unsafe.Pointer(in.Affinity))
+	out.Source = (*ShootSource)(unsafe.Pointer(in.Source))
 	return nil
 }
 
@@ -7639,6 +8253,8 @@ func autoConvert_v1beta1_ShootStatus_To_core_ShootStatus(in *ShootStatus, out *c
 	out.InPlaceUpdates = (*core.InPlaceUpdatesStatus)(unsafe.Pointer(in.InPlaceUpdates))
 	out.ManualWorkerPoolRollout = (*core.ManualWorkerPoolRollout)(unsafe.Pointer(in.ManualWorkerPoolRollout))
 	out.LiveMigration = (*core.LiveMigration)(unsafe.Pointer(in.LiveMigration))
+	out.MaintenancePreview = (*core.MaintenancePreview)(unsafe.Pointer(in.MaintenancePreview))
+	out.LastActivityTime = (*metav1.Time)(unsafe.Pointer(in.LastActivityTime))
 	return nil
 }
 
@@ -7671,6 +8287,8 @@ func autoConvert_core_ShootStatus_To_v1beta1_ShootStatus(in *core.ShootStatus, o
 	out.InPlaceUpdates = (*InPlaceUpdatesStatus)(unsafe.Pointer(in.InPlaceUpdates))
 	out.ManualWorkerPoolRollout = (*ManualWorkerPoolRollout)(unsafe.Pointer(in.ManualWorkerPoolRollout))
 	out.LiveMigration = (*LiveMigration)(unsafe.Pointer(in.LiveMigration))
+	out.MaintenancePreview = (*MaintenancePreview)(unsafe.Pointer(in.MaintenancePreview))
+	out.LastActivityTime = (*metav1.Time)(unsafe.Pointer(in.LastActivityTime))
 	return nil
 }
 
@@ -7705,6 +8323,26 @@ func Convert_core_ShootTemplate_To_v1beta1_ShootTemplate(in *core.ShootTemplate,
 	return autoConvert_core_ShootTemplate_To_v1beta1_ShootTemplate(in, out, s)
 }
 
+func autoConvert_v1beta1_ShootWakeUp_To_core_ShootWakeUp(in *ShootWakeUp, out *core.ShootWakeUp, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	return nil
+}
+
+// Convert_v1beta1_ShootWakeUp_To_core_ShootWakeUp is an autogenerated conversion function.
+func Convert_v1beta1_ShootWakeUp_To_core_ShootWakeUp(in *ShootWakeUp, out *core.ShootWakeUp, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootWakeUp_To_core_ShootWakeUp(in, out, s)
+}
+
+func autoConvert_core_ShootWakeUp_To_v1beta1_ShootWakeUp(in *core.ShootWakeUp, out *ShootWakeUp, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	return nil
+}
+
+// Convert_core_ShootWakeUp_To_v1beta1_ShootWakeUp is an autogenerated conversion function.
+func Convert_core_ShootWakeUp_To_v1beta1_ShootWakeUp(in *core.ShootWakeUp, out *ShootWakeUp, s conversion.Scope) error {
+	return autoConvert_core_ShootWakeUp_To_v1beta1_ShootWakeUp(in, out, s)
+}
+
 func autoConvert_v1beta1_StructuredAuthentication_To_core_StructuredAuthentication(in *StructuredAuthentication, out *core.StructuredAuthentication, s conversion.Scope) error {
 	out.ConfigMapName = in.ConfigMapName
 	return nil
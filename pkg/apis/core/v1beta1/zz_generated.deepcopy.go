@@ -184,6 +184,36 @@ func (in *AdmissionPlugin) DeepCopy() *AdmissionPlugin {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Affinity) DeepCopyInto(out *Affinity) {
+	*out = *in
+	if in.ShootAffinity != nil {
+		in, out := &in.ShootAffinity, &out.ShootAffinity
+		*out = make([]ShootAffinityTerm, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ShootAntiAffinity != nil {
+		in, out := &in.ShootAntiAffinity, &out.ShootAntiAffinity
+		*out = make([]ShootAffinityTerm, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Affinity.
+func (in *Affinity) DeepCopy() *Affinity {
+	if in == nil {
+		return nil
+	}
+	out := new(Affinity)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Alerting) DeepCopyInto(out *Alerting) {
 	*out = *in
@@ -263,6 +293,32 @@ func (in *AuthorizerKubeconfigReference) DeepCopy() *AuthorizerKubeconfigReferen
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoUpdatePolicy) DeepCopyInto(out *AutoUpdatePolicy) {
+	*out = *in
+	if in.Channel != nil {
+		in, out := &in.Channel, &out.Channel
+		*out = new(UpdateChannel)
+		**out = **in
+	}
+	if in.SoakTime != nil {
+		in, out := &in.SoakTime, &out.SoakTime
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoUpdatePolicy.
+func (in *AutoUpdatePolicy) DeepCopy() *AutoUpdatePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoUpdatePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AvailabilityZone) DeepCopyInto(out *AvailabilityZone) {
 	*out = *in
@@ -307,6 +363,16 @@ func (in *Backup) DeepCopyInto(out *Backup) {
 		*out = new(v1.ObjectReference)
 		**out = **in
 	}
+	if in.Validation != nil {
+		in, out := &in.Validation, &out.Validation
+		*out = new(BackupValidation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Retention != nil {
+		in, out := &in.Retention, &out.Retention
+		*out = new(BackupRetention)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -421,6 +487,11 @@ func (in *BackupBucketSpec) DeepCopyInto(out *BackupBucketSpec) {
 		*out = new(v1.ObjectReference)
 		**out = **in
 	}
+	if in.Retention != nil {
+		in, out := &in.Retention, &out.Retention
+		*out = new(BackupRetention)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -579,6 +650,18 @@ func (in *BackupEntryStatus) DeepCopyInto(out *BackupEntryStatus) {
 		in, out := &in.MigrationStartTime, &out.MigrationStartTime
 		*out = (*in).DeepCopy()
 	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Usage != nil {
+		in, out := &in.Usage, &out.Usage
+		*out = new(BackupEntryUsage)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -592,6 +675,89 @@ func (in *BackupEntryStatus) DeepCopy() *BackupEntryStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupEntryUsage) DeepCopyInto(out *BackupEntryUsage) {
+	*out = *in
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+	if in.LastBackupTime != nil {
+		in, out := &in.LastBackupTime, &out.LastBackupTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupEntryUsage.
+func (in *BackupEntryUsage) DeepCopy() *BackupEntryUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupEntryUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupRetention) DeepCopyInto(out *BackupRetention) {
+	*out = *in
+	if in.DeletedEntriesGracePeriod != nil {
+		in, out := &in.DeletedEntriesGracePeriod, &out.DeletedEntriesGracePeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxFullSnapshots != nil {
+		in, out := &in.MaxFullSnapshots, &out.MaxFullSnapshots
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ColdStorageTransitionPeriod != nil {
+		in, out := &in.ColdStorageTransitionPeriod, &out.ColdStorageTransitionPeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupRetention.
+func (in *BackupRetention) DeepCopy() *BackupRetention {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupRetention)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupValidation) DeepCopyInto(out *BackupValidation) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.SamplingPercentage != nil {
+		in, out := &in.SamplingPercentage, &out.SamplingPercentage
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Concurrency != nil {
+		in, out := &in.Concurrency, &out.Concurrency
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupValidation.
+func (in *BackupValidation) DeepCopy() *BackupValidation {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupValidation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Bastion) DeepCopyInto(out *Bastion) {
 	*out = *in
@@ -2076,6 +2242,10 @@ func (in *ExpirableVersion) DeepCopy() *ExpirableVersion {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExpirableVersionStatus) DeepCopyInto(out *ExpirableVersionStatus) {
 	*out = *in
+	if in.AddedTime != nil {
+		in, out := &in.AddedTime, &out.AddedTime
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -2397,6 +2567,11 @@ func (in *Hibernation) DeepCopyInto(out *Hibernation) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Inactivity != nil {
+		in, out := &in.Inactivity, &out.Inactivity
+		*out = new(HibernationInactivity)
+		**out = **in
+	}
 	return
 }
 
@@ -2410,6 +2585,49 @@ func (in *Hibernation) DeepCopy() *Hibernation {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HibernationCalendar) DeepCopyInto(out *HibernationCalendar) {
+	*out = *in
+	if in.Dates != nil {
+		in, out := &in.Dates, &out.Dates
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ResourceRef != nil {
+		in, out := &in.ResourceRef, &out.ResourceRef
+		*out = new(autoscalingv1.CrossVersionObjectReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HibernationCalendar.
+func (in *HibernationCalendar) DeepCopy() *HibernationCalendar {
+	if in == nil {
+		return nil
+	}
+	out := new(HibernationCalendar)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HibernationInactivity) DeepCopyInto(out *HibernationInactivity) {
+	*out = *in
+	out.Timeout = in.Timeout
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HibernationInactivity.
+func (in *HibernationInactivity) DeepCopy() *HibernationInactivity {
+	if in == nil {
+		return nil
+	}
+	out := new(HibernationInactivity)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HibernationSchedule) DeepCopyInto(out *HibernationSchedule) {
 	*out = *in
@@ -3815,6 +4033,11 @@ func (in *MaintenanceAutoUpdate) DeepCopyInto(out *MaintenanceAutoUpdate) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Policy != nil {
+		in, out := &in.Policy, &out.Policy
+		*out = new(AutoUpdatePolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3859,6 +4082,47 @@ func (in *MaintenanceCredentialsAutoRotation) DeepCopy() *MaintenanceCredentials
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceFreeze) DeepCopyInto(out *MaintenanceFreeze) {
+	*out = *in
+	in.Begin.DeepCopyInto(&out.Begin)
+	in.End.DeepCopyInto(&out.End)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceFreeze.
+func (in *MaintenanceFreeze) DeepCopy() *MaintenanceFreeze {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceFreeze)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenancePreview) DeepCopyInto(out *MaintenancePreview) {
+	*out = *in
+	in.NextMaintenanceTime.DeepCopyInto(&out.NextMaintenanceTime)
+	if in.PlannedChanges != nil {
+		in, out := &in.PlannedChanges, &out.PlannedChanges
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenancePreview.
+func (in *MaintenancePreview) DeepCopy() *MaintenancePreview {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenancePreview)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MaintenanceRotationConfig) DeepCopyInto(out *MaintenanceRotationConfig) {
 	*out = *in
@@ -4460,6 +4724,22 @@ func (in *ProjectMember) DeepCopy() *ProjectMember {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectShootDeletion) DeepCopyInto(out *ProjectShootDeletion) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectShootDeletion.
+func (in *ProjectShootDeletion) DeepCopy() *ProjectShootDeletion {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectShootDeletion)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProjectSpec) DeepCopyInto(out *ProjectSpec) {
 	*out = *in
@@ -4507,6 +4787,30 @@ func (in *ProjectSpec) DeepCopyInto(out *ProjectSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ShootDeletion != nil {
+		in, out := &in.ShootDeletion, &out.ShootDeletion
+		*out = new(ProjectShootDeletion)
+		**out = **in
+	}
+	if in.MaintenanceFreezes != nil {
+		in, out := &in.MaintenanceFreezes, &out.MaintenanceFreezes
+		*out = make([]MaintenanceFreeze, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AutoUpdatePolicy != nil {
+		in, out := &in.AutoUpdatePolicy, &out.AutoUpdatePolicy
+		*out = new(AutoUpdatePolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HibernationCalendars != nil {
+		in, out := &in.HibernationCalendars, &out.HibernationCalendars
+		*out = make([]HibernationCalendar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -4802,6 +5106,87 @@ func (in *SSHAccess) DeepCopy() *SSHAccess {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchedulingExplanation) DeepCopyInto(out *SchedulingExplanation) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulingExplanation.
+func (in *SchedulingExplanation) DeepCopy() *SchedulingExplanation {
+	if in == nil {
+		return nil
+	}
+	out := new(SchedulingExplanation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SchedulingExplanation) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchedulingExplanationStatus) DeepCopyInto(out *SchedulingExplanationStatus) {
+	*out = *in
+	if in.SeedName != nil {
+		in, out := &in.SeedName, &out.SeedName
+		*out = new(string)
+		**out = **in
+	}
+	if in.SelectedSeedName != nil {
+		in, out := &in.SelectedSeedName, &out.SelectedSeedName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Message != nil {
+		in, out := &in.Message, &out.Message
+		*out = new(string)
+		**out = **in
+	}
+	if in.Seeds != nil {
+		in, out := &in.Seeds, &out.Seeds
+		*out = make([]SeedSchedulingResult, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulingExplanationStatus.
+func (in *SchedulingExplanationStatus) DeepCopy() *SchedulingExplanationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SchedulingExplanationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchedulingPluginScore) DeepCopyInto(out *SchedulingPluginScore) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulingPluginScore.
+func (in *SchedulingPluginScore) DeepCopy() *SchedulingPluginScore {
+	if in == nil {
+		return nil
+	}
+	out := new(SchedulingPluginScore)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretBinding) DeepCopyInto(out *SecretBinding) {
 	*out = *in
@@ -5087,6 +5472,42 @@ func (in *SeedProvider) DeepCopy() *SeedProvider {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedSchedulingResult) DeepCopyInto(out *SeedSchedulingResult) {
+	*out = *in
+	if in.RejectedBy != nil {
+		in, out := &in.RejectedBy, &out.RejectedBy
+		*out = new(string)
+		**out = **in
+	}
+	if in.Reason != nil {
+		in, out := &in.Reason, &out.Reason
+		*out = new(string)
+		**out = **in
+	}
+	if in.Scores != nil {
+		in, out := &in.Scores, &out.Scores
+		*out = make([]SchedulingPluginScore, len(*in))
+		copy(*out, *in)
+	}
+	if in.TotalScore != nil {
+		in, out := &in.TotalScore, &out.TotalScore
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedSchedulingResult.
+func (in *SeedSchedulingResult) DeepCopy() *SeedSchedulingResult {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedSchedulingResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SeedSelector) DeepCopyInto(out *SeedSelector) {
 	*out = *in
@@ -5597,6 +6018,11 @@ func (in *SeedStatus) DeepCopyInto(out *SeedStatus) {
 		*out = new(LastOperation)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Utilization != nil {
+		in, out := &in.Utilization, &out.Utilization
+		*out = new(SeedUtilization)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -5649,6 +6075,47 @@ func (in *SeedTemplate) DeepCopy() *SeedTemplate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedUtilization) DeepCopyInto(out *SeedUtilization) {
+	*out = *in
+	if in.Allocatable != nil {
+		in, out := &in.Allocatable, &out.Allocatable
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.Requests != nil {
+		in, out := &in.Requests, &out.Requests
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.Usage != nil {
+		in, out := &in.Usage, &out.Usage
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.LastUpdateTime != nil {
+		in, out := &in.LastUpdateTime, &out.LastUpdateTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedUtilization.
+func (in *SeedUtilization) DeepCopy() *SeedUtilization {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedUtilization)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SeedVolume) DeepCopyInto(out *SeedVolume) {
 	*out = *in
@@ -5815,6 +6282,23 @@ func (in *ShootAdvertisedAddress) DeepCopy() *ShootAdvertisedAddress {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootAffinityTerm) DeepCopyInto(out *ShootAffinityTerm) {
+	*out = *in
+	in.LabelSelector.DeepCopyInto(&out.LabelSelector)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootAffinityTerm.
+func (in *ShootAffinityTerm) DeepCopy() *ShootAffinityTerm {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootAffinityTerm)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootCredentials) DeepCopyInto(out *ShootCredentials) {
 	*out = *in
@@ -6015,6 +6499,26 @@ func (in *ShootSSHKeypairRotation) DeepCopy() *ShootSSHKeypairRotation {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootSource) DeepCopyInto(out *ShootSource) {
+	*out = *in
+	if in.TargetTime != nil {
+		in, out := &in.TargetTime, &out.TargetTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootSource.
+func (in *ShootSource) DeepCopy() *ShootSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootSpec) DeepCopyInto(out *ShootSpec) {
 	*out = *in
@@ -6131,6 +6635,16 @@ func (in *ShootSpec) DeepCopyInto(out *ShootSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Affinity != nil {
+		in, out := &in.Affinity, &out.Affinity
+		*out = new(Affinity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Source != nil {
+		in, out := &in.Source, &out.Source
+		*out = new(ShootSource)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -6330,6 +6844,15 @@ func (in *ShootStatus) DeepCopyInto(out *ShootStatus) {
 		*out = new(LiveMigration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.MaintenancePreview != nil {
+		in, out := &in.MaintenancePreview, &out.MaintenancePreview
+		*out = new(MaintenancePreview)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastActivityTime != nil {
+		in, out := &in.LastActivityTime, &out.LastActivityTime
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -6361,6 +6884,32 @@ func (in *ShootTemplate) DeepCopy() *ShootTemplate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootWakeUp) DeepCopyInto(out *ShootWakeUp) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootWakeUp.
+func (in *ShootWakeUp) DeepCopy() *ShootWakeUp {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootWakeUp)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ShootWakeUp) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StructuredAuthentication) DeepCopyInto(out *StructuredAuthentication) {
 	*out = *in
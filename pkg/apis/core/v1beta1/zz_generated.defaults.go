@@ -121,6 +121,11 @@ func SetObjectDefaults_SecretBindingList(in *SecretBindingList) {
 
 func SetObjectDefaults_Seed(in *Seed) {
 	SetDefaults_Seed(in)
+	if in.Spec.Backup != nil {
+		if in.Spec.Backup.Validation != nil {
+			SetDefaults_BackupValidation(in.Spec.Backup.Validation)
+		}
+	}
 	SetDefaults_SeedNetworks(&in.Spec.Networks)
 	if in.Spec.Settings != nil {
 		SetDefaults_SeedSettings(in.Spec.Settings)
@@ -44,6 +44,11 @@ func (in AdmissionPlugin) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.AdmissionPlugin"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in Affinity) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.Affinity"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in Alerting) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.Alerting"
@@ -64,6 +69,11 @@ func (in AuthorizerKubeconfigReference) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.AuthorizerKubeconfigReference"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in AutoUpdatePolicy) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.AutoUpdatePolicy"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in AvailabilityZone) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.AvailabilityZone"
@@ -119,6 +129,21 @@ func (in BackupEntryStatus) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.BackupEntryStatus"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in BackupEntryUsage) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.BackupEntryUsage"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in BackupRetention) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.BackupRetention"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in BackupValidation) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.BackupValidation"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in Bastion) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.Bastion"
@@ -424,6 +449,16 @@ func (in Hibernation) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.Hibernation"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in HibernationCalendar) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.HibernationCalendar"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in HibernationInactivity) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.HibernationInactivity"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in HibernationSchedule) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.HibernationSchedule"
@@ -634,6 +669,16 @@ func (in MaintenanceCredentialsAutoRotation) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.MaintenanceCredentialsAutoRotation"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in MaintenanceFreeze) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.MaintenanceFreeze"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in MaintenancePreview) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.MaintenancePreview"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in MaintenanceRotationConfig) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.MaintenanceRotationConfig"
@@ -739,6 +784,11 @@ func (in ProjectMember) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ProjectMember"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in ProjectShootDeletion) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ProjectShootDeletion"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in ProjectSpec) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ProjectSpec"
@@ -794,6 +844,21 @@ func (in SSHAccess) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.SSHAccess"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in SchedulingExplanation) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.SchedulingExplanation"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in SchedulingExplanationStatus) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.SchedulingExplanationStatus"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in SchedulingPluginScore) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.SchedulingPluginScore"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in SecretBinding) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.SecretBinding"
@@ -844,6 +909,11 @@ func (in SeedProvider) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.SeedProvider"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in SeedSchedulingResult) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.SeedSchedulingResult"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in SeedSelector) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.SeedSelector"
@@ -939,6 +1009,11 @@ func (in SeedTemplate) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.SeedTemplate"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in SeedUtilization) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.SeedUtilization"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in SeedVolume) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.SeedVolume"
@@ -969,6 +1044,11 @@ func (in ShootAdvertisedAddress) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ShootAdvertisedAddress"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in ShootAffinityTerm) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ShootAffinityTerm"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in ShootCredentials) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ShootCredentials"
@@ -1004,6 +1084,11 @@ func (in ShootSSHKeypairRotation) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ShootSSHKeypairRotation"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in ShootSource) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ShootSource"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in ShootSpec) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ShootSpec"
@@ -1034,6 +1119,11 @@ func (in ShootTemplate) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ShootTemplate"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in ShootWakeUp) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ShootWakeUp"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in StructuredAuthentication) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.StructuredAuthentication"
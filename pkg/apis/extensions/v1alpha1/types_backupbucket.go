@@ -68,6 +68,17 @@ type BackupBucketSpec struct {
 	Region string `json:"region"`
 	// SecretRef is a reference to a secret that contains the credentials to access object store.
 	SecretRef corev1.SecretReference `json:"secretRef"`
+	// Lifecycle contains the lifecycle policy for the objects in this bucket.
+	// +optional
+	Lifecycle *BackupBucketLifecycle `json:"lifecycle,omitempty"`
+}
+
+// BackupBucketLifecycle contains the lifecycle policy for the objects in a backup bucket.
+type BackupBucketLifecycle struct {
+	// ColdStorageTransitionPeriod is the age after which objects are transitioned to a cheaper storage class of the
+	// object store.
+	// +optional
+	ColdStorageTransitionPeriod *metav1.Duration `json:"coldStorageTransitionPeriod,omitempty"`
 }
 
 // BackupBucketStatus is the status for an BackupBucket resource.
@@ -8,6 +8,8 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 )
 
 var _ Object = (*BackupEntry)(nil)
@@ -84,4 +86,8 @@ type BackupEntrySpec struct {
 type BackupEntryStatus struct {
 	// DefaultStatus is a structure containing common fields used by all extension resources.
 	DefaultStatus `json:",inline"`
+	// Usage contains information about the storage used by the backups of this BackupEntry. It is reported by
+	// extensions which are able to determine it.
+	// +optional
+	Usage *gardencorev1beta1.BackupEntryUsage `json:"usage,omitempty"`
 }
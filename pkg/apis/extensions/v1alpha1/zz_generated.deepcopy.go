@@ -45,6 +45,27 @@ func (in *BackupBucket) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupBucketLifecycle) DeepCopyInto(out *BackupBucketLifecycle) {
+	*out = *in
+	if in.ColdStorageTransitionPeriod != nil {
+		in, out := &in.ColdStorageTransitionPeriod, &out.ColdStorageTransitionPeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupBucketLifecycle.
+func (in *BackupBucketLifecycle) DeepCopy() *BackupBucketLifecycle {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupBucketLifecycle)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackupBucketList) DeepCopyInto(out *BackupBucketList) {
 	*out = *in
@@ -83,6 +104,11 @@ func (in *BackupBucketSpec) DeepCopyInto(out *BackupBucketSpec) {
 	*out = *in
 	in.DefaultSpec.DeepCopyInto(&out.DefaultSpec)
 	out.SecretRef = in.SecretRef
+	if in.Lifecycle != nil {
+		in, out := &in.Lifecycle, &out.Lifecycle
+		*out = new(BackupBucketLifecycle)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -206,6 +232,11 @@ func (in *BackupEntrySpec) DeepCopy() *BackupEntrySpec {
 func (in *BackupEntryStatus) DeepCopyInto(out *BackupEntryStatus) {
 	*out = *in
 	in.DefaultStatus.DeepCopyInto(&out.DefaultStatus)
+	if in.Usage != nil {
+		in, out := &in.Usage, &out.Usage
+		*out = new(v1beta1.BackupEntryUsage)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -13,4 +13,11 @@ const (
 	// BastionShootName is the field selector path for finding
 	// the Shoot name of a operations.gardener.cloud/v1alpha1 Bastion.
 	BastionShootName = "spec.shootRef.name"
+
+	// ShootRestoreSeedName is the field selector path for finding
+	// the Seed cluster of a operations.gardener.cloud/v1alpha1 ShootRestore.
+	ShootRestoreSeedName = "spec.seedName"
+	// ShootRestoreShootName is the field selector path for finding
+	// the Shoot name of a operations.gardener.cloud/v1alpha1 ShootRestore.
+	ShootRestoreShootName = "spec.shootRef.name"
 )
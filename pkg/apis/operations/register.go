@@ -37,6 +37,10 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		&Bastion{},
 		&BastionList{},
+		&SeedRebalanceProposal{},
+		&SeedRebalanceProposalList{},
+		&ShootRestore{},
+		&ShootRestoreList{},
 	)
 
 	return nil
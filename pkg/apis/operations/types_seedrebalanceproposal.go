@@ -0,0 +1,116 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operations
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// SeedRebalanceProposal holds a set of shoot control plane migrations which would even out the load across the seeds.
+type SeedRebalanceProposal struct {
+	metav1.TypeMeta
+	// Standard object metadata.
+	metav1.ObjectMeta
+
+	// Specification of the SeedRebalanceProposal.
+	Spec SeedRebalanceProposalSpec
+	// Most recently observed status of the SeedRebalanceProposal.
+	Status SeedRebalanceProposalStatus
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// SeedRebalanceProposalList is a list of SeedRebalanceProposal objects.
+type SeedRebalanceProposalList struct {
+	metav1.TypeMeta
+	// Standard list object metadata.
+	metav1.ListMeta
+
+	// Items is the list of SeedRebalanceProposals.
+	Items []SeedRebalanceProposal
+}
+
+// SeedRebalanceProposalSpec is the specification of a SeedRebalanceProposal.
+type SeedRebalanceProposalSpec struct {
+	// Migrations is the list of proposed shoot control plane migrations. This field is immutable.
+	Migrations []SeedRebalanceMigration
+	// Approved states whether the proposal was approved by an operator. Once approved, the migrations are executed and
+	// the approval cannot be revoked anymore.
+	Approved bool
+}
+
+// SeedRebalanceMigration is a proposed migration of a shoot control plane to another seed.
+type SeedRebalanceMigration struct {
+	// ShootNamespace is the namespace of the shoot.
+	ShootNamespace string
+	// ShootName is the name of the shoot.
+	ShootName string
+	// SourceSeedName is the name of the seed the shoot control plane is currently running on.
+	SourceSeedName string
+	// TargetSeedName is the name of the seed the shoot control plane should be migrated to.
+	TargetSeedName string
+}
+
+// SeedRebalanceProposalStatus holds the most recently observed status of the SeedRebalanceProposal.
+type SeedRebalanceProposalStatus struct {
+	// Phase is the phase of the SeedRebalanceProposal.
+	Phase SeedRebalanceProposalPhase
+	// Migrations contains the progress of the individual migrations.
+	Migrations []SeedRebalanceMigrationStatus
+	// ObservedGeneration is the most recent generation observed for this SeedRebalanceProposal. It corresponds to the
+	// SeedRebalanceProposal's generation, which is updated on mutation by the API Server.
+	ObservedGeneration *int64
+}
+
+// SeedRebalanceMigrationStatus contains the progress of a proposed migration.
+type SeedRebalanceMigrationStatus struct {
+	// ShootNamespace is the namespace of the shoot.
+	ShootNamespace string
+	// ShootName is the name of the shoot.
+	ShootName string
+	// Phase is the phase of the migration.
+	Phase SeedRebalanceMigrationPhase
+	// Message is a human-readable message explaining the phase of the migration.
+	Message string
+	// LastTransitionTime is the last time the phase of the migration changed.
+	LastTransitionTime *metav1.Time
+}
+
+// SeedRebalanceProposalPhase is the phase of a SeedRebalanceProposal.
+type SeedRebalanceProposalPhase string
+
+const (
+	// SeedRebalanceProposalPending means that the proposal waits for the approval.
+	SeedRebalanceProposalPending SeedRebalanceProposalPhase = "Pending"
+	// SeedRebalanceProposalExecuting means that the migrations of the proposal are being executed.
+	SeedRebalanceProposalExecuting SeedRebalanceProposalPhase = "Executing"
+	// SeedRebalanceProposalCompleted means that all migrations of the proposal are finished.
+	SeedRebalanceProposalCompleted SeedRebalanceProposalPhase = "Completed"
+	// SeedRebalanceProposalExpired means that the proposal was neither approved nor started before its time to live
+	// elapsed. Expired proposals are not executed anymore.
+	SeedRebalanceProposalExpired SeedRebalanceProposalPhase = "Expired"
+)
+
+// SeedRebalanceMigrationPhase is the phase of a proposed migration.
+type SeedRebalanceMigrationPhase string
+
+const (
+	// SeedRebalanceMigrationPending means that the migration has not been started yet.
+	SeedRebalanceMigrationPending SeedRebalanceMigrationPhase = "Pending"
+	// SeedRebalanceMigrationMigrating means that the shoot was bound to the target seed and the control plane is being
+	// migrated.
+	SeedRebalanceMigrationMigrating SeedRebalanceMigrationPhase = "Migrating"
+	// SeedRebalanceMigrationSucceeded means that the control plane was successfully migrated to the target seed.
+	SeedRebalanceMigrationSucceeded SeedRebalanceMigrationPhase = "Succeeded"
+	// SeedRebalanceMigrationFailed means that the migration failed.
+	SeedRebalanceMigrationFailed SeedRebalanceMigrationPhase = "Failed"
+	// SeedRebalanceMigrationSkipped means that the migration was not executed because the shoot changed in the meantime,
+	// e.g., it was deleted or moved to another seed.
+	SeedRebalanceMigrationSkipped SeedRebalanceMigrationPhase = "Skipped"
+)
@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operations
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ShootRestore holds details about a restoration of a shoot's etcd from the snapshots in its BackupEntry.
+type ShootRestore struct {
+	metav1.TypeMeta
+	// Standard object metadata.
+	metav1.ObjectMeta
+
+	// Specification of the ShootRestore.
+	Spec ShootRestoreSpec
+	// Most recently observed status of the ShootRestore.
+	Status ShootRestoreStatus
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ShootRestoreList is a list of ShootRestore objects.
+type ShootRestoreList struct {
+	metav1.TypeMeta
+	// Standard list object metadata.
+	metav1.ListMeta
+
+	// Items is the list of ShootRestores.
+	Items []ShootRestore
+}
+
+// ShootRestoreSpec is the specification of a ShootRestore.
+type ShootRestoreSpec struct {
+	// ShootRef defines the shoot whose etcd shall be restored. This field is immutable.
+	ShootRef corev1.LocalObjectReference
+	// SeedName is the name of the seed the control plane of the shoot is running on. This field is populated when
+	// the ShootRestore is created.
+	SeedName *string
+	// TargetTime is the point in time whose state of the etcd shall be restored. Only the snapshots which were taken at
+	// or before this time are restored. This field is immutable.
+	TargetTime *metav1.Time
+}
+
+// ShootRestoreStatus holds the most recently observed status of the ShootRestore.
+type ShootRestoreStatus struct {
+	// Phase is the phase of the ShootRestore.
+	Phase ShootRestorePhase
+	// Message is a human-readable message explaining the phase of the ShootRestore.
+	Message string
+	// StartTime is the time when the restoration was started.
+	StartTime *metav1.Time
+	// CompletionTime is the time when the restoration succeeded or failed.
+	CompletionTime *metav1.Time
+	// ObservedGeneration is the most recent generation observed for this ShootRestore. It corresponds to the
+	// ShootRestore's generation, which is updated on mutation by the API Server.
+	ObservedGeneration *int64
+}
+
+// ShootRestorePhase is the phase of a ShootRestore.
+type ShootRestorePhase string
+
+const (
+	// ShootRestorePending means that the restoration has not been started yet, e.g., because the shoot is currently
+	// being reconciled.
+	ShootRestorePending ShootRestorePhase = "Pending"
+	// ShootRestoreRestoring means that the control plane of the shoot is scaled down and the etcd is being restored.
+	ShootRestoreRestoring ShootRestorePhase = "Restoring"
+	// ShootRestoreSucceeded means that the etcd was restored and the control plane is being brought back by a
+	// reconciliation of the shoot.
+	ShootRestoreSucceeded ShootRestorePhase = "Succeeded"
+	// ShootRestoreFailed means that the restoration failed.
+	ShootRestoreFailed ShootRestorePhase = "Failed"
+)
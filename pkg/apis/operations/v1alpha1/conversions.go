@@ -28,6 +28,19 @@ func addConversionFuncs(scheme *runtime.Scheme) error {
 		return err
 	}
 
+	if err := scheme.AddFieldLabelConversionFunc(SchemeGroupVersion.WithKind("ShootRestore"),
+		func(label, value string) (string, string, error) {
+			switch label {
+			case "metadata.name", "metadata.namespace", operations.ShootRestoreSeedName, operations.ShootRestoreShootName:
+				return label, value, nil
+			default:
+				return "", "", fmt.Errorf("field label not supported: %s", label)
+			}
+		},
+	); err != nil {
+		return err
+	}
+
 	// Add non-generated conversion functions
 
 	if err := scheme.AddConversionFunc((*Bastion)(nil), (*operations.Bastion)(nil), func(a, b any, scope conversion.Scope) error {
@@ -18,3 +18,23 @@ func (*BastionList) ProtoMessage() {}
 func (*BastionSpec) ProtoMessage() {}
 
 func (*BastionStatus) ProtoMessage() {}
+
+func (*SeedRebalanceMigration) ProtoMessage() {}
+
+func (*SeedRebalanceMigrationStatus) ProtoMessage() {}
+
+func (*SeedRebalanceProposal) ProtoMessage() {}
+
+func (*SeedRebalanceProposalList) ProtoMessage() {}
+
+func (*SeedRebalanceProposalSpec) ProtoMessage() {}
+
+func (*SeedRebalanceProposalStatus) ProtoMessage() {}
+
+func (*ShootRestore) ProtoMessage() {}
+
+func (*ShootRestoreList) ProtoMessage() {}
+
+func (*ShootRestoreSpec) ProtoMessage() {}
+
+func (*ShootRestoreStatus) ProtoMessage() {}
@@ -39,6 +39,10 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		&Bastion{},
 		&BastionList{},
+		&SeedRebalanceProposal{},
+		&SeedRebalanceProposalList{},
+		&ShootRestore{},
+		&ShootRestoreList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 
@@ -0,0 +1,124 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// SeedRebalanceProposal holds a set of shoot control plane migrations which would even out the load across the seeds.
+type SeedRebalanceProposal struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object metadata.
+	metav1.ObjectMeta `json:"metadata" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Specification of the SeedRebalanceProposal.
+	Spec SeedRebalanceProposalSpec `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+	// Most recently observed status of the SeedRebalanceProposal.
+	// +optional
+	Status SeedRebalanceProposalStatus `json:"status" protobuf:"bytes,3,opt,name=status"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// SeedRebalanceProposalList is a list of SeedRebalanceProposal objects.
+type SeedRebalanceProposalList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard list object metadata.
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Items is the list of SeedRebalanceProposals.
+	Items []SeedRebalanceProposal `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// SeedRebalanceProposalSpec is the specification of a SeedRebalanceProposal.
+type SeedRebalanceProposalSpec struct {
+	// Migrations is the list of proposed shoot control plane migrations. This field is immutable.
+	Migrations []SeedRebalanceMigration `json:"migrations" protobuf:"bytes,1,rep,name=migrations"`
+	// Approved states whether the proposal was approved by an operator. Once approved, the migrations are executed and
+	// the approval cannot be revoked anymore.
+	// +optional
+	Approved bool `json:"approved,omitempty" protobuf:"varint,2,opt,name=approved"`
+}
+
+// SeedRebalanceMigration is a proposed migration of a shoot control plane to another seed.
+type SeedRebalanceMigration struct {
+	// ShootNamespace is the namespace of the shoot.
+	ShootNamespace string `json:"shootNamespace" protobuf:"bytes,1,opt,name=shootNamespace"`
+	// ShootName is the name of the shoot.
+	ShootName string `json:"shootName" protobuf:"bytes,2,opt,name=shootName"`
+	// SourceSeedName is the name of the seed the shoot control plane is currently running on.
+	SourceSeedName string `json:"sourceSeedName" protobuf:"bytes,3,opt,name=sourceSeedName"`
+	// TargetSeedName is the name of the seed the shoot control plane should be migrated to.
+	TargetSeedName string `json:"targetSeedName" protobuf:"bytes,4,opt,name=targetSeedName"`
+}
+
+// SeedRebalanceProposalStatus holds the most recently observed status of the SeedRebalanceProposal.
+type SeedRebalanceProposalStatus struct {
+	// Phase is the phase of the SeedRebalanceProposal.
+	// +optional
+	Phase SeedRebalanceProposalPhase `json:"phase,omitempty" protobuf:"bytes,1,opt,name=phase,casttype=SeedRebalanceProposalPhase"`
+	// Migrations contains the progress of the individual migrations.
+	// +optional
+	Migrations []SeedRebalanceMigrationStatus `json:"migrations,omitempty" protobuf:"bytes,2,rep,name=migrations"`
+	// ObservedGeneration is the most recent generation observed for this SeedRebalanceProposal. It corresponds to the
+	// SeedRebalanceProposal's generation, which is updated on mutation by the API Server.
+	// +optional
+	ObservedGeneration *int64 `json:"observedGeneration,omitempty" protobuf:"varint,3,opt,name=observedGeneration"`
+}
+
+// SeedRebalanceMigrationStatus contains the progress of a proposed migration.
+type SeedRebalanceMigrationStatus struct {
+	// ShootNamespace is the namespace of the shoot.
+	ShootNamespace string `json:"shootNamespace" protobuf:"bytes,1,opt,name=shootNamespace"`
+	// ShootName is the name of the shoot.
+	ShootName string `json:"shootName" protobuf:"bytes,2,opt,name=shootName"`
+	// Phase is the phase of the migration.
+	Phase SeedRebalanceMigrationPhase `json:"phase" protobuf:"bytes,3,opt,name=phase,casttype=SeedRebalanceMigrationPhase"`
+	// Message is a human-readable message explaining the phase of the migration.
+	// +optional
+	Message string `json:"message,omitempty" protobuf:"bytes,4,opt,name=message"`
+	// LastTransitionTime is the last time the phase of the migration changed.
+	// +optional
+	LastTransitionTime *metav1.Time `json:"lastTransitionTime,omitempty" protobuf:"bytes,5,opt,name=lastTransitionTime"`
+}
+
+// SeedRebalanceProposalPhase is the phase of a SeedRebalanceProposal.
+type SeedRebalanceProposalPhase string
+
+const (
+	// SeedRebalanceProposalPending means that the proposal waits for the approval.
+	SeedRebalanceProposalPending SeedRebalanceProposalPhase = "Pending"
+	// SeedRebalanceProposalExecuting means that the migrations of the proposal are being executed.
+	SeedRebalanceProposalExecuting SeedRebalanceProposalPhase = "Executing"
+	// SeedRebalanceProposalCompleted means that all migrations of the proposal are finished.
+	SeedRebalanceProposalCompleted SeedRebalanceProposalPhase = "Completed"
+	// SeedRebalanceProposalExpired means that the proposal was neither approved nor started before its time to live
+	// elapsed. Expired proposals are not executed anymore.
+	SeedRebalanceProposalExpired SeedRebalanceProposalPhase = "Expired"
+)
+
+// SeedRebalanceMigrationPhase is the phase of a proposed migration.
+type SeedRebalanceMigrationPhase string
+
+const (
+	// SeedRebalanceMigrationPending means that the migration has not been started yet.
+	SeedRebalanceMigrationPending SeedRebalanceMigrationPhase = "Pending"
+	// SeedRebalanceMigrationMigrating means that the shoot was bound to the target seed and the control plane is being
+	// migrated.
+	SeedRebalanceMigrationMigrating SeedRebalanceMigrationPhase = "Migrating"
+	// SeedRebalanceMigrationSucceeded means that the control plane was successfully migrated to the target seed.
+	SeedRebalanceMigrationSucceeded SeedRebalanceMigrationPhase = "Succeeded"
+	// SeedRebalanceMigrationFailed means that the migration failed.
+	SeedRebalanceMigrationFailed SeedRebalanceMigrationPhase = "Failed"
+	// SeedRebalanceMigrationSkipped means that the migration was not executed because the shoot changed in the meantime,
+	// e.g., it was deleted or moved to another seed.
+	SeedRebalanceMigrationSkipped SeedRebalanceMigrationPhase = "Skipped"
+)
@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ShootRestore holds details about a restoration of a shoot's etcd from the snapshots in its BackupEntry.
+type ShootRestore struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object metadata.
+	metav1.ObjectMeta `json:"metadata" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Specification of the ShootRestore.
+	Spec ShootRestoreSpec `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+	// Most recently observed status of the ShootRestore.
+	// +optional
+	Status ShootRestoreStatus `json:"status" protobuf:"bytes,3,opt,name=status"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ShootRestoreList is a list of ShootRestore objects.
+type ShootRestoreList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard list object metadata.
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Items is the list of ShootRestores.
+	Items []ShootRestore `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// ShootRestoreSpec is the specification of a ShootRestore.
+type ShootRestoreSpec struct {
+	// ShootRef defines the shoot whose etcd shall be restored. This field is immutable.
+	ShootRef corev1.LocalObjectReference `json:"shootRef" protobuf:"bytes,1,opt,name=shootRef"`
+	// SeedName is the name of the seed the control plane of the shoot is running on. This field is populated when
+	// the ShootRestore is created.
+	// +optional
+	SeedName *string `json:"seedName,omitempty" protobuf:"bytes,2,opt,name=seedName"`
+	// TargetTime is the point in time whose state of the etcd shall be restored. Only the snapshots which were taken at
+	// or before this time are restored. This field is immutable.
+	TargetTime *metav1.Time `json:"targetTime,omitempty" protobuf:"bytes,3,opt,name=targetTime"`
+}
+
+// ShootRestoreStatus holds the most recently observed status of the ShootRestore.
+type ShootRestoreStatus struct {
+	// Phase is the phase of the ShootRestore.
+	// +optional
+	Phase ShootRestorePhase `json:"phase,omitempty" protobuf:"bytes,1,opt,name=phase,casttype=ShootRestorePhase"`
+	// Message is a human-readable message explaining the phase of the ShootRestore.
+	// +optional
+	Message string `json:"message,omitempty" protobuf:"bytes,2,opt,name=message"`
+	// StartTime is the time when the restoration was started.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty" protobuf:"bytes,3,opt,name=startTime"`
+	// CompletionTime is the time when the restoration succeeded or failed.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty" protobuf:"bytes,4,opt,name=completionTime"`
+	// ObservedGeneration is the most recent generation observed for this ShootRestore. It corresponds to the
+	// ShootRestore's generation, which is updated on mutation by the API Server.
+	// +optional
+	ObservedGeneration *int64 `json:"observedGeneration,omitempty" protobuf:"varint,5,opt,name=observedGeneration"`
+}
+
+// ShootRestorePhase is the phase of a ShootRestore.
+type ShootRestorePhase string
+
+const (
+	// ShootRestorePending means that the restoration has not been started yet, e.g., because the shoot is currently
+	// being reconciled.
+	ShootRestorePending ShootRestorePhase = "Pending"
+	// ShootRestoreRestoring means that the control plane of the shoot is scaled down and the etcd is being restored.
+	ShootRestoreRestoring ShootRestorePhase = "Restoring"
+	// ShootRestoreSucceeded means that the etcd was restored and the control plane is being brought back by a
+	// reconciliation of the shoot.
+	ShootRestoreSucceeded ShootRestorePhase = "Succeeded"
+	// ShootRestoreFailed means that the restoration failed.
+	ShootRestoreFailed ShootRestorePhase = "Failed"
+)
@@ -78,6 +78,106 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SeedRebalanceMigration)(nil), (*operations.SeedRebalanceMigration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SeedRebalanceMigration_To_operations_SeedRebalanceMigration(a.(*SeedRebalanceMigration), b.(*operations.SeedRebalanceMigration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*operations.SeedRebalanceMigration)(nil), (*SeedRebalanceMigration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_operations_SeedRebalanceMigration_To_v1alpha1_SeedRebalanceMigration(a.(*operations.SeedRebalanceMigration), b.(*SeedRebalanceMigration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SeedRebalanceMigrationStatus)(nil), (*operations.SeedRebalanceMigrationStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SeedRebalanceMigrationStatus_To_operations_SeedRebalanceMigrationStatus(a.(*SeedRebalanceMigrationStatus), b.(*operations.SeedRebalanceMigrationStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*operations.SeedRebalanceMigrationStatus)(nil), (*SeedRebalanceMigrationStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_operations_SeedRebalanceMigrationStatus_To_v1alpha1_SeedRebalanceMigrationStatus(a.(*operations.SeedRebalanceMigrationStatus), b.(*SeedRebalanceMigrationStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SeedRebalanceProposal)(nil), (*operations.SeedRebalanceProposal)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SeedRebalanceProposal_To_operations_SeedRebalanceProposal(a.(*SeedRebalanceProposal), b.(*operations.SeedRebalanceProposal), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*operations.SeedRebalanceProposal)(nil), (*SeedRebalanceProposal)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_operations_SeedRebalanceProposal_To_v1alpha1_SeedRebalanceProposal(a.(*operations.SeedRebalanceProposal), b.(*SeedRebalanceProposal), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SeedRebalanceProposalList)(nil), (*operations.SeedRebalanceProposalList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SeedRebalanceProposalList_To_operations_SeedRebalanceProposalList(a.(*SeedRebalanceProposalList), b.(*operations.SeedRebalanceProposalList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*operations.SeedRebalanceProposalList)(nil), (*SeedRebalanceProposalList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_operations_SeedRebalanceProposalList_To_v1alpha1_SeedRebalanceProposalList(a.(*operations.SeedRebalanceProposalList), b.(*SeedRebalanceProposalList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SeedRebalanceProposalSpec)(nil), (*operations.SeedRebalanceProposalSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SeedRebalanceProposalSpec_To_operations_SeedRebalanceProposalSpec(a.(*SeedRebalanceProposalSpec), b.(*operations.SeedRebalanceProposalSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*operations.SeedRebalanceProposalSpec)(nil), (*SeedRebalanceProposalSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_operations_SeedRebalanceProposalSpec_To_v1alpha1_SeedRebalanceProposalSpec(a.(*operations.SeedRebalanceProposalSpec), b.(*SeedRebalanceProposalSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SeedRebalanceProposalStatus)(nil), (*operations.SeedRebalanceProposalStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SeedRebalanceProposalStatus_To_operations_SeedRebalanceProposalStatus(a.(*SeedRebalanceProposalStatus), b.(*operations.SeedRebalanceProposalStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*operations.SeedRebalanceProposalStatus)(nil), (*SeedRebalanceProposalStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_operations_SeedRebalanceProposalStatus_To_v1alpha1_SeedRebalanceProposalStatus(a.(*operations.SeedRebalanceProposalStatus), b.(*SeedRebalanceProposalStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootRestore)(nil), (*operations.ShootRestore)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootRestore_To_operations_ShootRestore(a.(*ShootRestore), b.(*operations.ShootRestore), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*operations.ShootRestore)(nil), (*ShootRestore)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_operations_ShootRestore_To_v1alpha1_ShootRestore(a.(*operations.ShootRestore), b.(*ShootRestore), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootRestoreList)(nil), (*operations.ShootRestoreList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootRestoreList_To_operations_ShootRestoreList(a.(*ShootRestoreList), b.(*operations.ShootRestoreList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*operations.ShootRestoreList)(nil), (*ShootRestoreList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_operations_ShootRestoreList_To_v1alpha1_ShootRestoreList(a.(*operations.ShootRestoreList), b.(*ShootRestoreList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootRestoreSpec)(nil), (*operations.ShootRestoreSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootRestoreSpec_To_operations_ShootRestoreSpec(a.(*ShootRestoreSpec), b.(*operations.ShootRestoreSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*operations.ShootRestoreSpec)(nil), (*ShootRestoreSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_operations_ShootRestoreSpec_To_v1alpha1_ShootRestoreSpec(a.(*operations.ShootRestoreSpec), b.(*ShootRestoreSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootRestoreStatus)(nil), (*operations.ShootRestoreStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootRestoreStatus_To_operations_ShootRestoreStatus(a.(*ShootRestoreStatus), b.(*operations.ShootRestoreStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*operations.ShootRestoreStatus)(nil), (*ShootRestoreStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_operations_ShootRestoreStatus_To_v1alpha1_ShootRestoreStatus(a.(*operations.ShootRestoreStatus), b.(*ShootRestoreStatus), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -210,3 +310,263 @@ func autoConvert_operations_BastionStatus_To_v1alpha1_BastionStatus(in *operatio
 func Convert_operations_BastionStatus_To_v1alpha1_BastionStatus(in *operations.BastionStatus, out *BastionStatus, s conversion.Scope) error {
 	return autoConvert_operations_BastionStatus_To_v1alpha1_BastionStatus(in, out, s)
 }
+
+func autoConvert_v1alpha1_SeedRebalanceMigration_To_operations_SeedRebalanceMigration(in *SeedRebalanceMigration, out *operations.SeedRebalanceMigration, s conversion.Scope) error {
+	out.ShootNamespace = in.ShootNamespace
+	out.ShootName = in.ShootName
+	out.SourceSeedName = in.SourceSeedName
+	out.TargetSeedName = in.TargetSeedName
+	return nil
+}
+
+// Convert_v1alpha1_SeedRebalanceMigration_To_operations_SeedRebalanceMigration is an autogenerated conversion function.
+func Convert_v1alpha1_SeedRebalanceMigration_To_operations_SeedRebalanceMigration(in *SeedRebalanceMigration, out *operations.SeedRebalanceMigration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SeedRebalanceMigration_To_operations_SeedRebalanceMigration(in, out, s)
+}
+
+func autoConvert_operations_SeedRebalanceMigration_To_v1alpha1_SeedRebalanceMigration(in *operations.SeedRebalanceMigration, out *SeedRebalanceMigration, s conversion.Scope) error {
+	out.ShootNamespace = in.ShootNamespace
+	out.ShootName = in.ShootName
+	out.SourceSeedName = in.SourceSeedName
+	out.TargetSeedName = in.TargetSeedName
+	return nil
+}
+
+// Convert_operations_SeedRebalanceMigration_To_v1alpha1_SeedRebalanceMigration is an autogenerated conversion function.
+func Convert_operations_SeedRebalanceMigration_To_v1alpha1_SeedRebalanceMigration(in *operations.SeedRebalanceMigration, out *SeedRebalanceMigration, s conversion.Scope) error {
+	return autoConvert_operations_SeedRebalanceMigration_To_v1alpha1_SeedRebalanceMigration(in, out, s)
+}
+
+func autoConvert_v1alpha1_SeedRebalanceMigrationStatus_To_operations_SeedRebalanceMigrationStatus(in *SeedRebalanceMigrationStatus, out *operations.SeedRebalanceMigrationStatus, s conversion.Scope) error {
+	out.ShootNamespace = in.ShootNamespace
+	out.ShootName = in.ShootName
+	out.Phase = operations.SeedRebalanceMigrationPhase(in.Phase)
+	out.Message = in.Message
+	out.LastTransitionTime = (*metav1.Time)(unsafe.Pointer(in.LastTransitionTime))
+	return nil
+}
+
+// Convert_v1alpha1_SeedRebalanceMigrationStatus_To_operations_SeedRebalanceMigrationStatus is an autogenerated conversion function.
+func Convert_v1alpha1_SeedRebalanceMigrationStatus_To_operations_SeedRebalanceMigrationStatus(in *SeedRebalanceMigrationStatus, out *operations.SeedRebalanceMigrationStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SeedRebalanceMigrationStatus_To_operations_SeedRebalanceMigrationStatus(in, out, s)
+}
+
+func autoConvert_operations_SeedRebalanceMigrationStatus_To_v1alpha1_SeedRebalanceMigrationStatus(in *operations.SeedRebalanceMigrationStatus, out *SeedRebalanceMigrationStatus, s conversion.Scope) error {
+	out.ShootNamespace = in.ShootNamespace
+	out.ShootName = in.ShootName
+	out.Phase = SeedRebalanceMigrationPhase(in.Phase)
+	out.Message = in.Message
+	out.LastTransitionTime = (*metav1.Time)(unsafe.Pointer(in.LastTransitionTime))
+	return nil
+}
+
+// Convert_operations_SeedRebalanceMigrationStatus_To_v1alpha1_SeedRebalanceMigrationStatus is an autogenerated conversion function.
+func Convert_operations_SeedRebalanceMigrationStatus_To_v1alpha1_SeedRebalanceMigrationStatus(in *operations.SeedRebalanceMigrationStatus, out *SeedRebalanceMigrationStatus, s conversion.Scope) error {
+	return autoConvert_operations_SeedRebalanceMigrationStatus_To_v1alpha1_SeedRebalanceMigrationStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_SeedRebalanceProposal_To_operations_SeedRebalanceProposal(in *SeedRebalanceProposal, out *operations.SeedRebalanceProposal, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_SeedRebalanceProposalSpec_To_operations_SeedRebalanceProposalSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_SeedRebalanceProposalStatus_To_operations_SeedRebalanceProposalStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_SeedRebalanceProposal_To_operations_SeedRebalanceProposal is an autogenerated conversion function.
+func Convert_v1alpha1_SeedRebalanceProposal_To_operations_SeedRebalanceProposal(in *SeedRebalanceProposal, out *operations.SeedRebalanceProposal, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SeedRebalanceProposal_To_operations_SeedRebalanceProposal(in, out, s)
+}
+
+func autoConvert_operations_SeedRebalanceProposal_To_v1alpha1_SeedRebalanceProposal(in *operations.SeedRebalanceProposal, out *SeedRebalanceProposal, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_operations_SeedRebalanceProposalSpec_To_v1alpha1_SeedRebalanceProposalSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_operations_SeedRebalanceProposalStatus_To_v1alpha1_SeedRebalanceProposalStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_operations_SeedRebalanceProposal_To_v1alpha1_SeedRebalanceProposal is an autogenerated conversion function.
+func Convert_operations_SeedRebalanceProposal_To_v1alpha1_SeedRebalanceProposal(in *operations.SeedRebalanceProposal, out *SeedRebalanceProposal, s conversion.Scope) error {
+	return autoConvert_operations_SeedRebalanceProposal_To_v1alpha1_SeedRebalanceProposal(in, out, s)
+}
+
+func autoConvert_v1alpha1_SeedRebalanceProposalList_To_operations_SeedRebalanceProposalList(in *SeedRebalanceProposalList, out *operations.SeedRebalanceProposalList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]operations.SeedRebalanceProposal)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_SeedRebalanceProposalList_To_operations_SeedRebalanceProposalList is an autogenerated conversion function.
+func Convert_v1alpha1_SeedRebalanceProposalList_To_operations_SeedRebalanceProposalList(in *SeedRebalanceProposalList, out *operations.SeedRebalanceProposalList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SeedRebalanceProposalList_To_operations_SeedRebalanceProposalList(in, out, s)
+}
+
+func autoConvert_operations_SeedRebalanceProposalList_To_v1alpha1_SeedRebalanceProposalList(in *operations.SeedRebalanceProposalList, out *SeedRebalanceProposalList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]SeedRebalanceProposal)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_operations_SeedRebalanceProposalList_To_v1alpha1_SeedRebalanceProposalList is an autogenerated conversion function.
+func Convert_operations_SeedRebalanceProposalList_To_v1alpha1_SeedRebalanceProposalList(in *operations.SeedRebalanceProposalList, out *SeedRebalanceProposalList, s conversion.Scope) error {
+	return autoConvert_operations_SeedRebalanceProposalList_To_v1alpha1_SeedRebalanceProposalList(in, out, s)
+}
+
+func autoConvert_v1alpha1_SeedRebalanceProposalSpec_To_operations_SeedRebalanceProposalSpec(in *SeedRebalanceProposalSpec, out *operations.SeedRebalanceProposalSpec, s conversion.Scope) error {
+	out.Migrations = *(*[]operations.SeedRebalanceMigration)(unsafe.Pointer(&in.Migrations))
+	out.Approved = in.Approved
+	return nil
+}
+
+// Convert_v1alpha1_SeedRebalanceProposalSpec_To_operations_SeedRebalanceProposalSpec is an autogenerated conversion function.
+func Convert_v1alpha1_SeedRebalanceProposalSpec_To_operations_SeedRebalanceProposalSpec(in *SeedRebalanceProposalSpec, out *operations.SeedRebalanceProposalSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SeedRebalanceProposalSpec_To_operations_SeedRebalanceProposalSpec(in, out, s)
+}
+
+func autoConvert_operations_SeedRebalanceProposalSpec_To_v1alpha1_SeedRebalanceProposalSpec(in *operations.SeedRebalanceProposalSpec, out *SeedRebalanceProposalSpec, s conversion.Scope) error {
+	out.Migrations = *(*[]SeedRebalanceMigration)(unsafe.Pointer(&in.Migrations))
+	out.Approved = in.Approved
+	return nil
+}
+
+// Convert_operations_SeedRebalanceProposalSpec_To_v1alpha1_SeedRebalanceProposalSpec is an autogenerated conversion function.
+func Convert_operations_SeedRebalanceProposalSpec_To_v1alpha1_SeedRebalanceProposalSpec(in *operations.SeedRebalanceProposalSpec, out *SeedRebalanceProposalSpec, s conversion.Scope) error {
+	return autoConvert_operations_SeedRebalanceProposalSpec_To_v1alpha1_SeedRebalanceProposalSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_SeedRebalanceProposalStatus_To_operations_SeedRebalanceProposalStatus(in *SeedRebalanceProposalStatus, out *operations.SeedRebalanceProposalStatus, s conversion.Scope) error {
+	out.Phase = operations.SeedRebalanceProposalPhase(in.Phase)
+	out.Migrations = *(*[]operations.SeedRebalanceMigrationStatus)(unsafe.Pointer(&in.Migrations))
+	out.ObservedGeneration = (*int64)(unsafe.Pointer(in.ObservedGeneration))
+	return nil
+}
+
+// Convert_v1alpha1_SeedRebalanceProposalStatus_To_operations_SeedRebalanceProposalStatus is an autogenerated conversion function.
+func Convert_v1alpha1_SeedRebalanceProposalStatus_To_operations_SeedRebalanceProposalStatus(in *SeedRebalanceProposalStatus, out *operations.SeedRebalanceProposalStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SeedRebalanceProposalStatus_To_operations_SeedRebalanceProposalStatus(in, out, s)
+}
+
+func autoConvert_operations_SeedRebalanceProposalStatus_To_v1alpha1_SeedRebalanceProposalStatus(in *operations.SeedRebalanceProposalStatus, out *SeedRebalanceProposalStatus, s conversion.Scope) error {
+	out.Phase = SeedRebalanceProposalPhase(in.Phase)
+	out.Migrations = *(*[]SeedRebalanceMigrationStatus)(unsafe.Pointer(&in.Migrations))
+	out.ObservedGeneration = (*int64)(unsafe.Pointer(in.ObservedGeneration))
+	return nil
+}
+
+// Convert_operations_SeedRebalanceProposalStatus_To_v1alpha1_SeedRebalanceProposalStatus is an autogenerated conversion function.
+func Convert_operations_SeedRebalanceProposalStatus_To_v1alpha1_SeedRebalanceProposalStatus(in *operations.SeedRebalanceProposalStatus, out *SeedRebalanceProposalStatus, s conversion.Scope) error {
+	return autoConvert_operations_SeedRebalanceProposalStatus_To_v1alpha1_SeedRebalanceProposalStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_ShootRestore_To_operations_ShootRestore(in *ShootRestore, out *operations.ShootRestore, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_ShootRestoreSpec_To_operations_ShootRestoreSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_ShootRestoreStatus_To_operations_ShootRestoreStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_ShootRestore_To_operations_ShootRestore is an autogenerated conversion function.
+func Convert_v1alpha1_ShootRestore_To_operations_ShootRestore(in *ShootRestore, out *operations.ShootRestore, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootRestore_To_operations_ShootRestore(in, out, s)
+}
+
+func autoConvert_operations_ShootRestore_To_v1alpha1_ShootRestore(in *operations.ShootRestore, out *ShootRestore, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_operations_ShootRestoreSpec_To_v1alpha1_ShootRestoreSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_operations_ShootRestoreStatus_To_v1alpha1_ShootRestoreStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_operations_ShootRestore_To_v1alpha1_ShootRestore is an autogenerated conversion function.
+func Convert_operations_ShootRestore_To_v1alpha1_ShootRestore(in *operations.ShootRestore, out *ShootRestore, s conversion.Scope) error {
+	return autoConvert_operations_ShootRestore_To_v1alpha1_ShootRestore(in, out, s)
+}
+
+func autoConvert_v1alpha1_ShootRestoreList_To_operations_ShootRestoreList(in *ShootRestoreList, out *operations.ShootRestoreList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]operations.ShootRestore)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_ShootRestoreList_To_operations_ShootRestoreList is an autogenerated conversion function.
+func Convert_v1alpha1_ShootRestoreList_To_operations_ShootRestoreList(in *ShootRestoreList, out *operations.ShootRestoreList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootRestoreList_To_operations_ShootRestoreList(in, out, s)
+}
+
+func autoConvert_operations_ShootRestoreList_To_v1alpha1_ShootRestoreList(in *operations.ShootRestoreList, out *ShootRestoreList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]ShootRestore)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_operations_ShootRestoreList_To_v1alpha1_ShootRestoreList is an autogenerated conversion function.
+func Convert_operations_ShootRestoreList_To_v1alpha1_ShootRestoreList(in *operations.ShootRestoreList, out *ShootRestoreList, s conversion.Scope) error {
+	return autoConvert_operations_ShootRestoreList_To_v1alpha1_ShootRestoreList(in, out, s)
+}
+
+func autoConvert_v1alpha1_ShootRestoreSpec_To_operations_ShootRestoreSpec(in *ShootRestoreSpec, out *operations.ShootRestoreSpec, s conversion.Scope) error {
+	out.ShootRef = in.ShootRef
+	out.SeedName = (*string)(unsafe.Pointer(in.SeedName))
+	out.TargetTime = (*metav1.Time)(unsafe.Pointer(in.TargetTime))
+	return nil
+}
+
+// Convert_v1alpha1_ShootRestoreSpec_To_operations_ShootRestoreSpec is an autogenerated conversion function.
+func Convert_v1alpha1_ShootRestoreSpec_To_operations_ShootRestoreSpec(in *ShootRestoreSpec, out *operations.ShootRestoreSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootRestoreSpec_To_operations_ShootRestoreSpec(in, out, s)
+}
+
+func autoConvert_operations_ShootRestoreSpec_To_v1alpha1_ShootRestoreSpec(in *operations.ShootRestoreSpec, out *ShootRestoreSpec, s conversion.Scope) error {
+	out.ShootRef = in.ShootRef
+	out.SeedName = (*string)(unsafe.Pointer(in.SeedName))
+	out.TargetTime = (*metav1.Time)(unsafe.Pointer(in.TargetTime))
+	return nil
+}
+
+// Convert_operations_ShootRestoreSpec_To_v1alpha1_ShootRestoreSpec is an autogenerated conversion function.
+func Convert_operations_ShootRestoreSpec_To_v1alpha1_ShootRestoreSpec(in *operations.ShootRestoreSpec, out *ShootRestoreSpec, s conversion.Scope) error {
+	return autoConvert_operations_ShootRestoreSpec_To_v1alpha1_ShootRestoreSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_ShootRestoreStatus_To_operations_ShootRestoreStatus(in *ShootRestoreStatus, out *operations.ShootRestoreStatus, s conversion.Scope) error {
+	out.Phase = operations.ShootRestorePhase(in.Phase)
+	out.Message = in.Message
+	out.StartTime = (*metav1.Time)(unsafe.Pointer(in.StartTime))
+	out.CompletionTime = (*metav1.Time)(unsafe.Pointer(in.CompletionTime))
+	out.ObservedGeneration = (*int64)(unsafe.Pointer(in.ObservedGeneration))
+	return nil
+}
+
+// Convert_v1alpha1_ShootRestoreStatus_To_operations_ShootRestoreStatus is an autogenerated conversion function.
+func Convert_v1alpha1_ShootRestoreStatus_To_operations_ShootRestoreStatus(in *ShootRestoreStatus, out *operations.ShootRestoreStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootRestoreStatus_To_operations_ShootRestoreStatus(in, out, s)
+}
+
+func autoConvert_operations_ShootRestoreStatus_To_v1alpha1_ShootRestoreStatus(in *operations.ShootRestoreStatus, out *ShootRestoreStatus, s conversion.Scope) error {
+	out.Phase = ShootRestorePhase(in.Phase)
+	out.Message = in.Message
+	out.StartTime = (*metav1.Time)(unsafe.Pointer(in.StartTime))
+	out.CompletionTime = (*metav1.Time)(unsafe.Pointer(in.CompletionTime))
+	out.ObservedGeneration = (*int64)(unsafe.Pointer(in.ObservedGeneration))
+	return nil
+}
+
+// Convert_operations_ShootRestoreStatus_To_v1alpha1_ShootRestoreStatus is an autogenerated conversion function.
+func Convert_operations_ShootRestoreStatus_To_v1alpha1_ShootRestoreStatus(in *operations.ShootRestoreStatus, out *ShootRestoreStatus, s conversion.Scope) error {
+	return autoConvert_operations_ShootRestoreStatus_To_v1alpha1_ShootRestoreStatus(in, out, s)
+}
@@ -33,3 +33,53 @@ func (in BastionSpec) OpenAPIModelName() string {
 func (in BastionStatus) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.operations.v1alpha1.BastionStatus"
 }
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in SeedRebalanceMigration) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.operations.v1alpha1.SeedRebalanceMigration"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in SeedRebalanceMigrationStatus) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.operations.v1alpha1.SeedRebalanceMigrationStatus"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in SeedRebalanceProposal) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.operations.v1alpha1.SeedRebalanceProposal"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in SeedRebalanceProposalList) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.operations.v1alpha1.SeedRebalanceProposalList"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in SeedRebalanceProposalSpec) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.operations.v1alpha1.SeedRebalanceProposalSpec"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in SeedRebalanceProposalStatus) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.operations.v1alpha1.SeedRebalanceProposalStatus"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in ShootRestore) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.operations.v1alpha1.ShootRestore"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in ShootRestoreList) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.operations.v1alpha1.ShootRestoreList"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in ShootRestoreSpec) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.operations.v1alpha1.ShootRestoreSpec"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in ShootRestoreStatus) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.operations.v1alpha1.ShootRestoreStatus"
+}
@@ -167,3 +167,265 @@ func (in *BastionStatus) DeepCopy() *BastionStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedRebalanceMigration) DeepCopyInto(out *SeedRebalanceMigration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedRebalanceMigration.
+func (in *SeedRebalanceMigration) DeepCopy() *SeedRebalanceMigration {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedRebalanceMigration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedRebalanceMigrationStatus) DeepCopyInto(out *SeedRebalanceMigrationStatus) {
+	*out = *in
+	if in.LastTransitionTime != nil {
+		in, out := &in.LastTransitionTime, &out.LastTransitionTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedRebalanceMigrationStatus.
+func (in *SeedRebalanceMigrationStatus) DeepCopy() *SeedRebalanceMigrationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedRebalanceMigrationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedRebalanceProposal) DeepCopyInto(out *SeedRebalanceProposal) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedRebalanceProposal.
+func (in *SeedRebalanceProposal) DeepCopy() *SeedRebalanceProposal {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedRebalanceProposal)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SeedRebalanceProposal) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedRebalanceProposalList) DeepCopyInto(out *SeedRebalanceProposalList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SeedRebalanceProposal, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedRebalanceProposalList.
+func (in *SeedRebalanceProposalList) DeepCopy() *SeedRebalanceProposalList {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedRebalanceProposalList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SeedRebalanceProposalList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedRebalanceProposalSpec) DeepCopyInto(out *SeedRebalanceProposalSpec) {
+	*out = *in
+	if in.Migrations != nil {
+		in, out := &in.Migrations, &out.Migrations
+		*out = make([]SeedRebalanceMigration, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedRebalanceProposalSpec.
+func (in *SeedRebalanceProposalSpec) DeepCopy() *SeedRebalanceProposalSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedRebalanceProposalSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedRebalanceProposalStatus) DeepCopyInto(out *SeedRebalanceProposalStatus) {
+	*out = *in
+	if in.Migrations != nil {
+		in, out := &in.Migrations, &out.Migrations
+		*out = make([]SeedRebalanceMigrationStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ObservedGeneration != nil {
+		in, out := &in.ObservedGeneration, &out.ObservedGeneration
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedRebalanceProposalStatus.
+func (in *SeedRebalanceProposalStatus) DeepCopy() *SeedRebalanceProposalStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedRebalanceProposalStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootRestore) DeepCopyInto(out *ShootRestore) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootRestore.
+func (in *ShootRestore) DeepCopy() *ShootRestore {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootRestore)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ShootRestore) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootRestoreList) DeepCopyInto(out *ShootRestoreList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ShootRestore, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootRestoreList.
+func (in *ShootRestoreList) DeepCopy() *ShootRestoreList {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootRestoreList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ShootRestoreList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootRestoreSpec) DeepCopyInto(out *ShootRestoreSpec) {
+	*out = *in
+	out.ShootRef = in.ShootRef
+	if in.SeedName != nil {
+		in, out := &in.SeedName, &out.SeedName
+		*out = new(string)
+		**out = **in
+	}
+	if in.TargetTime != nil {
+		in, out := &in.TargetTime, &out.TargetTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootRestoreSpec.
+func (in *ShootRestoreSpec) DeepCopy() *ShootRestoreSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootRestoreSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootRestoreStatus) DeepCopyInto(out *ShootRestoreStatus) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.ObservedGeneration != nil {
+		in, out := &in.ObservedGeneration, &out.ObservedGeneration
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootRestoreStatus.
+func (in *ShootRestoreStatus) DeepCopy() *ShootRestoreStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootRestoreStatus)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -46,9 +46,11 @@ type ManagedSeedSetSpec struct {
 	Selector metav1.LabelSelector
 	// Template describes the ManagedSeed that will be created if insufficient replicas are detected.
 	// Each ManagedSeed created / updated by the ManagedSeedSet will fulfill this template.
+	// Changes of the template are rolled out to the existing ManagedSeeds according to the UpdateStrategy.
 	Template ManagedSeedTemplate
 	// ShootTemplate describes the Shoot that will be created if insufficient replicas are detected for hosting the corresponding ManagedSeed.
 	// Each Shoot created / updated by the ManagedSeedSet will fulfill this template.
+	// Changes of the shoot template are only applied to the Shoots of new replicas.
 	ShootTemplate gardencore.ShootTemplate
 	// UpdateStrategy specifies the UpdateStrategy that will be
 	// employed to update ManagedSeeds / Shoots in the ManagedSeedSet when a revision is made to
@@ -84,6 +86,18 @@ const (
 type RollingUpdateStrategy struct {
 	// Partition indicates the ordinal at which the ManagedSeedSet should be partitioned. Defaults to 0.
 	Partition *int32
+	// MaxUnavailable is the maximum number of replicas that are updated at the same time. Defaults to 1.
+	MaxUnavailable *int32
+	// HealthGate, if set, makes the controller wait for the seeds of already updated replicas to stay healthy for a
+	// soak period before it continues with the next replicas.
+	HealthGate *RollingUpdateHealthGate
+}
+
+// RollingUpdateHealthGate configures the health gating between the replicas of a rolling update.
+type RollingUpdateHealthGate struct {
+	// SoakDuration is the duration for which the seed of an updated replica must be ready before the next replicas
+	// are updated. Defaults to 10m.
+	SoakDuration *metav1.Duration
 }
 
 // ManagedSeedSetStatus represents the current state of a ManagedSeedSet.
@@ -140,6 +154,9 @@ const (
 	SeedNotReadyReason PendingReplicaReason = "SeedNotReady"
 	// ShootNotHealthyReason indicates that the replica's shoot is not healthy.
 	ShootNotHealthyReason PendingReplicaReason = "ShootNotHealthy"
+	// SeedSoakingReason indicates that the replica's seed has been updated and is soaking before the rolling update
+	// continues with the next replicas.
+	SeedSoakingReason PendingReplicaReason = "SeedSoaking"
 )
 
 // PendingReplica contains information about a replica that is currently pending creation, update, or deletion.
@@ -8,4 +8,7 @@ const (
 	// AnnotationProtectFromDeletion is a constant for an annotation on a replica of a ManagedSeedSet
 	// (either ManagedSeed or Shoot) to protect it from deletion..
 	AnnotationProtectFromDeletion = "seedmanagement.gardener.cloud/protect-from-deletion"
+	// AnnotationRevision is a constant for an annotation on a ManagedSeed replica of a ManagedSeedSet that contains the
+	// revision of the ManagedSeedSet template it was last created or updated from.
+	AnnotationRevision = "seedmanagement.gardener.cloud/revision"
 )
@@ -4,6 +4,12 @@
 
 package v1alpha1
 
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
 // SetDefaults_ManagedSeedSet sets default values for ManagedSeed objects.
 func SetDefaults_ManagedSeedSet(obj *ManagedSeedSet) {
 	// Set default replicas
@@ -37,4 +43,17 @@ func SetDefaults_RollingUpdateStrategy(obj *RollingUpdateStrategy) {
 	if obj.Partition == nil {
 		obj.Partition = new(int32(0))
 	}
+
+	// Set default max unavailable
+	if obj.MaxUnavailable == nil {
+		obj.MaxUnavailable = new(int32(1))
+	}
+}
+
+// SetDefaults_RollingUpdateHealthGate sets default values for RollingUpdateHealthGate objects.
+func SetDefaults_RollingUpdateHealthGate(obj *RollingUpdateHealthGate) {
+	// Set default soak duration
+	if obj.SoakDuration == nil {
+		obj.SoakDuration = &metav1.Duration{Duration: 10 * time.Minute}
+	}
 }
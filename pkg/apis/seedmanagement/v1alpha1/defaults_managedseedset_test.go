@@ -5,8 +5,11 @@
 package v1alpha1_test
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	. "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1"
 )
@@ -63,27 +66,60 @@ var _ = Describe("Defaults", func() {
 	})
 
 	Describe("RollingUpdateStrategy defaulting", func() {
-		It("should default partition to 0", func() {
+		It("should default partition to 0 and maxUnavailable to 1", func() {
 			obj.Spec.UpdateStrategy = &UpdateStrategy{
 				RollingUpdate: &RollingUpdateStrategy{},
 			}
 			SetObjectDefaults_ManagedSeedSet(obj)
 
 			Expect(obj.Spec.UpdateStrategy.RollingUpdate).To(Equal(&RollingUpdateStrategy{
-				Partition: new(int32(0)),
+				Partition:      new(int32(0)),
+				MaxUnavailable: new(int32(1)),
 			}))
 		})
 
 		It("should not overwrote the already set values for RollingUpdateStrategy", func() {
 			obj.Spec.UpdateStrategy = &UpdateStrategy{
 				RollingUpdate: &RollingUpdateStrategy{
-					Partition: new(int32(1)),
+					Partition:      new(int32(1)),
+					MaxUnavailable: new(int32(2)),
 				},
 			}
 			SetObjectDefaults_ManagedSeedSet(obj)
 
 			Expect(obj.Spec.UpdateStrategy.RollingUpdate).To(Equal(&RollingUpdateStrategy{
-				Partition: new(int32(1)),
+				Partition:      new(int32(1)),
+				MaxUnavailable: new(int32(2)),
+			}))
+		})
+	})
+
+	Describe("RollingUpdateHealthGate defaulting", func() {
+		It("should default soakDuration to 10m", func() {
+			obj.Spec.UpdateStrategy = &UpdateStrategy{
+				RollingUpdate: &RollingUpdateStrategy{
+					HealthGate: &RollingUpdateHealthGate{},
+				},
+			}
+			SetObjectDefaults_ManagedSeedSet(obj)
+
+			Expect(obj.Spec.UpdateStrategy.RollingUpdate.HealthGate).To(Equal(&RollingUpdateHealthGate{
+				SoakDuration: &metav1.Duration{Duration: 10 * time.Minute},
+			}))
+		})
+
+		It("should not overwrite the already set values for RollingUpdateHealthGate", func() {
+			obj.Spec.UpdateStrategy = &UpdateStrategy{
+				RollingUpdate: &RollingUpdateStrategy{
+					HealthGate: &RollingUpdateHealthGate{
+						SoakDuration: &metav1.Duration{Duration: time.Hour},
+					},
+				},
+			}
+			SetObjectDefaults_ManagedSeedSet(obj)
+
+			Expect(obj.Spec.UpdateStrategy.RollingUpdate.HealthGate).To(Equal(&RollingUpdateHealthGate{
+				SoakDuration: &metav1.Duration{Duration: time.Hour},
 			}))
 		})
 	})
@@ -17,6 +17,7 @@ import (
 
 	k8s_io_api_core_v1 "k8s.io/api/core/v1"
 	v11 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	math_bits "math/bits"
 	reflect "reflect"
@@ -61,6 +62,8 @@ func (m *ManagedSeedTemplate) Reset() { *m = ManagedSeedTemplate{} }
 
 func (m *PendingReplica) Reset() { *m = PendingReplica{} }
 
+func (m *RollingUpdateHealthGate) Reset() { *m = RollingUpdateHealthGate{} }
+
 func (m *RollingUpdateStrategy) Reset() { *m = RollingUpdateStrategy{} }
 
 func (m *Shoot) Reset() { *m = Shoot{} }
@@ -1166,6 +1169,41 @@ func (m *PendingReplica) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RollingUpdateHealthGate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RollingUpdateHealthGate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RollingUpdateHealthGate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SoakDuration != nil {
+		{
+			size, err := m.SoakDuration.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *RollingUpdateStrategy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1186,6 +1224,23 @@ func (m *RollingUpdateStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.HealthGate != nil {
+		{
+			size, err := m.HealthGate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MaxUnavailable != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.MaxUnavailable))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.Partition != nil {
 		i = encodeVarintGenerated(dAtA, i, uint64(*m.Partition))
 		i--
@@ -1669,6 +1724,19 @@ func (m *PendingReplica) Size() (n int) {
 	return n
 }
 
+func (m *RollingUpdateHealthGate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SoakDuration != nil {
+		l = m.SoakDuration.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *RollingUpdateStrategy) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1678,6 +1746,13 @@ func (m *RollingUpdateStrategy) Size() (n int) {
 	if m.Partition != nil {
 		n += 1 + sovGenerated(uint64(*m.Partition))
 	}
+	if m.MaxUnavailable != nil {
+		n += 1 + sovGenerated(uint64(*m.MaxUnavailable))
+	}
+	if m.HealthGate != nil {
+		l = m.HealthGate.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -2027,12 +2102,24 @@ func (this *PendingReplica) String() string {
 	}, "")
 	return s
 }
+func (this *RollingUpdateHealthGate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RollingUpdateHealthGate{`,
+		`SoakDuration:` + strings.Replace(fmt.Sprintf("%v", this.SoakDuration), "Duration", "v1.Duration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *RollingUpdateStrategy) String() string {
 	if this == nil {
 		return "nil"
 	}
 	s := strings.Join([]string{`&RollingUpdateStrategy{`,
 		`Partition:` + valueToStringGenerated(this.Partition) + `,`,
+		`MaxUnavailable:` + valueToStringGenerated(this.MaxUnavailable) + `,`,
+		`HealthGate:` + strings.Replace(this.HealthGate.String(), "RollingUpdateHealthGate", "RollingUpdateHealthGate", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5372,6 +5459,92 @@ func (m *PendingReplica) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RollingUpdateHealthGate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RollingUpdateHealthGate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RollingUpdateHealthGate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SoakDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SoakDuration == nil {
+				m.SoakDuration = &v1.Duration{}
+			}
+			if err := m.SoakDuration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *RollingUpdateStrategy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5421,6 +5594,62 @@ func (m *RollingUpdateStrategy) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Partition = &v
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUnavailable", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MaxUnavailable = &v
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthGate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HealthGate == nil {
+				m.HealthGate = &RollingUpdateHealthGate{}
+			}
+			if err := m.HealthGate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
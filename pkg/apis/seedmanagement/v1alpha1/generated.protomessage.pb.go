@@ -47,6 +47,8 @@ func (*ManagedSeedTemplate) ProtoMessage() {}
 
 func (*PendingReplica) ProtoMessage() {}
 
+func (*RollingUpdateHealthGate) ProtoMessage() {}
+
 func (*RollingUpdateStrategy) ProtoMessage() {}
 
 func (*Shoot) ProtoMessage() {}
@@ -51,9 +51,11 @@ type ManagedSeedSetSpec struct {
 	Selector metav1.LabelSelector `json:"selector" protobuf:"bytes,2,opt,name=selector"`
 	// Template describes the ManagedSeed that will be created if insufficient replicas are detected.
 	// Each ManagedSeed created / updated by the ManagedSeedSet will fulfill this template.
+	// Changes of the template are rolled out to the existing ManagedSeeds according to the UpdateStrategy.
 	Template ManagedSeedTemplate `json:"template" protobuf:"bytes,3,opt,name=template"`
 	// ShootTemplate describes the Shoot that will be created if insufficient replicas are detected for hosting the corresponding ManagedSeed.
 	// Each Shoot created / updated by the ManagedSeedSet will fulfill this template.
+	// Changes of the shoot template are only applied to the Shoots of new replicas.
 	ShootTemplate gardencorev1beta1.ShootTemplate `json:"shootTemplate" protobuf:"bytes,4,rep,name=shootTemplate"`
 	// UpdateStrategy specifies the UpdateStrategy that will be
 	// employed to update ManagedSeeds / Shoots in the ManagedSeedSet when a revision is made to
@@ -94,6 +96,21 @@ type RollingUpdateStrategy struct {
 	// Partition indicates the ordinal at which the ManagedSeedSet should be partitioned. Defaults to 0.
 	// +optional
 	Partition *int32 `json:"partition,omitempty" protobuf:"varint,1,opt,name=partition"`
+	// MaxUnavailable is the maximum number of replicas that are updated at the same time. Defaults to 1.
+	// +optional
+	MaxUnavailable *int32 `json:"maxUnavailable,omitempty" protobuf:"varint,2,opt,name=maxUnavailable"`
+	// HealthGate, if set, makes the controller wait for the seeds of already updated replicas to stay healthy for a
+	// soak period before it continues with the next replicas.
+	// +optional
+	HealthGate *RollingUpdateHealthGate `json:"healthGate,omitempty" protobuf:"bytes,3,opt,name=healthGate"`
+}
+
+// RollingUpdateHealthGate configures the health gating between the replicas of a rolling update.
+type RollingUpdateHealthGate struct {
+	// SoakDuration is the duration for which the seed of an updated replica must be ready before the next replicas
+	// are updated. Defaults to 10m.
+	// +optional
+	SoakDuration *metav1.Duration `json:"soakDuration,omitempty" protobuf:"bytes,1,opt,name=soakDuration"`
 }
 
 // ManagedSeedSetStatus represents the current state of a ManagedSeedSet.
@@ -155,6 +172,9 @@ const (
 	SeedNotReadyReason PendingReplicaReason = "SeedNotReady"
 	// ShootNotHealthyReason indicates that the replica's shoot is not healthy.
 	ShootNotHealthyReason PendingReplicaReason = "ShootNotHealthy"
+	// SeedSoakingReason indicates that the replica's seed has been updated and is soaking before the rolling update
+	// continues with the next replicas.
+	SeedSoakingReason PendingReplicaReason = "SeedSoaking"
 )
 
 // PendingReplica contains information about a replica that is currently pending creation, update, or deletion.
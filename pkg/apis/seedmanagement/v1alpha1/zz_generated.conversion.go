@@ -17,6 +17,7 @@ import (
 	v1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	seedmanagement "github.com/gardener/gardener/pkg/apis/seedmanagement"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -198,6 +199,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*RollingUpdateHealthGate)(nil), (*seedmanagement.RollingUpdateHealthGate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RollingUpdateHealthGate_To_seedmanagement_RollingUpdateHealthGate(a.(*RollingUpdateHealthGate), b.(*seedmanagement.RollingUpdateHealthGate), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*seedmanagement.RollingUpdateHealthGate)(nil), (*RollingUpdateHealthGate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_seedmanagement_RollingUpdateHealthGate_To_v1alpha1_RollingUpdateHealthGate(a.(*seedmanagement.RollingUpdateHealthGate), b.(*RollingUpdateHealthGate), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*RollingUpdateStrategy)(nil), (*seedmanagement.RollingUpdateStrategy)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_RollingUpdateStrategy_To_seedmanagement_RollingUpdateStrategy(a.(*RollingUpdateStrategy), b.(*seedmanagement.RollingUpdateStrategy), scope)
 	}); err != nil {
@@ -869,8 +880,30 @@ func Convert_seedmanagement_PendingReplica_To_v1alpha1_PendingReplica(in *seedma
 	return autoConvert_seedmanagement_PendingReplica_To_v1alpha1_PendingReplica(in, out, s)
 }
 
+func autoConvert_v1alpha1_RollingUpdateHealthGate_To_seedmanagement_RollingUpdateHealthGate(in *RollingUpdateHealthGate, out *seedmanagement.RollingUpdateHealthGate, s conversion.Scope) error {
+	out.SoakDuration = (*metav1.Duration)(unsafe.Pointer(in.SoakDuration))
+	return nil
+}
+
+// Convert_v1alpha1_RollingUpdateHealthGate_To_seedmanagement_RollingUpdateHealthGate is an autogenerated conversion function.
+func Convert_v1alpha1_RollingUpdateHealthGate_To_seedmanagement_RollingUpdateHealthGate(in *RollingUpdateHealthGate, out *seedmanagement.RollingUpdateHealthGate, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RollingUpdateHealthGate_To_seedmanagement_RollingUpdateHealthGate(in, out, s)
+}
+
+func autoConvert_seedmanagement_RollingUpdateHealthGate_To_v1alpha1_RollingUpdateHealthGate(in *seedmanagement.RollingUpdateHealthGate, out *RollingUpdateHealthGate, s conversion.Scope) error {
+	out.SoakDuration = (*metav1.Duration)(unsafe.Pointer(in.SoakDuration))
+	return nil
+}
+
+// Convert_seedmanagement_RollingUpdateHealthGate_To_v1alpha1_RollingUpdateHealthGate is an autogenerated conversion function.
+func Convert_seedmanagement_RollingUpdateHealthGate_To_v1alpha1_RollingUpdateHealthGate(in *seedmanagement.RollingUpdateHealthGate, out *RollingUpdateHealthGate, s conversion.Scope) error {
+	return autoConvert_seedmanagement_RollingUpdateHealthGate_To_v1alpha1_RollingUpdateHealthGate(in, out, s)
+}
+
 func autoConvert_v1alpha1_RollingUpdateStrategy_To_seedmanagement_RollingUpdateStrategy(in *RollingUpdateStrategy, out *seedmanagement.RollingUpdateStrategy, s conversion.Scope) error {
 	out.Partition = (*int32)(unsafe.Pointer(in.Partition))
+	out.MaxUnavailable = (*int32)(unsafe.Pointer(in.MaxUnavailable))
+	out.HealthGate = (*seedmanagement.RollingUpdateHealthGate)(unsafe.Pointer(in.HealthGate))
 	return nil
 }
 
@@ -881,6 +914,8 @@ func Convert_v1alpha1_RollingUpdateStrategy_To_seedmanagement_RollingUpdateStrat
 
 func autoConvert_seedmanagement_RollingUpdateStrategy_To_v1alpha1_RollingUpdateStrategy(in *seedmanagement.RollingUpdateStrategy, out *RollingUpdateStrategy, s conversion.Scope) error {
 	out.Partition = (*int32)(unsafe.Pointer(in.Partition))
+	out.MaxUnavailable = (*int32)(unsafe.Pointer(in.MaxUnavailable))
+	out.HealthGate = (*RollingUpdateHealthGate)(unsafe.Pointer(in.HealthGate))
 	return nil
 }
 
@@ -239,6 +239,9 @@ func SetObjectDefaults_ManagedSeedSet(in *ManagedSeedSet) {
 		SetDefaults_UpdateStrategy(in.Spec.UpdateStrategy)
 		if in.Spec.UpdateStrategy.RollingUpdate != nil {
 			SetDefaults_RollingUpdateStrategy(in.Spec.UpdateStrategy.RollingUpdate)
+			if in.Spec.UpdateStrategy.RollingUpdate.HealthGate != nil {
+				SetDefaults_RollingUpdateHealthGate(in.Spec.UpdateStrategy.RollingUpdate.HealthGate)
+			}
 		}
 	}
 }
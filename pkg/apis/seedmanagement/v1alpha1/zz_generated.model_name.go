@@ -104,6 +104,11 @@ func (in PendingReplica) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.seedmanagement.v1alpha1.PendingReplica"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in RollingUpdateHealthGate) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.seedmanagement.v1alpha1.RollingUpdateHealthGate"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in RollingUpdateStrategy) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.seedmanagement.v1alpha1.RollingUpdateStrategy"
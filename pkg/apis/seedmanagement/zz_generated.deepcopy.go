@@ -12,6 +12,7 @@ package seedmanagement
 import (
 	core "github.com/gardener/gardener/pkg/apis/core"
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -596,6 +597,27 @@ func (in *PendingReplica) DeepCopy() *PendingReplica {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RollingUpdateHealthGate) DeepCopyInto(out *RollingUpdateHealthGate) {
+	*out = *in
+	if in.SoakDuration != nil {
+		in, out := &in.SoakDuration, &out.SoakDuration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RollingUpdateHealthGate.
+func (in *RollingUpdateHealthGate) DeepCopy() *RollingUpdateHealthGate {
+	if in == nil {
+		return nil
+	}
+	out := new(RollingUpdateHealthGate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RollingUpdateStrategy) DeepCopyInto(out *RollingUpdateStrategy) {
 	*out = *in
@@ -604,6 +626,16 @@ func (in *RollingUpdateStrategy) DeepCopyInto(out *RollingUpdateStrategy) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(int32)
+		**out = **in
+	}
+	if in.HealthGate != nil {
+		in, out := &in.HealthGate, &out.HealthGate
+		*out = new(RollingUpdateHealthGate)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -222,6 +222,18 @@ func (m *MaintenanceTimeWindow) RandomDurationUntilNext(from time.Time, shiftBeg
 	return time.Duration(int64(begin.Sub(from)) + RandomFunc(0, delta.Nanoseconds()))
 }
 
+// NextBegin returns the begin of the next maintenance time window that starts after the given time <from>. If <from>
+// is inside the maintenance time window, the begin of the following maintenance time window is returned.
+func (m *MaintenanceTimeWindow) NextBegin(from time.Time) time.Time {
+	from = from.UTC()
+
+	begin := m.AdjustedBegin(from)
+	if !begin.After(from) {
+		begin = begin.AddDate(0, 0, 1)
+	}
+	return begin
+}
+
 // Duration returns the duration of the maintenance time window.
 func (m *MaintenanceTimeWindow) Duration() time.Duration {
 	var (
@@ -274,6 +274,20 @@ var _ = Describe("utils", func() {
 			Entry("(23-0), shift begin if contained, does not contain now (after)", from23to0, true, newTime(0, 59, 1, 0), 23*time.Hour+59*time.Second),
 		)
 
+		DescribeTable("#NextBegin",
+			func(maintenanceTimeWindow *MaintenanceTimeWindow, now time.Time, expected time.Duration) {
+				Expect(maintenanceTimeWindow.NextBegin(now).Sub(now)).To(Equal(expected))
+			},
+
+			Entry("(16-19), does contain now", from16to19, newTime(17, 0, 0, 0), 23*time.Hour),
+			Entry("(16-19), does not contain now (before)", from16to19, newTime(15, 0, 0, 0), time.Hour),
+			Entry("(16-19), does not contain now (after)", from16to19, newTime(20, 0, 0, 0), 20*time.Hour),
+			Entry("(16-19), now is the begin", from16to19, newTime(16, 0, 0, 0), 24*time.Hour),
+			Entry("(23-1), does contain now (before midnight)", from23to1, newTime(23, 30, 0, 0), 23*time.Hour+30*time.Minute),
+			Entry("(23-1), does contain now (after midnight)", from23to1, newTime(0, 30, 0, 0), 22*time.Hour+30*time.Minute),
+			Entry("(23-1), does not contain now", from23to1, newTime(2, 0, 0, 0), 21*time.Hour),
+		)
+
 		DescribeTable("#Duration",
 			func(maintenanceTimeWindow *MaintenanceTimeWindow, expected time.Duration) {
 				Expect(maintenanceTimeWindow.Duration()).To(Equal(expected))
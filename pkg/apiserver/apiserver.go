@@ -8,21 +8,27 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"os"
 	"time"
 
 	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
 	genericapiserver "k8s.io/apiserver/pkg/server"
 	kubeinformers "k8s.io/client-go/informers"
 	clientauthorizationv1 "k8s.io/client-go/kubernetes/typed/authorization/v1"
 	"k8s.io/client-go/util/keyutil"
 
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/scheduler/v1alpha1"
 	corerest "github.com/gardener/gardener/pkg/apiserver/registry/core/rest"
 	operationsrest "github.com/gardener/gardener/pkg/apiserver/registry/operations/rest"
 	securityrest "github.com/gardener/gardener/pkg/apiserver/registry/security/rest"
 	seedmanagementrest "github.com/gardener/gardener/pkg/apiserver/registry/seedmanagement/rest"
 	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/externalversions"
 	"github.com/gardener/gardener/pkg/logger"
+	shootcontroller "github.com/gardener/gardener/pkg/scheduler/controller/shoot"
 	"github.com/gardener/gardener/pkg/utils/workloadidentity"
 )
 
@@ -35,6 +41,7 @@ type ExtraConfig struct {
 	WorkloadIdentityTokenMinExpiration time.Duration
 	WorkloadIdentityTokenMaxExpiration time.Duration
 	WorkloadIdentitySigningKey         any
+	ShootSchedulerConfig               *schedulerconfigv1alpha1.ShootSchedulerConfiguration
 }
 
 // Config contains Gardener API server configuration.
@@ -107,6 +114,7 @@ func (c completedConfig) New() (*GardenerServer, error) {
 			KubeInformerFactory:           c.kubeInformerFactory,
 			CoreInformerFactory:           c.coreInformerFactory,
 			SubjectAccessReviewer:         c.subjectAccessReviewer,
+			ShootSchedulerConfig:          c.ExtraConfig.ShootSchedulerConfig,
 		}).NewRESTStorage(c.GenericConfig.RESTOptionsGetter)
 		seedManagementAPIGroupInfo = (seedmanagementrest.StorageProvider{}).NewRESTStorage(c.GenericConfig.RESTOptionsGetter)
 		operationsAPIGroupInfo     = (operationsrest.StorageProvider{}).NewRESTStorage(c.GenericConfig.RESTOptionsGetter)
@@ -133,6 +141,7 @@ type ExtraOptions struct {
 	WorkloadIdentityTokenMinExpiration time.Duration
 	WorkloadIdentityTokenMaxExpiration time.Duration
 	WorkloadIdentitySigningKeyFile     string
+	ShootSchedulerConfigFile           string
 
 	LogLevel  string
 	LogFormat string
@@ -181,6 +190,14 @@ func (o *ExtraOptions) Validate() []error {
 		}
 	}
 
+	if len(o.ShootSchedulerConfigFile) != 0 {
+		if config, err := loadShootSchedulerConfig(o.ShootSchedulerConfigFile); err != nil {
+			allErrors = append(allErrors, fmt.Errorf("--shoot-scheduler-config-file does not contain a valid scheduler configuration, err: %w", err))
+		} else if _, err := shootcontroller.NewFramework(config); err != nil {
+			allErrors = append(allErrors, fmt.Errorf("--shoot-scheduler-config-file contains an invalid shoot scheduler configuration, err: %w", err))
+		}
+	}
+
 	if !sets.New(logger.AllLogLevels...).Has(o.LogLevel) {
 		allErrors = append(allErrors, fmt.Errorf("invalid --log-level: %s", o.LogLevel))
 	}
@@ -202,6 +219,7 @@ func (o *ExtraOptions) AddFlags(fs *pflag.FlagSet) {
 	fs.DurationVar(&o.WorkloadIdentityTokenMinExpiration, "workload-identity-token-min-expiration", time.Hour, "The minimum validity duration of a workload identity token. If an otherwise valid TokenRequest with a validity duration less than this value is requested, a token will be issued with a validity duration of this value.")
 	fs.DurationVar(&o.WorkloadIdentityTokenMaxExpiration, "workload-identity-token-max-expiration", time.Hour*48, "The maximum validity duration of a workload identity token. If an otherwise valid TokenRequest with a validity duration greater than this value is requested, a token will be issued with a validity duration of this value.")
 	fs.StringVar(&o.WorkloadIdentitySigningKeyFile, "workload-identity-signing-key-file", o.WorkloadIdentitySigningKeyFile, "Path to the file that contains the current private key of the workload identity token issuer. The issuer will sign issued ID tokens with this private key.")
+	fs.StringVar(&o.ShootSchedulerConfigFile, "shoot-scheduler-config-file", o.ShootSchedulerConfigFile, "Path to the configuration file of the gardener-scheduler. The shoot scheduler configuration is used to explain scheduling decisions via the shoots/schedulingexplanation subresource. If not set, the default configuration of the gardener-scheduler is used.")
 
 	fs.StringVar(&o.LogLevel, "log-level", "info", "The level/severity for the logs. Must be one of [info,debug,error]")
 	fs.StringVar(&o.LogFormat, "log-format", "json", "The format for the logs. Must be one of [json,text]")
@@ -224,5 +242,35 @@ func (o *ExtraOptions) ApplyTo(c *Config) error {
 		c.ExtraConfig.WorkloadIdentitySigningKey = signingKey
 	}
 
+	if len(o.ShootSchedulerConfigFile) != 0 {
+		config, err := loadShootSchedulerConfig(o.ShootSchedulerConfigFile)
+		if err != nil {
+			return fmt.Errorf("failed to load shoot scheduler configuration from file %q: %w", o.ShootSchedulerConfigFile, err)
+		}
+		c.ExtraConfig.ShootSchedulerConfig = config
+	}
+
 	return nil
 }
+
+var schedulerConfigDecoder runtime.Decoder
+
+func init() {
+	configScheme := runtime.NewScheme()
+	utilruntime.Must(schedulerconfigv1alpha1.AddToScheme(configScheme))
+	schedulerConfigDecoder = serializer.NewCodecFactory(configScheme).UniversalDecoder()
+}
+
+func loadShootSchedulerConfig(path string) (*schedulerconfigv1alpha1.ShootSchedulerConfiguration, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	config := &schedulerconfigv1alpha1.SchedulerConfiguration{}
+	if err := runtime.DecodeInto(schedulerConfigDecoder, data, config); err != nil {
+		return nil, err
+	}
+
+	return config.Schedulers.Shoot, nil
+}
@@ -49,10 +49,12 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		v1beta1.Addon{}.OpenAPIModelName():                                        schema_pkg_apis_core_v1beta1_Addon(ref),
 		v1beta1.Addons{}.OpenAPIModelName():                                       schema_pkg_apis_core_v1beta1_Addons(ref),
 		v1beta1.AdmissionPlugin{}.OpenAPIModelName():                              schema_pkg_apis_core_v1beta1_AdmissionPlugin(ref),
+		v1beta1.Affinity{}.OpenAPIModelName():                                     schema_pkg_apis_core_v1beta1_Affinity(ref),
 		v1beta1.Alerting{}.OpenAPIModelName():                                     schema_pkg_apis_core_v1beta1_Alerting(ref),
 		v1beta1.AuditConfig{}.OpenAPIModelName():                                  schema_pkg_apis_core_v1beta1_AuditConfig(ref),
 		v1beta1.AuditPolicy{}.OpenAPIModelName():                                  schema_pkg_apis_core_v1beta1_AuditPolicy(ref),
 		v1beta1.AuthorizerKubeconfigReference{}.OpenAPIModelName():                schema_pkg_apis_core_v1beta1_AuthorizerKubeconfigReference(ref),
+		v1beta1.AutoUpdatePolicy{}.OpenAPIModelName():                             schema_pkg_apis_core_v1beta1_AutoUpdatePolicy(ref),
 		v1beta1.AvailabilityZone{}.OpenAPIModelName():                             schema_pkg_apis_core_v1beta1_AvailabilityZone(ref),
 		v1beta1.Backup{}.OpenAPIModelName():                                       schema_pkg_apis_core_v1beta1_Backup(ref),
 		v1beta1.BackupBucket{}.OpenAPIModelName():                                 schema_pkg_apis_core_v1beta1_BackupBucket(ref),
@@ -64,6 +66,9 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		v1beta1.BackupEntryList{}.OpenAPIModelName():                              schema_pkg_apis_core_v1beta1_BackupEntryList(ref),
 		v1beta1.BackupEntrySpec{}.OpenAPIModelName():                              schema_pkg_apis_core_v1beta1_BackupEntrySpec(ref),
 		v1beta1.BackupEntryStatus{}.OpenAPIModelName():                            schema_pkg_apis_core_v1beta1_BackupEntryStatus(ref),
+		v1beta1.BackupEntryUsage{}.OpenAPIModelName():                             schema_pkg_apis_core_v1beta1_BackupEntryUsage(ref),
+		v1beta1.BackupRetention{}.OpenAPIModelName():                              schema_pkg_apis_core_v1beta1_BackupRetention(ref),
+		v1beta1.BackupValidation{}.OpenAPIModelName():                             schema_pkg_apis_core_v1beta1_BackupValidation(ref),
 		v1beta1.Bastion{}.OpenAPIModelName():                                      schema_pkg_apis_core_v1beta1_Bastion(ref),
 		v1beta1.BastionMachineImage{}.OpenAPIModelName():                          schema_pkg_apis_core_v1beta1_BastionMachineImage(ref),
 		v1beta1.BastionMachineType{}.OpenAPIModelName():                           schema_pkg_apis_core_v1beta1_BastionMachineType(ref),
@@ -125,6 +130,8 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		v1beta1.GardenerResourceData{}.OpenAPIModelName():                         schema_pkg_apis_core_v1beta1_GardenerResourceData(ref),
 		v1beta1.HelmControllerDeployment{}.OpenAPIModelName():                     schema_pkg_apis_core_v1beta1_HelmControllerDeployment(ref),
 		v1beta1.Hibernation{}.OpenAPIModelName():                                  schema_pkg_apis_core_v1beta1_Hibernation(ref),
+		v1beta1.HibernationCalendar{}.OpenAPIModelName():                          schema_pkg_apis_core_v1beta1_HibernationCalendar(ref),
+		v1beta1.HibernationInactivity{}.OpenAPIModelName():                        schema_pkg_apis_core_v1beta1_HibernationInactivity(ref),
 		v1beta1.HibernationSchedule{}.OpenAPIModelName():                          schema_pkg_apis_core_v1beta1_HibernationSchedule(ref),
 		v1beta1.HighAvailability{}.OpenAPIModelName():                             schema_pkg_apis_core_v1beta1_HighAvailability(ref),
 		v1beta1.HorizontalPodAutoscalerConfig{}.OpenAPIModelName():                schema_pkg_apis_core_v1beta1_HorizontalPodAutoscalerConfig(ref),
@@ -167,6 +174,8 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		v1beta1.MaintenanceAutoRotation{}.OpenAPIModelName():                      schema_pkg_apis_core_v1beta1_MaintenanceAutoRotation(ref),
 		v1beta1.MaintenanceAutoUpdate{}.OpenAPIModelName():                        schema_pkg_apis_core_v1beta1_MaintenanceAutoUpdate(ref),
 		v1beta1.MaintenanceCredentialsAutoRotation{}.OpenAPIModelName():           schema_pkg_apis_core_v1beta1_MaintenanceCredentialsAutoRotation(ref),
+		v1beta1.MaintenanceFreeze{}.OpenAPIModelName():                            schema_pkg_apis_core_v1beta1_MaintenanceFreeze(ref),
+		v1beta1.MaintenancePreview{}.OpenAPIModelName():                           schema_pkg_apis_core_v1beta1_MaintenancePreview(ref),
 		v1beta1.MaintenanceRotationConfig{}.OpenAPIModelName():                    schema_pkg_apis_core_v1beta1_MaintenanceRotationConfig(ref),
 		v1beta1.MaintenanceTimeWindow{}.OpenAPIModelName():                        schema_pkg_apis_core_v1beta1_MaintenanceTimeWindow(ref),
 		v1beta1.ManualWorkerPoolRollout{}.OpenAPIModelName():                      schema_pkg_apis_core_v1beta1_ManualWorkerPoolRollout(ref),
@@ -188,6 +197,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		v1beta1.Project{}.OpenAPIModelName():                                      schema_pkg_apis_core_v1beta1_Project(ref),
 		v1beta1.ProjectList{}.OpenAPIModelName():                                  schema_pkg_apis_core_v1beta1_ProjectList(ref),
 		v1beta1.ProjectMember{}.OpenAPIModelName():                                schema_pkg_apis_core_v1beta1_ProjectMember(ref),
+		v1beta1.ProjectShootDeletion{}.OpenAPIModelName():                         schema_pkg_apis_core_v1beta1_ProjectShootDeletion(ref),
 		v1beta1.ProjectSpec{}.OpenAPIModelName():                                  schema_pkg_apis_core_v1beta1_ProjectSpec(ref),
 		v1beta1.ProjectStatus{}.OpenAPIModelName():                                schema_pkg_apis_core_v1beta1_ProjectStatus(ref),
 		v1beta1.ProjectTolerations{}.OpenAPIModelName():                           schema_pkg_apis_core_v1beta1_ProjectTolerations(ref),
@@ -199,6 +209,9 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		v1beta1.ResourceData{}.OpenAPIModelName():                                 schema_pkg_apis_core_v1beta1_ResourceData(ref),
 		v1beta1.ResourceWatchCacheSize{}.OpenAPIModelName():                       schema_pkg_apis_core_v1beta1_ResourceWatchCacheSize(ref),
 		v1beta1.SSHAccess{}.OpenAPIModelName():                                    schema_pkg_apis_core_v1beta1_SSHAccess(ref),
+		v1beta1.SchedulingExplanation{}.OpenAPIModelName():                        schema_pkg_apis_core_v1beta1_SchedulingExplanation(ref),
+		v1beta1.SchedulingExplanationStatus{}.OpenAPIModelName():                  schema_pkg_apis_core_v1beta1_SchedulingExplanationStatus(ref),
+		v1beta1.SchedulingPluginScore{}.OpenAPIModelName():                        schema_pkg_apis_core_v1beta1_SchedulingPluginScore(ref),
 		v1beta1.SecretBinding{}.OpenAPIModelName():                                schema_pkg_apis_core_v1beta1_SecretBinding(ref),
 		v1beta1.SecretBindingList{}.OpenAPIModelName():                            schema_pkg_apis_core_v1beta1_SecretBindingList(ref),
 		v1beta1.SecretBindingProvider{}.OpenAPIModelName():                        schema_pkg_apis_core_v1beta1_SecretBindingProvider(ref),
@@ -209,6 +222,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		v1beta1.SeedList{}.OpenAPIModelName():                                     schema_pkg_apis_core_v1beta1_SeedList(ref),
 		v1beta1.SeedNetworks{}.OpenAPIModelName():                                 schema_pkg_apis_core_v1beta1_SeedNetworks(ref),
 		v1beta1.SeedProvider{}.OpenAPIModelName():                                 schema_pkg_apis_core_v1beta1_SeedProvider(ref),
+		v1beta1.SeedSchedulingResult{}.OpenAPIModelName():                         schema_pkg_apis_core_v1beta1_SeedSchedulingResult(ref),
 		v1beta1.SeedSelector{}.OpenAPIModelName():                                 schema_pkg_apis_core_v1beta1_SeedSelector(ref),
 		v1beta1.SeedSettingDependencyWatchdog{}.OpenAPIModelName():                schema_pkg_apis_core_v1beta1_SeedSettingDependencyWatchdog(ref),
 		v1beta1.SeedSettingDependencyWatchdogProber{}.OpenAPIModelName():          schema_pkg_apis_core_v1beta1_SeedSettingDependencyWatchdogProber(ref),
@@ -228,12 +242,14 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		v1beta1.SeedStatus{}.OpenAPIModelName():                                   schema_pkg_apis_core_v1beta1_SeedStatus(ref),
 		v1beta1.SeedTaint{}.OpenAPIModelName():                                    schema_pkg_apis_core_v1beta1_SeedTaint(ref),
 		v1beta1.SeedTemplate{}.OpenAPIModelName():                                 schema_pkg_apis_core_v1beta1_SeedTemplate(ref),
+		v1beta1.SeedUtilization{}.OpenAPIModelName():                              schema_pkg_apis_core_v1beta1_SeedUtilization(ref),
 		v1beta1.SeedVolume{}.OpenAPIModelName():                                   schema_pkg_apis_core_v1beta1_SeedVolume(ref),
 		v1beta1.SeedVolumeProvider{}.OpenAPIModelName():                           schema_pkg_apis_core_v1beta1_SeedVolumeProvider(ref),
 		v1beta1.ServiceAccountConfig{}.OpenAPIModelName():                         schema_pkg_apis_core_v1beta1_ServiceAccountConfig(ref),
 		v1beta1.ServiceAccountKeyRotation{}.OpenAPIModelName():                    schema_pkg_apis_core_v1beta1_ServiceAccountKeyRotation(ref),
 		v1beta1.Shoot{}.OpenAPIModelName():                                        schema_pkg_apis_core_v1beta1_Shoot(ref),
 		v1beta1.ShootAdvertisedAddress{}.OpenAPIModelName():                       schema_pkg_apis_core_v1beta1_ShootAdvertisedAddress(ref),
+		v1beta1.ShootAffinityTerm{}.OpenAPIModelName():                            schema_pkg_apis_core_v1beta1_ShootAffinityTerm(ref),
 		v1beta1.ShootCredentials{}.OpenAPIModelName():                             schema_pkg_apis_core_v1beta1_ShootCredentials(ref),
 		v1beta1.ShootCredentialsRotation{}.OpenAPIModelName():                     schema_pkg_apis_core_v1beta1_ShootCredentialsRotation(ref),
 		v1beta1.ShootKubeconfigRotation{}.OpenAPIModelName():                      schema_pkg_apis_core_v1beta1_ShootKubeconfigRotation(ref),
@@ -241,12 +257,14 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		v1beta1.ShootMachineImage{}.OpenAPIModelName():                            schema_pkg_apis_core_v1beta1_ShootMachineImage(ref),
 		v1beta1.ShootNetworks{}.OpenAPIModelName():                                schema_pkg_apis_core_v1beta1_ShootNetworks(ref),
 		v1beta1.ShootSSHKeypairRotation{}.OpenAPIModelName():                      schema_pkg_apis_core_v1beta1_ShootSSHKeypairRotation(ref),
+		v1beta1.ShootSource{}.OpenAPIModelName():                                  schema_pkg_apis_core_v1beta1_ShootSource(ref),
 		v1beta1.ShootSpec{}.OpenAPIModelName():                                    schema_pkg_apis_core_v1beta1_ShootSpec(ref),
 		v1beta1.ShootState{}.OpenAPIModelName():                                   schema_pkg_apis_core_v1beta1_ShootState(ref),
 		v1beta1.ShootStateList{}.OpenAPIModelName():                               schema_pkg_apis_core_v1beta1_ShootStateList(ref),
 		v1beta1.ShootStateSpec{}.OpenAPIModelName():                               schema_pkg_apis_core_v1beta1_ShootStateSpec(ref),
 		v1beta1.ShootStatus{}.OpenAPIModelName():                                  schema_pkg_apis_core_v1beta1_ShootStatus(ref),
 		v1beta1.ShootTemplate{}.OpenAPIModelName():                                schema_pkg_apis_core_v1beta1_ShootTemplate(ref),
+		v1beta1.ShootWakeUp{}.OpenAPIModelName():                                  schema_pkg_apis_core_v1beta1_ShootWakeUp(ref),
 		v1beta1.StructuredAuthentication{}.OpenAPIModelName():                     schema_pkg_apis_core_v1beta1_StructuredAuthentication(ref),
 		v1beta1.StructuredAuthorization{}.OpenAPIModelName():                      schema_pkg_apis_core_v1beta1_StructuredAuthorization(ref),
 		v1beta1.SystemComponents{}.OpenAPIModelName():                             schema_pkg_apis_core_v1beta1_SystemComponents(ref),
@@ -265,6 +283,16 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		operationsv1alpha1.BastionList{}.OpenAPIModelName():                       schema_pkg_apis_operations_v1alpha1_BastionList(ref),
 		operationsv1alpha1.BastionSpec{}.OpenAPIModelName():                       schema_pkg_apis_operations_v1alpha1_BastionSpec(ref),
 		operationsv1alpha1.BastionStatus{}.OpenAPIModelName():                     schema_pkg_apis_operations_v1alpha1_BastionStatus(ref),
+		operationsv1alpha1.SeedRebalanceMigration{}.OpenAPIModelName():            schema_pkg_apis_operations_v1alpha1_SeedRebalanceMigration(ref),
+		operationsv1alpha1.SeedRebalanceMigrationStatus{}.OpenAPIModelName():      schema_pkg_apis_operations_v1alpha1_SeedRebalanceMigrationStatus(ref),
+		operationsv1alpha1.SeedRebalanceProposal{}.OpenAPIModelName():             schema_pkg_apis_operations_v1alpha1_SeedRebalanceProposal(ref),
+		operationsv1alpha1.SeedRebalanceProposalList{}.OpenAPIModelName():         schema_pkg_apis_operations_v1alpha1_SeedRebalanceProposalList(ref),
+		operationsv1alpha1.SeedRebalanceProposalSpec{}.OpenAPIModelName():         schema_pkg_apis_operations_v1alpha1_SeedRebalanceProposalSpec(ref),
+		operationsv1alpha1.SeedRebalanceProposalStatus{}.OpenAPIModelName():       schema_pkg_apis_operations_v1alpha1_SeedRebalanceProposalStatus(ref),
+		operationsv1alpha1.ShootRestore{}.OpenAPIModelName():                      schema_pkg_apis_operations_v1alpha1_ShootRestore(ref),
+		operationsv1alpha1.ShootRestoreList{}.OpenAPIModelName():                  schema_pkg_apis_operations_v1alpha1_ShootRestoreList(ref),
+		operationsv1alpha1.ShootRestoreSpec{}.OpenAPIModelName():                  schema_pkg_apis_operations_v1alpha1_ShootRestoreSpec(ref),
+		operationsv1alpha1.ShootRestoreStatus{}.OpenAPIModelName():                schema_pkg_apis_operations_v1alpha1_ShootRestoreStatus(ref),
 		securityv1alpha1.ContextObject{}.OpenAPIModelName():                       schema_pkg_apis_security_v1alpha1_ContextObject(ref),
 		securityv1alpha1.CredentialsBinding{}.OpenAPIModelName():                  schema_pkg_apis_security_v1alpha1_CredentialsBinding(ref),
 		securityv1alpha1.CredentialsBindingList{}.OpenAPIModelName():              schema_pkg_apis_security_v1alpha1_CredentialsBindingList(ref),
@@ -296,6 +324,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		seedmanagementv1alpha1.ManagedSeedStatus{}.OpenAPIModelName():             schema_pkg_apis_seedmanagement_v1alpha1_ManagedSeedStatus(ref),
 		seedmanagementv1alpha1.ManagedSeedTemplate{}.OpenAPIModelName():           schema_pkg_apis_seedmanagement_v1alpha1_ManagedSeedTemplate(ref),
 		seedmanagementv1alpha1.PendingReplica{}.OpenAPIModelName():                schema_pkg_apis_seedmanagement_v1alpha1_PendingReplica(ref),
+		seedmanagementv1alpha1.RollingUpdateHealthGate{}.OpenAPIModelName():       schema_pkg_apis_seedmanagement_v1alpha1_RollingUpdateHealthGate(ref),
 		seedmanagementv1alpha1.RollingUpdateStrategy{}.OpenAPIModelName():         schema_pkg_apis_seedmanagement_v1alpha1_RollingUpdateStrategy(ref),
 		seedmanagementv1alpha1.Shoot{}.OpenAPIModelName():                         schema_pkg_apis_seedmanagement_v1alpha1_Shoot(ref),
 		seedmanagementv1alpha1.UpdateStrategy{}.OpenAPIModelName():                schema_pkg_apis_seedmanagement_v1alpha1_UpdateStrategy(ref),
@@ -1285,6 +1314,49 @@ func schema_pkg_apis_core_v1beta1_AdmissionPlugin(ref common.ReferenceCallback)
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_Affinity(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Affinity contains the scheduling constraints of a shoot's control plane in relation to other shoots.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"shootAffinity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ShootAffinity contains terms selecting shoots in the same project whose control planes should run on the same seed as the control plane of this shoot. A term is only considered if at least one of the selected shoots is already scheduled. The terms are only honoured when the shoot is scheduled.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1beta1.ShootAffinityTerm{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"shootAntiAffinity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ShootAntiAffinity contains terms selecting shoots in the same project whose control planes must not run on the same seed as the control plane of this shoot. The terms are honoured when the shoot is scheduled or migrated to another seed.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1beta1.ShootAffinityTerm{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			v1beta1.ShootAffinityTerm{}.OpenAPIModelName()},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_Alerting(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1385,6 +1457,34 @@ func schema_pkg_apis_core_v1beta1_AuthorizerKubeconfigReference(ref common.Refer
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_AutoUpdatePolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AutoUpdatePolicy constrains which versions are picked by automatic updates of Kubernetes and machine image versions.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"channel": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Channel is the update channel which determines the minimum age of versions before they are picked by automatic updates. The soak times of the channels are configured by the Gardener operator. Possible values are \"early\" and \"stable\". Mutually exclusive with SoakTime.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"soakTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SoakTime is the minimum duration since a version was added to the CloudProfile before it is picked by automatic updates. Mutually exclusive with Channel.",
+							Ref:         ref(metav1.Duration{}.OpenAPIModelName()),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			metav1.Duration{}.OpenAPIModelName()},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_AvailabilityZone(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1471,12 +1571,24 @@ func schema_pkg_apis_core_v1beta1_Backup(ref common.ReferenceCallback) common.Op
 							Ref:         ref(corev1.ObjectReference{}.OpenAPIModelName()),
 						},
 					},
+					"validation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Validation contains the configuration for the periodic validation of the backups of the shoots on this seed. If set, the gardenlet regularly restores the latest backup of a sample of the BackupEntries into a temporary etcd and checks its consistency.",
+							Ref:         ref(v1beta1.BackupValidation{}.OpenAPIModelName()),
+						},
+					},
+					"retention": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Retention contains the retention and lifecycle policy for the backups of the shoots on this seed.",
+							Ref:         ref(v1beta1.BackupRetention{}.OpenAPIModelName()),
+						},
+					},
 				},
 				Required: []string{"provider"},
 			},
 		},
 		Dependencies: []string{
-			corev1.ObjectReference{}.OpenAPIModelName(), runtime.RawExtension{}.OpenAPIModelName()},
+			v1beta1.BackupRetention{}.OpenAPIModelName(), v1beta1.BackupValidation{}.OpenAPIModelName(), corev1.ObjectReference{}.OpenAPIModelName(), runtime.RawExtension{}.OpenAPIModelName()},
 	}
 }
 
@@ -1651,12 +1763,18 @@ func schema_pkg_apis_core_v1beta1_BackupBucketSpec(ref common.ReferenceCallback)
 							Ref:         ref(corev1.ObjectReference{}.OpenAPIModelName()),
 						},
 					},
+					"retention": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Retention contains the retention and lifecycle policy for the backups in this bucket.",
+							Ref:         ref(v1beta1.BackupRetention{}.OpenAPIModelName()),
+						},
+					},
 				},
 				Required: []string{"provider"},
 			},
 		},
 		Dependencies: []string{
-			v1beta1.BackupBucketProvider{}.OpenAPIModelName(), corev1.ObjectReference{}.OpenAPIModelName(), runtime.RawExtension{}.OpenAPIModelName()},
+			v1beta1.BackupBucketProvider{}.OpenAPIModelName(), v1beta1.BackupRetention{}.OpenAPIModelName(), corev1.ObjectReference{}.OpenAPIModelName(), runtime.RawExtension{}.OpenAPIModelName()},
 	}
 }
 
@@ -1884,11 +2002,151 @@ func schema_pkg_apis_core_v1beta1_BackupEntryStatus(ref common.ReferenceCallback
 							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
 						},
 					},
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "type",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions represents the latest available observations of a BackupEntry's current state.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1beta1.Condition{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"usage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Usage contains information about the storage used by the backups of this BackupEntry.",
+							Ref:         ref(v1beta1.BackupEntryUsage{}.OpenAPIModelName()),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			v1beta1.BackupEntryUsage{}.OpenAPIModelName(), v1beta1.Condition{}.OpenAPIModelName(), v1beta1.LastError{}.OpenAPIModelName(), v1beta1.LastOperation{}.OpenAPIModelName(), metav1.Time{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_BackupEntryUsage(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BackupEntryUsage contains information about the storage used by the backups of a BackupEntry.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"sizeBytes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SizeBytes is the total size of all objects of the BackupEntry in bytes.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"objectCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObjectCount is the number of objects of the BackupEntry.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"lastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastUpdateTime is the time when the usage was determined.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
+						},
+					},
+					"lastBackupTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastBackupTime is the time when the newest object of the BackupEntry was written.",
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
+						},
+					},
+				},
+				Required: []string{"sizeBytes", "objectCount", "lastUpdateTime"},
+			},
+		},
+		Dependencies: []string{
+			metav1.Time{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_BackupRetention(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BackupRetention contains the retention and lifecycle policy for backups.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"deletedEntriesGracePeriod": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeletedEntriesGracePeriod is the duration for which the backups of deleted shoots are kept before they are removed from the bucket. If set, it takes precedence over the deletion grace period configured for the gardenlet.",
+							Ref:         ref(metav1.Duration{}.OpenAPIModelName()),
+						},
+					},
+					"maxFullSnapshots": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxFullSnapshots is the maximum number of full snapshots which are kept for each etcd. Older full snapshots and their delta snapshots are garbage collected. If not set, snapshots are garbage collected exponentially.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"coldStorageTransitionPeriod": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ColdStorageTransitionPeriod is the age after which backups are transitioned to a cheaper storage class of the object store. Whether and how this is supported depends on the provider.",
+							Ref:         ref(metav1.Duration{}.OpenAPIModelName()),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			metav1.Duration{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_BackupValidation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BackupValidation contains the configuration for the periodic validation of backups by test restores.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"interval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Interval is the interval in which a sample of the BackupEntries of the seed is validated. Defaults to `24h`.",
+							Ref:         ref(metav1.Duration{}.OpenAPIModelName()),
+						},
+					},
+					"samplingPercentage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SamplingPercentage is the percentage of the BackupEntries of the seed which are validated in each interval. The BackupEntries whose last validation is the oldest are validated first. Defaults to `10`.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"concurrency": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Concurrency is the maximum number of validations which are performed in parallel. Defaults to `1`.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			v1beta1.LastError{}.OpenAPIModelName(), v1beta1.LastOperation{}.OpenAPIModelName(), metav1.Time{}.OpenAPIModelName()},
+			metav1.Duration{}.OpenAPIModelName()},
 	}
 }
 
@@ -4045,10 +4303,18 @@ func schema_pkg_apis_core_v1beta1_ExpirableVersionStatus(ref common.ReferenceCal
 							Format:      "",
 						},
 					},
+					"addedTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AddedTime is the time at which the version was first observed in the CloudProfile.",
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
+						},
+					},
 				},
 				Required: []string{"version", "classification"},
 			},
 		},
+		Dependencies: []string{
+			metav1.Time{}.OpenAPIModelName()},
 	}
 }
 
@@ -4521,11 +4787,84 @@ func schema_pkg_apis_core_v1beta1_Hibernation(ref common.ReferenceCallback) comm
 							},
 						},
 					},
+					"inactivity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Inactivity configures the automatic hibernation of the Shoot after a period without user activity.",
+							Ref:         ref(v1beta1.HibernationInactivity{}.OpenAPIModelName()),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			v1beta1.HibernationInactivity{}.OpenAPIModelName(), v1beta1.HibernationSchedule{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_HibernationCalendar(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HibernationCalendar is a calendar of days on which shoots are not woken up by their hibernation schedules.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the hibernation calendar.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"dates": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Dates is a list of calendar days in the format `YYYY-MM-DD`.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"resourceRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResourceRef references a ConfigMap or Secret in the project namespace which contains an iCalendar file (RFC 5545) in the `calendar.ics` data key. All days covered by the events of the file are calendar days.",
+							Ref:         ref(autoscalingv1.CrossVersionObjectReference{}.OpenAPIModelName()),
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{
+			autoscalingv1.CrossVersionObjectReference{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_HibernationInactivity(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HibernationInactivity configures the automatic hibernation of a Shoot after a period without user activity.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"timeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timeout is the duration without user requests to the kube-apiserver after which the Shoot is hibernated.",
+							Ref:         ref(metav1.Duration{}.OpenAPIModelName()),
+						},
+					},
 				},
+				Required: []string{"timeout"},
 			},
 		},
 		Dependencies: []string{
-			v1beta1.HibernationSchedule{}.OpenAPIModelName()},
+			metav1.Duration{}.OpenAPIModelName()},
 	}
 }
 
@@ -6552,10 +6891,18 @@ func schema_pkg_apis_core_v1beta1_MaintenanceAutoUpdate(ref common.ReferenceCall
 							Format:      "",
 						},
 					},
+					"policy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Policy constrains which versions are picked by automatic updates. If not set, the auto-update policy of the project is used.",
+							Ref:         ref(v1beta1.AutoUpdatePolicy{}.OpenAPIModelName()),
+						},
+					},
 				},
 				Required: []string{"kubernetesVersion"},
 			},
 		},
+		Dependencies: []string{
+			v1beta1.AutoUpdatePolicy{}.OpenAPIModelName()},
 	}
 }
 
@@ -6592,20 +6939,103 @@ func schema_pkg_apis_core_v1beta1_MaintenanceCredentialsAutoRotation(ref common.
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_MaintenanceRotationConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_MaintenanceFreeze(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "MaintenanceRotationConfig contains configuration for automatic rotation.",
+				Description: "MaintenanceFreeze is a period in which no automatic maintenance operations are performed for shoots.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"rotationPeriod": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RotationPeriod is the period between a completed rotation and the start of a new rotation (default: 7d). The allowed rotation period is between 30m and 90d. When set to 0, rotation is disabled.",
-							Ref:         ref(metav1.Duration{}.OpenAPIModelName()),
+							Description: "Name is the name of the maintenance freeze.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-				},
+					"begin": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Begin is the time when the maintenance freeze begins.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
+						},
+					},
+					"end": {
+						SchemaProps: spec.SchemaProps{
+							Description: "End is the time when the maintenance freeze ends.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
+						},
+					},
+				},
+				Required: []string{"name", "begin", "end"},
+			},
+		},
+		Dependencies: []string{
+			metav1.Time{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_MaintenancePreview(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MaintenancePreview contains the changes that the next maintenance of the Shoot is expected to perform.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"nextMaintenanceTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NextMaintenanceTime is the begin of the next maintenance time window of the Shoot.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
+						},
+					},
+					"plannedChanges": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PlannedChanges are human-readable descriptions of the changes that the next maintenance is expected to perform, based on the current state of the Shoot and its CloudProfile.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"lastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastUpdateTime is the time when the preview was computed.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
+						},
+					},
+				},
+				Required: []string{"nextMaintenanceTime", "lastUpdateTime"},
+			},
+		},
+		Dependencies: []string{
+			metav1.Time{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_MaintenanceRotationConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MaintenanceRotationConfig contains configuration for automatic rotation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"rotationPeriod": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RotationPeriod is the period between a completed rotation and the start of a new rotation (default: 7d). The allowed rotation period is between 30m and 90d. When set to 0, rotation is disabled.",
+							Ref:         ref(metav1.Duration{}.OpenAPIModelName()),
+						},
+					},
+				},
 			},
 		},
 		Dependencies: []string{
@@ -7546,6 +7976,29 @@ func schema_pkg_apis_core_v1beta1_ProjectMember(ref common.ReferenceCallback) co
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_ProjectShootDeletion(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ProjectShootDeletion contains configuration for the deletion of shoots in a project.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"gracePeriod": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GracePeriod is the duration for which a deleted shoot is kept hibernated before it is actually deleted. During this period, the deletion can be reverted with the `undelete` operation.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(metav1.Duration{}.OpenAPIModelName()),
+						},
+					},
+				},
+				Required: []string{"gracePeriod"},
+			},
+		},
+		Dependencies: []string{
+			metav1.Duration{}.OpenAPIModelName()},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_ProjectSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -7620,11 +8073,51 @@ func schema_pkg_apis_core_v1beta1_ProjectSpec(ref common.ReferenceCallback) comm
 							},
 						},
 					},
+					"shootDeletion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ShootDeletion contains configuration for the deletion of shoots in this project.",
+							Ref:         ref(v1beta1.ProjectShootDeletion{}.OpenAPIModelName()),
+						},
+					},
+					"maintenanceFreezes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaintenanceFreezes are periods in which no automatic maintenance operations are performed for the shoots in this project. Forceful updates of expired versions are still performed.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1beta1.MaintenanceFreeze{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"autoUpdatePolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AutoUpdatePolicy constrains which versions are picked by automatic updates for the shoots in this project which do not configure their own policy.",
+							Ref:         ref(v1beta1.AutoUpdatePolicy{}.OpenAPIModelName()),
+						},
+					},
+					"hibernationCalendars": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HibernationCalendars are calendars of days (e.g., public holidays or company shutdown days) on which the shoots in this project are not woken up by their hibernation schedules.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1beta1.HibernationCalendar{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			v1beta1.DualApprovalForDeletion{}.OpenAPIModelName(), v1beta1.ProjectMember{}.OpenAPIModelName(), v1beta1.ProjectTolerations{}.OpenAPIModelName(), rbacv1.Subject{}.OpenAPIModelName()},
+			v1beta1.AutoUpdatePolicy{}.OpenAPIModelName(), v1beta1.DualApprovalForDeletion{}.OpenAPIModelName(), v1beta1.HibernationCalendar{}.OpenAPIModelName(), v1beta1.MaintenanceFreeze{}.OpenAPIModelName(), v1beta1.ProjectMember{}.OpenAPIModelName(), v1beta1.ProjectShootDeletion{}.OpenAPIModelName(), v1beta1.ProjectTolerations{}.OpenAPIModelName(), rbacv1.Subject{}.OpenAPIModelName()},
 	}
 }
 
@@ -8128,6 +8621,137 @@ func schema_pkg_apis_core_v1beta1_SSHAccess(ref common.ReferenceCallback) common
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_SchedulingExplanation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SchedulingExplanation explains the scheduling decision for a Shoot. It is returned by the `shoots/schedulingexplanation` subresource which runs the scheduling pipeline of the gardener-scheduler without binding the Shoot to a Seed.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard object metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(metav1.ObjectMeta{}.OpenAPIModelName()),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status contains the result of the scheduling simulation.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(v1beta1.SchedulingExplanationStatus{}.OpenAPIModelName()),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			v1beta1.SchedulingExplanationStatus{}.OpenAPIModelName(), metav1.ObjectMeta{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_SchedulingExplanationStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SchedulingExplanationStatus contains the result of the scheduling simulation for a Shoot.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"seedName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SeedName is the name of the Seed the Shoot is currently scheduled to.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"selectedSeedName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SelectedSeedName is the name of the Seed the scheduler would select for the Shoot.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message describes why no Seed could be selected for the Shoot.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"seeds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Seeds contains the scheduling results for all Seeds which were considered.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1beta1.SeedSchedulingResult{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			v1beta1.SeedSchedulingResult{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_SchedulingPluginScore(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SchedulingPluginScore is the normalized score a score plugin computed for a Seed.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the score plugin.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"weight": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Weight is the weight of the score plugin.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"score": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Score is the normalized score in the range of [0, 100].",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"name", "weight", "score"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_SecretBinding(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -8611,37 +9235,96 @@ func schema_pkg_apis_core_v1beta1_SeedProvider(ref common.ReferenceCallback) com
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_SeedSelector(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_SeedSchedulingResult(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SeedSelector contains constraints for selecting seed to be usable for shoots using a profile",
+				Description: "SeedSchedulingResult contains the scheduling result for a single Seed.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"matchLabels": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels map is equivalent to an element of matchExpressions, whose key field is \"key\", the operator is \"In\", and the values array contains only \"value\". The requirements are ANDed.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "Name is the name of the Seed.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"matchExpressions": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-list-type": "atomic",
-							},
+					"rejectedBy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RejectedBy is the name of the filter plugin which rejected the Seed.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
+					},
+					"reason": {
 						SchemaProps: spec.SchemaProps{
-							Description: "matchExpressions is a list of label selector requirements. The requirements are ANDed.",
+							Description: "Reason describes why the Seed was rejected.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"scores": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Scores contains the normalized scores of all score plugins. It is only set if the Seed passed all filters.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1beta1.SchedulingPluginScore{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"totalScore": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TotalScore is the weighted sum of all scores. It is only set if the Seed passed all filters.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{
+			v1beta1.SchedulingPluginScore{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_SeedSelector(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SeedSelector contains constraints for selecting seed to be usable for shoots using a profile",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"matchLabels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels map is equivalent to an element of matchExpressions, whose key field is \"key\", the operator is \"In\", and the values array contains only \"value\". The requirements are ANDed.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"matchExpressions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "matchExpressions is a list of label selector requirements. The requirements are ANDed.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -9405,11 +10088,17 @@ func schema_pkg_apis_core_v1beta1_SeedStatus(ref common.ReferenceCallback) commo
 							Ref:         ref(v1beta1.LastOperation{}.OpenAPIModelName()),
 						},
 					},
+					"utilization": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Utilization contains the aggregated resource requests and usage of the shoot control planes hosted by the seed.",
+							Ref:         ref(v1beta1.SeedUtilization{}.OpenAPIModelName()),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			v1beta1.Condition{}.OpenAPIModelName(), v1beta1.Gardener{}.OpenAPIModelName(), v1beta1.LastOperation{}.OpenAPIModelName(), resource.Quantity{}.OpenAPIModelName(), metav1.Time{}.OpenAPIModelName()},
+			v1beta1.Condition{}.OpenAPIModelName(), v1beta1.Gardener{}.OpenAPIModelName(), v1beta1.LastOperation{}.OpenAPIModelName(), v1beta1.SeedUtilization{}.OpenAPIModelName(), resource.Quantity{}.OpenAPIModelName(), metav1.Time{}.OpenAPIModelName()},
 	}
 }
 
@@ -9471,6 +10160,69 @@ func schema_pkg_apis_core_v1beta1_SeedTemplate(ref common.ReferenceCallback) com
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_SeedUtilization(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SeedUtilization contains the aggregated resource requests and usage of the shoot control planes hosted by a seed.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"allocatable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Allocatable is the amount of resources (cpu, memory) of the seed's nodes that are available for pods and, if configured in the seed's allocatable resources, the amount of storage (etcd-volume) available for etcd volumes.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref(resource.Quantity{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"requests": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Requests is the sum of the resource requests of all shoot control planes hosted by the seed.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref(resource.Quantity{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"usage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Usage is the sum of the actual resource usage of all shoot control planes hosted by the seed. It is only reported if the metrics API is available in the seed cluster.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref(resource.Quantity{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"lastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastUpdateTime is the time when the utilization was last computed.",
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			resource.Quantity{}.OpenAPIModelName(), metav1.Time{}.OpenAPIModelName()},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_SeedVolume(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -9741,6 +10493,29 @@ func schema_pkg_apis_core_v1beta1_ShootAdvertisedAddress(ref common.ReferenceCal
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_ShootAffinityTerm(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootAffinityTerm selects a group of shoots in the same project.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"labelSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LabelSelector is a label query over the shoots in the same project.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(metav1.LabelSelector{}.OpenAPIModelName()),
+						},
+					},
+				},
+				Required: []string{"labelSelector"},
+			},
+		},
+		Dependencies: []string{
+			metav1.LabelSelector{}.OpenAPIModelName()},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_ShootCredentials(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -9982,6 +10757,44 @@ func schema_pkg_apis_core_v1beta1_ShootSSHKeypairRotation(ref common.ReferenceCa
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_ShootSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootSource references an existing shoot whose etcd backup is used to seed the etcd of a new shoot.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace is the namespace of the source shoot.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the source shoot.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"targetTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetTime is the point in time whose state of the source shoot's etcd shall be copied. Only the snapshots which were taken at or before this time are copied. If not set, the latest snapshots are copied.",
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
+						},
+					},
+				},
+				Required: []string{"namespace", "name"},
+			},
+		},
+		Dependencies: []string{
+			metav1.Time{}.OpenAPIModelName()},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_ShootSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -10182,12 +10995,24 @@ func schema_pkg_apis_core_v1beta1_ShootSpec(ref common.ReferenceCallback) common
 							},
 						},
 					},
+					"affinity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Affinity contains the scheduling constraints of the shoot's control plane in relation to other shoots.",
+							Ref:         ref(v1beta1.Affinity{}.OpenAPIModelName()),
+						},
+					},
+					"source": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Source references an existing shoot whose etcd backup is used to seed the etcd of this shoot when it is created. This field is immutable.",
+							Ref:         ref(v1beta1.ShootSource{}.OpenAPIModelName()),
+						},
+					},
 				},
 				Required: []string{"kubernetes", "provider", "region"},
 			},
 		},
 		Dependencies: []string{
-			v1beta1.AccessRestrictionWithOptions{}.OpenAPIModelName(), v1beta1.Addons{}.OpenAPIModelName(), v1beta1.CloudProfileReference{}.OpenAPIModelName(), v1beta1.ControlPlane{}.OpenAPIModelName(), v1beta1.DNS{}.OpenAPIModelName(), v1beta1.Extension{}.OpenAPIModelName(), v1beta1.Hibernation{}.OpenAPIModelName(), v1beta1.Kubernetes{}.OpenAPIModelName(), v1beta1.Maintenance{}.OpenAPIModelName(), v1beta1.Monitoring{}.OpenAPIModelName(), v1beta1.NamedResourceReference{}.OpenAPIModelName(), v1beta1.Networking{}.OpenAPIModelName(), v1beta1.Provider{}.OpenAPIModelName(), v1beta1.SeedSelector{}.OpenAPIModelName(), v1beta1.SystemComponents{}.OpenAPIModelName(), v1beta1.Toleration{}.OpenAPIModelName()},
+			v1beta1.AccessRestrictionWithOptions{}.OpenAPIModelName(), v1beta1.Addons{}.OpenAPIModelName(), v1beta1.Affinity{}.OpenAPIModelName(), v1beta1.CloudProfileReference{}.OpenAPIModelName(), v1beta1.ControlPlane{}.OpenAPIModelName(), v1beta1.DNS{}.OpenAPIModelName(), v1beta1.Extension{}.OpenAPIModelName(), v1beta1.Hibernation{}.OpenAPIModelName(), v1beta1.Kubernetes{}.OpenAPIModelName(), v1beta1.Maintenance{}.OpenAPIModelName(), v1beta1.Monitoring{}.OpenAPIModelName(), v1beta1.NamedResourceReference{}.OpenAPIModelName(), v1beta1.Networking{}.OpenAPIModelName(), v1beta1.Provider{}.OpenAPIModelName(), v1beta1.SeedSelector{}.OpenAPIModelName(), v1beta1.ShootSource{}.OpenAPIModelName(), v1beta1.SystemComponents{}.OpenAPIModelName(), v1beta1.Toleration{}.OpenAPIModelName()},
 	}
 }
 
@@ -10541,12 +11366,24 @@ func schema_pkg_apis_core_v1beta1_ShootStatus(ref common.ReferenceCallback) comm
 							Ref:         ref(v1beta1.LiveMigration{}.OpenAPIModelName()),
 						},
 					},
+					"maintenancePreview": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaintenancePreview contains the changes that the next maintenance of the Shoot is expected to perform. It is refreshed periodically by the maintenance controller.",
+							Ref:         ref(v1beta1.MaintenancePreview{}.OpenAPIModelName()),
+						},
+					},
+					"lastActivityTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastActivityTime is the last time at which user requests to the kube-apiserver of the Shoot were observed. It is also set when the Shoot is woken up.",
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
+						},
+					},
 				},
 				Required: []string{"gardener", "hibernated", "technicalID", "uid"},
 			},
 		},
 		Dependencies: []string{
-			v1beta1.Condition{}.OpenAPIModelName(), v1beta1.Gardener{}.OpenAPIModelName(), v1beta1.InPlaceUpdatesStatus{}.OpenAPIModelName(), v1beta1.LastError{}.OpenAPIModelName(), v1beta1.LastMaintenance{}.OpenAPIModelName(), v1beta1.LastOperation{}.OpenAPIModelName(), v1beta1.LiveMigration{}.OpenAPIModelName(), v1beta1.ManualWorkerPoolRollout{}.OpenAPIModelName(), v1beta1.NetworkingStatus{}.OpenAPIModelName(), v1beta1.ShootAdvertisedAddress{}.OpenAPIModelName(), v1beta1.ShootCredentials{}.OpenAPIModelName(), metav1.Time{}.OpenAPIModelName()},
+			v1beta1.Condition{}.OpenAPIModelName(), v1beta1.Gardener{}.OpenAPIModelName(), v1beta1.InPlaceUpdatesStatus{}.OpenAPIModelName(), v1beta1.LastError{}.OpenAPIModelName(), v1beta1.LastMaintenance{}.OpenAPIModelName(), v1beta1.LastOperation{}.OpenAPIModelName(), v1beta1.LiveMigration{}.OpenAPIModelName(), v1beta1.MaintenancePreview{}.OpenAPIModelName(), v1beta1.ManualWorkerPoolRollout{}.OpenAPIModelName(), v1beta1.NetworkingStatus{}.OpenAPIModelName(), v1beta1.ShootAdvertisedAddress{}.OpenAPIModelName(), v1beta1.ShootCredentials{}.OpenAPIModelName(), metav1.Time{}.OpenAPIModelName()},
 	}
 }
 
@@ -10579,6 +11416,42 @@ func schema_pkg_apis_core_v1beta1_ShootTemplate(ref common.ReferenceCallback) co
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_ShootWakeUp(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootWakeUp is a request to wake up a hibernated Shoot. It is sent to the `shoots/wakeup` subresource which disables the hibernation of the Shoot and records the request as activity.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard object metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(metav1.ObjectMeta{}.OpenAPIModelName()),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			metav1.ObjectMeta{}.OpenAPIModelName()},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_StructuredAuthentication(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -11561,92 +12434,559 @@ func schema_pkg_apis_operations_v1alpha1_BastionStatus(ref common.ReferenceCallb
 	}
 }
 
-func schema_pkg_apis_security_v1alpha1_ContextObject(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_operations_v1alpha1_SeedRebalanceMigration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ContextObject identifies the object the token is requested for.",
+				Description: "SeedRebalanceMigration is a proposed migration of a shoot control plane to another seed.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"shootNamespace": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind of the object the token is requested for. Valid kinds are 'Shoot', 'Seed', etc.",
+							Description: "ShootNamespace is the namespace of the shoot.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"shootName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "API version of the object the token is requested for.",
+							Description: "ShootName is the name of the shoot.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"name": {
+					"sourceSeedName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name of the object the token is requested for.",
+							Description: "SourceSeedName is the name of the seed the shoot control plane is currently running on.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"namespace": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Namespace of the object the token is requested for.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"uid": {
+					"targetSeedName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "UID of the object the token is requested for.",
+							Description: "TargetSeedName is the name of the seed the shoot control plane should be migrated to.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"kind", "apiVersion", "name", "uid"},
+				Required: []string{"shootNamespace", "shootName", "sourceSeedName", "targetSeedName"},
 			},
 		},
 	}
 }
 
-func schema_pkg_apis_security_v1alpha1_CredentialsBinding(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_operations_v1alpha1_SeedRebalanceMigrationStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "CredentialsBinding represents a binding to credentials in the same or another namespace.",
+				Description: "SeedRebalanceMigrationStatus contains the progress of a proposed migration.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"shootNamespace": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "ShootNamespace is the namespace of the shoot.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"shootName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "ShootName is the name of the shoot.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Standard object metadata.",
-							Default:     map[string]interface{}{},
-							Ref:         ref(metav1.ObjectMeta{}.OpenAPIModelName()),
+							Description: "Phase is the phase of the migration.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"provider": {
+					"message": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Provider defines the provider type of the CredentialsBinding. This field is immutable.",
-							Default:     map[string]interface{}{},
-							Ref:         ref(securityv1alpha1.CredentialsBindingProvider{}.OpenAPIModelName()),
+							Description: "Message is a human-readable message explaining the phase of the migration.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastTransitionTime is the last time the phase of the migration changed.",
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
+						},
+					},
+				},
+				Required: []string{"shootNamespace", "shootName", "phase"},
+			},
+		},
+		Dependencies: []string{
+			metav1.Time{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_operations_v1alpha1_SeedRebalanceProposal(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SeedRebalanceProposal holds a set of shoot control plane migrations which would even out the load across the seeds.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard object metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(metav1.ObjectMeta{}.OpenAPIModelName()),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specification of the SeedRebalanceProposal.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(operationsv1alpha1.SeedRebalanceProposalSpec{}.OpenAPIModelName()),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Most recently observed status of the SeedRebalanceProposal.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(operationsv1alpha1.SeedRebalanceProposalStatus{}.OpenAPIModelName()),
+						},
+					},
+				},
+				Required: []string{"metadata", "spec"},
+			},
+		},
+		Dependencies: []string{
+			operationsv1alpha1.SeedRebalanceProposalSpec{}.OpenAPIModelName(), operationsv1alpha1.SeedRebalanceProposalStatus{}.OpenAPIModelName(), metav1.ObjectMeta{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_operations_v1alpha1_SeedRebalanceProposalList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SeedRebalanceProposalList is a list of SeedRebalanceProposal objects.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard list object metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(metav1.ListMeta{}.OpenAPIModelName()),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Items is the list of SeedRebalanceProposals.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(operationsv1alpha1.SeedRebalanceProposal{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			operationsv1alpha1.SeedRebalanceProposal{}.OpenAPIModelName(), metav1.ListMeta{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_operations_v1alpha1_SeedRebalanceProposalSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SeedRebalanceProposalSpec is the specification of a SeedRebalanceProposal.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"migrations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Migrations is the list of proposed shoot control plane migrations. This field is immutable.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(operationsv1alpha1.SeedRebalanceMigration{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"approved": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Approved states whether the proposal was approved by an operator. Once approved, the migrations are executed and the approval cannot be revoked anymore.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"migrations"},
+			},
+		},
+		Dependencies: []string{
+			operationsv1alpha1.SeedRebalanceMigration{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_operations_v1alpha1_SeedRebalanceProposalStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SeedRebalanceProposalStatus holds the most recently observed status of the SeedRebalanceProposal.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase is the phase of the SeedRebalanceProposal.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"migrations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Migrations contains the progress of the individual migrations.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(operationsv1alpha1.SeedRebalanceMigrationStatus{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the most recent generation observed for this SeedRebalanceProposal. It corresponds to the SeedRebalanceProposal's generation, which is updated on mutation by the API Server.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			operationsv1alpha1.SeedRebalanceMigrationStatus{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_operations_v1alpha1_ShootRestore(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootRestore holds details about a restoration of a shoot's etcd from the snapshots in its BackupEntry.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard object metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(metav1.ObjectMeta{}.OpenAPIModelName()),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specification of the ShootRestore.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(operationsv1alpha1.ShootRestoreSpec{}.OpenAPIModelName()),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Most recently observed status of the ShootRestore.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(operationsv1alpha1.ShootRestoreStatus{}.OpenAPIModelName()),
+						},
+					},
+				},
+				Required: []string{"metadata", "spec"},
+			},
+		},
+		Dependencies: []string{
+			operationsv1alpha1.ShootRestoreSpec{}.OpenAPIModelName(), operationsv1alpha1.ShootRestoreStatus{}.OpenAPIModelName(), metav1.ObjectMeta{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_operations_v1alpha1_ShootRestoreList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootRestoreList is a list of ShootRestore objects.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard list object metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(metav1.ListMeta{}.OpenAPIModelName()),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Items is the list of ShootRestores.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(operationsv1alpha1.ShootRestore{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			operationsv1alpha1.ShootRestore{}.OpenAPIModelName(), metav1.ListMeta{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_operations_v1alpha1_ShootRestoreSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootRestoreSpec is the specification of a ShootRestore.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"shootRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ShootRef defines the shoot whose etcd shall be restored. This field is immutable.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(corev1.LocalObjectReference{}.OpenAPIModelName()),
+						},
+					},
+					"seedName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SeedName is the name of the seed the control plane of the shoot is running on. This field is populated when the ShootRestore is created.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"targetTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetTime is the point in time whose state of the etcd shall be restored. Only the snapshots which were taken at or before this time are restored. This field is immutable.",
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
+						},
+					},
+				},
+				Required: []string{"shootRef", "targetTime"},
+			},
+		},
+		Dependencies: []string{
+			corev1.LocalObjectReference{}.OpenAPIModelName(), metav1.Time{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_operations_v1alpha1_ShootRestoreStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootRestoreStatus holds the most recently observed status of the ShootRestore.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase is the phase of the ShootRestore.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message is a human-readable message explaining the phase of the ShootRestore.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"startTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StartTime is the time when the restoration was started.",
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
+						},
+					},
+					"completionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CompletionTime is the time when the restoration succeeded or failed.",
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
+						},
+					},
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the most recent generation observed for this ShootRestore. It corresponds to the ShootRestore's generation, which is updated on mutation by the API Server.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			metav1.Time{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_security_v1alpha1_ContextObject(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ContextObject identifies the object the token is requested for.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind of the object the token is requested for. Valid kinds are 'Shoot', 'Seed', etc.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "API version of the object the token is requested for.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the object the token is requested for.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace of the object the token is requested for.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"uid": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UID of the object the token is requested for.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"kind", "apiVersion", "name", "uid"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_security_v1alpha1_CredentialsBinding(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CredentialsBinding represents a binding to credentials in the same or another namespace.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard object metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(metav1.ObjectMeta{}.OpenAPIModelName()),
+						},
+					},
+					"provider": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Provider defines the provider type of the CredentialsBinding. This field is immutable.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(securityv1alpha1.CredentialsBindingProvider{}.OpenAPIModelName()),
 						},
 					},
 					"credentialsRef": {
@@ -12856,14 +14196,14 @@ func schema_pkg_apis_seedmanagement_v1alpha1_ManagedSeedSetSpec(ref common.Refer
 					},
 					"template": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Template describes the ManagedSeed that will be created if insufficient replicas are detected. Each ManagedSeed created / updated by the ManagedSeedSet will fulfill this template.",
+							Description: "Template describes the ManagedSeed that will be created if insufficient replicas are detected. Each ManagedSeed created / updated by the ManagedSeedSet will fulfill this template. Changes of the template are rolled out to the existing ManagedSeeds according to the UpdateStrategy.",
 							Default:     map[string]interface{}{},
 							Ref:         ref(seedmanagementv1alpha1.ManagedSeedTemplate{}.OpenAPIModelName()),
 						},
 					},
 					"shootTemplate": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ShootTemplate describes the Shoot that will be created if insufficient replicas are detected for hosting the corresponding ManagedSeed. Each Shoot created / updated by the ManagedSeedSet will fulfill this template.",
+							Description: "ShootTemplate describes the Shoot that will be created if insufficient replicas are detected for hosting the corresponding ManagedSeed. Each Shoot created / updated by the ManagedSeedSet will fulfill this template. Changes of the shoot template are only applied to the Shoots of new replicas.",
 							Default:     map[string]interface{}{},
 							Ref:         ref(v1beta1.ShootTemplate{}.OpenAPIModelName()),
 						},
@@ -13141,6 +14481,27 @@ func schema_pkg_apis_seedmanagement_v1alpha1_PendingReplica(ref common.Reference
 	}
 }
 
+func schema_pkg_apis_seedmanagement_v1alpha1_RollingUpdateHealthGate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RollingUpdateHealthGate configures the health gating between the replicas of a rolling update.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"soakDuration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SoakDuration is the duration for which the seed of an updated replica must be ready before the next replicas are updated. Defaults to 10m.",
+							Ref:         ref(metav1.Duration{}.OpenAPIModelName()),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			metav1.Duration{}.OpenAPIModelName()},
+	}
+}
+
 func schema_pkg_apis_seedmanagement_v1alpha1_RollingUpdateStrategy(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -13155,9 +14516,24 @@ func schema_pkg_apis_seedmanagement_v1alpha1_RollingUpdateStrategy(ref common.Re
 							Format:      "int32",
 						},
 					},
+					"maxUnavailable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxUnavailable is the maximum number of replicas that are updated at the same time. Defaults to 1.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"healthGate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HealthGate, if set, makes the controller wait for the seeds of already updated replicas to stay healthy for a soak period before it continues with the next replicas.",
+							Ref:         ref(seedmanagementv1alpha1.RollingUpdateHealthGate{}.OpenAPIModelName()),
+						},
+					},
 				},
 			},
 		},
+		Dependencies: []string{
+			seedmanagementv1alpha1.RollingUpdateHealthGate{}.OpenAPIModelName()},
 	}
 }
 
@@ -34,6 +34,7 @@ import (
 	shoottolerationrestriction "github.com/gardener/gardener/plugin/pkg/shoot/tolerationrestriction"
 	shootvalidator "github.com/gardener/gardener/plugin/pkg/shoot/validator"
 	shootvpa "github.com/gardener/gardener/plugin/pkg/shoot/vpa"
+	shootrestorevalidator "github.com/gardener/gardener/plugin/pkg/shootrestore/validator"
 )
 
 // RegisterAllAdmissionPlugins registers all admission plugins.
@@ -62,6 +63,7 @@ func RegisterAllAdmissionPlugins(plugins *admission.Plugins) {
 	managedseedvalidator.Register(plugins)
 	managedseedshoot.Register(plugins)
 	bastionvalidator.Register(plugins)
+	shootrestorevalidator.Register(plugins)
 	resourcequota.Register(plugins)
 	shootvpa.Register(plugins)
 	shootresourcereservation.Register(plugins)
@@ -5,6 +5,7 @@
 package rest
 
 import (
+	"fmt"
 	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -15,6 +16,7 @@ import (
 	clientauthorizationv1 "k8s.io/client-go/kubernetes/typed/authorization/v1"
 
 	"github.com/gardener/gardener/pkg/api"
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/scheduler/v1alpha1"
 	"github.com/gardener/gardener/pkg/apis/core"
 	gardencorev1 "github.com/gardener/gardener/pkg/apis/core/v1"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
@@ -34,6 +36,7 @@ import (
 	shootstore "github.com/gardener/gardener/pkg/apiserver/registry/core/shoot/storage"
 	shootstatestore "github.com/gardener/gardener/pkg/apiserver/registry/core/shootstate/storage"
 	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/externalversions"
+	shootcontroller "github.com/gardener/gardener/pkg/scheduler/controller/shoot"
 )
 
 // StorageProvider contains configurations related to the core resources.
@@ -44,6 +47,7 @@ type StorageProvider struct {
 	KubeInformerFactory           kubeinformers.SharedInformerFactory
 	CoreInformerFactory           gardencoreinformers.SharedInformerFactory
 	SubjectAccessReviewer         clientauthorizationv1.SubjectAccessReviewInterface
+	ShootSchedulerConfig          *schedulerconfigv1alpha1.ShootSchedulerConfiguration
 }
 
 // NewRESTStorage creates a new API group info object and registers the v1beta1 core storage.
@@ -119,11 +123,26 @@ func (p StorageProvider) v1beta1Storage(restOptionsGetter generic.RESTOptionsGet
 	shootStateStorage := shootstatestore.NewStorage(restOptionsGetter)
 	storage["shootstates"] = shootStateStorage.ShootState
 
+	shootSchedulerConfig := p.ShootSchedulerConfig
+	if shootSchedulerConfig == nil {
+		shootSchedulerConfig = &schedulerconfigv1alpha1.ShootSchedulerConfiguration{Strategy: schedulerconfigv1alpha1.Default}
+	}
+	shootSchedulerFramework, err := shootcontroller.NewFramework(shootSchedulerConfig)
+	if err != nil {
+		panic(fmt.Errorf("failed creating shoot scheduler framework: %w", err))
+	}
+
 	shootStorage := shootstore.NewStorage(
 		restOptionsGetter,
 		p.CoreInformerFactory.Core().V1beta1().InternalSecrets().Lister(),
 		p.KubeInformerFactory.Core().V1().Secrets().Lister(),
 		p.KubeInformerFactory.Core().V1().ConfigMaps().Lister(),
+		p.CoreInformerFactory.Core().V1beta1().Seeds().Lister(),
+		p.CoreInformerFactory.Core().V1beta1().Shoots().Lister(),
+		p.CoreInformerFactory.Core().V1beta1().CloudProfiles().Lister(),
+		p.CoreInformerFactory.Core().V1beta1().NamespacedCloudProfiles().Lister(),
+		p.CoreInformerFactory.Core().V1beta1().Projects().Lister(),
+		shootSchedulerFramework,
 		p.AdminKubeconfigMaxExpiration,
 		p.ViewerKubeconfigMaxExpiration,
 		p.CredentialsRotationInterval,
@@ -134,6 +153,8 @@ func (p StorageProvider) v1beta1Storage(restOptionsGetter generic.RESTOptionsGet
 	storage["shoots/binding"] = shootStorage.Binding
 	storage["shoots/adminkubeconfig"] = shootStorage.AdminKubeconfig
 	storage["shoots/viewerkubeconfig"] = shootStorage.ViewerKubeconfig
+	storage["shoots/schedulingexplanation"] = shootStorage.SchedulingExplanation
+	storage["shoots/wakeup"] = shootStorage.WakeUp
 
 	return storage
 }
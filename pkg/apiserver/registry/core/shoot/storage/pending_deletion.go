@@ -0,0 +1,144 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/utils/clock"
+
+	gardencorehelper "github.com/gardener/gardener/pkg/api/core/helper"
+	"github.com/gardener/gardener/pkg/apis/core"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	gardencorev1beta1listers "github.com/gardener/gardener/pkg/client/core/listers/core/v1beta1"
+	admissionutils "github.com/gardener/gardener/plugin/pkg/utils"
+)
+
+type getterDeleter interface {
+	rest.Getter
+	rest.GracefulDeleter
+}
+
+// pendingDeletion delays the deletion of shoots in projects which configure a grace period for shoot deletions. Such
+// shoots are hibernated and marked as pending deletion instead of being deleted. They are only deleted when they are
+// deleted again after the grace period has passed.
+type pendingDeletion struct {
+	store         getterDeleter
+	updater       rest.Updater
+	projectLister gardencorev1beta1listers.ProjectLister
+	clock         clock.Clock
+}
+
+// Delete deletes the shoot with the given name or marks it as pending deletion.
+func (p *pendingDeletion) Delete(ctx context.Context, name string, deleteValidation rest.ValidateObjectFunc, options *metav1.DeleteOptions) (runtime.Object, bool, error) {
+	if options == nil {
+		options = &metav1.DeleteOptions{}
+	}
+
+	obj, err := p.store.Get(ctx, name, &metav1.GetOptions{})
+	if err != nil {
+		return nil, false, err
+	}
+	shoot, ok := obj.(*core.Shoot)
+	if !ok {
+		return nil, false, fmt.Errorf("object is not a shoot")
+	}
+
+	gracePeriod, err := p.gracePeriod(shoot)
+	if err != nil {
+		return nil, false, err
+	}
+	if gracePeriod == 0 {
+		return p.store.Delete(ctx, name, deleteValidation, options)
+	}
+
+	if gardencorehelper.IsShootPendingDeletion(shoot) {
+		until, err := time.Parse(time.RFC3339, shoot.Annotations[v1beta1constants.ShootPendingDeletionUntil])
+		if err != nil || !p.clock.Now().Before(until) {
+			return p.store.Delete(ctx, name, deleteValidation, options)
+		}
+		// The shoot is already pending deletion, i.e., there is nothing to do until its grace period has passed.
+		return shoot, false, nil
+	}
+
+	if err := deleteValidation(ctx, shoot); err != nil {
+		return nil, false, err
+	}
+
+	until := p.clock.Now().Add(gracePeriod).UTC()
+	obj, _, err = p.updater.Update(ctx, name, rest.DefaultUpdatedObjectInfo(nil, func(_ context.Context, _, oldObj runtime.Object) (runtime.Object, error) {
+		shoot := oldObj.(*core.Shoot).DeepCopy()
+		if err := checkPreconditions(shoot, options.Preconditions); err != nil {
+			return nil, err
+		}
+		markShootPendingDeletion(shoot, until)
+		return shoot, nil
+	}), rest.ValidateAllObjectFunc, rest.ValidateAllObjectUpdateFunc, false, &metav1.UpdateOptions{DryRun: options.DryRun})
+	if err != nil {
+		return nil, false, err
+	}
+
+	return obj, false, nil
+}
+
+// gracePeriod returns the grace period for the deletion of the given shoot. It is zero if the shoot must be deleted
+// right away.
+func (p *pendingDeletion) gracePeriod(shoot *core.Shoot) (time.Duration, error) {
+	if shoot.DeletionTimestamp != nil ||
+		// Shoots which have not been reconciled yet do not have any resources which could be kept.
+		shoot.Status.LastOperation == nil ||
+		gardencorehelper.IsShootSelfHosted(shoot.Spec.Provider.Workers) ||
+		metav1.HasAnnotation(shoot.ObjectMeta, v1beta1constants.ShootExported) {
+		return 0, nil
+	}
+
+	project, err := admissionutils.ProjectForNamespaceFromLister(p.projectLister, shoot.Namespace)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return 0, nil
+		}
+		return 0, apierrors.NewInternalError(fmt.Errorf("failed determining project for namespace %q: %w", shoot.Namespace, err))
+	}
+
+	if project.Spec.ShootDeletion == nil {
+		return 0, nil
+	}
+	return project.Spec.ShootDeletion.GracePeriod.Duration, nil
+}
+
+func checkPreconditions(shoot *core.Shoot, preconditions *metav1.Preconditions) error {
+	if preconditions == nil {
+		return nil
+	}
+	if preconditions.UID != nil && *preconditions.UID != shoot.UID {
+		return apierrors.NewConflict(core.Resource("shoots"), shoot.Name, fmt.Errorf("precondition failed: UID in precondition: %v, UID in object meta: %v", *preconditions.UID, shoot.UID))
+	}
+	if preconditions.ResourceVersion != nil && *preconditions.ResourceVersion != shoot.ResourceVersion {
+		return apierrors.NewConflict(core.Resource("shoots"), shoot.Name, fmt.Errorf("precondition failed: ResourceVersion in precondition: %v, ResourceVersion in object meta: %v", *preconditions.ResourceVersion, shoot.ResourceVersion))
+	}
+	return nil
+}
+
+// markShootPendingDeletion marks the given shoot as pending deletion until the given time and hibernates it. If the
+// shoot was not hibernated before, it is annotated so that the `undelete` operation wakes it up again.
+func markShootPendingDeletion(shoot *core.Shoot, until time.Time) {
+	metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.ShootPendingDeletionUntil, until.Format(time.RFC3339))
+
+	if gardencorehelper.HibernationIsEnabled(shoot) {
+		return
+	}
+
+	metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.ShootHibernatedForDeletion, "true")
+	if shoot.Spec.Hibernation == nil {
+		shoot.Spec.Hibernation = &core.Hibernation{}
+	}
+	shoot.Spec.Hibernation.Enabled = new(true)
+}
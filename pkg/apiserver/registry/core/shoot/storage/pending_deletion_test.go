@@ -0,0 +1,195 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/rest"
+	testclock "k8s.io/utils/clock/testing"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/externalversions"
+)
+
+var _ = Describe("PendingDeletion", func() {
+	var (
+		ctx = context.TODO()
+
+		namespace = "garden-dev"
+		shootName = "foo"
+		now       = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+		coreInformerFactory gardencoreinformers.SharedInformerFactory
+		project             *gardencorev1beta1.Project
+		shoot               *core.Shoot
+		store               *fakeStore
+
+		deleteValidationCalled bool
+		deleteValidation       rest.ValidateObjectFunc
+
+		p *pendingDeletion
+	)
+
+	BeforeEach(func() {
+		coreInformerFactory = gardencoreinformers.NewSharedInformerFactory(nil, 0)
+
+		project = &gardencorev1beta1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: "dev"},
+			Spec: gardencorev1beta1.ProjectSpec{
+				Namespace:     &namespace,
+				ShootDeletion: &gardencorev1beta1.ProjectShootDeletion{GracePeriod: metav1.Duration{Duration: 72 * time.Hour}},
+			},
+		}
+		Expect(coreInformerFactory.Core().V1beta1().Projects().Informer().GetStore().Add(project)).To(Succeed())
+
+		shoot = &core.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: shootName, Namespace: namespace, UID: "uid", ResourceVersion: "1"},
+			Status: core.ShootStatus{
+				LastOperation: &core.LastOperation{Type: core.LastOperationTypeReconcile, State: core.LastOperationStateSucceeded},
+			},
+		}
+		store = &fakeStore{obj: shoot}
+
+		deleteValidationCalled = false
+		deleteValidation = func(_ context.Context, _ runtime.Object) error {
+			deleteValidationCalled = true
+			return nil
+		}
+
+		p = &pendingDeletion{
+			store:         store,
+			updater:       store,
+			projectLister: coreInformerFactory.Core().V1beta1().Projects().Lister(),
+			clock:         testclock.NewFakeClock(now),
+		}
+	})
+
+	Describe("#Delete", func() {
+		It("should hibernate the shoot and mark it as pending deletion", func() {
+			obj, deleted, err := p.Delete(ctx, shootName, deleteValidation, &metav1.DeleteOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(deleted).To(BeFalse())
+			Expect(deleteValidationCalled).To(BeTrue())
+			Expect(store.deleted).To(BeFalse())
+
+			updatedShoot := obj.(*core.Shoot)
+			Expect(updatedShoot.Annotations).To(And(
+				HaveKeyWithValue(v1beta1constants.ShootPendingDeletionUntil, "2026-01-04T00:00:00Z"),
+				HaveKeyWithValue(v1beta1constants.ShootHibernatedForDeletion, "true"),
+			))
+			Expect(updatedShoot.Spec.Hibernation.Enabled).To(PointTo(BeTrue()))
+		})
+
+		It("should not mark the shoot to be woken up if it was already hibernated", func() {
+			shoot.Spec.Hibernation = &core.Hibernation{Enabled: new(true)}
+
+			obj, _, err := p.Delete(ctx, shootName, deleteValidation, &metav1.DeleteOptions{})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(obj.(*core.Shoot).Annotations).To(And(
+				HaveKey(v1beta1constants.ShootPendingDeletionUntil),
+				Not(HaveKey(v1beta1constants.ShootHibernatedForDeletion)),
+			))
+		})
+
+		It("should not mark the shoot as pending deletion if the deletion is not confirmed", func() {
+			deleteValidation = func(_ context.Context, _ runtime.Object) error {
+				return fmt.Errorf("deletion is not confirmed")
+			}
+
+			_, _, err := p.Delete(ctx, shootName, deleteValidation, &metav1.DeleteOptions{})
+			Expect(err).To(MatchError("deletion is not confirmed"))
+			Expect(store.updated).To(BeFalse())
+		})
+
+		It("should fail if the preconditions are not met", func() {
+			_, _, err := p.Delete(ctx, shootName, deleteValidation, &metav1.DeleteOptions{Preconditions: metav1.NewUIDPreconditions("other")})
+			Expect(apierrors.IsConflict(err)).To(BeTrue())
+		})
+
+		It("should not change a shoot which is already pending deletion", func() {
+			metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.ShootPendingDeletionUntil, "2026-01-02T00:00:00Z")
+
+			obj, deleted, err := p.Delete(ctx, shootName, deleteValidation, &metav1.DeleteOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(deleted).To(BeFalse())
+			Expect(obj).To(Equal(shoot))
+			Expect(store.updated).To(BeFalse())
+			Expect(store.deleted).To(BeFalse())
+		})
+
+		It("should delete the shoot if its grace period has passed", func() {
+			metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.ShootPendingDeletionUntil, "2026-01-01T00:00:00Z")
+
+			_, _, err := p.Delete(ctx, shootName, deleteValidation, &metav1.DeleteOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(store.deleted).To(BeTrue())
+		})
+
+		It("should delete the shoot if the project does not configure a grace period", func() {
+			project.Spec.ShootDeletion = nil
+
+			_, _, err := p.Delete(ctx, shootName, deleteValidation, &metav1.DeleteOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(store.deleted).To(BeTrue())
+			Expect(store.updated).To(BeFalse())
+		})
+
+		It("should delete the shoot if it was not reconciled yet", func() {
+			shoot.Status.LastOperation = nil
+
+			_, _, err := p.Delete(ctx, shootName, deleteValidation, &metav1.DeleteOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(store.deleted).To(BeTrue())
+		})
+
+		It("should delete the shoot if it was exported", func() {
+			metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.ShootExported, "true")
+
+			_, _, err := p.Delete(ctx, shootName, deleteValidation, &metav1.DeleteOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(store.deleted).To(BeTrue())
+		})
+	})
+})
+
+type fakeStore struct {
+	obj     *core.Shoot
+	updated bool
+	deleted bool
+}
+
+func (f *fakeStore) New() runtime.Object {
+	return &core.Shoot{}
+}
+
+func (f *fakeStore) Get(_ context.Context, _ string, _ *metav1.GetOptions) (runtime.Object, error) {
+	return f.obj, nil
+}
+
+func (f *fakeStore) Delete(_ context.Context, _ string, _ rest.ValidateObjectFunc, _ *metav1.DeleteOptions) (runtime.Object, bool, error) {
+	f.deleted = true
+	return f.obj, true, nil
+}
+
+func (f *fakeStore) Update(ctx context.Context, _ string, objInfo rest.UpdatedObjectInfo, _ rest.ValidateObjectFunc, _ rest.ValidateObjectUpdateFunc, _ bool, _ *metav1.UpdateOptions) (runtime.Object, bool, error) {
+	obj, err := objInfo.UpdatedObject(ctx, f.obj)
+	if err != nil {
+		return nil, false, err
+	}
+	f.updated = true
+	return obj, false, nil
+}
@@ -0,0 +1,241 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/registry/rest"
+	kubecorev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener/pkg/api"
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	gardencorev1beta1listers "github.com/gardener/gardener/pkg/client/core/listers/core/v1beta1"
+	shootcontroller "github.com/gardener/gardener/pkg/scheduler/controller/shoot"
+	"github.com/gardener/gardener/pkg/scheduler/framework"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	admissionutils "github.com/gardener/gardener/plugin/pkg/utils"
+)
+
+// SchedulingExplanationREST implements the REST endpoint which explains the scheduling decision for a shoot.
+type SchedulingExplanationREST struct {
+	shootStorage                 getter
+	seedLister                   gardencorev1beta1listers.SeedLister
+	shootLister                  gardencorev1beta1listers.ShootLister
+	cloudProfileLister           gardencorev1beta1listers.CloudProfileLister
+	namespacedCloudProfileLister gardencorev1beta1listers.NamespacedCloudProfileLister
+	projectLister                gardencorev1beta1listers.ProjectLister
+	configMapLister              kubecorev1listers.ConfigMapLister
+	framework                    *framework.Framework
+}
+
+var (
+	_ = rest.Getter(&SchedulingExplanationREST{})
+	_ = rest.GroupVersionKindProvider(&SchedulingExplanationREST{})
+)
+
+// NewSchedulingExplanationREST returns a new SchedulingExplanationREST. The given framework must be configured like the
+// one of the gardener-scheduler, otherwise the explanation does not match the actual scheduling decision.
+func NewSchedulingExplanationREST(
+	shootGetter getter,
+	seedLister gardencorev1beta1listers.SeedLister,
+	shootLister gardencorev1beta1listers.ShootLister,
+	cloudProfileLister gardencorev1beta1listers.CloudProfileLister,
+	namespacedCloudProfileLister gardencorev1beta1listers.NamespacedCloudProfileLister,
+	projectLister gardencorev1beta1listers.ProjectLister,
+	configMapLister kubecorev1listers.ConfigMapLister,
+	fw *framework.Framework,
+) *SchedulingExplanationREST {
+	return &SchedulingExplanationREST{
+		shootStorage:                 shootGetter,
+		seedLister:                   seedLister,
+		shootLister:                  shootLister,
+		cloudProfileLister:           cloudProfileLister,
+		namespacedCloudProfileLister: namespacedCloudProfileLister,
+		projectLister:                projectLister,
+		configMapLister:              configMapLister,
+		framework:                    fw,
+	}
+}
+
+// New returns an instance of the object.
+func (r *SchedulingExplanationREST) New() runtime.Object {
+	return &core.SchedulingExplanation{}
+}
+
+// Destroy cleans up its resources on shutdown.
+func (r *SchedulingExplanationREST) Destroy() {
+	// Given that underlying store is shared with REST, we don't destroy it here explicitly.
+}
+
+// GroupVersionKind returns the GVK of the scheduling explanation.
+func (r *SchedulingExplanationREST) GroupVersionKind(schema.GroupVersion) schema.GroupVersionKind {
+	return gardencorev1beta1.SchemeGroupVersion.WithKind("SchedulingExplanation")
+}
+
+// Get runs the scheduling pipeline for the shoot with the given name and returns the decisions of all plugins. The
+// shoot itself is not taken into account when computing the seed usage, hence the result also explains the decision
+// for shoots which are already scheduled.
+func (r *SchedulingExplanationREST) Get(ctx context.Context, name string, _ *metav1.GetOptions) (runtime.Object, error) {
+	shootObj, err := r.shootStorage.Get(ctx, name, &metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	shoot, ok := shootObj.(*core.Shoot)
+	if !ok {
+		return nil, apierrors.NewInternalError(fmt.Errorf("cannot convert to *core.Shoot object - got type %T", shootObj))
+	}
+
+	state, seeds, err := r.newCycleState(shoot)
+	if err != nil {
+		return nil, apierrors.NewInternalError(err)
+	}
+
+	shootV1beta1 := &gardencorev1beta1.Shoot{}
+	if err := api.Scheme.Convert(shoot, shootV1beta1, nil); err != nil {
+		return nil, apierrors.NewInternalError(fmt.Errorf("failed converting %T to %T: %w", shoot, shootV1beta1, err))
+	}
+
+	explanation := &core.SchedulingExplanation{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              shoot.Name,
+			Namespace:         shoot.Namespace,
+			CreationTimestamp: metav1.Now(),
+		},
+		Status: core.SchedulingExplanationStatus{
+			SeedName: shoot.Spec.SeedName,
+		},
+	}
+
+	if _, err := r.framework.Schedule(ctx, state, shootV1beta1, seeds); err != nil {
+		explanation.Status.Message = ptr.To(err.Error())
+	}
+
+	if state.Diagnosis.SelectedSeed != "" {
+		explanation.Status.SelectedSeedName = ptr.To(state.Diagnosis.SelectedSeed)
+	}
+
+	for _, seed := range state.Diagnosis.Seeds {
+		explanation.Status.Seeds = append(explanation.Status.Seeds, seedSchedulingResult(seed))
+	}
+
+	return explanation, nil
+}
+
+func (r *SchedulingExplanationREST) newCycleState(shoot *core.Shoot) (*framework.CycleState, []gardencorev1beta1.Seed, error) {
+	seedList, err := r.seedLister.List(labels.Everything())
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed listing seeds: %w", err)
+	}
+
+	seeds := make([]gardencorev1beta1.Seed, 0, len(seedList))
+	for _, seed := range seedList {
+		seeds = append(seeds, *seed)
+	}
+
+	shootList, err := r.shootLister.List(labels.Everything())
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed listing shoots: %w", err)
+	}
+
+	shoots := make([]*gardencorev1beta1.Shoot, 0, len(shootList))
+	for _, s := range shootList {
+		if s.Namespace == shoot.Namespace && s.Name == shoot.Name {
+			continue
+		}
+		shoots = append(shoots, s)
+	}
+
+	cloudProfile, err := r.getCloudProfile(shoot)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	project, err := admissionutils.ProjectForNamespaceFromLister(r.projectLister, shoot.Namespace)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed determining project for namespace %q: %w", shoot.Namespace, err)
+	}
+
+	regionConfigs, err := r.configMapLister.ConfigMaps(v1beta1constants.GardenNamespace).List(labels.SelectorFromSet(labels.Set{v1beta1constants.SchedulingPurpose: v1beta1constants.SchedulingPurposeRegionConfig}))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed listing scheduler region configs: %w", err)
+	}
+
+	configMaps := make([]corev1.ConfigMap, 0, len(regionConfigs))
+	for _, configMap := range regionConfigs {
+		configMaps = append(configMaps, *configMap)
+	}
+
+	return &framework.CycleState{
+		Log:          logr.Discard(),
+		CloudProfile: cloudProfile,
+		Project:      project,
+		RegionConfig: shootcontroller.FindRegionConfig(logr.Discard(), configMaps, cloudProfile.Name),
+		Shoots:       shoots,
+		SeedUsage:    v1beta1helper.CalculateSeedUsage(shoots),
+		Diagnosis:    &framework.Diagnosis{},
+	}, seeds, nil
+}
+
+func (r *SchedulingExplanationREST) getCloudProfile(shoot *core.Shoot) (*gardencorev1beta1.CloudProfile, error) {
+	cloudProfileReference := gardenerutils.BuildCoreCloudProfileReference(shoot)
+	if cloudProfileReference == nil {
+		return nil, fmt.Errorf("could not determine cloudprofile from shoot")
+	}
+
+	cloudProfileSpec, err := gardenerutils.GetCloudProfileSpec(r.cloudProfileLister, r.namespacedCloudProfileLister, shoot)
+	if err != nil {
+		return nil, fmt.Errorf("failed getting cloudprofile %q: %w", cloudProfileReference.Name, err)
+	}
+
+	cloudProfile := &gardencorev1beta1.CloudProfile{
+		ObjectMeta: metav1.ObjectMeta{Name: cloudProfileReference.Name},
+		Spec:       *cloudProfileSpec,
+	}
+	if cloudProfileReference.Kind == v1beta1constants.CloudProfileReferenceKindNamespacedCloudProfile {
+		cloudProfile.Namespace = shoot.Namespace
+	}
+
+	return cloudProfile, nil
+}
+
+func seedSchedulingResult(seed framework.SeedDiagnosis) core.SeedSchedulingResult {
+	result := core.SeedSchedulingResult{Name: seed.Name}
+
+	if seed.RejectedBy != "" {
+		result.RejectedBy = ptr.To(seed.RejectedBy)
+		result.Reason = ptr.To(seed.Reason)
+		return result
+	}
+
+	if seed.Scores == nil {
+		// The seed passed all filters, but the scheduling failed before the score phase completed.
+		return result
+	}
+
+	for _, score := range seed.Scores {
+		result.Scores = append(result.Scores, core.SchedulingPluginScore{
+			Name:   score.Name,
+			Weight: int32(score.Weight), // #nosec G115 -- Weights are configured as int32.
+			Score:  score.Score,
+		})
+	}
+	result.TotalScore = ptr.To(seed.TotalScore)
+
+	return result
+}
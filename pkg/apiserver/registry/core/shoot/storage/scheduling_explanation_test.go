@@ -0,0 +1,176 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubeinformers "k8s.io/client-go/informers"
+	"k8s.io/utils/ptr"
+
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/scheduler/v1alpha1"
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/externalversions"
+	shootcontroller "github.com/gardener/gardener/pkg/scheduler/controller/shoot"
+)
+
+var _ = Describe("SchedulingExplanation", func() {
+	var (
+		ctx = context.TODO()
+
+		namespace = "garden-dev"
+		shootName = "foo"
+
+		coreInformerFactory gardencoreinformers.SharedInformerFactory
+		kubeInformerFactory kubeinformers.SharedInformerFactory
+		shootGetter         *fakeGetter
+		shoot               *core.Shoot
+
+		rest *SchedulingExplanationREST
+	)
+
+	newSeed := func(name, region string, visible bool) *gardencorev1beta1.Seed {
+		return &gardencorev1beta1.Seed{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: gardencorev1beta1.SeedSpec{
+				Provider: gardencorev1beta1.SeedProvider{Type: "local", Region: region},
+				Settings: &gardencorev1beta1.SeedSettings{Scheduling: &gardencorev1beta1.SeedSettingScheduling{Visible: visible}},
+			},
+			Status: gardencorev1beta1.SeedStatus{
+				LastOperation: &gardencorev1beta1.LastOperation{},
+				Conditions:    []gardencorev1beta1.Condition{{Type: gardencorev1beta1.GardenletReady, Status: gardencorev1beta1.ConditionTrue}},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		coreInformerFactory = gardencoreinformers.NewSharedInformerFactory(nil, 0)
+		kubeInformerFactory = kubeinformers.NewSharedInformerFactory(nil, 0)
+
+		shoot = &core.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: shootName, Namespace: namespace},
+			Spec: core.ShootSpec{
+				CloudProfileName: ptr.To("profile"),
+				Provider:         core.Provider{Type: "local"},
+				Region:           "region-1",
+				SeedName:         ptr.To("seed-1"),
+			},
+		}
+		shootGetter = &fakeGetter{obj: shoot}
+
+		Expect(coreInformerFactory.Core().V1beta1().CloudProfiles().Informer().GetStore().Add(&gardencorev1beta1.CloudProfile{
+			ObjectMeta: metav1.ObjectMeta{Name: "profile"},
+		})).To(Succeed())
+		Expect(coreInformerFactory.Core().V1beta1().Projects().Informer().GetStore().Add(&gardencorev1beta1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: "dev"},
+			Spec:       gardencorev1beta1.ProjectSpec{Namespace: &namespace},
+		})).To(Succeed())
+		// The shoot itself must not be taken into account for the capacity of its current seed.
+		Expect(coreInformerFactory.Core().V1beta1().Shoots().Informer().GetStore().Add(&gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: shootName, Namespace: namespace},
+			Spec:       gardencorev1beta1.ShootSpec{SeedName: ptr.To("seed-1")},
+		})).To(Succeed())
+
+		seed1 := newSeed("seed-1", "region-1", true)
+		seed1.Status.Allocatable = gardencorev1beta1.ResourceList{gardencorev1beta1.ResourceShoots: resource.MustParse("1")}
+		seed4 := newSeed("seed-4", "region-1", true)
+		seed4.Status.Allocatable = gardencorev1beta1.ResourceList{gardencorev1beta1.ResourceShoots: resource.MustParse("0")}
+
+		for _, seed := range []*gardencorev1beta1.Seed{seed1, newSeed("seed-2", "region-1", false), newSeed("seed-3", "region-2", true), seed4} {
+			Expect(coreInformerFactory.Core().V1beta1().Seeds().Informer().GetStore().Add(seed)).To(Succeed())
+		}
+
+		fw, err := shootcontroller.NewFramework(&schedulerconfigv1alpha1.ShootSchedulerConfiguration{Strategy: schedulerconfigv1alpha1.SameRegion})
+		Expect(err).NotTo(HaveOccurred())
+
+		rest = NewSchedulingExplanationREST(
+			shootGetter,
+			coreInformerFactory.Core().V1beta1().Seeds().Lister(),
+			coreInformerFactory.Core().V1beta1().Shoots().Lister(),
+			coreInformerFactory.Core().V1beta1().CloudProfiles().Lister(),
+			coreInformerFactory.Core().V1beta1().NamespacedCloudProfiles().Lister(),
+			coreInformerFactory.Core().V1beta1().Projects().Lister(),
+			kubeInformerFactory.Core().V1().ConfigMaps().Lister(),
+			fw,
+		)
+	})
+
+	Describe("#Get", func() {
+		It("should explain the scheduling decision for an already scheduled shoot", func() {
+			obj, err := rest.Get(ctx, shootName, &metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+
+			explanation := obj.(*core.SchedulingExplanation)
+			Expect(explanation.Name).To(Equal(shootName))
+			Expect(explanation.Namespace).To(Equal(namespace))
+			Expect(explanation.Status.SeedName).To(HaveValue(Equal("seed-1")))
+			Expect(explanation.Status.SelectedSeedName).To(HaveValue(Equal("seed-1")))
+			Expect(explanation.Status.Message).To(BeNil())
+			Expect(explanation.Status.Seeds).To(ConsistOf(
+				core.SeedSchedulingResult{
+					Name: "seed-1",
+					Scores: []core.SchedulingPluginScore{
+						{Name: "SeedUtilization", Weight: 2, Score: 0},
+						{Name: "LeastShootsDeployed", Weight: 1, Score: 100},
+					},
+					TotalScore: ptr.To[int64](100),
+				},
+				core.SeedSchedulingResult{
+					Name:       "seed-2",
+					RejectedBy: ptr.To("SeedReadiness"),
+					Reason:     ptr.To("seed was filtered out by plugin SeedReadiness"),
+				},
+				core.SeedSchedulingResult{
+					Name:       "seed-3",
+					RejectedBy: ptr.To("SameRegion"),
+					Reason:     ptr.To("seed was filtered out by plugin SameRegion"),
+				},
+				core.SeedSchedulingResult{
+					Name:       "seed-4",
+					RejectedBy: ptr.To("Candidates"),
+					Reason:     ptr.To("seed does not have available capacity for shoots"),
+				},
+			))
+		})
+
+		It("should explain why no seed could be found", func() {
+			shoot.Spec.Region = "region-3"
+			shoot.Spec.SeedName = nil
+
+			obj, err := rest.Get(ctx, shootName, &metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+
+			explanation := obj.(*core.SchedulingExplanation)
+			Expect(explanation.Status.SeedName).To(BeNil())
+			Expect(explanation.Status.SelectedSeedName).To(BeNil())
+			Expect(explanation.Status.Message).To(HaveValue(ContainSubstring("no matching seed candidate found")))
+			Expect(explanation.Status.Seeds).To(ContainElement(core.SeedSchedulingResult{
+				Name:       "seed-1",
+				RejectedBy: ptr.To("SameRegion"),
+				Reason:     ptr.To("no matching seed candidate found for Configuration (Cloud Profile 'profile', Region 'region-3', SeedDeterminationStrategy 'SameRegion')"),
+			}))
+		})
+
+		It("should return an error if the shoot cannot be read", func() {
+			shootGetter.err = fmt.Errorf("fake")
+
+			_, err := rest.Get(ctx, shootName, &metav1.GetOptions{})
+			Expect(err).To(MatchError("fake"))
+		})
+
+		It("should return an error if the cloud profile does not exist", func() {
+			shoot.Spec.CloudProfileName = ptr.To("other")
+
+			_, err := rest.Get(ctx, shootName, &metav1.GetOptions{})
+			Expect(err).To(MatchError(ContainSubstring(`failed getting cloudprofile "other"`)))
+		})
+	})
+})
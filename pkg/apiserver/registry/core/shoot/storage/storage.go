@@ -8,32 +8,42 @@ import (
 	"context"
 	"time"
 
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metainternalversion "k8s.io/apimachinery/pkg/apis/meta/internalversion"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apiserver/pkg/registry/generic"
 	genericregistry "k8s.io/apiserver/pkg/registry/generic/registry"
 	"k8s.io/apiserver/pkg/registry/rest"
 	"k8s.io/apiserver/pkg/storage"
 	clientauthorizationv1 "k8s.io/client-go/kubernetes/typed/authorization/v1"
 	kubecorev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/utils/clock"
 
 	"github.com/gardener/gardener/pkg/apis/core"
 	"github.com/gardener/gardener/pkg/apiserver/registry/core/shoot"
 	gardencorev1beta1listers "github.com/gardener/gardener/pkg/client/core/listers/core/v1beta1"
+	"github.com/gardener/gardener/pkg/scheduler/framework"
 )
 
 // REST implements a RESTStorage for shoots against etcd
 type REST struct {
 	*genericregistry.Store
+
+	pendingDeletion *pendingDeletion
 }
 
 // ShootStorage implements the storage for Shoots and all their subresources.
 type ShootStorage struct {
-	Shoot            *REST
-	Status           *StatusREST
-	AdminKubeconfig  *KubeconfigREST
-	ViewerKubeconfig *KubeconfigREST
-	Binding          *BindingREST
+	Shoot                 *REST
+	Status                *StatusREST
+	AdminKubeconfig       *KubeconfigREST
+	ViewerKubeconfig      *KubeconfigREST
+	Binding               *BindingREST
+	SchedulingExplanation *SchedulingExplanationREST
+	WakeUp                *WakeUpREST
 }
 
 // NewStorage creates a new ShootStorage object.
@@ -42,19 +52,28 @@ func NewStorage(
 	internalSecretLister gardencorev1beta1listers.InternalSecretLister,
 	secretLister kubecorev1listers.SecretLister,
 	configMapLister kubecorev1listers.ConfigMapLister,
+	seedLister gardencorev1beta1listers.SeedLister,
+	shootLister gardencorev1beta1listers.ShootLister,
+	cloudProfileLister gardencorev1beta1listers.CloudProfileLister,
+	namespacedCloudProfileLister gardencorev1beta1listers.NamespacedCloudProfileLister,
+	projectLister gardencorev1beta1listers.ProjectLister,
+	schedulerFramework *framework.Framework,
 	adminKubeconfigMaxExpiration time.Duration,
 	viewerKubeconfigMaxExpiration time.Duration,
 	credentialsRotationInterval time.Duration,
 	subjectAccessReviewer clientauthorizationv1.SubjectAccessReviewInterface,
 ) ShootStorage {
 	shootRest, shootStatusRest, bindingREST := NewREST(optsGetter, credentialsRotationInterval)
+	shootRest.pendingDeletion.projectLister = projectLister
 
 	return ShootStorage{
-		Shoot:            shootRest,
-		Status:           shootStatusRest,
-		Binding:          bindingREST,
-		AdminKubeconfig:  NewAdminKubeconfigREST(shootRest, secretLister, internalSecretLister, configMapLister, adminKubeconfigMaxExpiration, subjectAccessReviewer),
-		ViewerKubeconfig: NewViewerKubeconfigREST(shootRest, secretLister, internalSecretLister, configMapLister, viewerKubeconfigMaxExpiration, subjectAccessReviewer),
+		Shoot:                 shootRest,
+		Status:                shootStatusRest,
+		Binding:               bindingREST,
+		AdminKubeconfig:       NewAdminKubeconfigREST(shootRest, secretLister, internalSecretLister, configMapLister, adminKubeconfigMaxExpiration, subjectAccessReviewer),
+		ViewerKubeconfig:      NewViewerKubeconfigREST(shootRest, secretLister, internalSecretLister, configMapLister, viewerKubeconfigMaxExpiration, subjectAccessReviewer),
+		SchedulingExplanation: NewSchedulingExplanationREST(shootRest, seedLister, shootLister, cloudProfileLister, namespacedCloudProfileLister, projectLister, configMapLister, schedulerFramework),
+		WakeUp:                NewWakeUpREST(shootRest.Store),
 	}
 }
 
@@ -92,7 +111,78 @@ func NewREST(optsGetter generic.RESTOptionsGetter, credentialsRotationInterval t
 	statusStore.UpdateStrategy = shoot.NewStatusStrategy()
 	bindingStore := *store
 	bindingStore.UpdateStrategy = shoot.NewBindingStrategy()
-	return &REST{store}, &StatusREST{store: &statusStore}, &BindingREST{store: &bindingStore}
+	pendingDeletionStore := *store
+	pendingDeletionStore.UpdateStrategy = shoot.NewPendingDeletionStrategy(credentialsRotationInterval)
+
+	shootRest := &REST{
+		Store: store,
+		pendingDeletion: &pendingDeletion{
+			store:   store,
+			updater: &pendingDeletionStore,
+			clock:   clock.RealClock{},
+		},
+	}
+
+	return shootRest, &StatusREST{store: &statusStore}, &BindingREST{store: &bindingStore}
+}
+
+// Delete deletes the shoot with the given name. If the project of the shoot configures a grace period for shoot
+// deletions, the shoot is hibernated and marked as pending deletion instead. It is deleted when it is deleted again
+// after the grace period has passed.
+func (r *REST) Delete(ctx context.Context, name string, deleteValidation rest.ValidateObjectFunc, options *metav1.DeleteOptions) (runtime.Object, bool, error) {
+	if r.pendingDeletion.projectLister == nil {
+		return r.Store.Delete(ctx, name, deleteValidation, options)
+	}
+	return r.pendingDeletion.Delete(ctx, name, deleteValidation, options)
+}
+
+// DeleteCollection deletes all shoots matching the given list options. Like Delete, it respects the grace period for
+// shoot deletions of the project.
+func (r *REST) DeleteCollection(ctx context.Context, deleteValidation rest.ValidateObjectFunc, options *metav1.DeleteOptions, listOptions *metainternalversion.ListOptions) (runtime.Object, error) {
+	if listOptions == nil {
+		listOptions = &metainternalversion.ListOptions{}
+	} else {
+		listOptions = listOptions.DeepCopy()
+	}
+
+	listObj, err := r.Store.List(ctx, listOptions)
+	if err != nil {
+		return nil, err
+	}
+	items, err := meta.ExtractList(listObj)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		deleted = make([]runtime.Object, 0, len(items))
+		errs    []error
+	)
+
+	for _, item := range items {
+		accessor, err := meta.Accessor(item)
+		if err != nil {
+			return nil, err
+		}
+
+		obj, _, err := r.Delete(ctx, accessor.GetName(), deleteValidation, options.DeepCopy())
+		if err != nil {
+			if !apierrors.IsNotFound(err) {
+				errs = append(errs, err)
+			}
+			continue
+		}
+		deleted = append(deleted, obj)
+	}
+
+	if len(errs) > 0 {
+		return nil, utilerrors.NewAggregate(errs)
+	}
+
+	if err := meta.SetList(listObj, deleted); err != nil {
+		return nil, err
+	}
+	return listObj, nil
 }
 
 // Implement CategoriesProvider
@@ -0,0 +1,104 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/registry/rest"
+
+	gardencorehelper "github.com/gardener/gardener/pkg/api/core/helper"
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+type getterUpdater interface {
+	rest.Getter
+	rest.Updater
+}
+
+// WakeUpREST implements the REST endpoint which wakes up hibernated shoots.
+type WakeUpREST struct {
+	shootStorage getterUpdater
+}
+
+var (
+	_ = rest.NamedCreater(&WakeUpREST{})
+	_ = rest.GroupVersionKindProvider(&WakeUpREST{})
+)
+
+// NewWakeUpREST returns a new WakeUpREST.
+func NewWakeUpREST(shootStorage getterUpdater) *WakeUpREST {
+	return &WakeUpREST{shootStorage: shootStorage}
+}
+
+// New returns an instance of the object.
+func (r *WakeUpREST) New() runtime.Object {
+	return &core.ShootWakeUp{}
+}
+
+// Destroy cleans up its resources on shutdown.
+func (r *WakeUpREST) Destroy() {
+	// Given that underlying store is shared with REST, we don't destroy it here explicitly.
+}
+
+// GroupVersionKind returns the GVK of the wake-up request.
+func (r *WakeUpREST) GroupVersionKind(schema.GroupVersion) schema.GroupVersionKind {
+	return gardencorev1beta1.SchemeGroupVersion.WithKind("ShootWakeUp")
+}
+
+// Create disables the hibernation of the shoot with the given name. Users with permissions for this subresource can
+// wake up shoots without being allowed to update them. Requests for shoots which are not hibernated are no-ops.
+func (r *WakeUpREST) Create(ctx context.Context, name string, obj runtime.Object, createValidation rest.ValidateObjectFunc, options *metav1.CreateOptions) (runtime.Object, error) {
+	if createValidation != nil {
+		if err := createValidation(ctx, obj.DeepCopyObject()); err != nil {
+			return nil, err
+		}
+	}
+
+	shootObj, err := r.shootStorage.Get(ctx, name, &metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	shoot, ok := shootObj.(*core.Shoot)
+	if !ok {
+		return nil, apierrors.NewInternalError(fmt.Errorf("cannot convert to *core.Shoot object - got type %T", shootObj))
+	}
+
+	wakeUp := &core.ShootWakeUp{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              shoot.Name,
+			Namespace:         shoot.Namespace,
+			CreationTimestamp: metav1.Now(),
+		},
+	}
+
+	if !gardencorehelper.HibernationIsEnabled(shoot) {
+		return wakeUp, nil
+	}
+
+	if options == nil {
+		options = &metav1.CreateOptions{}
+	}
+
+	// The shoot strategy records the wake-up as activity in the status of the shoot.
+	if _, _, err := r.shootStorage.Update(ctx, name, rest.DefaultUpdatedObjectInfo(nil, func(_ context.Context, _, oldObj runtime.Object) (runtime.Object, error) {
+		shoot := oldObj.(*core.Shoot).DeepCopy()
+		if shoot.Spec.Hibernation != nil {
+			shoot.Spec.Hibernation.Enabled = new(false)
+		}
+		return shoot, nil
+	}), rest.ValidateAllObjectFunc, rest.ValidateAllObjectUpdateFunc, false, &metav1.UpdateOptions{DryRun: options.DryRun}); err != nil {
+		return nil, err
+	}
+
+	return wakeUp, nil
+}
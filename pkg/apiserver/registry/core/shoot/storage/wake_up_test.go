@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+)
+
+var _ = Describe("WakeUp", func() {
+	var (
+		ctx = context.TODO()
+
+		shootName = "foo"
+
+		shoot *core.Shoot
+		store *fakeStore
+
+		rest *WakeUpREST
+	)
+
+	BeforeEach(func() {
+		shoot = &core.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: shootName, Namespace: "garden-dev"},
+			Spec: core.ShootSpec{
+				Hibernation: &core.Hibernation{Enabled: new(true)},
+			},
+		}
+		store = &fakeStore{obj: shoot}
+
+		rest = NewWakeUpREST(store)
+	})
+
+	Describe("#Create", func() {
+		It("should disable the hibernation of the shoot", func() {
+			obj, err := rest.Create(ctx, shootName, &core.ShootWakeUp{}, nil, &metav1.CreateOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(obj).To(BeAssignableToTypeOf(&core.ShootWakeUp{}))
+			Expect(obj.(*core.ShootWakeUp).Name).To(Equal(shootName))
+			Expect(store.updated).To(BeTrue())
+		})
+
+		It("should not update the shoot if it is not hibernated", func() {
+			shoot.Spec.Hibernation.Enabled = new(false)
+
+			_, err := rest.Create(ctx, shootName, &core.ShootWakeUp{}, nil, &metav1.CreateOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(store.updated).To(BeFalse())
+		})
+
+		It("should not update the shoot if the request is not admitted", func() {
+			createValidation := func(_ context.Context, _ runtime.Object) error {
+				return fmt.Errorf("forbidden")
+			}
+
+			_, err := rest.Create(ctx, shootName, &core.ShootWakeUp{}, createValidation, &metav1.CreateOptions{})
+			Expect(err).To(MatchError("forbidden"))
+			Expect(store.updated).To(BeFalse())
+		})
+	})
+})
@@ -79,6 +79,8 @@ func (shootStrategy) PrepareForUpdate(_ context.Context, obj, old runtime.Object
 		newShoot.Annotations[v1beta1constants.GardenerMaintenanceOperation] = cleanUpOperation(op)
 	}
 
+	syncPendingDeletion(oldShoot, newShoot)
+	syncLastActivityTime(oldShoot, newShoot)
 	SyncDNSProviderCredentials(newShoot)
 
 	if mustIncreaseGeneration(oldShoot, newShoot) {
@@ -107,6 +109,11 @@ func mustIncreaseGeneration(oldShoot, newShoot *core.Shoot) bool {
 		return true
 	}
 
+	// The shoot is marked as pending deletion or its deletion is reverted.
+	if gardencorehelper.IsShootPendingDeletion(oldShoot) != gardencorehelper.IsShootPendingDeletion(newShoot) {
+		return true
+	}
+
 	if lastOperation := newShoot.Status.LastOperation; lastOperation != nil {
 		var (
 			mustIncrease                  bool
@@ -177,6 +184,11 @@ func mustIncreaseGeneration(oldShoot, newShoot *core.Shoot) bool {
 						mustIncrease = true
 					}
 
+				case v1beta1constants.ShootOperationExport:
+					// We don't want to remove the annotation so that the gardenlet can pick it up and prepare the shoot
+					// for the export. It has to remove the annotation after it is done.
+					mustIncrease = true
+
 				case v1beta1constants.ShootOperationForceInPlaceUpdate:
 					// The annotation will be removed later by gardenlet once the in-place update is finished.
 					// The generation will be increased if there really is a spec change in the object.
@@ -205,6 +217,51 @@ func mustIncreaseGeneration(oldShoot, newShoot *core.Shoot) bool {
 	return false
 }
 
+// syncPendingDeletion ensures that the annotations marking a shoot as pending deletion cannot be changed by updates, as
+// they may only be set by deleting the shoot. If the `undelete` operation is requested for a shoot pending deletion,
+// the annotations are removed, and the shoot is woken up again if it was hibernated because of its deletion.
+func syncPendingDeletion(oldShoot, newShoot *core.Shoot) {
+	if gardencorehelper.IsShootPendingDeletion(oldShoot) && newShoot.Annotations[v1beta1constants.GardenerOperation] == v1beta1constants.ShootOperationUndelete {
+		if oldShoot.Annotations[v1beta1constants.ShootHibernatedForDeletion] == "true" && newShoot.Spec.Hibernation != nil {
+			newShoot.Spec.Hibernation.Enabled = new(false)
+		}
+		delete(newShoot.Annotations, v1beta1constants.GardenerOperation)
+		delete(newShoot.Annotations, v1beta1constants.ShootPendingDeletionUntil)
+		delete(newShoot.Annotations, v1beta1constants.ShootHibernatedForDeletion)
+		return
+	}
+
+	for _, key := range []string{v1beta1constants.ShootPendingDeletionUntil, v1beta1constants.ShootHibernatedForDeletion} {
+		if value, ok := oldShoot.Annotations[key]; ok {
+			metav1.SetMetaDataAnnotation(&newShoot.ObjectMeta, key, value)
+		} else {
+			delete(newShoot.Annotations, key)
+		}
+	}
+}
+
+// syncLastActivityTime records the wake-up of a hibernated shoot as activity so that its inactivity timeout starts over.
+// The same applies when the automatic hibernation based on inactivity is enabled, otherwise shoots which did not report
+// any activity so far would be hibernated right away.
+func syncLastActivityTime(oldShoot, newShoot *core.Shoot) {
+	var (
+		wokenUp           = gardencorehelper.HibernationIsEnabled(oldShoot) && !gardencorehelper.HibernationIsEnabled(newShoot)
+		inactivityEnabled = getHibernationInactivity(oldShoot) == nil && getHibernationInactivity(newShoot) != nil
+	)
+
+	if wokenUp || inactivityEnabled {
+		now := metav1.Now()
+		newShoot.Status.LastActivityTime = &now
+	}
+}
+
+func getHibernationInactivity(shoot *core.Shoot) *core.HibernationInactivity {
+	if shoot.Spec.Hibernation == nil {
+		return nil
+	}
+	return shoot.Spec.Hibernation.Inactivity
+}
+
 func mustIncreaseGenerationForSpecChanges(oldShoot, newShoot *core.Shoot) bool {
 	if newShoot.Spec.Maintenance != nil && newShoot.Spec.Maintenance.ConfineSpecUpdateRollout != nil && *newShoot.Spec.Maintenance.ConfineSpecUpdateRollout {
 		return gardencorehelper.HibernationIsEnabled(oldShoot) != gardencorehelper.HibernationIsEnabled(newShoot)
@@ -380,6 +437,31 @@ func (shootBindingStrategy) WarningsOnUpdate(_ context.Context, _, _ runtime.Obj
 	return nil
 }
 
+type shootPendingDeletionStrategy struct {
+	shootStrategy
+}
+
+// NewPendingDeletionStrategy returns a new storage strategy for marking Shoots as pending deletion.
+func NewPendingDeletionStrategy(credentialsRotationInterval time.Duration) shootPendingDeletionStrategy {
+	return shootPendingDeletionStrategy{NewStrategy(credentialsRotationInterval)}
+}
+
+func (shootPendingDeletionStrategy) PrepareForUpdate(_ context.Context, obj, old runtime.Object) {
+	newShoot := obj.(*core.Shoot)
+	oldShoot := old.(*core.Shoot)
+
+	newShoot.Status = oldShoot.Status
+
+	if !apiequality.Semantic.DeepEqual(oldShoot.Spec, newShoot.Spec) ||
+		gardencorehelper.IsShootPendingDeletion(oldShoot) != gardencorehelper.IsShootPendingDeletion(newShoot) {
+		newShoot.Generation = oldShoot.Generation + 1
+	}
+}
+
+func (shootPendingDeletionStrategy) WarningsOnUpdate(_ context.Context, _, _ runtime.Object) []string {
+	return nil
+}
+
 // ToSelectableFields returns a field set that represents the object
 func ToSelectableFields(shoot *core.Shoot) fields.Set {
 	// The purpose of allocation with a given number of elements is to reduce
@@ -11,6 +11,7 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
 	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
@@ -640,6 +641,13 @@ var _ = Describe("Strategy", func() {
 					[]string{v1beta1constants.OperationRotateRolloutWorkers + "=foo"},
 				),
 
+				Entry("export",
+					v1beta1constants.ShootOperationExport,
+					nil,
+					true,
+					[]string{v1beta1constants.ShootOperationExport},
+				),
+
 				Entry("force-in-place-update",
 					v1beta1constants.ShootOperationForceInPlaceUpdate,
 					nil,
@@ -697,6 +705,88 @@ var _ = Describe("Strategy", func() {
 			)
 		})
 
+		Context("pending deletion", func() {
+			BeforeEach(func() {
+				oldShoot.Generation = 1
+				oldShoot.Spec.Hibernation = &core.Hibernation{Enabled: new(true)}
+				metav1.SetMetaDataAnnotation(&oldShoot.ObjectMeta, v1beta1constants.ShootPendingDeletionUntil, "2026-01-01T00:00:00Z")
+				metav1.SetMetaDataAnnotation(&oldShoot.ObjectMeta, v1beta1constants.ShootHibernatedForDeletion, "true")
+				newShoot = oldShoot.DeepCopy()
+			})
+
+			It("should not allow removing the pending deletion annotations", func() {
+				delete(newShoot.Annotations, v1beta1constants.ShootPendingDeletionUntil)
+				delete(newShoot.Annotations, v1beta1constants.ShootHibernatedForDeletion)
+
+				strategy.PrepareForUpdate(ctx, newShoot, oldShoot)
+
+				Expect(newShoot.Annotations).To(Equal(oldShoot.Annotations))
+				Expect(newShoot.Generation).To(Equal(oldShoot.Generation))
+			})
+
+			It("should not allow adding the pending deletion annotations", func() {
+				oldShoot = &core.Shoot{}
+				newShoot = oldShoot.DeepCopy()
+				metav1.SetMetaDataAnnotation(&newShoot.ObjectMeta, v1beta1constants.ShootPendingDeletionUntil, "2026-01-01T00:00:00Z")
+
+				strategy.PrepareForUpdate(ctx, newShoot, oldShoot)
+
+				Expect(newShoot.Annotations).To(BeEmpty())
+			})
+
+			It("should revert the deletion and wake up the shoot on the undelete operation", func() {
+				metav1.SetMetaDataAnnotation(&newShoot.ObjectMeta, v1beta1constants.GardenerOperation, v1beta1constants.ShootOperationUndelete)
+
+				strategy.PrepareForUpdate(ctx, newShoot, oldShoot)
+
+				Expect(newShoot.Annotations).To(BeEmpty())
+				Expect(newShoot.Spec.Hibernation.Enabled).To(PointTo(BeFalse()))
+				Expect(newShoot.Generation).To(Equal(oldShoot.Generation + 1))
+			})
+
+			It("should revert the deletion but keep the shoot hibernated if it was hibernated before", func() {
+				delete(oldShoot.Annotations, v1beta1constants.ShootHibernatedForDeletion)
+				newShoot = oldShoot.DeepCopy()
+				metav1.SetMetaDataAnnotation(&newShoot.ObjectMeta, v1beta1constants.GardenerOperation, v1beta1constants.ShootOperationUndelete)
+
+				strategy.PrepareForUpdate(ctx, newShoot, oldShoot)
+
+				Expect(newShoot.Annotations).To(BeEmpty())
+				Expect(newShoot.Spec.Hibernation.Enabled).To(PointTo(BeTrue()))
+				Expect(newShoot.Generation).To(Equal(oldShoot.Generation + 1))
+			})
+		})
+
+		Context("last activity time", func() {
+			BeforeEach(func() {
+				oldShoot.Spec.Hibernation = &core.Hibernation{Enabled: new(true)}
+				newShoot = oldShoot.DeepCopy()
+			})
+
+			It("should record the wake-up of the shoot as activity", func() {
+				newShoot.Spec.Hibernation.Enabled = new(false)
+
+				strategy.PrepareForUpdate(ctx, newShoot, oldShoot)
+
+				Expect(newShoot.Status.LastActivityTime).NotTo(BeNil())
+				Expect(oldShoot.Status.LastActivityTime).To(BeNil())
+			})
+
+			It("should not change the last activity time if the shoot stays hibernated", func() {
+				strategy.PrepareForUpdate(ctx, newShoot, oldShoot)
+
+				Expect(newShoot.Status.LastActivityTime).To(BeNil())
+			})
+
+			It("should record the enablement of the automatic hibernation based on inactivity as activity", func() {
+				newShoot.Spec.Hibernation.Inactivity = &core.HibernationInactivity{Timeout: metav1.Duration{Duration: 8 * time.Hour}}
+
+				strategy.PrepareForUpdate(ctx, newShoot, oldShoot)
+
+				Expect(newShoot.Status.LastActivityTime).NotTo(BeNil())
+			})
+		})
+
 		Context("DNS Provider Credentials", func() {
 			// TODO(vpnachev): Remove this context once support for Kubernetes 1.34 is dropped.
 			It("should sync Secret credentialsRef to secretName and increase generation", func() {
@@ -983,6 +1073,44 @@ var _ = Describe("Strategy", func() {
 		})
 	})
 
+	Context("PendingDeletionStrategy", func() {
+		BeforeEach(func() {
+			strategy = NewPendingDeletionStrategy(0)
+		})
+
+		Describe("#PrepareForUpdate", func() {
+			var (
+				oldShoot *core.Shoot
+				newShoot *core.Shoot
+			)
+
+			BeforeEach(func() {
+				oldShoot = &core.Shoot{ObjectMeta: metav1.ObjectMeta{Generation: 1}}
+				newShoot = oldShoot.DeepCopy()
+			})
+
+			It("should not allow editing the status", func() {
+				newShoot.Status.TechnicalID = "foo"
+
+				strategy.PrepareForUpdate(ctx, newShoot, oldShoot)
+
+				Expect(newShoot.Status).To(Equal(oldShoot.Status))
+			})
+
+			It("should keep the pending deletion annotations and increase the generation", func() {
+				newShoot.Spec.Hibernation = &core.Hibernation{Enabled: new(true)}
+				metav1.SetMetaDataAnnotation(&newShoot.ObjectMeta, v1beta1constants.ShootPendingDeletionUntil, "2026-01-01T00:00:00Z")
+				metav1.SetMetaDataAnnotation(&newShoot.ObjectMeta, v1beta1constants.ShootHibernatedForDeletion, "true")
+
+				strategy.PrepareForUpdate(ctx, newShoot, oldShoot)
+
+				Expect(newShoot.Annotations).To(HaveKeyWithValue(v1beta1constants.ShootPendingDeletionUntil, "2026-01-01T00:00:00Z"))
+				Expect(newShoot.Annotations).To(HaveKeyWithValue(v1beta1constants.ShootHibernatedForDeletion, "true"))
+				Expect(newShoot.Generation).To(Equal(oldShoot.Generation + 1))
+			})
+		})
+	})
+
 	Context("StatusStrategy", func() {
 		BeforeEach(func() {
 			strategy = NewStatusStrategy()
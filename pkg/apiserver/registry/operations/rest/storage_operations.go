@@ -14,6 +14,8 @@ import (
 	"github.com/gardener/gardener/pkg/apis/operations"
 	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
 	bastionstore "github.com/gardener/gardener/pkg/apiserver/registry/operations/bastion/storage"
+	seedrebalanceproposalstore "github.com/gardener/gardener/pkg/apiserver/registry/operations/seedrebalanceproposal/storage"
+	shootrestorestore "github.com/gardener/gardener/pkg/apiserver/registry/operations/shootrestore/storage"
 )
 
 // StorageProvider is an empty struct.
@@ -38,5 +40,13 @@ func (p StorageProvider) v1alpha1Storage(restOptionsGetter generic.RESTOptionsGe
 	storage["bastions"] = bastionStorage.Bastion
 	storage["bastions/status"] = bastionStorage.Status
 
+	seedRebalanceProposalStorage := seedrebalanceproposalstore.NewStorage(restOptionsGetter)
+	storage["seedrebalanceproposals"] = seedRebalanceProposalStorage.SeedRebalanceProposal
+	storage["seedrebalanceproposals/status"] = seedRebalanceProposalStorage.Status
+
+	shootRestoreStorage := shootrestorestore.NewStorage(restOptionsGetter)
+	storage["shootrestores"] = shootRestoreStorage.ShootRestore
+	storage["shootrestores/status"] = shootRestoreStorage.Status
+
 	return storage
 }
@@ -0,0 +1,108 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package seedrebalanceproposal
+
+import (
+	"context"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/storage/names"
+
+	"github.com/gardener/gardener/pkg/api"
+	operationsvalidation "github.com/gardener/gardener/pkg/api/operations/validation"
+	"github.com/gardener/gardener/pkg/apis/operations"
+)
+
+type seedRebalanceProposalStrategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+}
+
+// Strategy defines the storage strategy for SeedRebalanceProposals.
+var Strategy = seedRebalanceProposalStrategy{api.Scheme, names.SimpleNameGenerator}
+
+func (seedRebalanceProposalStrategy) NamespaceScoped() bool {
+	return false
+}
+
+func (seedRebalanceProposalStrategy) PrepareForCreate(_ context.Context, obj runtime.Object) {
+	proposal := obj.(*operations.SeedRebalanceProposal)
+	proposal.Generation = 1
+	proposal.Status = operations.SeedRebalanceProposalStatus{}
+}
+
+func (seedRebalanceProposalStrategy) PrepareForUpdate(_ context.Context, obj, old runtime.Object) {
+	newProposal := obj.(*operations.SeedRebalanceProposal)
+	oldProposal := old.(*operations.SeedRebalanceProposal)
+	newProposal.Status = oldProposal.Status
+
+	if mustIncreaseGeneration(oldProposal, newProposal) {
+		newProposal.Generation = oldProposal.Generation + 1
+	}
+}
+
+func mustIncreaseGeneration(oldProposal, newProposal *operations.SeedRebalanceProposal) bool {
+	// The SeedRebalanceProposal specification changes.
+	if !apiequality.Semantic.DeepEqual(oldProposal.Spec, newProposal.Spec) {
+		return true
+	}
+
+	// The deletion timestamp was set.
+	if oldProposal.DeletionTimestamp == nil && newProposal.DeletionTimestamp != nil {
+		return true
+	}
+
+	return false
+}
+
+func (seedRebalanceProposalStrategy) Validate(_ context.Context, obj runtime.Object) field.ErrorList {
+	proposal := obj.(*operations.SeedRebalanceProposal)
+	return operationsvalidation.ValidateSeedRebalanceProposal(proposal)
+}
+
+func (seedRebalanceProposalStrategy) Canonicalize(_ runtime.Object) {
+}
+
+func (seedRebalanceProposalStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+func (seedRebalanceProposalStrategy) ValidateUpdate(_ context.Context, newObj, oldObj runtime.Object) field.ErrorList {
+	oldProposal, newProposal := oldObj.(*operations.SeedRebalanceProposal), newObj.(*operations.SeedRebalanceProposal)
+	return operationsvalidation.ValidateSeedRebalanceProposalUpdate(newProposal, oldProposal)
+}
+
+func (seedRebalanceProposalStrategy) AllowUnconditionalUpdate() bool {
+	return false
+}
+
+// WarningsOnCreate returns warnings to the client performing a create.
+func (seedRebalanceProposalStrategy) WarningsOnCreate(_ context.Context, _ runtime.Object) []string {
+	return nil
+}
+
+// WarningsOnUpdate returns warnings to the client performing the update.
+func (seedRebalanceProposalStrategy) WarningsOnUpdate(_ context.Context, _, _ runtime.Object) []string {
+	return nil
+}
+
+type seedRebalanceProposalStatusStrategy struct {
+	seedRebalanceProposalStrategy
+}
+
+// StatusStrategy defines the storage strategy for the status subresource of SeedRebalanceProposals.
+var StatusStrategy = seedRebalanceProposalStatusStrategy{Strategy}
+
+func (seedRebalanceProposalStatusStrategy) PrepareForUpdate(_ context.Context, obj, old runtime.Object) {
+	newProposal := obj.(*operations.SeedRebalanceProposal)
+	oldProposal := old.(*operations.SeedRebalanceProposal)
+	newProposal.Spec = oldProposal.Spec
+}
+
+func (seedRebalanceProposalStatusStrategy) ValidateUpdate(_ context.Context, obj, old runtime.Object) field.ErrorList {
+	return operationsvalidation.ValidateSeedRebalanceProposalStatusUpdate(obj.(*operations.SeedRebalanceProposal), old.(*operations.SeedRebalanceProposal))
+}
@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package seedrebalanceproposal_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/gardener/gardener/pkg/apis/operations"
+	. "github.com/gardener/gardener/pkg/apiserver/registry/operations/seedrebalanceproposal"
+)
+
+var _ = Describe("Strategy", func() {
+	var (
+		ctx      = context.TODO()
+		proposal *operations.SeedRebalanceProposal
+	)
+
+	BeforeEach(func() {
+		proposal = &operations.SeedRebalanceProposal{
+			ObjectMeta: metav1.ObjectMeta{Name: "rebalance-abcde", Generation: 1},
+			Spec: operations.SeedRebalanceProposalSpec{
+				Migrations: []operations.SeedRebalanceMigration{{ShootNamespace: "garden-dev", ShootName: "foo", SourceSeedName: "seed-1", TargetSeedName: "seed-2"}},
+			},
+		}
+	})
+
+	Describe("#PrepareForCreate", func() {
+		It("should reset the status and set the generation", func() {
+			proposal.Generation = 0
+			proposal.Status.Phase = operations.SeedRebalanceProposalCompleted
+
+			Strategy.PrepareForCreate(ctx, proposal)
+
+			Expect(proposal.Generation).To(Equal(int64(1)))
+			Expect(proposal.Status).To(Equal(operations.SeedRebalanceProposalStatus{}))
+		})
+	})
+
+	Describe("#PrepareForUpdate", func() {
+		It("should increase the generation when the proposal gets approved", func() {
+			newProposal := proposal.DeepCopy()
+			newProposal.Spec.Approved = true
+
+			Strategy.PrepareForUpdate(ctx, newProposal, proposal)
+
+			Expect(newProposal.Generation).To(Equal(int64(2)))
+		})
+
+		It("should not increase the generation and keep the status for metadata changes", func() {
+			proposal.Status.Phase = operations.SeedRebalanceProposalPending
+			newProposal := proposal.DeepCopy()
+			newProposal.Labels = map[string]string{"foo": "bar"}
+			newProposal.Status.Phase = operations.SeedRebalanceProposalCompleted
+
+			Strategy.PrepareForUpdate(ctx, newProposal, proposal)
+
+			Expect(newProposal.Generation).To(Equal(int64(1)))
+			Expect(newProposal.Status.Phase).To(Equal(operations.SeedRebalanceProposalPending))
+		})
+	})
+
+	Describe("#StatusStrategy.PrepareForUpdate", func() {
+		It("should not allow changing the spec", func() {
+			newProposal := proposal.DeepCopy()
+			newProposal.Spec.Approved = true
+			newProposal.Status.Phase = operations.SeedRebalanceProposalExecuting
+
+			StatusStrategy.PrepareForUpdate(ctx, newProposal, proposal)
+
+			Expect(newProposal.Spec.Approved).To(BeFalse())
+			Expect(newProposal.Status.Phase).To(Equal(operations.SeedRebalanceProposalExecuting))
+		})
+	})
+})
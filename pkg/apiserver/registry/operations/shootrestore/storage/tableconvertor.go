@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metatable "k8s.io/apimachinery/pkg/api/meta/table"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/rest"
+
+	"github.com/gardener/gardener/pkg/apis/operations"
+)
+
+var swaggerMetadataDescriptions = metav1.ObjectMeta{}.SwaggerDoc()
+
+type convertor struct {
+	headers []metav1beta1.TableColumnDefinition
+}
+
+func newTableConvertor() rest.TableConvertor {
+	return &convertor{
+		headers: []metav1beta1.TableColumnDefinition{
+			{Name: "Name", Type: "string", Format: "name", Description: swaggerMetadataDescriptions["name"]},
+			{Name: "Shoot", Type: "string", Format: "name", Description: "The Shoot whose etcd is restored."},
+			{Name: "Seed", Type: "string", Format: "name", Description: "The Seed cluster on which the Shoot is scheduled."},
+			{Name: "Target Time", Type: "string", Description: "The point in time whose state of the etcd is restored."},
+			{Name: "Phase", Type: "string", Description: "The phase of the restoration."},
+			{Name: "Age", Type: "date", Description: swaggerMetadataDescriptions["creationTimestamp"]},
+		},
+	}
+}
+
+// ConvertToTable converts the output to a table.
+func (c *convertor) ConvertToTable(_ context.Context, obj runtime.Object, _ runtime.Object) (*metav1beta1.Table, error) {
+	var (
+		err   error
+		table = &metav1beta1.Table{
+			ColumnDefinitions: c.headers,
+		}
+	)
+
+	if m, err := meta.ListAccessor(obj); err == nil {
+		table.ResourceVersion = m.GetResourceVersion()
+		table.Continue = m.GetContinue()
+	} else {
+		if m, err := meta.CommonAccessor(obj); err == nil {
+			table.ResourceVersion = m.GetResourceVersion()
+		}
+	}
+
+	table.Rows, err = metatable.MetaToTableRow(obj, func(obj runtime.Object, _ metav1.Object, _, _ string) ([]any, error) {
+		var (
+			shootRestore = obj.(*operations.ShootRestore)
+			cells        = []any{}
+		)
+
+		cells = append(cells, shootRestore.Name)
+		cells = append(cells, shootRestore.Spec.ShootRef.Name)
+
+		if shootRestore.Spec.SeedName == nil {
+			cells = append(cells, "<pending>")
+		} else {
+			cells = append(cells, *shootRestore.Spec.SeedName)
+		}
+
+		if shootRestore.Spec.TargetTime == nil {
+			cells = append(cells, "<none>")
+		} else {
+			cells = append(cells, shootRestore.Spec.TargetTime.UTC().Format(time.RFC3339))
+		}
+
+		if len(shootRestore.Status.Phase) == 0 {
+			cells = append(cells, "<pending>")
+		} else {
+			cells = append(cells, string(shootRestore.Status.Phase))
+		}
+
+		cells = append(cells, metatable.ConvertToHumanReadableDateType(shootRestore.CreationTimestamp))
+
+		return cells, nil
+	})
+
+	return table, err
+}
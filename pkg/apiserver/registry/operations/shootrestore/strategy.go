@@ -0,0 +1,163 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shootrestore
+
+import (
+	"context"
+	"fmt"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/registry/generic"
+	"k8s.io/apiserver/pkg/storage"
+	"k8s.io/apiserver/pkg/storage/names"
+
+	"github.com/gardener/gardener/pkg/api"
+	operationsvalidation "github.com/gardener/gardener/pkg/api/operations/validation"
+	"github.com/gardener/gardener/pkg/apis/operations"
+)
+
+type shootRestoreStrategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+}
+
+// Strategy defines the storage strategy for ShootRestores.
+var Strategy = shootRestoreStrategy{api.Scheme, names.SimpleNameGenerator}
+
+func (shootRestoreStrategy) NamespaceScoped() bool {
+	return true
+}
+
+func (shootRestoreStrategy) PrepareForCreate(_ context.Context, obj runtime.Object) {
+	shootRestore := obj.(*operations.ShootRestore)
+
+	shootRestore.Generation = 1
+	shootRestore.Status = operations.ShootRestoreStatus{}
+}
+
+func (shootRestoreStrategy) PrepareForUpdate(_ context.Context, obj, old runtime.Object) {
+	newShootRestore := obj.(*operations.ShootRestore)
+	oldShootRestore := old.(*operations.ShootRestore)
+	newShootRestore.Status = oldShootRestore.Status
+
+	if mustIncreaseGeneration(oldShootRestore, newShootRestore) {
+		newShootRestore.Generation = oldShootRestore.Generation + 1
+	}
+}
+
+func mustIncreaseGeneration(oldShootRestore, newShootRestore *operations.ShootRestore) bool {
+	// The ShootRestore specification changes.
+	if !apiequality.Semantic.DeepEqual(oldShootRestore.Spec, newShootRestore.Spec) {
+		return true
+	}
+
+	// The deletion timestamp was set.
+	if oldShootRestore.DeletionTimestamp == nil && newShootRestore.DeletionTimestamp != nil {
+		return true
+	}
+
+	return false
+}
+
+func (shootRestoreStrategy) Validate(_ context.Context, obj runtime.Object) field.ErrorList {
+	shootRestore := obj.(*operations.ShootRestore)
+	return operationsvalidation.ValidateShootRestore(shootRestore)
+}
+
+func (shootRestoreStrategy) Canonicalize(_ runtime.Object) {
+}
+
+func (shootRestoreStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+func (shootRestoreStrategy) ValidateUpdate(_ context.Context, newObj, oldObj runtime.Object) field.ErrorList {
+	oldShootRestore, newShootRestore := oldObj.(*operations.ShootRestore), newObj.(*operations.ShootRestore)
+	return operationsvalidation.ValidateShootRestoreUpdate(newShootRestore, oldShootRestore)
+}
+
+func (shootRestoreStrategy) AllowUnconditionalUpdate() bool {
+	return false
+}
+
+// WarningsOnCreate returns warnings to the client performing a create.
+func (shootRestoreStrategy) WarningsOnCreate(_ context.Context, _ runtime.Object) []string {
+	return nil
+}
+
+// WarningsOnUpdate returns warnings to the client performing the update.
+func (shootRestoreStrategy) WarningsOnUpdate(_ context.Context, _, _ runtime.Object) []string {
+	return nil
+}
+
+type shootRestoreStatusStrategy struct {
+	shootRestoreStrategy
+}
+
+// StatusStrategy defines the storage strategy for the status subresource of ShootRestores.
+var StatusStrategy = shootRestoreStatusStrategy{Strategy}
+
+func (shootRestoreStatusStrategy) PrepareForUpdate(_ context.Context, obj, old runtime.Object) {
+	newShootRestore := obj.(*operations.ShootRestore)
+	oldShootRestore := old.(*operations.ShootRestore)
+	newShootRestore.Spec = oldShootRestore.Spec
+}
+
+func (shootRestoreStatusStrategy) ValidateUpdate(_ context.Context, obj, old runtime.Object) field.ErrorList {
+	return operationsvalidation.ValidateShootRestoreStatusUpdate(obj.(*operations.ShootRestore), old.(*operations.ShootRestore))
+}
+
+// ToSelectableFields returns a field set that represents the object
+func ToSelectableFields(shootRestore *operations.ShootRestore) fields.Set {
+	// The purpose of allocation with a given number of elements is to reduce
+	// amount of allocations needed to create the fields.Set. If you add any
+	// field here or the number of object-meta related fields changes, this should
+	// be adjusted.
+	shootRestoreSpecificFieldsSet := make(fields.Set, 4)
+	shootRestoreSpecificFieldsSet[operations.ShootRestoreSeedName] = getSeedName(shootRestore)
+	shootRestoreSpecificFieldsSet[operations.ShootRestoreShootName] = shootRestore.Spec.ShootRef.Name
+	return generic.AddObjectMetaFieldsSet(shootRestoreSpecificFieldsSet, &shootRestore.ObjectMeta, true)
+}
+
+// GetAttrs returns labels and fields of a given object for filtering purposes.
+func GetAttrs(obj runtime.Object) (labels.Set, fields.Set, error) {
+	shootRestore, ok := obj.(*operations.ShootRestore)
+	if !ok {
+		return nil, nil, fmt.Errorf("not a shootrestore")
+	}
+	return labels.Set(shootRestore.Labels), ToSelectableFields(shootRestore), nil
+}
+
+// MatchShootRestore returns a generic matcher for a given label and field selector.
+func MatchShootRestore(label labels.Selector, field fields.Selector) storage.SelectionPredicate {
+	return storage.SelectionPredicate{
+		Label:       label,
+		Field:       field,
+		GetAttrs:    GetAttrs,
+		IndexFields: []string{operations.ShootRestoreSeedName},
+	}
+}
+
+// SeedNameTriggerFunc returns spec.seedName of given ShootRestore.
+func SeedNameTriggerFunc(obj runtime.Object) string {
+	shootRestore, ok := obj.(*operations.ShootRestore)
+	if !ok {
+		return ""
+	}
+
+	return getSeedName(shootRestore)
+}
+
+func getSeedName(shootRestore *operations.ShootRestore) string {
+	if shootRestore.Spec.SeedName == nil {
+		return ""
+	}
+
+	return *shootRestore.Spec.SeedName
+}
@@ -0,0 +1,159 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shootrestore
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+
+	gardencore "github.com/gardener/gardener/pkg/apis/core"
+	"github.com/gardener/gardener/pkg/apis/operations"
+)
+
+var _ = Describe("ToSelectableFields", func() {
+	It("should return correct fields", func() {
+		result := ToSelectableFields(newShootRestore("shoot", "foo"))
+
+		Expect(result).To(HaveLen(4))
+		Expect(result.Get("metadata.name")).To(Equal("test"))
+		Expect(result.Get("metadata.namespace")).To(Equal("test-namespace"))
+		Expect(result.Get(operations.ShootRestoreSeedName)).To(Equal("foo"))
+		Expect(result.Get(operations.ShootRestoreShootName)).To(Equal("shoot"))
+	})
+})
+
+var _ = Describe("GetAttrs", func() {
+	It("should return error when object is not ShootRestore", func() {
+		_, _, err := GetAttrs(&gardencore.Seed{})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should return correct result", func() {
+		ls, fs, err := GetAttrs(newShootRestore("shoot", "foo"))
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ls).To(HaveLen(1))
+		Expect(ls.Get("foo")).To(Equal("bar"))
+		Expect(fs.Get(operations.ShootRestoreSeedName)).To(Equal("foo"))
+		Expect(fs.Get(operations.ShootRestoreShootName)).To(Equal("shoot"))
+	})
+})
+
+var _ = Describe("SeedNameTriggerFunc", func() {
+	It("should return spec.seedName", func() {
+		Expect(SeedNameTriggerFunc(newShootRestore("shoot", "foo"))).To(Equal("foo"))
+	})
+
+	It("should return an empty string if the seed name is not set", func() {
+		Expect(SeedNameTriggerFunc(newShootRestore("shoot", ""))).To(BeEmpty())
+	})
+})
+
+var _ = Describe("MatchShootRestore", func() {
+	It("should return correct predicate", func() {
+		ls, _ := labels.Parse("app=test")
+		fs := fields.OneTermEqualSelector(operations.ShootRestoreSeedName, "foo")
+
+		result := MatchShootRestore(ls, fs)
+
+		Expect(result.Label).To(Equal(ls))
+		Expect(result.Field).To(Equal(fs))
+		Expect(result.IndexFields).To(ConsistOf(operations.ShootRestoreSeedName))
+	})
+})
+
+var _ = Describe("Strategy", func() {
+	var (
+		ctx          = context.TODO()
+		shootRestore *operations.ShootRestore
+	)
+
+	BeforeEach(func() {
+		shootRestore = newShootRestore("shoot", "foo")
+	})
+
+	Describe("#PrepareForCreate", func() {
+		It("should set the generation and reset the status", func() {
+			shootRestore.Status.Phase = operations.ShootRestoreSucceeded
+
+			Strategy.PrepareForCreate(ctx, shootRestore)
+
+			Expect(shootRestore.Generation).To(Equal(int64(1)))
+			Expect(shootRestore.Status).To(Equal(operations.ShootRestoreStatus{}))
+		})
+	})
+
+	Describe("#PrepareForUpdate", func() {
+		var oldShootRestore *operations.ShootRestore
+
+		BeforeEach(func() {
+			shootRestore.Generation = 1
+			oldShootRestore = shootRestore.DeepCopy()
+		})
+
+		It("should not change the status", func() {
+			shootRestore.Status.Phase = operations.ShootRestoreSucceeded
+
+			Strategy.PrepareForUpdate(ctx, shootRestore, oldShootRestore)
+
+			Expect(shootRestore.Status).To(Equal(oldShootRestore.Status))
+			Expect(shootRestore.Generation).To(Equal(int64(1)))
+		})
+
+		It("should increase the generation if the spec changes", func() {
+			shootRestore.Spec.ShootRef.Name = "other"
+
+			Strategy.PrepareForUpdate(ctx, shootRestore, oldShootRestore)
+
+			Expect(shootRestore.Generation).To(Equal(int64(2)))
+		})
+
+		It("should increase the generation if the deletion timestamp is set", func() {
+			shootRestore.DeletionTimestamp = &metav1.Time{}
+
+			Strategy.PrepareForUpdate(ctx, shootRestore, oldShootRestore)
+
+			Expect(shootRestore.Generation).To(Equal(int64(2)))
+		})
+	})
+
+	Describe("#StatusStrategy.PrepareForUpdate", func() {
+		It("should not change the spec", func() {
+			oldShootRestore := shootRestore.DeepCopy()
+			shootRestore.Spec.ShootRef.Name = "other"
+			shootRestore.Status.Phase = operations.ShootRestoreRestoring
+
+			StatusStrategy.PrepareForUpdate(ctx, shootRestore, oldShootRestore)
+
+			Expect(shootRestore.Spec).To(Equal(oldShootRestore.Spec))
+			Expect(shootRestore.Status.Phase).To(Equal(operations.ShootRestoreRestoring))
+		})
+	})
+})
+
+func newShootRestore(shootName, seedName string) *operations.ShootRestore {
+	shootRestore := &operations.ShootRestore{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "test-namespace",
+			Labels:    map[string]string{"foo": "bar"},
+		},
+		Spec: operations.ShootRestoreSpec{
+			ShootRef: corev1.LocalObjectReference{Name: shootName},
+		},
+	}
+
+	if seedName != "" {
+		shootRestore.Spec.SeedName = &seedName
+	}
+
+	return shootRestore
+}
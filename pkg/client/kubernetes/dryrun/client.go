@@ -0,0 +1,208 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dryrun
+
+import (
+	"context"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+// NewClient returns a client which sends all writes with the dry-run option to the API server and records the
+// resulting changes of the objects in the given Recorder. Reads are passed through to the given client. The objects
+// passed to write calls are updated with the response of the API server, so callers observe the same behaviour as
+// without dry-run, except that the changes are not persisted.
+// Writes to subresources, e.g. the status, are sent with the dry-run option as well but are not recorded.
+func NewClient(c client.Client, cluster string, recorder *Recorder) client.Client {
+	return &dryRunClient{Client: c, cluster: cluster, recorder: recorder}
+}
+
+type dryRunClient struct {
+	client.Client
+
+	cluster  string
+	recorder *Recorder
+}
+
+func (c *dryRunClient) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	gvk, live, err := c.getLive(ctx, obj)
+	if err != nil {
+		return err
+	}
+
+	if err := c.Client.Create(ctx, obj, append(opts, client.DryRunAll)...); err != nil {
+		return err
+	}
+
+	return c.record(gvk, obj, live, obj)
+}
+
+func (c *dryRunClient) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+	gvk, live, err := c.getLive(ctx, obj)
+	if err != nil {
+		return err
+	}
+
+	if err := c.Client.Update(ctx, obj, append(opts, client.DryRunAll)...); err != nil {
+		return err
+	}
+
+	return c.record(gvk, obj, live, obj)
+}
+
+func (c *dryRunClient) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+	gvk, live, err := c.getLive(ctx, obj)
+	if err != nil {
+		return err
+	}
+
+	if err := c.Client.Patch(ctx, obj, patch, append(opts, client.DryRunAll)...); err != nil {
+		return err
+	}
+
+	return c.record(gvk, obj, live, obj)
+}
+
+func (c *dryRunClient) Delete(ctx context.Context, obj client.Object, opts ...client.DeleteOption) error {
+	gvk, live, err := c.getLive(ctx, obj)
+	if err != nil {
+		return err
+	}
+
+	err = c.Client.Delete(ctx, obj, append(opts, client.DryRunAll)...)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	// Objects which were created before in dry-run mode do not exist, hence the deletion is recorded even if it failed
+	// with a NotFound error.
+	if recordErr := c.record(gvk, obj, live, nil); recordErr != nil {
+		return recordErr
+	}
+	return err
+}
+
+func (c *dryRunClient) DeleteAllOf(ctx context.Context, obj client.Object, opts ...client.DeleteAllOfOption) error {
+	gvk, err := apiutil.GVKForObject(obj, c.Scheme())
+	if err != nil {
+		return err
+	}
+
+	if err := c.Client.DeleteAllOf(ctx, obj, append(opts, client.DryRunAll)...); err != nil {
+		return err
+	}
+
+	deleteAllOfOptions := &client.DeleteAllOfOptions{}
+	deleteAllOfOptions.ApplyOptions(opts)
+	c.recorder.recordDeleteCollection(c.cluster, gvk, deleteAllOfOptions.Namespace)
+	return nil
+}
+
+func (c *dryRunClient) Apply(context.Context, runtime.ApplyConfiguration, ...client.ApplyOption) error {
+	// Apply configurations are not used by Gardener. Ignore them in dry-run mode instead of applying them for real.
+	return nil
+}
+
+func (c *dryRunClient) Status() client.SubResourceWriter {
+	return &dryRunSubResourceWriter{SubResourceWriter: c.Client.Status()}
+}
+
+func (c *dryRunClient) SubResource(subResource string) client.SubResourceClient {
+	subResourceClient := c.Client.SubResource(subResource)
+	return &dryRunSubResourceClient{
+		SubResourceReader: subResourceClient,
+		dryRunSubResourceWriter: dryRunSubResourceWriter{
+			SubResourceWriter: subResourceClient,
+		},
+	}
+}
+
+// getLive returns the GroupVersionKind and the current state of the given object. The returned object is nil if the
+// object does not exist yet or if the name is generated by the API server.
+func (c *dryRunClient) getLive(ctx context.Context, obj client.Object) (schema.GroupVersionKind, *unstructured.Unstructured, error) {
+	gvk, err := apiutil.GVKForObject(obj, c.Scheme())
+	if err != nil {
+		return gvk, nil, err
+	}
+
+	if obj.GetName() == "" {
+		return gvk, nil, nil
+	}
+
+	var live client.Object
+	if _, ok := obj.(runtime.Unstructured); ok {
+		live = &unstructured.Unstructured{}
+		live.GetObjectKind().SetGroupVersionKind(gvk)
+	} else {
+		newObj, err := c.Scheme().New(gvk)
+		if err != nil {
+			return gvk, nil, err
+		}
+		live = newObj.(client.Object)
+	}
+
+	if err := c.Client.Get(ctx, client.ObjectKeyFromObject(obj), live); err != nil {
+		if apierrors.IsNotFound(err) {
+			return gvk, nil, nil
+		}
+		return gvk, nil, err
+	}
+
+	liveUnstructured, err := toUnstructured(gvk, live)
+	if err != nil {
+		return gvk, nil, err
+	}
+	return gvk, liveUnstructured, nil
+}
+
+func (c *dryRunClient) record(gvk schema.GroupVersionKind, obj client.Object, live *unstructured.Unstructured, desired client.Object) error {
+	var desiredUnstructured *unstructured.Unstructured
+	if desired != nil {
+		var err error
+		if desiredUnstructured, err = toUnstructured(gvk, desired); err != nil {
+			return err
+		}
+	}
+
+	c.recorder.record(c.cluster, gvk, obj.GetNamespace(), obj.GetName(), live, desiredUnstructured)
+	return nil
+}
+
+func toUnstructured(gvk schema.GroupVersionKind, obj client.Object) (*unstructured.Unstructured, error) {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj.DeepCopyObject())
+	if err != nil {
+		return nil, err
+	}
+
+	u := &unstructured.Unstructured{Object: content}
+	u.SetGroupVersionKind(gvk)
+	return u, nil
+}
+
+type dryRunSubResourceWriter struct {
+	client.SubResourceWriter
+}
+
+func (w *dryRunSubResourceWriter) Create(ctx context.Context, obj client.Object, subResource client.Object, opts ...client.SubResourceCreateOption) error {
+	return w.SubResourceWriter.Create(ctx, obj, subResource, append(opts, client.DryRunAll)...)
+}
+
+func (w *dryRunSubResourceWriter) Update(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+	return w.SubResourceWriter.Update(ctx, obj, append(opts, client.DryRunAll)...)
+}
+
+func (w *dryRunSubResourceWriter) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+	return w.SubResourceWriter.Patch(ctx, obj, patch, append(opts, client.DryRunAll)...)
+}
+
+type dryRunSubResourceClient struct {
+	client.SubResourceReader
+	dryRunSubResourceWriter
+}
@@ -0,0 +1,170 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dryrun_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/client/kubernetes/dryrun"
+	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Client", func() {
+	var (
+		ctx = context.Background()
+
+		fakeClient   client.Client
+		recorder     *Recorder
+		dryRunClient client.Client
+
+		existing *corev1.ConfigMap
+	)
+
+	BeforeEach(func() {
+		existing = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "existing", Namespace: "default"},
+			Data:       map[string]string{"foo": "bar"},
+		}
+
+		fakeClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.SeedScheme).
+			WithObjects(existing.DeepCopy(), &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod", Namespace: "default"}}).
+			WithStatusSubresource(&corev1.Pod{}).
+			Build()
+		recorder = NewRecorder()
+		dryRunClient = NewClient(fakeClient, "seed", recorder)
+	})
+
+	It("should record the creation of an object without persisting it", func() {
+		obj := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "new", Namespace: "default"},
+			Data:       map[string]string{"key": "value"},
+		}
+		Expect(dryRunClient.Create(ctx, obj)).To(Succeed())
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(obj), &corev1.ConfigMap{})).To(BeNotFoundError())
+
+		changes, err := recorder.Changes()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(HaveExactElements(And(
+			HaveField("Cluster", "seed"),
+			HaveField("Operation", OperationCreate),
+			HaveField("APIVersion", "v1"),
+			HaveField("Kind", "ConfigMap"),
+			HaveField("Namespace", "default"),
+			HaveField("Name", "new"),
+			HaveField("Diff", ContainSubstring(`"value"`)),
+		)))
+	})
+
+	It("should record the update of an object without persisting it", func() {
+		obj := existing.DeepCopy()
+		patch := client.MergeFrom(obj.DeepCopy())
+		obj.Data["foo"] = "baz"
+		Expect(dryRunClient.Patch(ctx, obj, patch)).To(Succeed())
+
+		live := &corev1.ConfigMap{}
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(obj), live)).To(Succeed())
+		Expect(live.Data).To(HaveKeyWithValue("foo", "bar"))
+
+		changes, err := recorder.Changes()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(HaveExactElements(And(
+			HaveField("Operation", OperationUpdate),
+			HaveField("Name", "existing"),
+			HaveField("Diff", And(ContainSubstring(`-`), ContainSubstring(`"bar"`), ContainSubstring(`+`), ContainSubstring(`"baz"`))),
+		)))
+	})
+
+	It("should not record updates which do not change the object", func() {
+		obj := &corev1.ConfigMap{}
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(existing), obj)).To(Succeed())
+		Expect(dryRunClient.Update(ctx, obj)).To(Succeed())
+
+		Expect(recorder.Changes()).To(BeEmpty())
+	})
+
+	It("should record the deletion of an existing object without persisting it", func() {
+		Expect(dryRunClient.Delete(ctx, existing.DeepCopy())).To(Succeed())
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(existing), &corev1.ConfigMap{})).To(Succeed())
+
+		Expect(recorder.Changes()).To(HaveExactElements(And(
+			HaveField("Operation", OperationDelete),
+			HaveField("Name", "existing"),
+		)))
+	})
+
+	It("should not record objects which were created and deleted again", func() {
+		obj := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "new", Namespace: "default"}}
+		Expect(dryRunClient.Create(ctx, obj)).To(Succeed())
+		Expect(client.IgnoreNotFound(dryRunClient.Delete(ctx, obj))).To(Succeed())
+
+		Expect(recorder.Changes()).To(BeEmpty())
+	})
+
+	It("should record the deletion of collections", func() {
+		Expect(dryRunClient.DeleteAllOf(ctx, &corev1.ConfigMap{}, client.InNamespace("default"))).To(Succeed())
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(existing), &corev1.ConfigMap{})).To(Succeed())
+
+		Expect(recorder.Changes()).To(HaveExactElements(And(
+			HaveField("Operation", OperationDeleteCollection),
+			HaveField("Kind", "ConfigMap"),
+			HaveField("Namespace", "default"),
+		)))
+	})
+
+	It("should neither persist nor record status updates", func() {
+		pod := &corev1.Pod{}
+		Expect(fakeClient.Get(ctx, client.ObjectKey{Name: "pod", Namespace: "default"}, pod)).To(Succeed())
+
+		patch := client.MergeFrom(pod.DeepCopy())
+		pod.Status.Phase = corev1.PodRunning
+		Expect(dryRunClient.Status().Patch(ctx, pod, patch)).To(Succeed())
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(pod), pod)).To(Succeed())
+		Expect(pod.Status.Phase).To(BeEmpty())
+		Expect(recorder.Changes()).To(BeEmpty())
+	})
+
+	It("should sort the changes", func() {
+		Expect(dryRunClient.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "b", Namespace: "default"}})).To(Succeed())
+		Expect(dryRunClient.Create(ctx, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "b", Namespace: "default"}})).To(Succeed())
+		Expect(dryRunClient.Create(ctx, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "a", Namespace: "default"}})).To(Succeed())
+		Expect(NewClient(fakeClient, "garden", recorder).Create(ctx, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "c", Namespace: "default"}})).To(Succeed())
+
+		changes, err := recorder.Changes()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(HaveExactElements(
+			And(HaveField("Cluster", "garden"), HaveField("Kind", "ConfigMap"), HaveField("Name", "c")),
+			And(HaveField("Cluster", "seed"), HaveField("Kind", "ConfigMap"), HaveField("Name", "a")),
+			And(HaveField("Cluster", "seed"), HaveField("Kind", "ConfigMap"), HaveField("Name", "b")),
+			And(HaveField("Cluster", "seed"), HaveField("Kind", "Secret"), HaveField("Name", "b")),
+		))
+	})
+
+	Describe("#NewClientSet", func() {
+		It("should return a ClientSet with a dry-run client", func() {
+			clientSet := NewClientSet(fakekubernetes.NewClientSetBuilder().WithClient(fakeClient).Build(), "shoot", recorder)
+
+			obj := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "new", Namespace: "default"}}
+			Expect(clientSet.Client().Create(ctx, obj)).To(Succeed())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(obj), &corev1.ConfigMap{})).To(BeNotFoundError())
+
+			Expect(recorder.Changes()).To(HaveExactElements(And(
+				HaveField("Cluster", "shoot"),
+				HaveField("Operation", OperationCreate),
+			)))
+		})
+	})
+})
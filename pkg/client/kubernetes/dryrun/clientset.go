@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dryrun
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/client/kubernetes/clientmap"
+)
+
+// NewClientSet returns a ClientSet whose controller-runtime client, Applier and ChartApplier only perform dry-run
+// writes, see NewClient. Writes via the typed Kubernetes clientset, the REST client and the PodExecutor are not covered
+// and must not be used in dry-run mode.
+func NewClientSet(clientSet kubernetes.Interface, cluster string, recorder *Recorder) kubernetes.Interface {
+	var (
+		c       = NewClient(clientSet.Client(), cluster, recorder)
+		applier = kubernetes.NewApplier(c, c.RESTMapper())
+	)
+
+	return &dryRunClientSet{
+		Interface:    clientSet,
+		client:       c,
+		applier:      applier,
+		chartApplier: kubernetes.NewChartApplier(clientSet.ChartRenderer(), applier),
+	}
+}
+
+type dryRunClientSet struct {
+	kubernetes.Interface
+
+	client       client.Client
+	applier      kubernetes.Applier
+	chartApplier kubernetes.ChartApplier
+}
+
+func (c *dryRunClientSet) Client() client.Client                 { return c.client }
+func (c *dryRunClientSet) Applier() kubernetes.Applier           { return c.applier }
+func (c *dryRunClientSet) ChartApplier() kubernetes.ChartApplier { return c.chartApplier }
+
+// NewClientMap returns a ClientMap which wraps all ClientSets returned by the given ClientMap with NewClientSet.
+func NewClientMap(clientMap clientmap.ClientMap, cluster string, recorder *Recorder) clientmap.ClientMap {
+	return &dryRunClientMap{ClientMap: clientMap, cluster: cluster, recorder: recorder}
+}
+
+type dryRunClientMap struct {
+	clientmap.ClientMap
+
+	cluster  string
+	recorder *Recorder
+}
+
+func (m *dryRunClientMap) GetClient(ctx context.Context, key clientmap.ClientSetKey) (kubernetes.Interface, error) {
+	clientSet, err := m.ClientMap.GetClient(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewClientSet(clientSet, m.cluster, m.recorder), nil
+}
@@ -0,0 +1,248 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dryrun
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/andybalholm/brotli"
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/yaml"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+)
+
+const (
+	redacted        = "(redacted)"
+	redactedChanged = "(redacted, changed)"
+)
+
+// volatileMetadataFields are fields which are maintained by the API server and would show up in every diff.
+var volatileMetadataFields = []string{"resourceVersion", "generation", "uid", "creationTimestamp", "managedFields", "selfLink"}
+
+// volatileAnnotations are annotations which are set on every write and would show up in every diff.
+var volatileAnnotations = []string{v1beta1constants.GardenerTimestamp, v1beta1constants.GardenerTraceParent}
+
+// Diff returns the difference between the live and the desired state of an object. Either of them may be nil if the
+// object does not exist before or after the change. Fields maintained by the API server and the status are ignored.
+// The data of secrets is redacted, only changed keys are marked. The data of ManagedResource secrets is decompressed so
+// that the diff shows the changes of the contained manifests, with the data of contained secrets being redacted.
+func Diff(live, desired *unstructured.Unstructured) (string, error) {
+	var (
+		liveContent    = normalize(live)
+		desiredContent = normalize(desired)
+	)
+
+	if isSecret(live) || isSecret(desired) {
+		if err := redactSecret(liveContent, desiredContent, true); err != nil {
+			return "", err
+		}
+	}
+
+	return cmp.Diff(liveContent, desiredContent), nil
+}
+
+func normalize(obj *unstructured.Unstructured) map[string]any {
+	if obj == nil {
+		return nil
+	}
+
+	content := runtime.DeepCopyJSON(obj.Object)
+	delete(content, "apiVersion")
+	delete(content, "kind")
+	delete(content, "status")
+
+	for _, field := range volatileMetadataFields {
+		unstructured.RemoveNestedField(content, "metadata", field)
+	}
+	for _, annotation := range volatileAnnotations {
+		unstructured.RemoveNestedField(content, "metadata", "annotations", annotation)
+	}
+	if annotations, ok := nestedMap(content, "metadata", "annotations"); ok && len(annotations) == 0 {
+		unstructured.RemoveNestedField(content, "metadata", "annotations")
+	}
+
+	return content
+}
+
+func isSecret(obj *unstructured.Unstructured) bool {
+	if obj == nil {
+		return false
+	}
+	gvk := obj.GroupVersionKind()
+	return gvk.Group == "" && gvk.Kind == "Secret"
+}
+
+func nestedMap(obj map[string]any, fields ...string) (map[string]any, bool) {
+	if obj == nil {
+		return nil, false
+	}
+	value, found, err := unstructured.NestedFieldNoCopy(obj, fields...)
+	if err != nil || !found {
+		return nil, false
+	}
+	m, ok := value.(map[string]any)
+	return m, ok
+}
+
+// redactSecret replaces the data of the given live and desired secret in place. Keys whose values differ are marked as
+// changed. If decodeCompressed is true, Brotli compressed data (as used for ManagedResource secrets) is decompressed
+// instead.
+func redactSecret(live, desired map[string]any, decodeCompressed bool) error {
+	for _, field := range []string{"data", "stringData"} {
+		var (
+			liveData, _    = nestedMap(live, field)
+			desiredData, _ = nestedMap(desired, field)
+		)
+
+		for key := range sets.KeySet(liveData).Union(sets.KeySet(desiredData)) {
+			liveValue, inLive := liveData[key]
+			desiredValue, inDesired := desiredData[key]
+
+			if decodeCompressed && field == "data" && strings.HasSuffix(key, resourcesv1alpha1.BrotliCompressionSuffix) {
+				liveManifest, desiredManifest, err := decodeManifests(liveValue, desiredValue)
+				if err != nil {
+					return fmt.Errorf("failed decoding data key %q: %w", key, err)
+				}
+				if inLive {
+					liveData[key] = liveManifest
+				}
+				if inDesired {
+					desiredData[key] = desiredManifest
+				}
+				continue
+			}
+
+			if inLive {
+				liveData[key] = redacted
+			}
+			if inDesired {
+				desiredData[key] = redacted
+				if inLive && liveValue != desiredValue {
+					desiredData[key] = redactedChanged
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// decodeManifests decompresses the given live and desired values of a ManagedResource secret and returns the contained
+// manifests with the data of contained secrets being redacted.
+func decodeManifests(liveValue, desiredValue any) (any, any, error) {
+	liveObjects, err := decodeManifest(liveValue)
+	if err != nil {
+		return nil, nil, err
+	}
+	desiredObjects, err := decodeManifest(desiredValue)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	liveSecrets := make(map[string]map[string]any)
+	for _, obj := range liveObjects {
+		if key, ok := manifestSecretKey(obj); ok {
+			liveSecrets[key] = obj
+		}
+	}
+
+	matched := sets.New[string]()
+	for _, obj := range desiredObjects {
+		if key, ok := manifestSecretKey(obj); ok {
+			if err := redactSecret(liveSecrets[key], obj, false); err != nil {
+				return nil, nil, err
+			}
+			matched.Insert(key)
+		}
+	}
+	for key, obj := range liveSecrets {
+		if !matched.Has(key) {
+			if err := redactSecret(obj, nil, false); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+
+	liveManifest, err := encodeManifest(liveObjects)
+	if err != nil {
+		return nil, nil, err
+	}
+	desiredManifest, err := encodeManifest(desiredObjects)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return liveManifest, desiredManifest, nil
+}
+
+func decodeManifest(value any) ([]map[string]any, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	encoded, ok := value.(string)
+	if !ok {
+		return nil, fmt.Errorf("unexpected type %T", value)
+	}
+	compressed, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, err
+	}
+	data, err := io.ReadAll(brotli.NewReader(bytes.NewReader(compressed)))
+	if err != nil {
+		return nil, fmt.Errorf("could not read brotli compressed data: %w", err)
+	}
+
+	var objects []map[string]any
+	for objRaw := range strings.SplitSeq(string(data), "---\n") {
+		if strings.TrimSpace(objRaw) == "" {
+			continue
+		}
+
+		obj := make(map[string]any)
+		if err := yaml.Unmarshal([]byte(objRaw), &obj); err != nil {
+			return nil, fmt.Errorf("could not decode object: %w", err)
+		}
+		objects = append(objects, obj)
+	}
+
+	return objects, nil
+}
+
+func encodeManifest(objects []map[string]any) (any, error) {
+	if objects == nil {
+		return nil, nil
+	}
+
+	documents := make([]string, 0, len(objects))
+	for _, obj := range objects {
+		document, err := yaml.Marshal(obj)
+		if err != nil {
+			return nil, err
+		}
+		documents = append(documents, string(document))
+	}
+
+	return strings.Join(documents, "---\n"), nil
+}
+
+func manifestSecretKey(obj map[string]any) (string, bool) {
+	if obj["apiVersion"] != "v1" || obj["kind"] != "Secret" {
+		return "", false
+	}
+
+	namespace, _, _ := unstructured.NestedString(obj, "metadata", "namespace")
+	name, _, _ := unstructured.NestedString(obj, "metadata", "name")
+	return namespace + "/" + name, true
+}
@@ -0,0 +1,110 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dryrun_test
+
+import (
+	"encoding/base64"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	. "github.com/gardener/gardener/pkg/client/kubernetes/dryrun"
+	"github.com/gardener/gardener/pkg/utils/test"
+)
+
+var _ = Describe("Diff", func() {
+	newObject := func(kind string, data map[string]any) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "v1",
+			"kind":       kind,
+			"metadata": map[string]any{
+				"name":            "foo",
+				"namespace":       "bar",
+				"resourceVersion": "42",
+				"annotations": map[string]any{
+					"gardener.cloud/timestamp": "2026-01-01T00:00:00Z",
+				},
+			},
+			"data": data,
+		}}
+	}
+
+	It("should return an empty diff if only fields maintained by the API server differ", func() {
+		live := newObject("ConfigMap", map[string]any{"foo": "bar"})
+		desired := newObject("ConfigMap", map[string]any{"foo": "bar"})
+		desired.SetResourceVersion("43")
+		desired.SetAnnotations(map[string]string{"gardener.cloud/timestamp": "2026-01-02T00:00:00Z"})
+
+		Expect(Diff(live, desired)).To(BeEmpty())
+	})
+
+	It("should return the diff of the data", func() {
+		diff, err := Diff(newObject("ConfigMap", map[string]any{"foo": "bar"}), newObject("ConfigMap", map[string]any{"foo": "baz"}))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(diff).To(And(ContainSubstring(`"bar"`), ContainSubstring(`"baz"`)))
+	})
+
+	It("should redact the data of secrets", func() {
+		var (
+			live = newObject("Secret", map[string]any{
+				"unchanged": base64.StdEncoding.EncodeToString([]byte("unchanged-secret")),
+				"changed":   base64.StdEncoding.EncodeToString([]byte("old-secret")),
+				"removed":   base64.StdEncoding.EncodeToString([]byte("removed-secret")),
+			})
+			desired = newObject("Secret", map[string]any{
+				"unchanged": base64.StdEncoding.EncodeToString([]byte("unchanged-secret")),
+				"changed":   base64.StdEncoding.EncodeToString([]byte("new-secret")),
+			})
+		)
+
+		diff, err := Diff(live, desired)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(diff).To(And(
+			ContainSubstring(`"changed"`),
+			ContainSubstring("(redacted, changed)"),
+			ContainSubstring(`"removed"`),
+			Not(ContainSubstring(base64.StdEncoding.EncodeToString([]byte("unchanged-secret")))),
+			Not(ContainSubstring(base64.StdEncoding.EncodeToString([]byte("new-secret")))),
+			Not(ContainSubstring(base64.StdEncoding.EncodeToString([]byte("old-secret")))),
+		))
+	})
+
+	It("should decompress the manifests of ManagedResource secrets and redact contained secrets", func() {
+		compress := func(configMapValue, secretValue string) string {
+			data, err := test.BrotliCompressionForManifests(
+				`apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: config
+  namespace: kube-system
+data:
+  key: `+configMapValue,
+				`apiVersion: v1
+kind: Secret
+metadata:
+  name: credentials
+  namespace: kube-system
+data:
+  password: `+base64.StdEncoding.EncodeToString([]byte(secretValue)),
+			)
+			Expect(err).NotTo(HaveOccurred())
+			return base64.StdEncoding.EncodeToString(data)
+		}
+
+		diff, err := Diff(
+			newObject("Secret", map[string]any{"data.yaml.br": compress("old-value", "old-password")}),
+			newObject("Secret", map[string]any{"data.yaml.br": compress("new-value", "new-password")}),
+		)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(diff).To(And(
+			ContainSubstring("key: old-value"),
+			ContainSubstring("key: new-value"),
+			ContainSubstring("password: (redacted, changed)"),
+			Not(ContainSubstring(base64.StdEncoding.EncodeToString([]byte("old-password")))),
+			Not(ContainSubstring(base64.StdEncoding.EncodeToString([]byte("new-password")))),
+		))
+	})
+})
@@ -0,0 +1,211 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dryrun
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Operation is the kind of a change which would be applied to an object.
+type Operation string
+
+const (
+	// OperationCreate means that the object does not exist yet and would be created.
+	OperationCreate Operation = "Create"
+	// OperationUpdate means that the existing object would be modified.
+	OperationUpdate Operation = "Update"
+	// OperationDelete means that the existing object would be deleted.
+	OperationDelete Operation = "Delete"
+	// OperationDeleteCollection means that all objects of a kind matching some selector would be deleted.
+	OperationDeleteCollection Operation = "DeleteCollection"
+)
+
+// Change is a change to a single object which would be applied if the operation was not run in dry-run mode.
+type Change struct {
+	// Cluster identifies the cluster the object belongs to, e.g., 'garden', 'seed' or 'shoot'.
+	Cluster string `json:"cluster"`
+	// Operation is the kind of the change.
+	Operation Operation `json:"operation"`
+	// APIVersion is the API version of the object.
+	APIVersion string `json:"apiVersion"`
+	// Kind is the kind of the object.
+	Kind string `json:"kind"`
+	// Namespace is the namespace of the object.
+	Namespace string `json:"namespace,omitempty"`
+	// Name is the name of the object.
+	Name string `json:"name,omitempty"`
+	// Diff is the difference between the live and the desired object. Data of secrets is redacted.
+	Diff string `json:"diff,omitempty"`
+}
+
+// String returns a short representation of the change, e.g. 'Update seed apps/v1.Deployment shoot--foo--bar/etcd'.
+func (c Change) String() string {
+	name := c.Name
+	if c.Namespace != "" {
+		name = c.Namespace + "/" + c.Name
+	}
+	return strings.TrimSpace(fmt.Sprintf("%s %s %s.%s %s", c.Operation, c.Cluster, c.APIVersion, c.Kind, name))
+}
+
+type objectKey struct {
+	cluster   string
+	gvk       schema.GroupVersionKind
+	namespace string
+	name      string
+}
+
+type recordedObject struct {
+	// live is the state of the object before the first recorded write, nil if the object did not exist.
+	live *unstructured.Unstructured
+	// desired is the state of the object after the last recorded write, nil if the object was deleted.
+	desired *unstructured.Unstructured
+	// deleteCollection is set for DeleteAllOf calls.
+	deleteCollection bool
+}
+
+// Recorder collects the writes of dry-run clients. It is safe for concurrent use.
+type Recorder struct {
+	lock    sync.Mutex
+	objects map[objectKey]*recordedObject
+}
+
+// NewRecorder returns a new Recorder.
+func NewRecorder() *Recorder {
+	return &Recorder{objects: make(map[objectKey]*recordedObject)}
+}
+
+// record stores a write to the given object. Multiple writes to the same object are merged, i.e., the resulting change
+// describes the difference between the object before the first write and after the last write.
+func (r *Recorder) record(cluster string, gvk schema.GroupVersionKind, namespace, name string, live, desired *unstructured.Unstructured) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	key := objectKey{cluster: cluster, gvk: gvk, namespace: namespace, name: name}
+	if obj, ok := r.objects[key]; ok {
+		obj.desired = desired
+		return
+	}
+
+	r.objects[key] = &recordedObject{live: live, desired: desired}
+}
+
+// recordDeleteCollection stores a DeleteAllOf call for the given kind and namespace.
+func (r *Recorder) recordDeleteCollection(cluster string, gvk schema.GroupVersionKind, namespace string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.objects[objectKey{cluster: cluster, gvk: gvk, namespace: namespace}] = &recordedObject{deleteCollection: true}
+}
+
+// Changes returns the recorded changes sorted by cluster, API version, kind, namespace and name. Writes which did not
+// modify the live objects are omitted.
+func (r *Recorder) Changes() ([]Change, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	changes := make([]Change, 0, len(r.objects))
+	for key, obj := range r.objects {
+		apiVersion, kind := key.gvk.ToAPIVersionAndKind()
+		change := Change{
+			Cluster:    key.cluster,
+			APIVersion: apiVersion,
+			Kind:       kind,
+			Namespace:  key.namespace,
+			Name:       key.name,
+		}
+
+		switch {
+		case obj.deleteCollection:
+			change.Operation = OperationDeleteCollection
+		case obj.live == nil && obj.desired == nil:
+			// created and deleted again
+			continue
+		case obj.live == nil:
+			change.Operation = OperationCreate
+		case obj.desired == nil:
+			change.Operation = OperationDelete
+		default:
+			change.Operation = OperationUpdate
+		}
+
+		if !obj.deleteCollection {
+			diff, err := Diff(obj.live, obj.desired)
+			if err != nil {
+				return nil, fmt.Errorf("failed computing diff for %s: %w", change, err)
+			}
+			if diff == "" && change.Operation == OperationUpdate {
+				continue
+			}
+			change.Diff = diff
+		}
+
+		changes = append(changes, change)
+	}
+
+	slices.SortFunc(changes, func(a, b Change) int {
+		return cmp.Or(
+			cmp.Compare(a.Cluster, b.Cluster),
+			cmp.Compare(a.APIVersion, b.APIVersion),
+			cmp.Compare(a.Kind, b.Kind),
+			cmp.Compare(a.Namespace, b.Namespace),
+			cmp.Compare(a.Name, b.Name),
+		)
+	})
+
+	return changes, nil
+}
+
+// Summary returns the number of changes per cluster and operation in a human-readable form, one line per cluster.
+func Summary(changes []Change) string {
+	var (
+		clusters []string
+		counts   = make(map[string]map[Operation]int)
+	)
+
+	for _, change := range changes {
+		if _, ok := counts[change.Cluster]; !ok {
+			clusters = append(clusters, change.Cluster)
+			counts[change.Cluster] = make(map[Operation]int)
+		}
+		counts[change.Cluster][change.Operation]++
+	}
+
+	if len(clusters) == 0 {
+		return "No changes.\n"
+	}
+
+	var b strings.Builder
+	for _, cluster := range clusters {
+		fmt.Fprintf(&b, "%s: %d to create, %d to update, %d to delete\n", cluster,
+			counts[cluster][OperationCreate],
+			counts[cluster][OperationUpdate],
+			counts[cluster][OperationDelete]+counts[cluster][OperationDeleteCollection],
+		)
+	}
+	return b.String()
+}
+
+// Report renders all changes including their diffs in a human-readable form.
+func Report(changes []Change) string {
+	var b strings.Builder
+	for _, change := range changes {
+		fmt.Fprintf(&b, "# %s\n", change)
+		if change.Diff != "" {
+			b.WriteString(change.Diff)
+			if !strings.HasSuffix(change.Diff, "\n") {
+				b.WriteString("\n")
+			}
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
@@ -0,0 +1,48 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dryrun_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener/pkg/client/kubernetes/dryrun"
+)
+
+var _ = Describe("Recorder", func() {
+	var changes []Change
+
+	BeforeEach(func() {
+		changes = []Change{
+			{Cluster: "garden", Operation: OperationUpdate, APIVersion: "v1", Kind: "ConfigMap", Namespace: "garden-foo", Name: "bar.ca-cluster", Diff: "some diff"},
+			{Cluster: "seed", Operation: OperationCreate, APIVersion: "apps/v1", Kind: "Deployment", Namespace: "shoot--foo--bar", Name: "kube-apiserver"},
+			{Cluster: "seed", Operation: OperationDelete, APIVersion: "v1", Kind: "Secret", Namespace: "shoot--foo--bar", Name: "old"},
+			{Cluster: "seed", Operation: OperationDeleteCollection, APIVersion: "v1", Kind: "Pod", Namespace: "shoot--foo--bar"},
+		}
+	})
+
+	Describe("#Summary", func() {
+		It("should count the changes per cluster", func() {
+			Expect(Summary(changes)).To(Equal(`garden: 0 to create, 1 to update, 0 to delete
+seed: 1 to create, 0 to update, 2 to delete
+`))
+		})
+
+		It("should report that there are no changes", func() {
+			Expect(Summary(nil)).To(Equal("No changes.\n"))
+		})
+	})
+
+	Describe("#Report", func() {
+		It("should render the changes with their diffs", func() {
+			Expect(Report(changes[:2])).To(Equal(`# Update garden v1.ConfigMap garden-foo/bar.ca-cluster
+some diff
+
+# Create seed apps/v1.Deployment shoot--foo--bar/kube-apiserver
+
+`))
+		})
+	})
+})
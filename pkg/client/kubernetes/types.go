@@ -119,6 +119,7 @@ var (
 		opentelemetryv1beta1.AddToScheme,
 		victoriametricsv1beta1.AddToScheme,
 		victoriametricsv1.AddToScheme,
+		metricsv1beta1.AddToScheme,
 	)
 
 	shootSchemeBuilder = runtime.NewSchemeBuilder(
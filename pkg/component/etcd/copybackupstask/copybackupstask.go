@@ -38,6 +38,8 @@ type Interface interface {
 	SetSourceStore(druidcorev1alpha1.StoreSpec)
 	// SetTargetStore sets the specifications for the object store provider to which backups will be copied.
 	SetTargetStore(druidcorev1alpha1.StoreSpec)
+	// SetWaitForFinalSnapshot sets the parameters for waiting for a final full snapshot before copying backups.
+	SetWaitForFinalSnapshot(*druidcorev1alpha1.WaitForFinalSnapshotSpec)
 }
 
 // Values contains the values used to create an EtcdCopyBackupsTask resources.
@@ -146,6 +148,11 @@ func (e *etcdCopyBackupsTask) SetTargetStore(store druidcorev1alpha1.StoreSpec)
 	e.values.TargetStore = store
 }
 
+// SetWaitForFinalSnapshot sets the parameters for waiting for a final full snapshot before copying backups.
+func (e *etcdCopyBackupsTask) SetWaitForFinalSnapshot(waitForFinalSnapshot *druidcorev1alpha1.WaitForFinalSnapshotSpec) {
+	e.values.WaitForFinalSnapshot = waitForFinalSnapshot
+}
+
 // waitForConditions waits until the EtcdCopyBackupsTask conditions have been populated by the etcd-druid.
 func waitForConditions(obj client.Object) error {
 	task, ok := obj.(*druidcorev1alpha1.EtcdCopyBackupsTask)
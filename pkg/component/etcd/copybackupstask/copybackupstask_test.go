@@ -73,6 +73,16 @@ var _ = Describe("CopyBackupsTask", func() {
 			Expect(actual.Spec.SourceStore).To(Equal(expected.Spec.SourceStore))
 			Expect(actual.Spec.TargetStore).To(Equal(expected.Spec.TargetStore))
 		})
+
+		It("should create the EtcdCopyBackupsTask with the configured final snapshot settings", func() {
+			waitForFinalSnapshot := &druidcorev1alpha1.WaitForFinalSnapshotSpec{Enabled: true}
+			etcdCopyBackupsTask.SetWaitForFinalSnapshot(waitForFinalSnapshot)
+			Expect(etcdCopyBackupsTask.Deploy(ctx)).To(Succeed())
+
+			actual := &druidcorev1alpha1.EtcdCopyBackupsTask{}
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(expected), actual)).To(Succeed())
+			Expect(actual.Spec.WaitForFinalSnapshot).To(Equal(waitForFinalSnapshot))
+		})
 	})
 
 	Describe("#Destroy", func() {
@@ -183,6 +183,9 @@ type BackupConfig struct {
 	LeaderElection *gardenletconfigv1alpha1.ETCDBackupLeaderElection
 	// DeltaSnapshotRetentionPeriod defines the duration for which delta snapshots will be retained, excluding the latest snapshot set.
 	DeltaSnapshotRetentionPeriod *metav1.Duration
+	// MaxFullSnapshots is the maximum number of full snapshots which are retained. If set, older snapshots are garbage
+	// collected based on this limit instead of exponentially.
+	MaxFullSnapshots *int32
 }
 
 // AutoscalingConfig contains information for configuring autoscaling settings for etcd.
@@ -421,6 +424,12 @@ func (e *etcd) Deploy(ctx context.Context) error {
 			e.etcd.Spec.Backup.DeltaSnapshotMemoryLimit = new(resource.MustParse("100Mi"))
 			e.etcd.Spec.Backup.DeltaSnapshotRetentionPeriod = e.values.BackupConfig.DeltaSnapshotRetentionPeriod
 
+			if e.values.BackupConfig.MaxFullSnapshots != nil {
+				limitBasedGarbageCollectionPolicy := druidcorev1alpha1.GarbageCollectionPolicy(druidcorev1alpha1.GarbageCollectionPolicyLimitBased)
+				e.etcd.Spec.Backup.GarbageCollectionPolicy = &limitBasedGarbageCollectionPolicy
+				e.etcd.Spec.Backup.MaxBackupsLimitBasedGC = e.values.BackupConfig.MaxFullSnapshots
+			}
+
 			if e.values.BackupConfig.LeaderElection != nil {
 				e.etcd.Spec.Backup.LeaderElection = &druidcorev1alpha1.LeaderElectionSpec{
 					EtcdConnectionTimeout: e.values.BackupConfig.LeaderElection.EtcdConnectionTimeout,
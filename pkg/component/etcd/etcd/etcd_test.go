@@ -1091,6 +1091,19 @@ var _ = Describe("Etcd", func() {
 				Expect(c.Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: etcdName}, reconciledEtcd)).To(Succeed())
 				Expect(reconciledEtcd.Spec.Backup.FullSnapshotSchedule).To(HaveValue(Equal(existingBackupSchedule)))
 			})
+
+			It("should successfully deploy (with backup) and garbage collect snapshots based on a limit", func() {
+				limitedBackupConfig := *backupConfig
+				limitedBackupConfig.MaxFullSnapshots = new(int32(5))
+				etcd.SetBackupConfig(&limitedBackupConfig)
+
+				Expect(etcd.Deploy(ctx)).To(Succeed())
+
+				reconciledEtcd := &druidcorev1alpha1.Etcd{}
+				Expect(c.Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: etcdName}, reconciledEtcd)).To(Succeed())
+				Expect(reconciledEtcd.Spec.Backup.GarbageCollectionPolicy).To(HaveValue(Equal(druidcorev1alpha1.GarbageCollectionPolicy(druidcorev1alpha1.GarbageCollectionPolicyLimitBased))))
+				Expect(reconciledEtcd.Spec.Backup.MaxBackupsLimitBasedGC).To(HaveValue(Equal(int32(5))))
+			})
 		})
 
 		When("etcd should run as static pod", func() {
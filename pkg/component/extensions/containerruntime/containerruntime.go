@@ -21,6 +21,7 @@ import (
 	"github.com/gardener/gardener/pkg/controllerutils"
 	"github.com/gardener/gardener/pkg/extensions"
 	"github.com/gardener/gardener/pkg/utils/flow"
+	"github.com/gardener/gardener/pkg/utils/tracing"
 )
 
 const (
@@ -99,6 +100,7 @@ func (c *containerRuntime) deploy(ctx context.Context, cr *extensionsv1alpha1.Co
 	_, err := controllerutils.GetAndCreateOrMergePatch(ctx, c.client, cr, func() error {
 		metav1.SetMetaDataAnnotation(&cr.ObjectMeta, v1beta1constants.GardenerOperation, operation)
 		metav1.SetMetaDataAnnotation(&cr.ObjectMeta, v1beta1constants.GardenerTimestamp, TimeNow().UTC().Format(time.RFC3339Nano))
+		tracing.InjectIntoAnnotations(ctx, &cr.ObjectMeta)
 
 		cr.Spec.BinaryPath = extensionsv1alpha1.ContainerDRuntimeContainersBinFolder
 		cr.Spec.Type = coreCR.Type
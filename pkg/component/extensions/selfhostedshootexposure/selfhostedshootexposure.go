@@ -19,6 +19,7 @@ import (
 	kubeapiserverconstants "github.com/gardener/gardener/pkg/component/kubernetes/apiserver/constants"
 	"github.com/gardener/gardener/pkg/controllerutils"
 	"github.com/gardener/gardener/pkg/extensions"
+	"github.com/gardener/gardener/pkg/utils/tracing"
 )
 
 // Values contains the values used to create a SelfHostedShootExposure resource.
@@ -87,6 +88,7 @@ func (s *SelfHostedShootExposure) Deploy(ctx context.Context) error {
 	_, err := controllerutils.GetAndCreateOrMergePatch(ctx, s.client, s.exposure, func() error {
 		metav1.SetMetaDataAnnotation(&s.exposure.ObjectMeta, v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationReconcile)
 		metav1.SetMetaDataAnnotation(&s.exposure.ObjectMeta, v1beta1constants.GardenerTimestamp, s.Clock.Now().UTC().Format(time.RFC3339Nano))
+		tracing.InjectIntoAnnotations(ctx, &s.exposure.ObjectMeta)
 
 		s.exposure.Spec = extensionsv1alpha1.SelfHostedShootExposureSpec{
 			DefaultSpec: extensionsv1alpha1.DefaultSpec{
@@ -102,6 +102,7 @@ func (b *backupBucket) Deploy(ctx context.Context) error {
 			},
 			ProviderConfig: b.values.Config.ProviderConfig,
 			CredentialsRef: b.values.Config.CredentialsRef,
+			Retention:      b.values.Config.Retention,
 		}
 
 		if b.values.Seed != nil {
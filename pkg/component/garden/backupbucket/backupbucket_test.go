@@ -149,6 +149,22 @@ var _ = Describe("BackupBucket", func() {
 			Expect(actual).To(DeepEqual(expectedBackupBucket))
 		})
 
+		It("should set the retention policy of the BackupBucket", func() {
+			values.Config.Retention = &gardencorev1beta1.BackupRetention{
+				DeletedEntriesGracePeriod: &metav1.Duration{Duration: 72 * time.Hour},
+				MaxFullSnapshots:          new(int32(5)),
+			}
+
+			Expect(deployer.Deploy(ctx)).To(Succeed())
+
+			actual := &gardencorev1beta1.BackupBucket{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Name: backupBucketName}, actual)).To(Succeed())
+			Expect(actual.Spec.Retention).To(Equal(&gardencorev1beta1.BackupRetention{
+				DeletedEntriesGracePeriod: &metav1.Duration{Duration: 72 * time.Hour},
+				MaxFullSnapshots:          new(int32(5)),
+			}))
+		})
+
 		When("seed is present and region is overridden", func() {
 			BeforeEach(func() {
 				backupConfig.Region = new("overridden-region")
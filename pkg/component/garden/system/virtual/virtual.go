@@ -148,7 +148,7 @@ func (g *gardenSystem) computeResourcesData() (map[string][]byte, error) {
 				{
 					APIGroups: []string{gardencorev1beta1.GroupName},
 					Resources: []string{"*"},
-					Verbs:     []string{"create", "delete", "deletecollection", "get", "list", "watch", "patch", "update", "manage-members", "modify-spec-tolerations-whitelist", "modify-spec-kubernetes", "modify-spec-machineimages", "modify-spec-providerconfig", "mark-self-hosted"},
+					Verbs:     []string{"create", "delete", "deletecollection", "get", "list", "watch", "patch", "update", "manage-members", "modify-spec-tolerations-whitelist", "modify-spec-kubernetes", "modify-spec-machineimages", "modify-spec-providerconfig", "mark-self-hosted", "mark-exported"},
 				},
 				{
 					APIGroups: []string{
@@ -457,7 +457,7 @@ func (g *gardenSystem) computeResourcesData() (map[string][]byte, error) {
 				},
 				{
 					APIGroups: []string{operationsv1alpha1.GroupName},
-					Resources: []string{"bastions"},
+					Resources: []string{"bastions", "shootrestores"},
 					Verbs:     []string{"create", "delete", "deletecollection", "get", "list", "watch", "patch", "update"},
 				},
 				{
@@ -476,6 +476,16 @@ func (g *gardenSystem) computeResourcesData() (map[string][]byte, error) {
 					},
 					Verbs: []string{"create"},
 				},
+				{
+					APIGroups: []string{gardencorev1beta1.GroupName},
+					Resources: []string{"shoots/schedulingexplanation"},
+					Verbs:     []string{"get"},
+				},
+				{
+					APIGroups: []string{gardencorev1beta1.GroupName},
+					Resources: []string{"shoots/wakeup"},
+					Verbs:     []string{"create"},
+				},
 				{
 					APIGroups: []string{gardencorev1beta1.GroupName},
 					Resources: []string{"shoots/finalizers"},
@@ -568,7 +578,7 @@ func (g *gardenSystem) computeResourcesData() (map[string][]byte, error) {
 				},
 				{
 					APIGroups: []string{operationsv1alpha1.GroupName},
-					Resources: []string{"bastions"},
+					Resources: []string{"bastions", "shootrestores"},
 					Verbs:     []string{"get", "list", "watch"},
 				},
 				{
@@ -584,6 +594,11 @@ func (g *gardenSystem) computeResourcesData() (map[string][]byte, error) {
 					Resources: []string{"shoots/viewerkubeconfig"},
 					Verbs:     []string{"create"},
 				},
+				{
+					APIGroups: []string{gardencorev1beta1.GroupName},
+					Resources: []string{"shoots/schedulingexplanation"},
+					Verbs:     []string{"get"},
+				},
 			},
 		}
 		clusterRoleProjectViewerAggregated = &rbacv1.ClusterRole{
@@ -169,7 +169,7 @@ var _ = Describe("Virtual", func() {
 				{
 					APIGroups: []string{"core.gardener.cloud"},
 					Resources: []string{"*"},
-					Verbs:     []string{"create", "delete", "deletecollection", "get", "list", "watch", "patch", "update", "manage-members", "modify-spec-tolerations-whitelist", "modify-spec-kubernetes", "modify-spec-machineimages", "modify-spec-providerconfig", "mark-self-hosted"},
+					Verbs:     []string{"create", "delete", "deletecollection", "get", "list", "watch", "patch", "update", "manage-members", "modify-spec-tolerations-whitelist", "modify-spec-kubernetes", "modify-spec-machineimages", "modify-spec-providerconfig", "mark-self-hosted", "mark-exported"},
 				},
 				{
 					APIGroups: []string{
@@ -476,7 +476,7 @@ var _ = Describe("Virtual", func() {
 				},
 				{
 					APIGroups: []string{"operations.gardener.cloud"},
-					Resources: []string{"bastions"},
+					Resources: []string{"bastions", "shootrestores"},
 					Verbs:     []string{"create", "delete", "deletecollection", "get", "list", "watch", "patch", "update"},
 				},
 				{
@@ -495,6 +495,16 @@ var _ = Describe("Virtual", func() {
 					},
 					Verbs: []string{"create"},
 				},
+				{
+					APIGroups: []string{"core.gardener.cloud"},
+					Resources: []string{"shoots/schedulingexplanation"},
+					Verbs:     []string{"get"},
+				},
+				{
+					APIGroups: []string{"core.gardener.cloud"},
+					Resources: []string{"shoots/wakeup"},
+					Verbs:     []string{"create"},
+				},
 				{
 					APIGroups: []string{gardencorev1beta1.GroupName},
 					Resources: []string{"shoots/finalizers"},
@@ -585,7 +595,7 @@ var _ = Describe("Virtual", func() {
 				},
 				{
 					APIGroups: []string{"operations.gardener.cloud"},
-					Resources: []string{"bastions"},
+					Resources: []string{"bastions", "shootrestores"},
 					Verbs:     []string{"get", "list", "watch"},
 				},
 				{
@@ -601,6 +611,11 @@ var _ = Describe("Virtual", func() {
 					Resources: []string{"shoots/viewerkubeconfig"},
 					Verbs:     []string{"create"},
 				},
+				{
+					APIGroups: []string{"core.gardener.cloud"},
+					Resources: []string{"shoots/schedulingexplanation"},
+					Verbs:     []string{"get"},
+				},
 			},
 		}
 		clusterRoleProjectViewerAggregated = &rbacv1.ClusterRole{
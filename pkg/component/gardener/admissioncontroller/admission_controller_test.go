@@ -857,7 +857,7 @@ func clusterRole() *rbacv1.ClusterRole {
 			},
 			{
 				APIGroups: []string{"operations.gardener.cloud"},
-				Resources: []string{"bastions"},
+				Resources: []string{"bastions", "shootrestores"},
 				Verbs:     []string{"get", "list", "watch"},
 			},
 			{
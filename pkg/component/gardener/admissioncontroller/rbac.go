@@ -54,7 +54,7 @@ func (a *gardenerAdmissionController) clusterRole() *rbacv1.ClusterRole {
 			},
 			{
 				APIGroups: []string{operationsv1alpha1.GroupName},
-				Resources: []string{"bastions"},
+				Resources: []string{"bastions", "shootrestores"},
 				Verbs:     []string{"get", "list", "watch"},
 			},
 			{
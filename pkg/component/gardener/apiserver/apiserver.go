@@ -15,6 +15,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/scheduler/v1alpha1"
 	gardencorev1 "github.com/gardener/gardener/pkg/apis/core/v1"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
@@ -91,6 +92,10 @@ type Values struct {
 	ShootAdminKubeconfigMaxExpiration *metav1.Duration
 	// TargetVersion is the version of the kube-apiserver the gardener-apiserver is connecting to.
 	TargetVersion *semver.Version
+	// ShootSchedulerStrategy is the candidate determination strategy of the gardener-scheduler. It is used for
+	// explaining scheduling decisions via the shoots/schedulingexplanation subresource. If empty, the default strategy
+	// of the gardener-scheduler is assumed.
+	ShootSchedulerStrategy schedulerconfigv1alpha1.CandidateDeterminationStrategy
 }
 
 // AutoscalingConfig contains information for configuring autoscaling settings for the API server.
@@ -131,6 +136,7 @@ func (g *gardenerAPIServer) Deploy(ctx context.Context) error {
 		secretETCDEncryptionConfiguration = g.emptySecret(v1beta1constants.SecretNamePrefixGardenerETCDEncryptionConfiguration)
 		secretAuditWebhookKubeconfig      = g.emptySecret(secretAuditWebhookKubeconfigNamePrefix)
 		secretVirtualGardenAccess         = g.newVirtualGardenAccessSecret()
+		configMapShootScheduler           *corev1.ConfigMap
 	)
 
 	secretServer, err := g.reconcileSecretServer(ctx)
@@ -165,6 +171,13 @@ func (g *gardenerAPIServer) Deploy(ctx context.Context) error {
 		return err
 	}
 
+	if g.values.ShootSchedulerStrategy != "" {
+		configMapShootScheduler = g.emptyConfigMap(configMapShootSchedulerNamePrefix)
+		if err := g.reconcileConfigMapShootScheduler(ctx, configMapShootScheduler); err != nil {
+			return err
+		}
+	}
+
 	secretCAGardener, found := g.secretsManager.Get(operatorv1alpha1.SecretNameCAGardener)
 	if !found {
 		return fmt.Errorf("secret %q not found", operatorv1alpha1.SecretNameCAGardener)
@@ -189,7 +202,7 @@ func (g *gardenerAPIServer) Deploy(ctx context.Context) error {
 		g.podDisruptionBudget(),
 		g.serviceRuntime(),
 		g.verticalPodAutoscaler(),
-		g.deployment(secretCAETCD, secretETCDClient, secretGenericTokenKubeconfig, secretServer, secretAdmissionKubeconfigs, secretETCDEncryptionConfiguration, secretAuditWebhookKubeconfig, secretWorkloadIdentityKey, secretVirtualGardenAccess, configMapAuditPolicy, configMapAdmissionConfigs),
+		g.deployment(secretCAETCD, secretETCDClient, secretGenericTokenKubeconfig, secretServer, secretAdmissionKubeconfigs, secretETCDEncryptionConfiguration, secretAuditWebhookKubeconfig, secretWorkloadIdentityKey, secretVirtualGardenAccess, configMapAuditPolicy, configMapAdmissionConfigs, configMapShootScheduler),
 		g.serviceMonitor(),
 	)
 	if err != nil {
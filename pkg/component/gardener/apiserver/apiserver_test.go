@@ -6,6 +6,8 @@ package apiserver_test
 
 import (
 	"context"
+	"encoding/json"
+	"slices"
 	"time"
 
 	"github.com/Masterminds/semver/v3"
@@ -28,7 +30,9 @@ import (
 	apiregistrationv1 "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/yaml"
 
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/scheduler/v1alpha1"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
 	"github.com/gardener/gardener/pkg/component/apiserver"
@@ -1426,6 +1430,61 @@ kubeConfigFile: /etc/kubernetes/admission-kubeconfigs/validatingadmissionwebhook
 						Expect(managedResourceRuntime).To(consistOf(expectedRuntimeObjects...))
 					})
 				})
+
+				Context("with shoot scheduler strategy", func() {
+					var configMapShootScheduler *corev1.ConfigMap
+
+					BeforeEach(func() {
+						values.ShootSchedulerStrategy = schedulerconfigv1alpha1.MinimalDistance
+						deployer = New(fakeClient, namespace, fakeSecretManager, values)
+
+						data, err := json.Marshal(&schedulerconfigv1alpha1.SchedulerConfiguration{
+							TypeMeta: metav1.TypeMeta{
+								APIVersion: "scheduler.config.gardener.cloud/v1alpha1",
+								Kind:       "SchedulerConfiguration",
+							},
+							Schedulers: schedulerconfigv1alpha1.SchedulerControllerConfiguration{
+								Shoot: &schedulerconfigv1alpha1.ShootSchedulerConfiguration{
+									Strategy: schedulerconfigv1alpha1.MinimalDistance,
+								},
+							},
+						})
+						Expect(err).NotTo(HaveOccurred())
+						data, err = yaml.JSONToYAML(data)
+						Expect(err).NotTo(HaveOccurred())
+
+						configMapShootScheduler = &corev1.ConfigMap{
+							ObjectMeta: metav1.ObjectMeta{Name: "gardener-apiserver-shoot-scheduler-config", Namespace: namespace},
+							Data:       map[string]string{"schedulerconfiguration.yaml": string(data)},
+						}
+						Expect(kubernetesutils.MakeUnique(configMapShootScheduler)).To(Succeed())
+
+						// The generic kubeconfig is injected last, hence the shoot scheduler configuration is inserted before it.
+						container := &deployment.Spec.Template.Spec.Containers[0]
+						container.Args = append(container.Args, "--shoot-scheduler-config-file=/etc/gardener-apiserver/shoot-scheduler/schedulerconfiguration.yaml")
+						container.VolumeMounts = slices.Insert(container.VolumeMounts, len(container.VolumeMounts)-1, corev1.VolumeMount{
+							Name:      "shoot-scheduler-config",
+							MountPath: "/etc/gardener-apiserver/shoot-scheduler",
+						})
+						deployment.Spec.Template.Spec.Volumes = slices.Insert(deployment.Spec.Template.Spec.Volumes, len(deployment.Spec.Template.Spec.Volumes)-1, corev1.Volume{
+							Name: "shoot-scheduler-config",
+							VolumeSource: corev1.VolumeSource{
+								ConfigMap: &corev1.ConfigMapVolumeSource{
+									LocalObjectReference: corev1.LocalObjectReference{Name: configMapShootScheduler.Name},
+								},
+							},
+						})
+						utilruntime.Must(references.InjectAnnotations(deployment))
+					})
+
+					It("should deploy the shoot scheduler configuration and pass it to the deployment", func() {
+						Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(configMapShootScheduler), configMapShootScheduler)).To(Succeed())
+						Expect(configMapShootScheduler.Immutable).To(Equal(new(true)))
+						Expect(configMapShootScheduler.Data["schedulerconfiguration.yaml"]).To(ContainSubstring("candidateDeterminationStrategy: MinimalDistance"))
+
+						Expect(managedResourceRuntime).To(consistOf(append(expectedRuntimeObjects, serviceRuntime)...))
+					})
+				})
 			})
 		})
 	})
@@ -5,15 +5,70 @@
 package apiserver
 
 import (
+	"context"
+
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/runtime/serializer/json"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/scheduler/v1alpha1"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 )
 
 const (
-	configMapAuditPolicyNamePrefix = "gardener-apiserver-audit-policy-config"
-	configMapAdmissionNamePrefix   = "gardener-apiserver-admission-config"
+	configMapAuditPolicyNamePrefix    = "gardener-apiserver-audit-policy-config"
+	configMapAdmissionNamePrefix      = "gardener-apiserver-admission-config"
+	configMapShootSchedulerNamePrefix = "gardener-apiserver-shoot-scheduler-config"
+
+	configMapShootSchedulerDataKey = "schedulerconfiguration.yaml"
 )
 
+var schedulerCodec runtime.Codec
+
+func init() {
+	schedulerScheme := runtime.NewScheme()
+	utilruntime.Must(schedulerconfigv1alpha1.AddToScheme(schedulerScheme))
+
+	var (
+		ser = json.NewSerializerWithOptions(json.DefaultMetaFactory, schedulerScheme, schedulerScheme, json.SerializerOptions{
+			Yaml:   true,
+			Pretty: false,
+			Strict: false,
+		})
+		versions = schema.GroupVersions([]schema.GroupVersion{
+			schedulerconfigv1alpha1.SchemeGroupVersion,
+		})
+	)
+
+	schedulerCodec = serializer.NewCodecFactory(schedulerScheme).CodecForVersions(ser, ser, versions, versions)
+}
+
 func (g *gardenerAPIServer) emptyConfigMap(name string) *corev1.ConfigMap {
 	return &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: g.namespace}}
 }
+
+// reconcileConfigMapShootScheduler reconciles the ConfigMap containing the shoot scheduler configuration. It is used
+// for explaining scheduling decisions and must match the configuration of the gardener-scheduler.
+func (g *gardenerAPIServer) reconcileConfigMapShootScheduler(ctx context.Context, configMap *corev1.ConfigMap) error {
+	schedulerConfig := &schedulerconfigv1alpha1.SchedulerConfiguration{
+		Schedulers: schedulerconfigv1alpha1.SchedulerControllerConfiguration{
+			Shoot: &schedulerconfigv1alpha1.ShootSchedulerConfiguration{
+				Strategy: g.values.ShootSchedulerStrategy,
+			},
+		},
+	}
+
+	data, err := runtime.Encode(schedulerCodec, schedulerConfig)
+	if err != nil {
+		return err
+	}
+
+	configMap.Data = map[string]string{configMapShootSchedulerDataKey: string(data)}
+	utilruntime.Must(kubernetesutils.MakeUnique(configMap))
+	return client.IgnoreAlreadyExists(g.client.Create(ctx, configMap))
+}
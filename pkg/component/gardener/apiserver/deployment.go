@@ -43,6 +43,7 @@ func (g *gardenerAPIServer) deployment(
 	secretVirtualGardenAccess *gardenerutils.AccessSecret,
 	configMapAuditPolicy *corev1.ConfigMap,
 	configMapAdmissionConfigs *corev1.ConfigMap,
+	configMapShootScheduler *corev1.ConfigMap,
 ) *appsv1.Deployment {
 	args := []string{
 		"--authorization-always-allow-paths=/healthz",
@@ -157,6 +158,10 @@ func (g *gardenerAPIServer) deployment(
 	apiserver.InjectAdmissionSettings(deployment, configMapAdmissionConfigs, secretAdmissionKubeconfigs, g.values.Values)
 	apiserver.InjectEncryptionSettings(deployment, secretETCDEncryptionConfiguration)
 
+	if configMapShootScheduler != nil {
+		injectShootSchedulerSettings(deployment, configMapShootScheduler)
+	}
+
 	utilruntime.Must(gardenerutils.InjectGenericKubeconfig(deployment, secretGenericTokenKubeconfig.Name, secretVirtualGardenAccess.Secret.Name))
 	utilruntime.Must(references.InjectAnnotations(deployment))
 
@@ -202,3 +207,35 @@ func injectWorkloadIdentitySettings(deployment *appsv1.Deployment, issuer string
 		},
 	)
 }
+
+func injectShootSchedulerSettings(deployment *appsv1.Deployment, configMap *corev1.ConfigMap) {
+	const (
+		mountPath  = "/etc/gardener-apiserver/shoot-scheduler"
+		volumeName = "shoot-scheduler-config"
+	)
+
+	deployment.Spec.Template.Spec.Containers[0].Args = append(
+		deployment.Spec.Template.Spec.Containers[0].Args,
+		fmt.Sprintf("--shoot-scheduler-config-file=%s/%s", mountPath, configMapShootSchedulerDataKey),
+	)
+
+	deployment.Spec.Template.Spec.Containers[0].VolumeMounts = append(
+		deployment.Spec.Template.Spec.Containers[0].VolumeMounts,
+		corev1.VolumeMount{
+			Name:      volumeName,
+			MountPath: mountPath,
+		},
+	)
+
+	deployment.Spec.Template.Spec.Volumes = append(
+		deployment.Spec.Template.Spec.Volumes,
+		corev1.Volume{
+			Name: volumeName,
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{Name: configMap.Name},
+				},
+			},
+		},
+	)
+}
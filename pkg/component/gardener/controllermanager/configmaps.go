@@ -17,6 +17,7 @@ import (
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
 
 	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/controllermanager/v1alpha1"
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/scheduler/v1alpha1"
 	"github.com/gardener/gardener/pkg/logger"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
@@ -109,6 +110,10 @@ func (g *gardenerControllerManager) configMapControllerManagerConfig() (*corev1.
 		FeatureGates: g.values.FeatureGates,
 	}
 
+	if g.values.ShootSchedulerStrategy != "" {
+		controllerManagerConfig.ShootScheduler = &schedulerconfigv1alpha1.ShootSchedulerConfiguration{Strategy: g.values.ShootSchedulerStrategy}
+	}
+
 	data, err := runtime.Encode(controllerManagerCodec, controllerManagerConfig)
 	if err != nil {
 		return nil, err
@@ -12,6 +12,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/controllermanager/v1alpha1"
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/scheduler/v1alpha1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	operatorv1alpha1 "github.com/gardener/gardener/pkg/apis/operator/v1alpha1"
 	"github.com/gardener/gardener/pkg/component"
@@ -50,6 +51,9 @@ type Values struct {
 	Quotas []controllermanagerconfigv1alpha1.QuotaConfiguration
 	// FeatureGates is the set of feature gates.
 	FeatureGates map[string]bool
+	// ShootSchedulerStrategy is the candidate determination strategy of the gardener-scheduler. Controllers which
+	// determine seeds for shoots use it to make the same decisions as the gardener-scheduler.
+	ShootSchedulerStrategy schedulerconfigv1alpha1.CandidateDeterminationStrategy
 }
 
 // New creates a new instance of DeployWaiter for the gardener-controller-manager.
@@ -29,6 +29,7 @@ import (
 	"sigs.k8s.io/yaml"
 
 	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/controllermanager/v1alpha1"
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/scheduler/v1alpha1"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
 	"github.com/gardener/gardener/pkg/component"
@@ -364,6 +365,25 @@ var _ = Describe("GardenerControllerManager", func() {
 				Expect(managedResourceRuntime).To(consistOf(expectedRuntimeObjects...))
 				Expect(deployer.Deploy(ctx)).To(Succeed())
 			})
+
+			It("should configure the shoot scheduler strategy", func() {
+				values.ShootSchedulerStrategy = schedulerconfigv1alpha1.MinimalDistance
+				deployer = New(fakeClient, namespace, fakeSecretManager, values)
+
+				Expect(deployer.Deploy(ctx)).To(Succeed())
+
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResourceRuntime), managedResourceRuntime)).To(Succeed())
+				cm := configMap(namespace, values)
+				Expect(cm.Data["config.yaml"]).To(ContainSubstring("candidateDeterminationStrategy: MinimalDistance"))
+				Expect(managedResourceRuntime).To(consistOf(
+					cm,
+					podDisruptionBudget,
+					serviceRuntime,
+					serviceMonitor,
+					vpa,
+					deployment(namespace, cm.Name, values),
+				))
+			})
 		})
 
 		Context("secrets", func() {
@@ -743,6 +763,10 @@ func configMap(namespace string, testValues Values) *corev1.ConfigMap {
 		FeatureGates: testValues.FeatureGates,
 	}
 
+	if testValues.ShootSchedulerStrategy != "" {
+		controllerManagerConfig.ShootScheduler = &schedulerconfigv1alpha1.ShootSchedulerConfiguration{Strategy: testValues.ShootSchedulerStrategy}
+	}
+
 	data, err := json.Marshal(controllerManagerConfig)
 	utilruntime.Must(err)
 	data, err = yaml.JSONToYAML(data)
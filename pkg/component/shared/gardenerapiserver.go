@@ -15,6 +15,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/gardener/gardener/imagevector"
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/scheduler/v1alpha1"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	operatorv1alpha1 "github.com/gardener/gardener/pkg/apis/operator/v1alpha1"
 	"github.com/gardener/gardener/pkg/component/apiserver"
@@ -40,6 +41,7 @@ func NewGardenerAPIServer(
 	workloadIdentityTokenIssuer string,
 	goAwayChance *float64,
 	targetVersion *semver.Version,
+	shootSchedulerStrategy schedulerconfigv1alpha1.CandidateDeterminationStrategy,
 ) (
 	gardenerapiserver.Interface,
 	error,
@@ -111,6 +113,7 @@ func NewGardenerAPIServer(
 			TopologyAwareRoutingEnabled:       topologyAwareRoutingEnabled,
 			WorkloadIdentityTokenIssuer:       workloadIdentityTokenIssuer,
 			TargetVersion:                     targetVersion,
+			ShootSchedulerStrategy:            shootSchedulerStrategy,
 		},
 	), nil
 }
@@ -28,6 +28,7 @@ import (
 	"github.com/gardener/gardener/pkg/controllermanager/controller/quota"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/secretbinding"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/seed"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/seedrebalancer"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shootstate"
 )
@@ -133,6 +134,12 @@ func AddToManager(ctx context.Context, mgr manager.Manager, cfg *controllermanag
 		return fmt.Errorf("failed adding Seed controller: %w", err)
 	}
 
+	if config := cfg.Controllers.SeedRebalancer; config != nil {
+		if err := seedrebalancer.AddToManager(mgr, *config, cfg.ShootScheduler); err != nil {
+			return fmt.Errorf("failed adding SeedRebalancer controller: %w", err)
+		}
+	}
+
 	if err := shoot.AddToManager(mgr, *cfg); err != nil {
 		return fmt.Errorf("failed adding Shoot controller: %w", err)
 	}
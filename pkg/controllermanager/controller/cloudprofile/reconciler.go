@@ -8,16 +8,22 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
 	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/controllermanager/v1alpha1"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
@@ -28,6 +34,7 @@ import (
 type Reconciler struct {
 	Client   client.Client
 	Config   controllermanagerconfigv1alpha1.CloudProfileControllerConfiguration
+	Clock    clock.Clock
 	Recorder events.EventRecorder
 }
 
@@ -97,5 +104,110 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		}
 	}
 
+	if err := r.updateStatus(ctx, cloudProfile); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to update status: %w", err)
+	}
+
+	// The classifications in the status are refreshed when the next lifecycle stage of any version begins.
+	if nextTransition := nextClassificationTransition(cloudProfile, r.Clock.Now()); nextTransition != nil {
+		return reconcile.Result{RequeueAfter: nextTransition.Sub(r.Clock.Now())}, nil
+	}
+
 	return reconcile.Result{}, nil
 }
+
+// updateStatus records the current classification of the Kubernetes and machine image versions in the CloudProfile
+// status, together with the time at which they were first observed.
+func (r *Reconciler) updateStatus(ctx context.Context, cloudProfile *gardencorev1beta1.CloudProfile) error {
+	var (
+		patch     = client.MergeFrom(cloudProfile.DeepCopy())
+		addedTime = metav1.NewTime(r.Clock.Now()).Rfc3339Copy()
+		status    = gardencorev1beta1.CloudProfileStatus{}
+	)
+
+	// The time at which the versions were added is unknown if the status is populated for the first time, hence they
+	// are considered to have been added together with the CloudProfile.
+	if cloudProfile.Status.Kubernetes == nil && cloudProfile.Status.MachineImages == nil {
+		addedTime = cloudProfile.CreationTimestamp
+	}
+
+	if len(cloudProfile.Spec.Kubernetes.Versions) > 0 {
+		var oldVersions []gardencorev1beta1.ExpirableVersionStatus
+		if cloudProfile.Status.Kubernetes != nil {
+			oldVersions = cloudProfile.Status.Kubernetes.Versions
+		}
+		status.Kubernetes = &gardencorev1beta1.KubernetesStatus{
+			Versions: computeVersionStatuses(cloudProfile.Spec.Kubernetes.Versions, oldVersions, addedTime),
+		}
+	}
+
+	for _, machineImage := range cloudProfile.Spec.MachineImages {
+		var oldVersions []gardencorev1beta1.ExpirableVersionStatus
+		for _, machineImageStatus := range cloudProfile.Status.MachineImages {
+			if machineImageStatus.Name == machineImage.Name {
+				oldVersions = machineImageStatus.Versions
+				break
+			}
+		}
+		status.MachineImages = append(status.MachineImages, gardencorev1beta1.MachineImageStatus{
+			Name:     machineImage.Name,
+			Versions: computeVersionStatuses(v1beta1helper.ToExpirableVersions(machineImage.Versions), oldVersions, addedTime),
+		})
+	}
+
+	if apiequality.Semantic.DeepEqual(status, cloudProfile.Status) {
+		return nil
+	}
+
+	cloudProfile.Status = status
+	return r.Client.Status().Patch(ctx, cloudProfile, patch)
+}
+
+func computeVersionStatuses(versions []gardencorev1beta1.ExpirableVersion, oldStatuses []gardencorev1beta1.ExpirableVersionStatus, addedTime metav1.Time) []gardencorev1beta1.ExpirableVersionStatus {
+	oldAddedTimes := make(map[string]*metav1.Time, len(oldStatuses))
+	for _, oldStatus := range oldStatuses {
+		oldAddedTimes[oldStatus.Version] = oldStatus.AddedTime
+	}
+
+	statuses := make([]gardencorev1beta1.ExpirableVersionStatus, 0, len(versions))
+	for _, version := range versions {
+		versionAddedTime := oldAddedTimes[version.Version]
+		if versionAddedTime == nil {
+			versionAddedTime = addedTime.DeepCopy()
+		}
+
+		statuses = append(statuses, gardencorev1beta1.ExpirableVersionStatus{
+			Version:        version.Version,
+			Classification: v1beta1helper.CurrentLifecycleClassification(version),
+			AddedTime:      versionAddedTime,
+		})
+	}
+
+	return statuses
+}
+
+// nextClassificationTransition returns the earliest time after <now> at which the classification of a version of the
+// given CloudProfile changes, or nil if there is none.
+func nextClassificationTransition(cloudProfile *gardencorev1beta1.CloudProfile, now time.Time) *time.Time {
+	var next *time.Time
+
+	consider := func(t *metav1.Time) {
+		if t != nil && t.After(now) && (next == nil || t.Before(*next)) {
+			next = &t.Time
+		}
+	}
+
+	versions := slices.Clone(cloudProfile.Spec.Kubernetes.Versions)
+	for _, machineImage := range cloudProfile.Spec.MachineImages {
+		versions = append(versions, v1beta1helper.ToExpirableVersions(machineImage.Versions)...)
+	}
+
+	for _, version := range versions {
+		consider(version.ExpirationDate)
+		for _, stage := range version.Lifecycle {
+			consider(stage.StartTime)
+		}
+	}
+
+	return next
+}
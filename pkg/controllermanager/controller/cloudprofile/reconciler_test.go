@@ -7,12 +7,15 @@ package cloudprofile_test
 import (
 	"context"
 	"errors"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/events"
+	testclock "k8s.io/utils/clock/testing"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
@@ -32,6 +35,7 @@ var _ = Describe("Reconciler", func() {
 	var (
 		ctx        = context.TODO()
 		fakeClient client.Client
+		fakeClock  *testclock.FakeClock
 
 		cloudProfileName string
 		fakeErr          error
@@ -45,13 +49,15 @@ var _ = Describe("Reconciler", func() {
 
 		fakeClient = fakeclient.NewClientBuilder().
 			WithScheme(kubernetes.GardenScheme).
+			WithStatusSubresource(&gardencorev1beta1.CloudProfile{}).
 			WithIndex(
 				&gardencorev1beta1.NamespacedCloudProfile{},
 				core.NamespacedCloudProfileParentRefName,
 				indexer.NamespacedCloudProfileParentRefNameIndexerFunc,
 			).
 			Build()
-		reconciler = &Reconciler{Client: fakeClient, Recorder: &events.FakeRecorder{}}
+		fakeClock = testclock.NewFakeClock(time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC))
+		reconciler = &Reconciler{Client: fakeClient, Clock: fakeClock, Recorder: &events.FakeRecorder{}}
 		cloudProfile = &gardencorev1beta1.CloudProfile{
 			ObjectMeta: metav1.ObjectMeta{
 				Name: cloudProfileName,
@@ -74,7 +80,7 @@ var _ = Describe("Reconciler", func() {
 				},
 			}).
 			Build()
-		reconciler = &Reconciler{Client: fakeClient, Recorder: &events.FakeRecorder{}}
+		reconciler = &Reconciler{Client: fakeClient, Clock: fakeClock, Recorder: &events.FakeRecorder{}}
 
 		result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: cloudProfileName}})
 		Expect(result).To(Equal(reconcile.Result{}))
@@ -97,7 +103,7 @@ var _ = Describe("Reconciler", func() {
 					},
 				}).
 				Build()
-			reconciler = &Reconciler{Client: fakeClient, Recorder: &events.FakeRecorder{}}
+			reconciler = &Reconciler{Client: fakeClient, Clock: fakeClock, Recorder: &events.FakeRecorder{}}
 
 			Expect(fakeClient.Create(ctx, cloudProfile.DeepCopy())).To(Succeed())
 
@@ -115,6 +121,64 @@ var _ = Describe("Reconciler", func() {
 			Expect(fakeClient.Get(ctx, client.ObjectKey{Name: cloudProfileName}, cloudProfile)).To(Succeed())
 			Expect(cloudProfile.Finalizers).To(ContainElement(finalizerName))
 		})
+
+		Context("version status", func() {
+			BeforeEach(func() {
+				Expect(fakeClient.Get(ctx, client.ObjectKey{Name: cloudProfileName}, cloudProfile)).To(Succeed())
+				cloudProfile.Spec.Kubernetes.Versions = []gardencorev1beta1.ExpirableVersion{{Version: "1.33.1"}}
+				cloudProfile.Spec.MachineImages = []gardencorev1beta1.MachineImage{{
+					Name:     "gardenlinux",
+					Versions: []gardencorev1beta1.MachineImageVersion{{ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "1.0.0"}}},
+				}}
+				Expect(fakeClient.Update(ctx, cloudProfile)).To(Succeed())
+			})
+
+			It("should record the classification and the time at which versions were added", func() {
+				_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: cloudProfileName}})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeClient.Get(ctx, client.ObjectKey{Name: cloudProfileName}, cloudProfile)).To(Succeed())
+				Expect(cloudProfile.Status.Kubernetes.Versions).To(ConsistOf(
+					MatchFields(IgnoreExtras, Fields{
+						"Version":        Equal("1.33.1"),
+						"Classification": Equal(gardencorev1beta1.ClassificationSupported),
+						"AddedTime":      Not(BeNil()),
+					}),
+				))
+				Expect(cloudProfile.Status.MachineImages).To(ConsistOf(
+					MatchFields(IgnoreExtras, Fields{
+						"Name": Equal("gardenlinux"),
+						"Versions": ConsistOf(MatchFields(IgnoreExtras, Fields{
+							"Version":   Equal("1.0.0"),
+							"AddedTime": Not(BeNil()),
+						})),
+					}),
+				))
+				initialAddedTime := cloudProfile.Status.Kubernetes.Versions[0].AddedTime.DeepCopy()
+
+				fakeClock.Step(time.Hour)
+				cloudProfile.Spec.Kubernetes.Versions = append(cloudProfile.Spec.Kubernetes.Versions, gardencorev1beta1.ExpirableVersion{Version: "1.33.2"})
+				Expect(fakeClient.Update(ctx, cloudProfile)).To(Succeed())
+
+				_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: cloudProfileName}})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeClient.Get(ctx, client.ObjectKey{Name: cloudProfileName}, cloudProfile)).To(Succeed())
+				Expect(cloudProfile.Status.Kubernetes.Versions).To(HaveLen(2))
+				Expect(cloudProfile.Status.Kubernetes.Versions[0].AddedTime.Equal(initialAddedTime)).To(BeTrue())
+				Expect(cloudProfile.Status.Kubernetes.Versions[1].Version).To(Equal("1.33.2"))
+				Expect(cloudProfile.Status.Kubernetes.Versions[1].AddedTime.Time).To(BeTemporally("==", fakeClock.Now()))
+			})
+
+			It("should requeue when the next lifecycle stage of a version begins", func() {
+				cloudProfile.Spec.Kubernetes.Versions[0].ExpirationDate = &metav1.Time{Time: fakeClock.Now().Add(48 * time.Hour)}
+				Expect(fakeClient.Update(ctx, cloudProfile)).To(Succeed())
+
+				result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: cloudProfileName}})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(reconcile.Result{RequeueAfter: 48 * time.Hour}))
+			})
+		})
 	})
 
 	Context("when deletion timestamp set", func() {
@@ -180,7 +244,7 @@ var _ = Describe("Reconciler", func() {
 					},
 				}).
 				Build()
-			reconciler = &Reconciler{Client: fakeClient, Recorder: &events.FakeRecorder{}}
+			reconciler = &Reconciler{Client: fakeClient, Clock: fakeClock, Recorder: &events.FakeRecorder{}}
 
 			cp := cloudProfile.DeepCopy()
 			cp.ResourceVersion = ""
@@ -8,12 +8,14 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"slices"
 	"sort"
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/controllermanager/v1alpha1"
@@ -100,6 +102,30 @@ func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, managedSeedSe
 	status.Replicas = int32(len(replicas))           // #nosec G115 -- `ra.replicaGetter.GetReplicas(ctx, managedSeedSet)` returns a line for every ManagedSeeds in the system. This number cannot exceed max int32.
 	status.ReadyReplicas = int32(len(readyReplicas)) // #nosec G115 -- `ra.replicaGetter.GetReplicas(ctx, managedSeedSet)` returns a line for every ManagedSeeds in the system. This number cannot exceed max int32.
 
+	// Adopt managed seeds without revision which already match the template, so that they are not rolled out needlessly
+	for _, r := range replicas {
+		if err := r.AdoptManagedSeed(ctx, a.gardenClient); err != nil {
+			return status, false, err
+		}
+	}
+
+	// Update revisions and the replica counts per revision in status
+	// Replicas are updated in descending ordinal order, so the replica with the lowest ordinal is on the current revision
+	status.UpdateRevision = ComputeRevision(managedSeedSet)
+	status.CurrentRevision = status.UpdateRevision
+	if len(replicas) > 0 {
+		status.CurrentRevision = replicas[0].GetRevision()
+	}
+	status.CurrentReplicas, status.UpdatedReplicas = 0, 0
+	for _, r := range replicas {
+		if r.GetRevision() == status.CurrentRevision {
+			status.CurrentReplicas++
+		}
+		if r.GetRevision() == status.UpdateRevision {
+			status.UpdatedReplicas++
+		}
+	}
+
 	// Determine the actual and target replica counts
 	count := len(replicas)
 	targetCount := 0
@@ -160,6 +186,11 @@ func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, managedSeedSe
 		}
 	}
 
+	// Update outdated replicas
+	if pending, err := a.updateReplicas(ctx, log, managedSeedSet, status, replicas); err != nil || pending {
+		return status, false, err
+	}
+
 	log.V(1).Info("Nothing to do")
 	status.PendingReplica = nil
 	return status, true, nil
@@ -181,6 +212,8 @@ const (
 	EventWaitingForManagedSeedRegistered = "WaitingForManagedSeedRegistered"
 	EventWaitingForManagedSeedDeleted    = "WaitingForManagedSeedDeleted"
 	EventWaitingForSeedReady             = "WaitingForSeedReady"
+	EventUpdatingManagedSeed             = "UpdatingManagedSeed"
+	EventWaitingForSeedSoak              = "WaitingForSeedSoak"
 )
 
 func (a *actuator) reconcileReplica(
@@ -295,6 +328,70 @@ func (a *actuator) reconcileReplica(
 	return false, nil
 }
 
+// updateReplicas updates the managed seeds of outdated replicas with an ordinal greater than or equal to the partition
+// in descending ordinal order, at most maxUnavailable at a time. It is only called if all replicas are ready. If a
+// health gate is configured, the seed of the most recently updated replica must stay ready for the soak duration
+// before the next replicas are updated.
+func (a *actuator) updateReplicas(
+	ctx context.Context,
+	log logr.Logger,
+	managedSeedSet *seedmanagementv1alpha1.ManagedSeedSet,
+	status *seedmanagementv1alpha1.ManagedSeedSetStatus,
+	replicas []Replica,
+) (bool, error) {
+	partition, maxUnavailable, healthGate := getRollingUpdateParameters(managedSeedSet)
+
+	// Determine updated and outdated replicas in descending ordinal order
+	var updatedReplicas, outdatedReplicas []Replica
+	for _, r := range slices.Backward(replicas) {
+		switch {
+		case r.GetOrdinal() < partition:
+			continue
+		case r.GetRevision() == status.UpdateRevision:
+			updatedReplicas = append(updatedReplicas, r)
+		default:
+			outdatedReplicas = append(outdatedReplicas, r)
+		}
+	}
+	if len(outdatedReplicas) == 0 {
+		return false, nil
+	}
+
+	// Wait for the seed of the most recently updated replica to soak before continuing
+	if healthGate != nil && len(updatedReplicas) > 0 {
+		r := getPendingReplica(updatedReplicas, status)
+		if r == nil {
+			r = updatedReplicas[len(updatedReplicas)-1]
+		}
+		updatePendingReplica(status, r.GetName(), seedmanagementv1alpha1.SeedSoakingReason, nil)
+
+		soakDuration := ptr.Deref(healthGate.SoakDuration, metav1.Duration{}).Duration
+		if remaining := soakDuration - Now().Sub(status.PendingReplica.Since.Time); remaining > 0 {
+			log.Info("Waiting for Seed to soak before updating the next replicas", "replica", r.GetObjectKey(), "remaining", remaining)
+			a.infoEventf(managedSeedSet, EventWaitingForSeedSoak, gardencorev1beta1.EventActionReconcile, "Waiting for Seed %s to soak before updating the next replicas", r.GetName())
+			return true, nil
+		}
+	}
+
+	for _, r := range outdatedReplicas[:min(len(outdatedReplicas), int(maxUnavailable))] {
+		wasCurrent := r.GetRevision() == status.CurrentRevision
+
+		log.Info("Updating ManagedSeed", "replica", r.GetObjectKey(), "revision", status.UpdateRevision)
+		a.infoEventf(managedSeedSet, EventUpdatingManagedSeed, gardencorev1beta1.EventActionReconcile, "Updating ManagedSeed %s to revision %s", r.GetFullName(), status.UpdateRevision)
+		if err := r.UpdateManagedSeed(ctx, a.gardenClient); err != nil {
+			return false, err
+		}
+
+		// Account for the updated replica in status
+		if wasCurrent {
+			status.CurrentReplicas--
+		}
+		status.UpdatedReplicas++
+		updatePendingReplica(status, r.GetName(), seedmanagementv1alpha1.ManagedSeedPreparingReason, nil)
+	}
+	return true, nil
+}
+
 func (a *actuator) createReplica(
 	ctx context.Context,
 	log logr.Logger,
@@ -389,6 +486,17 @@ func getNextOrdinal(replicas []Replica, status *seedmanagementv1alpha1.ManagedSe
 	return status.NextReplicaNumber
 }
 
+// getRollingUpdateParameters returns the partition, the maximum number of unavailable replicas, and the health gate
+// of the rolling update strategy of the given ManagedSeedSet, falling back to the defaults if they are not set.
+func getRollingUpdateParameters(managedSeedSet *seedmanagementv1alpha1.ManagedSeedSet) (int32, int32, *seedmanagementv1alpha1.RollingUpdateHealthGate) {
+	if managedSeedSet.Spec.UpdateStrategy == nil || managedSeedSet.Spec.UpdateStrategy.RollingUpdate == nil {
+		return 0, 1, nil
+	}
+
+	rollingUpdate := managedSeedSet.Spec.UpdateStrategy.RollingUpdate
+	return ptr.Deref(rollingUpdate.Partition, 0), ptr.Deref(rollingUpdate.MaxUnavailable, 1), rollingUpdate.HealthGate
+}
+
 func replicaIsReady(r Replica) bool {
 	return r.GetStatus() == StatusManagedSeedRegistered && r.IsSeedReady() && r.GetShootHealthStatus() == gardenerutils.ShootStatusHealthy
 }
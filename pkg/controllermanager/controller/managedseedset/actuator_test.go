@@ -7,6 +7,7 @@ package managedseedset_test
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
@@ -55,6 +56,8 @@ var _ = Describe("Actuator", func() {
 		before  = metav1.Now()
 		now     = metav1.Now()
 		cleanup func()
+
+		revision = ComputeRevision(&seedmanagementv1alpha1.ManagedSeedSet{})
 	)
 
 	BeforeEach(func() {
@@ -137,11 +140,15 @@ var _ = Describe("Actuator", func() {
 				Replicas:           replicas,
 				ReadyReplicas:      readyReplicas,
 				NextReplicaNumber:  nextReplicaNumber,
+				CurrentReplicas:    1,
+				UpdatedReplicas:    1,
+				CurrentRevision:    revision,
+				UpdateRevision:     revision,
 				PendingReplica:     pendingReplica,
 			}
 		}
 
-		expectReplica = func(r *mockmanagedseedset.MockReplica, ordinal int32, status ReplicaStatus, seedReady bool, shootStatus gardenerutils.ShootStatus, deletable bool) {
+		expectReplicaWithRevision = func(r *mockmanagedseedset.MockReplica, ordinal int32, status ReplicaStatus, seedReady bool, shootStatus gardenerutils.ShootStatus, deletable bool, revision string) {
 			r.EXPECT().GetName().Return(getReplicaName(ordinal)).AnyTimes()
 			r.EXPECT().GetFullName().Return(getReplicaFullName(ordinal)).AnyTimes()
 			r.EXPECT().GetObjectKey().Return(getReplicaObjectKey(ordinal)).AnyTimes()
@@ -150,6 +157,11 @@ var _ = Describe("Actuator", func() {
 			r.EXPECT().IsSeedReady().Return(seedReady).AnyTimes()
 			r.EXPECT().GetShootHealthStatus().Return(shootStatus).AnyTimes()
 			r.EXPECT().IsDeletable().Return(deletable).AnyTimes()
+			r.EXPECT().GetRevision().Return(revision).AnyTimes()
+			r.EXPECT().AdoptManagedSeed(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+		}
+		expectReplica = func(r *mockmanagedseedset.MockReplica, ordinal int32, status ReplicaStatus, seedReady bool, shootStatus gardenerutils.ShootStatus, deletable bool) {
+			expectReplicaWithRevision(r, ordinal, status, seedReady, shootStatus, deletable, revision)
 		}
 	)
 
@@ -529,6 +541,164 @@ var _ = Describe("Actuator", func() {
 			),
 		)
 	})
+
+	Context("rolling update", func() {
+		const oldRevision = "old"
+
+		var (
+			r1, r2 *mockmanagedseedset.MockReplica
+
+			managedSeedSet *seedmanagementv1alpha1.ManagedSeedSet
+		)
+
+		BeforeEach(func() {
+			r1 = mockmanagedseedset.NewMockReplica(ctrl)
+			r2 = mockmanagedseedset.NewMockReplica(ctrl)
+
+			managedSeedSet = &seedmanagementv1alpha1.ManagedSeedSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       name,
+					Namespace:  namespace,
+					Generation: 1,
+				},
+				Spec: seedmanagementv1alpha1.ManagedSeedSetSpec{
+					Replicas: new(int32(3)),
+					UpdateStrategy: &seedmanagementv1alpha1.UpdateStrategy{
+						RollingUpdate: &seedmanagementv1alpha1.RollingUpdateStrategy{
+							Partition:      new(int32(0)),
+							MaxUnavailable: new(int32(1)),
+						},
+					},
+				},
+				Status: seedmanagementv1alpha1.ManagedSeedSetStatus{
+					Replicas:          3,
+					NextReplicaNumber: 3,
+				},
+			}
+		})
+
+		expectReadyReplicas := func(revisions ...string) {
+			for i, r := range []*mockmanagedseedset.MockReplica{r0, r1, r2} {
+				expectReplicaWithRevision(r, int32(i), StatusManagedSeedRegistered, true, gardenerutils.ShootStatusHealthy, true, revisions[i]) // #nosec G115 -- There are only three replicas.
+			}
+			rg.EXPECT().GetReplicas(ctx, managedSeedSet).Return([]Replica{r0, r1, r2}, nil)
+		}
+
+		expectUpdate := func(r *mockmanagedseedset.MockReplica, ordinal int32) {
+			recorder.EXPECT().Eventf(managedSeedSet, nil, corev1.EventTypeNormal, EventUpdatingManagedSeed, gardencorev1beta1.EventActionReconcile, "Updating ManagedSeed %s to revision %s", []any{getReplicaFullName(ordinal), revision})
+			r.EXPECT().UpdateManagedSeed(ctx, gc).Return(nil)
+		}
+
+		rollingUpdateStatus := func(currentReplicas, updatedReplicas int32, pendingReplica *seedmanagementv1alpha1.PendingReplica) *seedmanagementv1alpha1.ManagedSeedSetStatus {
+			return &seedmanagementv1alpha1.ManagedSeedSetStatus{
+				ObservedGeneration: 1,
+				Replicas:           3,
+				ReadyReplicas:      3,
+				NextReplicaNumber:  3,
+				CurrentReplicas:    currentReplicas,
+				UpdatedReplicas:    updatedReplicas,
+				CurrentRevision:    oldRevision,
+				UpdateRevision:     revision,
+				PendingReplica:     pendingReplica,
+			}
+		}
+
+		It("should update the replica with the highest ordinal first", func() {
+			expectReadyReplicas(oldRevision, oldRevision, oldRevision)
+			expectUpdate(r2, 2)
+
+			s, rf, err := actuator.Reconcile(ctx, log, managedSeedSet)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(s).To(Equal(rollingUpdateStatus(2, 1, &seedmanagementv1alpha1.PendingReplica{Name: getReplicaName(2), Reason: seedmanagementv1alpha1.ManagedSeedPreparingReason, Since: now})))
+			Expect(rf).To(BeFalse())
+		})
+
+		It("should update up to maxUnavailable replicas at the same time", func() {
+			managedSeedSet.Spec.UpdateStrategy.RollingUpdate.MaxUnavailable = new(int32(2))
+			expectReadyReplicas(oldRevision, oldRevision, oldRevision)
+			expectUpdate(r2, 2)
+			expectUpdate(r1, 1)
+
+			s, rf, err := actuator.Reconcile(ctx, log, managedSeedSet)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(s).To(Equal(rollingUpdateStatus(1, 2, &seedmanagementv1alpha1.PendingReplica{Name: getReplicaName(1), Reason: seedmanagementv1alpha1.ManagedSeedPreparingReason, Since: now})))
+			Expect(rf).To(BeFalse())
+		})
+
+		It("should not update replicas with an ordinal lower than the partition", func() {
+			managedSeedSet.Spec.UpdateStrategy.RollingUpdate.Partition = new(int32(2))
+			expectReadyReplicas(oldRevision, oldRevision, revision)
+
+			s, rf, err := actuator.Reconcile(ctx, log, managedSeedSet)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(s).To(Equal(rollingUpdateStatus(2, 1, nil)))
+			Expect(rf).To(BeTrue())
+		})
+
+		Context("with health gate", func() {
+			BeforeEach(func() {
+				managedSeedSet.Spec.UpdateStrategy.RollingUpdate.HealthGate = &seedmanagementv1alpha1.RollingUpdateHealthGate{
+					SoakDuration: &metav1.Duration{Duration: 10 * time.Minute},
+				}
+			})
+
+			It("should not wait before updating the first replica", func() {
+				expectReadyReplicas(oldRevision, oldRevision, oldRevision)
+				expectUpdate(r2, 2)
+
+				s, _, err := actuator.Reconcile(ctx, log, managedSeedSet)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(s).To(Equal(rollingUpdateStatus(2, 1, &seedmanagementv1alpha1.PendingReplica{Name: getReplicaName(2), Reason: seedmanagementv1alpha1.ManagedSeedPreparingReason, Since: now})))
+			})
+
+			It("should start soaking the seed of the updated replica once it is ready", func() {
+				managedSeedSet.Status.PendingReplica = &seedmanagementv1alpha1.PendingReplica{Name: getReplicaName(2), Reason: seedmanagementv1alpha1.ManagedSeedPreparingReason, Since: before}
+				expectReadyReplicas(oldRevision, oldRevision, revision)
+				recorder.EXPECT().Eventf(managedSeedSet, nil, corev1.EventTypeNormal, EventWaitingForSeedSoak, gardencorev1beta1.EventActionReconcile, "Waiting for Seed %s to soak before updating the next replicas", []any{getReplicaName(2)})
+
+				s, rf, err := actuator.Reconcile(ctx, log, managedSeedSet)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(s).To(Equal(rollingUpdateStatus(2, 1, &seedmanagementv1alpha1.PendingReplica{Name: getReplicaName(2), Reason: seedmanagementv1alpha1.SeedSoakingReason, Since: now})))
+				Expect(rf).To(BeFalse())
+			})
+
+			It("should keep waiting while the seed of the updated replica is soaking", func() {
+				since := metav1.NewTime(now.Add(-5 * time.Minute))
+				managedSeedSet.Status.PendingReplica = &seedmanagementv1alpha1.PendingReplica{Name: getReplicaName(2), Reason: seedmanagementv1alpha1.SeedSoakingReason, Since: since}
+				expectReadyReplicas(oldRevision, oldRevision, revision)
+				recorder.EXPECT().Eventf(managedSeedSet, nil, corev1.EventTypeNormal, EventWaitingForSeedSoak, gardencorev1beta1.EventActionReconcile, "Waiting for Seed %s to soak before updating the next replicas", []any{getReplicaName(2)})
+
+				s, _, err := actuator.Reconcile(ctx, log, managedSeedSet)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(s).To(Equal(rollingUpdateStatus(2, 1, &seedmanagementv1alpha1.PendingReplica{Name: getReplicaName(2), Reason: seedmanagementv1alpha1.SeedSoakingReason, Since: since})))
+			})
+
+			It("should restart the soak if the seed of the updated replica became unready", func() {
+				managedSeedSet.Status.PendingReplica = &seedmanagementv1alpha1.PendingReplica{Name: getReplicaName(2), Reason: seedmanagementv1alpha1.SeedSoakingReason, Since: metav1.NewTime(now.Add(-time.Hour))}
+				expectReplicaWithRevision(r0, 0, StatusManagedSeedRegistered, true, gardenerutils.ShootStatusHealthy, true, oldRevision)
+				expectReplicaWithRevision(r1, 1, StatusManagedSeedRegistered, true, gardenerutils.ShootStatusHealthy, true, oldRevision)
+				expectReplicaWithRevision(r2, 2, StatusManagedSeedRegistered, false, gardenerutils.ShootStatusHealthy, true, revision)
+				rg.EXPECT().GetReplicas(ctx, managedSeedSet).Return([]Replica{r0, r1, r2}, nil)
+				recorder.EXPECT().Eventf(managedSeedSet, nil, corev1.EventTypeNormal, EventWaitingForSeedReady, gardencorev1beta1.EventActionReconcile, "Waiting for Seed %s to be ready", []any{getReplicaName(2)})
+
+				s, _, err := actuator.Reconcile(ctx, log, managedSeedSet)
+				Expect(err).ToNot(HaveOccurred())
+				expectedStatus := rollingUpdateStatus(2, 1, &seedmanagementv1alpha1.PendingReplica{Name: getReplicaName(2), Reason: seedmanagementv1alpha1.SeedNotReadyReason, Since: now})
+				expectedStatus.ReadyReplicas = 2
+				Expect(s).To(Equal(expectedStatus))
+			})
+
+			It("should update the next replica after the seed of the updated replica has soaked", func() {
+				managedSeedSet.Status.PendingReplica = &seedmanagementv1alpha1.PendingReplica{Name: getReplicaName(2), Reason: seedmanagementv1alpha1.SeedSoakingReason, Since: metav1.NewTime(now.Add(-11 * time.Minute))}
+				expectReadyReplicas(oldRevision, oldRevision, revision)
+				expectUpdate(r1, 1)
+
+				s, _, err := actuator.Reconcile(ctx, log, managedSeedSet)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(s).To(Equal(rollingUpdateStatus(1, 2, &seedmanagementv1alpha1.PendingReplica{Name: getReplicaName(1), Reason: seedmanagementv1alpha1.ManagedSeedPreparingReason, Since: now})))
+			})
+		})
+	})
 })
 
 func getReplicaName(ordinal int32) string {
@@ -7,6 +7,7 @@ package managedseedset
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/go-logr/logr"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -72,7 +73,23 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, managedSeed
 	log.V(1).Info("Creation or update reconciled")
 
 	// Return success result
-	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+	return reconcile.Result{RequeueAfter: r.requeueAfter(managedSeedSet, status)}, nil
+}
+
+// requeueAfter returns the sync period, or the remaining soak duration if the seed of a replica is soaking and the
+// soak ends earlier.
+func (r *Reconciler) requeueAfter(managedSeedSet *seedmanagementv1alpha1.ManagedSeedSet, status *seedmanagementv1alpha1.ManagedSeedSetStatus) time.Duration {
+	requeueAfter := r.Config.SyncPeriod.Duration
+
+	if status == nil || status.PendingReplica == nil || status.PendingReplica.Reason != seedmanagementv1alpha1.SeedSoakingReason {
+		return requeueAfter
+	}
+	if _, _, healthGate := getRollingUpdateParameters(managedSeedSet); healthGate != nil && healthGate.SoakDuration != nil {
+		if remaining := healthGate.SoakDuration.Duration - Now().Sub(status.PendingReplica.Since.Time); remaining > 0 && remaining < requeueAfter {
+			return remaining
+		}
+	}
+	return requeueAfter
 }
 
 func (r *Reconciler) delete(ctx context.Context, log logr.Logger, managedSeedSet *seedmanagementv1alpha1.ManagedSeedSet) (result reconcile.Result, err error) {
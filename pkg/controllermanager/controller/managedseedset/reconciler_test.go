@@ -22,6 +22,7 @@ import (
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	. "github.com/gardener/gardener/pkg/controllermanager/controller/managedseedset"
 	mockmanagedseedset "github.com/gardener/gardener/pkg/controllermanager/controller/managedseedset/mock"
+	"github.com/gardener/gardener/pkg/utils/test"
 	. "github.com/gardener/gardener/pkg/utils/test/matchers"
 )
 
@@ -107,6 +108,32 @@ var _ = Describe("reconciler", func() {
 				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedSeedSet), managedSeedSet)).To(Succeed())
 				Expect(managedSeedSet.Status.ObservedGeneration).To(Equal(int64(1)))
 			})
+
+			It("should requeue when the soak of a replica's seed ends", func() {
+				now := metav1.Now()
+				DeferCleanup(test.WithVar(&Now, func() metav1.Time { return now }))
+
+				managedSeedSet.Finalizers = []string{gardencorev1beta1.GardenerName}
+				managedSeedSet.Spec.UpdateStrategy = &seedmanagementv1alpha1.UpdateStrategy{
+					RollingUpdate: &seedmanagementv1alpha1.RollingUpdateStrategy{
+						HealthGate: &seedmanagementv1alpha1.RollingUpdateHealthGate{
+							SoakDuration: &metav1.Duration{Duration: 10 * time.Minute},
+						},
+					},
+				}
+				Expect(fakeClient.Create(ctx, managedSeedSet.DeepCopy())).To(Succeed())
+
+				status.PendingReplica = &seedmanagementv1alpha1.PendingReplica{
+					Name:   name + "-0",
+					Reason: seedmanagementv1alpha1.SeedSoakingReason,
+					Since:  metav1.NewTime(now.Add(-4 * time.Minute)),
+				}
+				actuator.EXPECT().Reconcile(gomock.Any(), gomock.Any(), gomock.Any()).Return(status, false, nil)
+
+				result, err := reconciler.Reconcile(ctx, request)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result).To(Equal(reconcile.Result{RequeueAfter: 6 * time.Minute}))
+			})
 		})
 
 		Context("delete", func() {
@@ -7,19 +7,24 @@ package managedseedset
 import (
 	"context"
 	"fmt"
+	"maps"
 	"regexp"
 	"strconv"
 	"strings"
 
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/gardenlet/v1alpha1"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/apis/seedmanagement/encoding"
 	seedmanagementv1alpha1 "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1"
 	seedmanagementv1alpha1constants "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1/constants"
+	"github.com/gardener/gardener/pkg/utils"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 )
@@ -103,6 +108,15 @@ type Replica interface {
 	DeleteManagedSeed(ctx context.Context, c client.Client) error
 	// RetryShoot retries this replica's shoot using the given context and client.
 	RetryShoot(ctx context.Context, c client.Client) error
+	// GetRevision returns the ManagedSeedSet revision this replica's managed seed was created or last updated from.
+	// If the managed seed doesn't exist or has no revision, an empty string is returned.
+	GetRevision() string
+	// UpdateManagedSeed updates this replica's managed seed to the current template of the ManagedSeedSet using the
+	// given context and client.
+	UpdateManagedSeed(ctx context.Context, c client.Client) error
+	// AdoptManagedSeed stamps the current revision of the ManagedSeedSet on this replica's managed seed using the given
+	// context and client, if the managed seed has no revision yet and its gardenlet spec matches the current template.
+	AdoptManagedSeed(ctx context.Context, c client.Client) error
 }
 
 // ReplicaFactory provides a method for creating new replicas.
@@ -216,6 +230,15 @@ func (r *replica) GetStatus() ReplicaStatus {
 	}
 }
 
+// GetRevision returns the ManagedSeedSet revision this replica's managed seed was created or last updated from.
+// If the managed seed doesn't exist or has no revision, an empty string is returned.
+func (r *replica) GetRevision() string {
+	if r.managedSeed == nil {
+		return ""
+	}
+	return r.managedSeed.Annotations[seedmanagementv1alpha1constants.AnnotationRevision]
+}
+
 // IsSeedReady returns true if this replica's seed is ready, false otherwise.
 func (r *replica) IsSeedReady() bool {
 	return r.seed != nil && seedReady(r.seed)
@@ -258,6 +281,53 @@ func (r *replica) CreateManagedSeed(ctx context.Context, c client.Client) error
 	return nil
 }
 
+// UpdateManagedSeed updates this replica's managed seed to the current template of the ManagedSeedSet using the
+// given context and client. The gardenlet spec is replaced, while the template labels and annotations are merged into
+// the existing ones.
+func (r *replica) UpdateManagedSeed(ctx context.Context, c client.Client) error {
+	if r.managedSeed == nil {
+		return nil
+	}
+
+	desiredManagedSeed, err := newManagedSeed(r.managedSeedSet, r.GetOrdinal())
+	if err != nil {
+		return err
+	}
+
+	patch := client.MergeFrom(r.managedSeed.DeepCopy())
+	for k, v := range desiredManagedSeed.Labels {
+		metav1.SetMetaDataLabel(&r.managedSeed.ObjectMeta, k, v)
+	}
+	for k, v := range desiredManagedSeed.Annotations {
+		metav1.SetMetaDataAnnotation(&r.managedSeed.ObjectMeta, k, v)
+	}
+	r.managedSeed.Spec.Gardenlet = desiredManagedSeed.Spec.Gardenlet
+	return c.Patch(ctx, r.managedSeed, patch)
+}
+
+// AdoptManagedSeed stamps the current revision of the ManagedSeedSet on this replica's managed seed using the given
+// context and client, if the managed seed has no revision yet and its gardenlet spec matches the current template.
+// This prevents rolling out managed seeds created before revisions were tracked which are already up to date.
+func (r *replica) AdoptManagedSeed(ctx context.Context, c client.Client) error {
+	if r.managedSeed == nil || r.GetRevision() != "" {
+		return nil
+	}
+
+	desiredManagedSeed, err := newManagedSeed(r.managedSeedSet, r.GetOrdinal())
+	if err != nil {
+		return err
+	}
+
+	matches, err := gardenletSpecMatches(&r.managedSeed.Spec.Gardenlet, &desiredManagedSeed.Spec.Gardenlet)
+	if err != nil || !matches {
+		return err
+	}
+
+	patch := client.MergeFrom(r.managedSeed.DeepCopy())
+	metav1.SetMetaDataAnnotation(&r.managedSeed.ObjectMeta, seedmanagementv1alpha1constants.AnnotationRevision, ComputeRevision(r.managedSeedSet))
+	return c.Patch(ctx, r.managedSeed, patch)
+}
+
 // DeleteShoot deletes this replica's shoot using the given context and client.
 func (r *replica) DeleteShoot(ctx context.Context, c client.Client) error {
 	if r.shoot != nil {
@@ -362,8 +432,8 @@ func newManagedSeed(managedSeedSet *seedmanagementv1alpha1.ManagedSeedSet, ordin
 		ObjectMeta: metav1.ObjectMeta{
 			Name:        name,
 			Namespace:   managedSeedSet.Namespace,
-			Labels:      managedSeedSet.Spec.Template.Labels,
-			Annotations: managedSeedSet.Spec.Template.Annotations,
+			Labels:      maps.Clone(managedSeedSet.Spec.Template.Labels),
+			Annotations: maps.Clone(managedSeedSet.Spec.Template.Annotations),
 			OwnerReferences: []metav1.OwnerReference{
 				*metav1.NewControllerRef(managedSeedSet, seedmanagementv1alpha1.SchemeGroupVersion.WithKind("ManagedSeedSet")),
 			},
@@ -372,9 +442,10 @@ func newManagedSeed(managedSeedSet *seedmanagementv1alpha1.ManagedSeedSet, ordin
 			Shoot: &seedmanagementv1alpha1.Shoot{
 				Name: name,
 			},
-			Gardenlet: managedSeedSet.Spec.Template.Spec.Gardenlet,
+			Gardenlet: *managedSeedSet.Spec.Template.Spec.Gardenlet.DeepCopy(),
 		},
 	}
+	metav1.SetMetaDataAnnotation(&managedSeed.ObjectMeta, seedmanagementv1alpha1constants.AnnotationRevision, ComputeRevision(managedSeedSet))
 
 	// Replace placeholders in seed spec with the actual replica name
 	gardenletConfig, err := encoding.DecodeGardenletConfiguration(&managedSeed.Spec.Gardenlet.Config, false)
@@ -386,6 +457,41 @@ func newManagedSeed(managedSeedSet *seedmanagementv1alpha1.ManagedSeedSet, ordin
 	return managedSeed, nil
 }
 
+// gardenletSpecMatches returns true if the given gardenlet specs are semantically equal. The gardenlet configurations
+// are compared in their decoded form, so that differences in their serialization do not matter.
+func gardenletSpecMatches(actual, desired *seedmanagementv1alpha1.GardenletConfig) (bool, error) {
+	actualConfig, err := decodeGardenletConfiguration(&actual.Config)
+	if err != nil {
+		return false, err
+	}
+	desiredConfig, err := decodeGardenletConfiguration(&desired.Config)
+	if err != nil {
+		return false, err
+	}
+
+	actualWithoutConfig, desiredWithoutConfig := actual.DeepCopy(), desired.DeepCopy()
+	actualWithoutConfig.Config, desiredWithoutConfig.Config = runtime.RawExtension{}, runtime.RawExtension{}
+
+	return apiequality.Semantic.DeepEqual(actualWithoutConfig, desiredWithoutConfig) && apiequality.Semantic.DeepEqual(actualConfig, desiredConfig), nil
+}
+
+func decodeGardenletConfiguration(rawConfig *runtime.RawExtension) (*gardenletconfigv1alpha1.GardenletConfiguration, error) {
+	gardenletConfig, err := encoding.DecodeGardenletConfiguration(rawConfig, false)
+	if err != nil {
+		return nil, err
+	}
+
+	gardenletConfig = gardenletConfig.DeepCopy()
+	gardenletConfig.TypeMeta = metav1.TypeMeta{}
+	return gardenletConfig, nil
+}
+
+// ComputeRevision computes the revision of the ManagedSeed template of the given ManagedSeedSet. Managed seeds whose
+// revision annotation differs from it are outdated and updated during a rolling update.
+func ComputeRevision(managedSeedSet *seedmanagementv1alpha1.ManagedSeedSet) string {
+	return utils.ComputeChecksum(managedSeedSet.Spec.Template)[:10]
+}
+
 const placeholder = "replica-name"
 
 func replacePlaceholdersInShootSpec(spec *gardencorev1beta1.ShootSpec, name string) {
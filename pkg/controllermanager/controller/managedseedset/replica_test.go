@@ -325,6 +325,7 @@ var _ = Describe("Replica", func() {
 			Expect(createdMS.Name).To(Equal(replicaName))
 			Expect(createdMS.Namespace).To(Equal(namespace))
 			Expect(createdMS.Labels).To(Equal(map[string]string{"foo": "bar"}))
+			Expect(createdMS.Annotations).To(HaveKeyWithValue(seedmanagementv1alpha1constants.AnnotationRevision, ComputeRevision(managedSeedSet)))
 			Expect(createdMS.OwnerReferences).To(Equal([]metav1.OwnerReference{
 				*metav1.NewControllerRef(managedSeedSet, seedmanagementv1alpha1.SchemeGroupVersion.WithKind("ManagedSeedSet")),
 			}))
@@ -339,6 +340,101 @@ var _ = Describe("Replica", func() {
 		})
 	})
 
+	Describe("#UpdateManagedSeed", func() {
+		It("should update the managed seed to the current revision of the template", func() {
+			ms := managedSeed(nil, true, true)
+			ms.ResourceVersion = ""
+			Expect(fakeClient.Create(ctx, ms)).To(Succeed())
+
+			replica := NewReplica(managedSeedSet, nil, ms, nil, false)
+			Expect(replica.GetRevision()).To(BeEmpty())
+			Expect(replica.UpdateManagedSeed(ctx, fakeClient)).To(Succeed())
+			Expect(replica.GetRevision()).To(Equal(ComputeRevision(managedSeedSet)))
+
+			updatedMS := &seedmanagementv1alpha1.ManagedSeed{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: replicaName}, updatedMS)).To(Succeed())
+			Expect(updatedMS.Labels).To(Equal(map[string]string{"foo": "bar"}))
+			Expect(updatedMS.Annotations).To(Equal(map[string]string{
+				seedmanagementv1alpha1constants.AnnotationProtectFromDeletion: "true",
+				seedmanagementv1alpha1constants.AnnotationRevision:            ComputeRevision(managedSeedSet),
+			}))
+			gardenletConfig := &gardenletconfigv1alpha1.GardenletConfiguration{}
+			Expect(json.Unmarshal(updatedMS.Spec.Gardenlet.Config.Raw, gardenletConfig)).To(Succeed())
+			Expect(gardenletConfig.SeedConfig).ToNot(BeNil())
+			Expect(gardenletConfig.SeedConfig.SeedTemplate.Spec.Ingress.Domain).To(Equal("ingress." + replicaName + ".example.com"))
+		})
+
+		It("should do nothing if the managed seed does not exist", func() {
+			replica := NewReplica(managedSeedSet, shoot(nil, "", "", "", false), nil, nil, false)
+			Expect(replica.UpdateManagedSeed(ctx, fakeClient)).To(Succeed())
+		})
+	})
+
+	Describe("#AdoptManagedSeed", func() {
+		var ms *seedmanagementv1alpha1.ManagedSeed
+
+		BeforeEach(func() {
+			rawConfig, err := json.Marshal(&gardenletconfigv1alpha1.GardenletConfiguration{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: gardenletconfigv1alpha1.SchemeGroupVersion.String(),
+					Kind:       "GardenletConfiguration",
+				},
+				SeedConfig: &gardenletconfigv1alpha1.SeedConfig{
+					SeedTemplate: gardencorev1beta1.SeedTemplate{
+						Spec: gardencorev1beta1.SeedSpec{
+							Ingress: &gardencorev1beta1.Ingress{
+								Domain: "ingress." + replicaName + ".example.com",
+							},
+						},
+					},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			ms = managedSeed(nil, true, false)
+			ms.ResourceVersion = ""
+			ms.Spec.Gardenlet = seedmanagementv1alpha1.GardenletConfig{Config: runtime.RawExtension{Raw: rawConfig}}
+		})
+
+		It("should stamp the current revision on a managed seed without revision which matches the template", func() {
+			Expect(fakeClient.Create(ctx, ms)).To(Succeed())
+
+			replica := NewReplica(managedSeedSet, nil, ms, nil, false)
+			Expect(replica.AdoptManagedSeed(ctx, fakeClient)).To(Succeed())
+			Expect(replica.GetRevision()).To(Equal(ComputeRevision(managedSeedSet)))
+
+			updatedMS := &seedmanagementv1alpha1.ManagedSeed{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: replicaName}, updatedMS)).To(Succeed())
+			Expect(updatedMS.Annotations).To(HaveKeyWithValue(seedmanagementv1alpha1constants.AnnotationRevision, ComputeRevision(managedSeedSet)))
+		})
+
+		It("should not stamp the current revision on a managed seed which does not match the template", func() {
+			ms.Spec.Gardenlet.Deployment = &seedmanagementv1alpha1.GardenletDeployment{ReplicaCount: new(int32(3))}
+			Expect(fakeClient.Create(ctx, ms)).To(Succeed())
+
+			replica := NewReplica(managedSeedSet, nil, ms, nil, false)
+			Expect(replica.AdoptManagedSeed(ctx, fakeClient)).To(Succeed())
+			Expect(replica.GetRevision()).To(BeEmpty())
+
+			updatedMS := &seedmanagementv1alpha1.ManagedSeed{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: replicaName}, updatedMS)).To(Succeed())
+			Expect(updatedMS.Annotations).NotTo(HaveKey(seedmanagementv1alpha1constants.AnnotationRevision))
+		})
+
+		It("should not change the revision of a managed seed which already has one", func() {
+			metav1.SetMetaDataAnnotation(&ms.ObjectMeta, seedmanagementv1alpha1constants.AnnotationRevision, "outdated")
+
+			replica := NewReplica(managedSeedSet, nil, ms, nil, false)
+			Expect(replica.AdoptManagedSeed(ctx, fakeClient)).To(Succeed())
+			Expect(replica.GetRevision()).To(Equal("outdated"))
+		})
+
+		It("should do nothing if the managed seed does not exist", func() {
+			replica := NewReplica(managedSeedSet, shoot(nil, "", "", "", false), nil, nil, false)
+			Expect(replica.AdoptManagedSeed(ctx, fakeClient)).To(Succeed())
+		})
+	})
+
 	Describe("#DeleteShoot", func() {
 		It("should clean the retries, confirm the deletion, and delete the shoot", func() {
 			s := shoot(nil, "", "", "", false)
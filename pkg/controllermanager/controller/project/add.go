@@ -12,6 +12,7 @@ import (
 
 	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/controllermanager/v1alpha1"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/project/activity"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/project/backupusage"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/project/project"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/project/resourcequota"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/project/stale"
@@ -25,6 +26,12 @@ func AddToManager(ctx context.Context, mgr manager.Manager, cfg controllermanage
 		return fmt.Errorf("failed adding activity reconciler: %w", err)
 	}
 
+	if err := (&backupusage.Reconciler{
+		Config: *cfg.Controllers.Project,
+	}).AddToManager(mgr); err != nil {
+		return fmt.Errorf("failed adding backup usage reconciler: %w", err)
+	}
+
 	if err := (&project.Reconciler{
 		Config: *cfg.Controllers.Project,
 	}).AddToManager(mgr); err != nil {
@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backupusage
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	predicateutils "github.com/gardener/gardener/pkg/controllerutils/predicate"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+// ControllerName is the name of this controller.
+const ControllerName = "project-backup-usage"
+
+// AddToManager adds Reconciler to the given manager.
+func (r *Reconciler) AddToManager(mgr manager.Manager) error {
+	if r.Client == nil {
+		r.Client = mgr.GetClient()
+	}
+
+	return builder.
+		ControllerManagedBy(mgr).
+		Named(ControllerName).
+		For(&gardencorev1beta1.Project{}, builder.WithPredicates(predicateutils.ForEventTypes(predicateutils.Create, predicateutils.Delete))).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: ptr.Deref(r.Config.ConcurrentSyncs, 0),
+			ReconciliationTimeout:   controllerutils.DefaultReconciliationTimeout,
+		}).
+		Watches(
+			&gardencorev1beta1.BackupEntry{},
+			handler.EnqueueRequestsFromMapFunc(r.MapBackupEntryToProject(mgr.GetLogger().WithValues("controller", ControllerName))),
+			builder.WithPredicates(r.UsageChanged()),
+		).
+		Complete(r)
+}
+
+// UsageChanged returns a predicate which returns true for all CREATE and DELETE events of BackupEntries. UPDATE events
+// only pass if the usage reported in the status changed.
+func (r *Reconciler) UsageChanged() predicate.Predicate {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			backupEntry, ok := e.ObjectNew.(*gardencorev1beta1.BackupEntry)
+			if !ok {
+				return false
+			}
+
+			oldBackupEntry, ok := e.ObjectOld.(*gardencorev1beta1.BackupEntry)
+			if !ok {
+				return false
+			}
+
+			return !apiequality.Semantic.DeepEqual(oldBackupEntry.Status.Usage, backupEntry.Status.Usage)
+		},
+	}
+}
+
+// MapBackupEntryToProject is a handler.MapFunc for mapping a BackupEntry to the Project it belongs to.
+func (r *Reconciler) MapBackupEntryToProject(log logr.Logger) handler.MapFunc {
+	return func(ctx context.Context, obj client.Object) []reconcile.Request {
+		project, err := gardenerutils.ProjectForNamespaceFromReader(ctx, r.Client, obj.GetNamespace())
+		if err != nil {
+			if !apierrors.IsNotFound(err) {
+				log.Error(err, "Failed to get project for namespace", "namespace", obj.GetNamespace())
+			}
+			return nil
+		}
+
+		return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: project.Name}}}
+	}
+}
@@ -0,0 +1,110 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backupusage_test
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/api/indexer"
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/project/backupusage"
+)
+
+var _ = Describe("Add", func() {
+	var (
+		reconciler  *Reconciler
+		backupEntry *gardencorev1beta1.BackupEntry
+	)
+
+	BeforeEach(func() {
+		reconciler = &Reconciler{}
+		backupEntry = &gardencorev1beta1.BackupEntry{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "entry",
+				Namespace: "garden-project",
+			},
+		}
+	})
+
+	Describe("#UsageChanged", func() {
+		var p predicate.Predicate
+
+		BeforeEach(func() {
+			p = reconciler.UsageChanged()
+		})
+
+		It("should return true for create events", func() {
+			Expect(p.Create(event.CreateEvent{Object: backupEntry})).To(BeTrue())
+		})
+
+		It("should return true for delete events", func() {
+			Expect(p.Delete(event.DeleteEvent{Object: backupEntry})).To(BeTrue())
+		})
+
+		It("should return false if the usage did not change", func() {
+			Expect(p.Update(event.UpdateEvent{ObjectOld: backupEntry, ObjectNew: backupEntry.DeepCopy()})).To(BeFalse())
+		})
+
+		It("should return true if the usage changed", func() {
+			newBackupEntry := backupEntry.DeepCopy()
+			newBackupEntry.Status.Usage = &gardencorev1beta1.BackupEntryUsage{SizeBytes: 1024, ObjectCount: 1}
+
+			Expect(p.Update(event.UpdateEvent{ObjectOld: backupEntry, ObjectNew: newBackupEntry})).To(BeTrue())
+		})
+	})
+
+	Describe("#MapBackupEntryToProject", func() {
+		var (
+			ctx        = context.TODO()
+			log        logr.Logger
+			fakeClient client.Client
+
+			project *gardencorev1beta1.Project
+		)
+
+		BeforeEach(func() {
+			log = logr.Discard()
+			fakeClient = fakeclient.NewClientBuilder().
+				WithScheme(kubernetes.GardenScheme).
+				WithIndex(&gardencorev1beta1.Project{}, core.ProjectNamespace, indexer.ProjectNamespaceIndexerFunc).
+				Build()
+			reconciler.Client = fakeClient
+
+			project = &gardencorev1beta1.Project{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "project",
+				},
+			}
+		})
+
+		It("should do nothing if no related Project can be found", func() {
+			Expect(fakeClient.Create(ctx, project)).To(Succeed())
+
+			Expect(reconciler.MapBackupEntryToProject(log)(ctx, backupEntry)).To(BeEmpty())
+		})
+
+		It("should map the BackupEntry to the Project", func() {
+			project.Spec.Namespace = &backupEntry.Namespace
+			Expect(fakeClient.Create(ctx, project)).To(Succeed())
+
+			Expect(reconciler.MapBackupEntryToProject(log)(ctx, backupEntry)).To(ConsistOf(
+				reconcile.Request{NamespacedName: types.NamespacedName{Name: project.Name}},
+			))
+		})
+	})
+})
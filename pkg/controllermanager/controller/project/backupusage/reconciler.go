@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backupusage
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/controllermanager/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/controllermanager/metrics"
+)
+
+// Reconciler reconciles Projects and aggregates the backup usage reported in the status of their BackupEntries into
+// metrics.
+type Reconciler struct {
+	Client client.Client
+	Config controllermanagerconfigv1alpha1.ProjectControllerConfiguration
+}
+
+// Reconcile reconciles Projects and aggregates the backup usage reported in the status of their BackupEntries into
+// metrics.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	project := &gardencorev1beta1.Project{}
+	if err := r.Client.Get(ctx, request.NamespacedName, project); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, removing metrics")
+			deleteMetrics(request.Name)
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if project.Spec.Namespace == nil {
+		log.V(1).Info("Project does not have a namespace yet, nothing to aggregate")
+		return reconcile.Result{}, nil
+	}
+
+	backupEntryList := &gardencorev1beta1.BackupEntryList{}
+	if err := r.Client.List(ctx, backupEntryList, client.InNamespace(*project.Spec.Namespace)); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed listing BackupEntries: %w", err)
+	}
+
+	var sizeBytes, objectCount int64
+	for _, backupEntry := range backupEntryList.Items {
+		if backupEntry.Status.Usage == nil {
+			continue
+		}
+		sizeBytes += backupEntry.Status.Usage.SizeBytes
+		objectCount += backupEntry.Status.Usage.ObjectCount
+	}
+
+	log.V(1).Info("Updating backup usage metrics", "sizeBytes", sizeBytes, "objectCount", objectCount)
+	metrics.ProjectBackupSizeBytes.WithLabelValues(project.Name).Set(float64(sizeBytes))
+	metrics.ProjectBackupObjects.WithLabelValues(project.Name).Set(float64(objectCount))
+
+	return reconcile.Result{}, nil
+}
+
+func deleteMetrics(projectName string) {
+	metrics.ProjectBackupSizeBytes.DeleteLabelValues(projectName)
+	metrics.ProjectBackupObjects.DeleteLabelValues(projectName)
+}
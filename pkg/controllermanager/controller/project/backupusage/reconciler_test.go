@@ -0,0 +1,93 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backupusage_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/project/backupusage"
+	"github.com/gardener/gardener/pkg/controllermanager/metrics"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx        = context.TODO()
+		fakeClient client.Client
+		reconciler reconcile.Reconciler
+
+		projectName = "foo"
+		namespace   = "garden-foo"
+		project     *gardencorev1beta1.Project
+		request     reconcile.Request
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+		reconciler = &Reconciler{Client: fakeClient}
+
+		project = &gardencorev1beta1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: projectName},
+			Spec:       gardencorev1beta1.ProjectSpec{Namespace: &namespace},
+		}
+		request = reconcile.Request{NamespacedName: types.NamespacedName{Name: projectName}}
+
+		DeferCleanup(func() {
+			metrics.ProjectBackupSizeBytes.Reset()
+			metrics.ProjectBackupObjects.Reset()
+		})
+	})
+
+	newBackupEntry := func(name string, usage *gardencorev1beta1.BackupEntryUsage) *gardencorev1beta1.BackupEntry {
+		return &gardencorev1beta1.BackupEntry{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Status:     gardencorev1beta1.BackupEntryStatus{Usage: usage},
+		}
+	}
+
+	It("should aggregate the usage of all BackupEntries of the project", func() {
+		Expect(fakeClient.Create(ctx, project)).To(Succeed())
+		Expect(fakeClient.Create(ctx, newBackupEntry("entry-1", &gardencorev1beta1.BackupEntryUsage{SizeBytes: 1000, ObjectCount: 10}))).To(Succeed())
+		Expect(fakeClient.Create(ctx, newBackupEntry("entry-2", &gardencorev1beta1.BackupEntryUsage{SizeBytes: 500, ObjectCount: 5}))).To(Succeed())
+		Expect(fakeClient.Create(ctx, newBackupEntry("entry-3", nil))).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		Expect(testutil.ToFloat64(metrics.ProjectBackupSizeBytes.WithLabelValues(projectName))).To(Equal(float64(1500)))
+		Expect(testutil.ToFloat64(metrics.ProjectBackupObjects.WithLabelValues(projectName))).To(Equal(float64(15)))
+	})
+
+	It("should not consider BackupEntries of other projects", func() {
+		Expect(fakeClient.Create(ctx, project)).To(Succeed())
+		otherBackupEntry := newBackupEntry("entry-1", &gardencorev1beta1.BackupEntryUsage{SizeBytes: 1000, ObjectCount: 10})
+		otherBackupEntry.Namespace = "garden-bar"
+		Expect(fakeClient.Create(ctx, otherBackupEntry)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		Expect(testutil.ToFloat64(metrics.ProjectBackupSizeBytes.WithLabelValues(projectName))).To(BeZero())
+		Expect(testutil.ToFloat64(metrics.ProjectBackupObjects.WithLabelValues(projectName))).To(BeZero())
+	})
+
+	It("should remove the metrics if the project is gone", func() {
+		metrics.ProjectBackupSizeBytes.WithLabelValues(projectName).Set(1000)
+		metrics.ProjectBackupObjects.WithLabelValues(projectName).Set(10)
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		Expect(testutil.CollectAndCount(metrics.ProjectBackupSizeBytes)).To(BeZero())
+		Expect(testutil.CollectAndCount(metrics.ProjectBackupObjects)).To(BeZero())
+	})
+})
@@ -0,0 +1,83 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package drain
+
+import (
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	shootcontroller "github.com/gardener/gardener/pkg/scheduler/controller/shoot"
+)
+
+// ControllerName is the name of this controller.
+const ControllerName = "seed-drain"
+
+// AddToManager adds Reconciler to the given manager.
+func (r *Reconciler) AddToManager(mgr manager.Manager) error {
+	if r.Client == nil {
+		r.Client = mgr.GetClient()
+	}
+	if r.Clock == nil {
+		r.Clock = clock.RealClock{}
+	}
+	if r.Framework == nil {
+		fw, err := shootcontroller.NewFramework(r.SchedulerConfig)
+		if err != nil {
+			return err
+		}
+		r.Framework = fw
+	}
+
+	return builder.
+		ControllerManagedBy(mgr).
+		Named(ControllerName).
+		For(&gardencorev1beta1.Seed{}, builder.WithPredicates(r.SeedPredicate())).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: ptr.Deref(r.Config.ConcurrentSyncs, 0),
+			ReconciliationTimeout:   controllerutils.DefaultReconciliationTimeout,
+		}).
+		Complete(r)
+}
+
+// SeedPredicate reacts on Seed events that indicate that a drain operation was requested or revoked.
+func (r *Reconciler) SeedPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(e event.CreateEvent) bool {
+			seed, ok := e.Object.(*gardencorev1beta1.Seed)
+			if !ok {
+				return false
+			}
+
+			return v1beta1helper.HasSeedDrainAnnotation(seed) || v1beta1helper.GetCondition(seed.Status.Conditions, gardencorev1beta1.SeedDrained) != nil
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			newSeed, ok := e.ObjectNew.(*gardencorev1beta1.Seed)
+			if !ok {
+				return false
+			}
+
+			oldSeed, ok := e.ObjectOld.(*gardencorev1beta1.Seed)
+			if !ok {
+				return false
+			}
+
+			return v1beta1helper.HasSeedDrainAnnotation(oldSeed) != v1beta1helper.HasSeedDrainAnnotation(newSeed)
+		},
+		DeleteFunc: func(_ event.DeleteEvent) bool {
+			return false
+		},
+		GenericFunc: func(_ event.GenericEvent) bool {
+			return false
+		},
+	}
+}
@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package drain_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/seed/drain"
+)
+
+var _ = Describe("Add", func() {
+	var reconciler *Reconciler
+
+	BeforeEach(func() {
+		reconciler = &Reconciler{}
+	})
+
+	Describe("#SeedPredicate", func() {
+		var (
+			predicate predicate.Predicate
+			seed      *gardencorev1beta1.Seed
+		)
+
+		BeforeEach(func() {
+			predicate = reconciler.SeedPredicate()
+			seed = &gardencorev1beta1.Seed{ObjectMeta: metav1.ObjectMeta{Name: "seed"}}
+		})
+
+		Describe("#Create", func() {
+			It("should return false because seed is not drained", func() {
+				Expect(predicate.Create(event.CreateEvent{Object: seed})).To(BeFalse())
+			})
+
+			It("should return true because seed is drained", func() {
+				metav1.SetMetaDataAnnotation(&seed.ObjectMeta, "seed.gardener.cloud/drain", "true")
+
+				Expect(predicate.Create(event.CreateEvent{Object: seed})).To(BeTrue())
+			})
+
+			It("should return true because the Drained condition is still present", func() {
+				seed.Status.Conditions = []gardencorev1beta1.Condition{{Type: "Drained"}}
+
+				Expect(predicate.Create(event.CreateEvent{Object: seed})).To(BeTrue())
+			})
+		})
+
+		Describe("#Update", func() {
+			It("should return false because the drain annotation did not change", func() {
+				oldSeed := seed.DeepCopy()
+				seed.Labels = map[string]string{"foo": "bar"}
+
+				Expect(predicate.Update(event.UpdateEvent{ObjectOld: oldSeed, ObjectNew: seed})).To(BeFalse())
+			})
+
+			It("should return true because the drain annotation was added", func() {
+				oldSeed := seed.DeepCopy()
+				metav1.SetMetaDataAnnotation(&seed.ObjectMeta, "seed.gardener.cloud/drain", "true")
+
+				Expect(predicate.Update(event.UpdateEvent{ObjectOld: oldSeed, ObjectNew: seed})).To(BeTrue())
+			})
+
+			It("should return true because the drain annotation was removed", func() {
+				metav1.SetMetaDataAnnotation(&seed.ObjectMeta, "seed.gardener.cloud/drain", "true")
+				oldSeed := seed.DeepCopy()
+				seed.Annotations = nil
+
+				Expect(predicate.Update(event.UpdateEvent{ObjectOld: oldSeed, ObjectNew: seed})).To(BeTrue())
+			})
+		})
+
+		Describe("#Delete", func() {
+			It("should return false", func() {
+				Expect(predicate.Delete(event.DeleteEvent{Object: seed})).To(BeFalse())
+			})
+		})
+
+		Describe("#Generic", func() {
+			It("should return false", func() {
+				Expect(predicate.Generic(event.GenericEvent{Object: seed})).To(BeFalse())
+			})
+		})
+	})
+})
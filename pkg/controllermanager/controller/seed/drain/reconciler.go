@@ -0,0 +1,209 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package drain
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/controllermanager/v1alpha1"
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/scheduler/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/seed/utils"
+	shootcontroller "github.com/gardener/gardener/pkg/scheduler/controller/shoot"
+	"github.com/gardener/gardener/pkg/scheduler/framework"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+// Reconciler reconciles Seeds annotated with `seed.gardener.cloud/drain=true`. It migrates the control planes of all
+// shoots off the seed in batches and maintains the Drained condition of the seed.
+type Reconciler struct {
+	Client          client.Client
+	Config          controllermanagerconfigv1alpha1.SeedDrainControllerConfiguration
+	Clock           clock.Clock
+	SchedulerConfig *schedulerconfigv1alpha1.ShootSchedulerConfiguration
+	Framework       *framework.Framework
+}
+
+// Reconcile migrates the control planes of the shoots off a drained seed and reports the progress in the Drained
+// condition of the seed.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	seed := &gardencorev1beta1.Seed{}
+	if err := r.Client.Get(ctx, request.NamespacedName, seed); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if !v1beta1helper.HasSeedDrainAnnotation(seed) {
+		if v1beta1helper.GetCondition(seed.Status.Conditions, gardencorev1beta1.SeedDrained) == nil {
+			return reconcile.Result{}, nil
+		}
+
+		log.Info("Removing condition since seed is not drained anymore", "conditionType", gardencorev1beta1.SeedDrained)
+		patch := client.StrategicMergeFrom(seed.DeepCopy())
+		seed.Status.Conditions = v1beta1helper.RemoveConditions(seed.Status.Conditions, gardencorev1beta1.SeedDrained)
+		return reconcile.Result{}, r.Client.Status().Patch(ctx, seed, patch)
+	}
+
+	seedList := &gardencorev1beta1.SeedList{}
+	if err := r.Client.List(ctx, seedList); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed listing seeds: %w", err)
+	}
+
+	shootList := &gardencorev1beta1.ShootList{}
+	if err := r.Client.List(ctx, shootList); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed listing shoots: %w", err)
+	}
+
+	var (
+		shoots    = v1beta1helper.ConvertShootList(shootList.Items)
+		seedUsage = v1beta1helper.CalculateSeedUsage(shoots)
+		failures  = make(map[string]string)
+
+		remaining []*gardencorev1beta1.Shoot
+		migrating int
+	)
+
+	for _, shoot := range shoots {
+		switch {
+		case ptr.Deref(shoot.Spec.SeedName, "") == seed.Name:
+			remaining = append(remaining, shoot)
+		case ptr.Deref(shoot.Status.SeedName, "") == seed.Name:
+			migrating++
+			if lastOperation := shoot.Status.LastOperation; lastOperation != nil && lastOperation.State == gardencorev1beta1.LastOperationStateFailed {
+				failures[client.ObjectKeyFromObject(shoot).String()] = fmt.Sprintf("last operation of type %s failed: %s", lastOperation.Type, lastOperation.Description)
+			}
+		}
+	}
+
+	slices.SortFunc(remaining, func(a, b *gardencorev1beta1.Shoot) int {
+		return cmp.Or(cmp.Compare(a.Namespace, b.Namespace), cmp.Compare(a.Name, b.Name))
+	})
+
+	var migrated int
+	for _, shoot := range remaining {
+		if migrating >= ptr.Deref(r.Config.BatchSize, 0) {
+			break
+		}
+
+		key := client.ObjectKeyFromObject(shoot).String()
+
+		if shoot.DeletionTimestamp != nil || !ptr.Equal(shoot.Spec.SeedName, shoot.Status.SeedName) {
+			continue
+		}
+		if shoot.Status.LastOperation == nil || shoot.Status.LastOperation.State != gardencorev1beta1.LastOperationStateSucceeded {
+			if shoot.Status.LastOperation != nil && shoot.Status.LastOperation.State == gardencorev1beta1.LastOperationStateFailed {
+				failures[key] = "control plane cannot be migrated since the last operation failed"
+			}
+			continue
+		}
+		if !gardenerutils.IsNowInEffectiveShootMaintenanceTimeWindow(shoot, r.Clock) {
+			continue
+		}
+
+		targetSeed, err := r.findTargetSeed(ctx, log, shoot, seed, seedList.Items, shoots, seedUsage)
+		if err != nil {
+			failures[key] = fmt.Sprintf("no target seed found: %s", err)
+			continue
+		}
+
+		log.Info("Migrating control plane of shoot", "shoot", key, "targetSeed", targetSeed.Name)
+		shoot.Spec.SeedName = &targetSeed.Name
+		if err := r.Client.SubResource("binding").Update(ctx, shoot); err != nil {
+			if apierrors.IsInvalid(err) || apierrors.IsForbidden(err) {
+				failures[key] = fmt.Sprintf("changing the seed was rejected: %s", err)
+				continue
+			}
+			return reconcile.Result{}, fmt.Errorf("failed changing seed of shoot %s: %w", key, err)
+		}
+
+		// Account for the started migration so that the following shoots are scheduled against the resulting placement.
+		seedUsage[seed.Name]--
+		seedUsage[targetSeed.Name]++
+		migrating++
+		migrated++
+	}
+
+	condition := v1beta1helper.GetOrInitConditionWithClock(r.Clock, seed.Status.Conditions, gardencorev1beta1.SeedDrained)
+	switch {
+	case len(remaining)-migrated == 0 && migrating == 0:
+		condition = v1beta1helper.UpdatedConditionWithClock(r.Clock, condition, gardencorev1beta1.ConditionTrue, "SeedDrained", "The control planes of all shoots have been migrated off the seed.")
+	case len(failures) != 0:
+		condition = v1beta1helper.UpdatedConditionWithClock(r.Clock, condition, gardencorev1beta1.ConditionFalse, "DrainFailed", fmt.Sprintf("Some control planes could not be migrated off the seed: %+v", failures))
+	default:
+		condition = v1beta1helper.UpdatedConditionWithClock(r.Clock, condition, gardencorev1beta1.ConditionProgressing, "Draining", fmt.Sprintf("%d shoot(s) remaining on the seed, %d shoot(s) being migrated.", len(remaining)-migrated, migrating))
+	}
+
+	if err := utils.PatchSeedCondition(ctx, log, r.Client.Status(), seed, condition); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+}
+
+// findTargetSeed runs the scheduler for the shoot among the seeds its control plane can be migrated to and returns the
+// seed with the highest score. The drained seed itself is filtered out by the scheduler.
+func (r *Reconciler) findTargetSeed(
+	ctx context.Context,
+	log logr.Logger,
+	shoot *gardencorev1beta1.Shoot,
+	sourceSeed *gardencorev1beta1.Seed,
+	seeds []gardencorev1beta1.Seed,
+	shoots []*gardencorev1beta1.Shoot,
+	seedUsage map[string]int,
+) (
+	*gardencorev1beta1.Seed,
+	error,
+) {
+	// Only consider the seeds the control plane can be migrated to, see the validation of the `shoots/binding`
+	// subresource.
+	candidates := slices.DeleteFunc(slices.Clone(seeds), func(seed gardencorev1beta1.Seed) bool {
+		return seed.Name == sourceSeed.Name || !v1beta1helper.ControlPlaneMigrationPossible(sourceSeed, &seed)
+	})
+	if len(candidates) == 0 {
+		return nil, fmt.Errorf("none of the seeds supports a control plane migration from seed %s (backup configured and same internal domain)", sourceSeed.Name)
+	}
+
+	cloudProfile, err := gardenerutils.GetCloudProfile(ctx, r.Client, shoot)
+	if err != nil {
+		return nil, err
+	}
+	project, err := gardenerutils.ProjectForNamespaceFromReader(ctx, r.Client, shoot.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	regionConfig, err := shootcontroller.GetRegionConfig(ctx, log, r.Client, v1beta1constants.GardenNamespace, cloudProfile.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	state := &framework.CycleState{
+		Log:          log,
+		CloudProfile: cloudProfile,
+		Project:      project,
+		RegionConfig: regionConfig,
+		Shoots:       shoots,
+		SeedUsage:    seedUsage,
+	}
+
+	return r.Framework.Schedule(ctx, state, shoot, candidates)
+}
@@ -0,0 +1,247 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package drain_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/api/indexer"
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/controllermanager/v1alpha1"
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/scheduler/v1alpha1"
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/seed/drain"
+	shootcontroller "github.com/gardener/gardener/pkg/scheduler/controller/shoot"
+	"github.com/gardener/gardener/pkg/scheduler/framework"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx        = context.TODO()
+		fakeClient client.Client
+		fakeClock  *testclock.FakeClock
+		reconciler *Reconciler
+		bindings   []string
+
+		namespace = "garden-dev"
+		seed      *gardencorev1beta1.Seed
+		otherSeed *gardencorev1beta1.Seed
+		request   reconcile.Request
+	)
+
+	newSeed := func(name string) *gardencorev1beta1.Seed {
+		return &gardencorev1beta1.Seed{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: gardencorev1beta1.SeedSpec{
+				Backup: &gardencorev1beta1.Backup{Provider: "local"},
+				DNS:    gardencorev1beta1.SeedDNS{Internal: &gardencorev1beta1.SeedDNSProviderConfig{Domain: "internal.example.com"}},
+			},
+		}
+	}
+
+	newShoot := func(name, specSeedName, statusSeedName string) *gardencorev1beta1.Shoot {
+		return &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec: gardencorev1beta1.ShootSpec{
+				CloudProfileName: new("cloudprofile"),
+				SeedName:         &specSeedName,
+				Maintenance: &gardencorev1beta1.Maintenance{
+					TimeWindow: &gardencorev1beta1.MaintenanceTimeWindow{Begin: "220000+0000", End: "230000+0000"},
+				},
+			},
+			Status: gardencorev1beta1.ShootStatus{
+				SeedName:      &statusSeedName,
+				LastOperation: &gardencorev1beta1.LastOperation{Type: gardencorev1beta1.LastOperationTypeReconcile, State: gardencorev1beta1.LastOperationStateSucceeded},
+			},
+		}
+	}
+
+	createShoots := func(count int) {
+		for i := range count {
+			Expect(fakeClient.Create(ctx, newShoot(fmt.Sprintf("shoot-%d", i), seed.Name, seed.Name))).To(Succeed())
+		}
+	}
+
+	getCondition := func() *gardencorev1beta1.Condition {
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(seed), seed)).To(Succeed())
+		return v1beta1helper.GetCondition(seed.Status.Conditions, gardencorev1beta1.SeedDrained)
+	}
+
+	BeforeEach(func() {
+		bindings = nil
+		fakeClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithStatusSubresource(&gardencorev1beta1.Seed{}).
+			WithIndex(&gardencorev1beta1.Project{}, core.ProjectNamespace, indexer.ProjectNamespaceIndexerFunc).
+			WithInterceptorFuncs(interceptor.Funcs{
+				SubResourceUpdate: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, _ ...client.SubResourceUpdateOption) error {
+					Expect(subResourceName).To(Equal("binding"))
+					bindings = append(bindings, client.ObjectKeyFromObject(obj).String()+"->"+*obj.(*gardencorev1beta1.Shoot).Spec.SeedName)
+					return c.Update(ctx, obj)
+				},
+			}).
+			Build()
+		fakeClock = testclock.NewFakeClock(time.Date(2025, 1, 1, 22, 10, 0, 0, time.UTC))
+
+		fw, err := framework.New(
+			shootcontroller.NewRegistry(&schedulerconfigv1alpha1.ShootSchedulerConfiguration{}),
+			nil,
+			[]schedulerconfigv1alpha1.Plugin{{Name: shootcontroller.PluginNameLeastShootsDeployed}},
+		)
+		Expect(err).NotTo(HaveOccurred())
+
+		reconciler = &Reconciler{
+			Client:    fakeClient,
+			Clock:     fakeClock,
+			Framework: fw,
+			Config: controllermanagerconfigv1alpha1.SeedDrainControllerConfiguration{
+				SyncPeriod: &metav1.Duration{Duration: time.Minute},
+				BatchSize:  new(3),
+			},
+		}
+
+		Expect(fakeClient.Create(ctx, &gardencorev1beta1.CloudProfile{ObjectMeta: metav1.ObjectMeta{Name: "cloudprofile"}})).To(Succeed())
+		Expect(fakeClient.Create(ctx, &gardencorev1beta1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: "dev"},
+			Spec:       gardencorev1beta1.ProjectSpec{Namespace: &namespace},
+		})).To(Succeed())
+
+		seed = newSeed("seed-a")
+		metav1.SetMetaDataAnnotation(&seed.ObjectMeta, "seed.gardener.cloud/drain", "true")
+		otherSeed = newSeed("seed-b")
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(seed)}
+	})
+
+	JustBeforeEach(func() {
+		Expect(fakeClient.Create(ctx, seed)).To(Succeed())
+		Expect(fakeClient.Create(ctx, otherSeed)).To(Succeed())
+	})
+
+	It("should do nothing if the seed is gone", func() {
+		Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKey{Name: "foo"}})).To(Equal(reconcile.Result{}))
+	})
+
+	Context("seed is not drained", func() {
+		BeforeEach(func() {
+			seed.Annotations = nil
+		})
+
+		It("should remove the Drained condition", func() {
+			seed.Status.Conditions = []gardencorev1beta1.Condition{{Type: gardencorev1beta1.SeedDrained, Status: gardencorev1beta1.ConditionTrue}}
+			Expect(fakeClient.Status().Update(ctx, seed)).To(Succeed())
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+			Expect(getCondition()).To(BeNil())
+		})
+
+		It("should not migrate any shoot", func() {
+			createShoots(2)
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+			Expect(bindings).To(BeEmpty())
+			Expect(getCondition()).To(BeNil())
+		})
+	})
+
+	It("should report the seed as drained if no shoots are left", func() {
+		Expect(fakeClient.Create(ctx, newShoot("other", otherSeed.Name, otherSeed.Name))).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+		Expect(bindings).To(BeEmpty())
+
+		condition := getCondition()
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionTrue))
+		Expect(condition.Reason).To(Equal("SeedDrained"))
+	})
+
+	It("should migrate the shoots in batches", func() {
+		createShoots(4)
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+		Expect(bindings).To(ConsistOf(
+			"garden-dev/shoot-0->seed-b",
+			"garden-dev/shoot-1->seed-b",
+			"garden-dev/shoot-2->seed-b",
+		))
+
+		condition := getCondition()
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionProgressing))
+		Expect(condition.Reason).To(Equal("Draining"))
+		Expect(condition.Message).To(Equal("1 shoot(s) remaining on the seed, 3 shoot(s) being migrated."))
+	})
+
+	It("should pick the target seed with the scheduler", func() {
+		thirdSeed := newSeed("seed-c")
+		Expect(fakeClient.Create(ctx, thirdSeed)).To(Succeed())
+		Expect(fakeClient.Create(ctx, newShoot("other", otherSeed.Name, otherSeed.Name))).To(Succeed())
+		createShoots(2)
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+		Expect(bindings).To(ConsistOf(
+			"garden-dev/shoot-0->seed-c",
+			"garden-dev/shoot-1->seed-b",
+		))
+	})
+
+	It("should count running migrations against the batch size", func() {
+		Expect(fakeClient.Create(ctx, newShoot("migrating", otherSeed.Name, seed.Name))).To(Succeed())
+		createShoots(4)
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+		Expect(bindings).To(HaveLen(2))
+		Expect(getCondition().Message).To(Equal("2 shoot(s) remaining on the seed, 3 shoot(s) being migrated."))
+	})
+
+	It("should not migrate shoots outside of their maintenance time window", func() {
+		fakeClock.SetTime(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC))
+		createShoots(2)
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+		Expect(bindings).To(BeEmpty())
+		Expect(getCondition().Message).To(Equal("2 shoot(s) remaining on the seed, 0 shoot(s) being migrated."))
+	})
+
+	It("should report shoots for which no target seed could be found", func() {
+		otherSeed.Spec.Backup = nil
+		Expect(fakeClient.Update(ctx, otherSeed)).To(Succeed())
+		createShoots(1)
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+		Expect(bindings).To(BeEmpty())
+
+		condition := getCondition()
+		Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+		Expect(condition.Reason).To(Equal("DrainFailed"))
+		Expect(condition.Message).To(ContainSubstring("garden-dev/shoot-0:no target seed found"))
+	})
+
+	It("should report failed migrations", func() {
+		shoot := newShoot("migrating", otherSeed.Name, seed.Name)
+		shoot.Status.LastOperation = &gardencorev1beta1.LastOperation{Type: gardencorev1beta1.LastOperationTypeRestore, State: gardencorev1beta1.LastOperationStateFailed, Description: "some error"}
+		Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+
+		condition := getCondition()
+		Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+		Expect(condition.Reason).To(Equal("DrainFailed"))
+		Expect(condition.Message).To(ContainSubstring("garden-dev/migrating:last operation of type Restore failed: some error"))
+	})
+})
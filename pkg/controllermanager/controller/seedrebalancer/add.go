@@ -0,0 +1,34 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package seedrebalancer
+
+import (
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/controllermanager/v1alpha1"
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/scheduler/v1alpha1"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/seedrebalancer/placement"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/seedrebalancer/proposal"
+)
+
+// AddToManager adds all seed rebalancer controllers to the given manager.
+func AddToManager(mgr manager.Manager, cfg controllermanagerconfigv1alpha1.SeedRebalancerControllerConfiguration, schedulerConfig *schedulerconfigv1alpha1.ShootSchedulerConfiguration) error {
+	if err := (&placement.Reconciler{
+		Config:          cfg,
+		SchedulerConfig: schedulerConfig,
+	}).AddToManager(mgr); err != nil {
+		return fmt.Errorf("failed adding placement reconciler: %w", err)
+	}
+
+	if err := (&proposal.Reconciler{
+		Config: cfg,
+	}).AddToManager(mgr); err != nil {
+		return fmt.Errorf("failed adding proposal reconciler: %w", err)
+	}
+
+	return nil
+}
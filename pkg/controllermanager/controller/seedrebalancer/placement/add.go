@@ -0,0 +1,45 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package placement
+
+import (
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/gardener/gardener/pkg/controllerutils"
+	shootcontroller "github.com/gardener/gardener/pkg/scheduler/controller/shoot"
+)
+
+// ControllerName is the name of this controller.
+const ControllerName = "seed-rebalancer-placement"
+
+// AddToManager adds Reconciler to the given manager.
+func (r *Reconciler) AddToManager(mgr manager.Manager) error {
+	if r.Client == nil {
+		r.Client = mgr.GetClient()
+	}
+	if r.Clock == nil {
+		r.Clock = clock.RealClock{}
+	}
+	if r.Framework == nil {
+		fw, err := shootcontroller.NewFramework(r.SchedulerConfig)
+		if err != nil {
+			return err
+		}
+		r.Framework = fw
+	}
+
+	return builder.
+		ControllerManagedBy(mgr).
+		Named(ControllerName).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: 1,
+			ReconciliationTimeout:   r.Config.SyncPeriod.Duration,
+		}).
+		WatchesRawSource(controllerutils.EnqueueOnce).
+		Complete(r)
+}
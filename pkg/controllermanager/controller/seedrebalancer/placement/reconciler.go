@@ -0,0 +1,287 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package placement
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/controllermanager/v1alpha1"
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/scheduler/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/seedrebalancer/proposal"
+	shootcontroller "github.com/gardener/gardener/pkg/scheduler/controller/shoot"
+	"github.com/gardener/gardener/pkg/scheduler/framework"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+// Reconciler periodically scores the current placement of the shoot control planes with the logic of the scheduler and
+// proposes a bounded set of control plane migrations which would even out the load across the seeds.
+type Reconciler struct {
+	Client          client.Client
+	Config          controllermanagerconfigv1alpha1.SeedRebalancerControllerConfiguration
+	SchedulerConfig *schedulerconfigv1alpha1.ShootSchedulerConfiguration
+	Framework       *framework.Framework
+	Clock           clock.Clock
+}
+
+// Reconcile scores the current placement of the shoot control planes and creates a SeedRebalanceProposal if there are
+// migrations which would improve it.
+func (r *Reconciler) Reconcile(ctx context.Context, _ reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	proposalList := &operationsv1alpha1.SeedRebalanceProposalList{}
+	if err := r.Client.List(ctx, proposalList); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed listing seed rebalance proposals: %w", err)
+	}
+
+	var (
+		finishedProposals  []*operationsv1alpha1.SeedRebalanceProposal
+		unfinishedProposal *operationsv1alpha1.SeedRebalanceProposal
+	)
+
+	for i := range proposalList.Items {
+		p := &proposalList.Items[i]
+
+		switch {
+		case proposal.IsFinished(p):
+			finishedProposals = append(finishedProposals, p)
+		case proposal.IsExpired(p, r.Config.ProposalTTL.Duration, r.Clock.Now()):
+			// Expired proposals are finished by the proposal reconciler, they must not block new proposals meanwhile.
+			log.V(1).Info("Ignoring expired proposal", "seedRebalanceProposal", client.ObjectKeyFromObject(p))
+		default:
+			unfinishedProposal = p
+		}
+	}
+
+	if err := r.pruneFinishedProposals(ctx, log, finishedProposals); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if unfinishedProposal != nil {
+		log.V(1).Info("Found unfinished proposal, skipping evaluation of placement", "seedRebalanceProposal", client.ObjectKeyFromObject(unfinishedProposal))
+		return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+	}
+
+	migrations, err := r.computeMigrations(ctx, log)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if len(migrations) == 0 {
+		log.V(1).Info("Current placement of shoot control planes is balanced, nothing to propose")
+		return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+	}
+
+	newProposal := &operationsv1alpha1.SeedRebalanceProposal{
+		ObjectMeta: metav1.ObjectMeta{GenerateName: "rebalance-"},
+		Spec:       operationsv1alpha1.SeedRebalanceProposalSpec{Migrations: migrations},
+	}
+	if err := r.Client.Create(ctx, newProposal); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed creating seed rebalance proposal: %w", err)
+	}
+	log.Info("Created seed rebalance proposal", "seedRebalanceProposal", client.ObjectKeyFromObject(newProposal), "migrations", len(migrations))
+
+	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+}
+
+// pruneFinishedProposals deletes the oldest completed or expired proposals so that not more than the configured number
+// of them is kept.
+func (r *Reconciler) pruneFinishedProposals(ctx context.Context, log logr.Logger, finishedProposals []*operationsv1alpha1.SeedRebalanceProposal) error {
+	maxRetained := ptr.Deref(r.Config.MaxRetainedProposals, 0)
+	if len(finishedProposals) <= maxRetained {
+		return nil
+	}
+
+	slices.SortStableFunc(finishedProposals, func(a, b *operationsv1alpha1.SeedRebalanceProposal) int {
+		return cmp.Or(
+			b.CreationTimestamp.Compare(a.CreationTimestamp.Time),
+			cmp.Compare(a.Name, b.Name),
+		)
+	})
+
+	for _, p := range finishedProposals[maxRetained:] {
+		log.Info("Deleting finished seed rebalance proposal", "seedRebalanceProposal", client.ObjectKeyFromObject(p), "phase", p.Status.Phase)
+		if err := client.IgnoreNotFound(r.Client.Delete(ctx, p)); err != nil {
+			return fmt.Errorf("failed deleting seed rebalance proposal %s: %w", p.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func (r *Reconciler) computeMigrations(ctx context.Context, log logr.Logger) ([]operationsv1alpha1.SeedRebalanceMigration, error) {
+	seedList := &gardencorev1beta1.SeedList{}
+	if err := r.Client.List(ctx, seedList); err != nil {
+		return nil, fmt.Errorf("failed listing seeds: %w", err)
+	}
+
+	shootList := &gardencorev1beta1.ShootList{}
+	if err := r.Client.List(ctx, shootList); err != nil {
+		return nil, fmt.Errorf("failed listing shoots: %w", err)
+	}
+
+	var (
+		shoots    = v1beta1helper.ConvertShootList(shootList.Items)
+		seedUsage = v1beta1helper.CalculateSeedUsage(shoots)
+		seeds     = make(map[string]*gardencorev1beta1.Seed, len(seedList.Items))
+	)
+
+	for i := range seedList.Items {
+		seeds[seedList.Items[i].Name] = &seedList.Items[i]
+	}
+
+	// Shoots on the seeds with the most control planes are considered first since moving them away has the largest
+	// effect on the balance.
+	candidates := slices.DeleteFunc(slices.Clone(shoots), func(shoot *gardencorev1beta1.Shoot) bool {
+		return !isMigratable(shoot) || seeds[*shoot.Spec.SeedName] == nil
+	})
+	slices.SortStableFunc(candidates, func(a, b *gardencorev1beta1.Shoot) int {
+		return cmp.Or(
+			cmp.Compare(seedUsage[*b.Spec.SeedName], seedUsage[*a.Spec.SeedName]),
+			cmp.Compare(a.Namespace, b.Namespace),
+			cmp.Compare(a.Name, b.Name),
+		)
+	})
+
+	var migrations []operationsv1alpha1.SeedRebalanceMigration
+	for _, shoot := range candidates {
+		if len(migrations) >= ptr.Deref(r.Config.MaxMigrations, 0) {
+			break
+		}
+
+		sourceSeed := seeds[*shoot.Spec.SeedName]
+		targetSeed, err := r.findBetterSeed(ctx, log, shoot, sourceSeed, seedList.Items, shoots, seedUsage)
+		if err != nil {
+			log.V(1).Info("Could not evaluate placement of shoot, skipping it", "shoot", client.ObjectKeyFromObject(shoot), "reason", err.Error())
+			continue
+		}
+		if targetSeed == nil {
+			continue
+		}
+
+		// Account for the proposed migration so that the following shoots are evaluated against the resulting placement.
+		seedUsage[sourceSeed.Name]--
+		seedUsage[targetSeed.Name]++
+
+		migrations = append(migrations, operationsv1alpha1.SeedRebalanceMigration{
+			ShootNamespace: shoot.Namespace,
+			ShootName:      shoot.Name,
+			SourceSeedName: sourceSeed.Name,
+			TargetSeedName: targetSeed.Name,
+		})
+	}
+
+	return migrations, nil
+}
+
+// findBetterSeed runs the filter and score plugins of the scheduler as if the shoot was not yet scheduled. It returns
+// the seed with the highest score if it improves the score of the current seed by at least the configured minimum, or
+// nil otherwise.
+func (r *Reconciler) findBetterSeed(
+	ctx context.Context,
+	log logr.Logger,
+	shoot *gardencorev1beta1.Shoot,
+	sourceSeed *gardencorev1beta1.Seed,
+	seeds []gardencorev1beta1.Seed,
+	shoots []*gardencorev1beta1.Shoot,
+	seedUsage map[string]int,
+) (
+	*gardencorev1beta1.Seed,
+	error,
+) {
+	cloudProfile, err := gardenerutils.GetCloudProfile(ctx, r.Client, shoot)
+	if err != nil {
+		return nil, err
+	}
+	project, err := gardenerutils.ProjectForNamespaceFromReader(ctx, r.Client, shoot.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	regionConfig, err := shootcontroller.GetRegionConfig(ctx, log, r.Client, v1beta1constants.GardenNamespace, cloudProfile.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	usage := maps.Clone(seedUsage)
+	usage[sourceSeed.Name]--
+
+	state := &framework.CycleState{
+		Log:          log,
+		CloudProfile: cloudProfile,
+		Project:      project,
+		RegionConfig: regionConfig,
+		Shoots:       shoots,
+		SeedUsage:    usage,
+	}
+
+	filteredSeeds, err := r.Framework.RunFilterPlugins(ctx, state, shoot, seeds)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only keep the seeds the control plane can be migrated to, see the validation of the `shoots/binding` subresource.
+	filteredSeeds = slices.DeleteFunc(filteredSeeds, func(seed gardencorev1beta1.Seed) bool {
+		return seed.Name != sourceSeed.Name && !v1beta1helper.ControlPlaneMigrationPossible(sourceSeed, &seed)
+	})
+	if len(filteredSeeds) == 0 {
+		return nil, nil
+	}
+
+	scores, err := r.Framework.RunScorePlugins(ctx, state, shoot, filteredSeeds)
+	if err != nil {
+		return nil, err
+	}
+
+	// The current seed is scored with 0 if it is not suitable anymore.
+	var sourceScore int64
+	for _, score := range scores {
+		if score.Name == sourceSeed.Name {
+			sourceScore = score.Score
+		}
+	}
+
+	best := -1
+	for i, score := range scores {
+		if score.Name == sourceSeed.Name {
+			continue
+		}
+		if best == -1 || score.Score > scores[best].Score {
+			best = i
+		}
+	}
+
+	if best == -1 || scores[best].Score-sourceScore < ptr.Deref(r.Config.MinScoreImprovement, 0) {
+		return nil, nil
+	}
+
+	return &filteredSeeds[best], nil
+}
+
+// isMigratable returns true if the control plane of the shoot can be moved to another seed, i.e., the shoot is
+// scheduled, not being deleted or migrated, and its last operation succeeded. Shoots in the garden namespace are
+// skipped since they might be registered as seeds.
+func isMigratable(shoot *gardencorev1beta1.Shoot) bool {
+	return shoot.Namespace != v1beta1constants.GardenNamespace &&
+		shoot.DeletionTimestamp == nil &&
+		shoot.Spec.SeedName != nil &&
+		ptr.Equal(shoot.Spec.SeedName, shoot.Status.SeedName) &&
+		shoot.Status.LastOperation != nil &&
+		shoot.Status.LastOperation.State == gardencorev1beta1.LastOperationStateSucceeded
+}
@@ -0,0 +1,233 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package placement_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/api/indexer"
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/controllermanager/v1alpha1"
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/scheduler/v1alpha1"
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/seedrebalancer/placement"
+	shootcontroller "github.com/gardener/gardener/pkg/scheduler/controller/shoot"
+	"github.com/gardener/gardener/pkg/scheduler/framework"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx        = context.TODO()
+		fakeClient client.Client
+		fakeClock  *testclock.FakeClock
+		reconciler *Reconciler
+
+		namespace = "garden-dev"
+		seedA     *gardencorev1beta1.Seed
+		seedB     *gardencorev1beta1.Seed
+	)
+
+	newSeed := func(name string) *gardencorev1beta1.Seed {
+		return &gardencorev1beta1.Seed{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: gardencorev1beta1.SeedSpec{
+				Backup: &gardencorev1beta1.Backup{Provider: "local"},
+				DNS:    gardencorev1beta1.SeedDNS{Internal: &gardencorev1beta1.SeedDNSProviderConfig{Domain: "internal.example.com"}},
+			},
+		}
+	}
+
+	createShoots := func(seedName string, count int) {
+		for i := range count {
+			Expect(fakeClient.Create(ctx, &gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("%s-%d", seedName, i), Namespace: namespace},
+				Spec: gardencorev1beta1.ShootSpec{
+					CloudProfileName: new("cloudprofile"),
+					SeedName:         &seedName,
+				},
+				Status: gardencorev1beta1.ShootStatus{
+					SeedName:      &seedName,
+					LastOperation: &gardencorev1beta1.LastOperation{Type: gardencorev1beta1.LastOperationTypeReconcile, State: gardencorev1beta1.LastOperationStateSucceeded},
+				},
+			})).To(Succeed())
+		}
+	}
+
+	newProposal := func(name string, age time.Duration, phase operationsv1alpha1.SeedRebalanceProposalPhase) *operationsv1alpha1.SeedRebalanceProposal {
+		return &operationsv1alpha1.SeedRebalanceProposal{
+			ObjectMeta: metav1.ObjectMeta{Name: name, CreationTimestamp: metav1.NewTime(fakeClock.Now().Add(-age))},
+			Spec: operationsv1alpha1.SeedRebalanceProposalSpec{Migrations: []operationsv1alpha1.SeedRebalanceMigration{
+				{ShootNamespace: namespace, ShootName: "foo", SourceSeedName: "seed-a", TargetSeedName: "seed-b"},
+			}},
+			Status: operationsv1alpha1.SeedRebalanceProposalStatus{Phase: phase},
+		}
+	}
+
+	listProposals := func() []operationsv1alpha1.SeedRebalanceProposal {
+		proposalList := &operationsv1alpha1.SeedRebalanceProposalList{}
+		Expect(fakeClient.List(ctx, proposalList)).To(Succeed())
+		return proposalList.Items
+	}
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithIndex(&gardencorev1beta1.Project{}, core.ProjectNamespace, indexer.ProjectNamespaceIndexerFunc).
+			Build()
+
+		fw, err := framework.New(
+			shootcontroller.NewRegistry(&schedulerconfigv1alpha1.ShootSchedulerConfiguration{}),
+			nil,
+			[]schedulerconfigv1alpha1.Plugin{{Name: shootcontroller.PluginNameLeastShootsDeployed}},
+		)
+		Expect(err).NotTo(HaveOccurred())
+		fakeClock = testclock.NewFakeClock(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC))
+
+		reconciler = &Reconciler{
+			Client:    fakeClient,
+			Framework: fw,
+			Clock:     fakeClock,
+			Config: controllermanagerconfigv1alpha1.SeedRebalancerControllerConfiguration{
+				SyncPeriod:           &metav1.Duration{Duration: time.Hour},
+				MaxMigrations:        new(5),
+				MinScoreImprovement:  new(int64(20)),
+				ProposalTTL:          &metav1.Duration{Duration: 24 * time.Hour},
+				MaxRetainedProposals: new(2),
+			},
+		}
+
+		Expect(fakeClient.Create(ctx, &gardencorev1beta1.CloudProfile{ObjectMeta: metav1.ObjectMeta{Name: "cloudprofile"}})).To(Succeed())
+		Expect(fakeClient.Create(ctx, &gardencorev1beta1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: "dev"},
+			Spec:       gardencorev1beta1.ProjectSpec{Namespace: &namespace},
+		})).To(Succeed())
+
+		seedA = newSeed("seed-a")
+		seedB = newSeed("seed-b")
+	})
+
+	JustBeforeEach(func() {
+		Expect(fakeClient.Create(ctx, seedA)).To(Succeed())
+		Expect(fakeClient.Create(ctx, seedB)).To(Succeed())
+	})
+
+	It("should propose migrations evening out the number of shoots", func() {
+		createShoots(seedA.Name, 5)
+
+		Expect(reconciler.Reconcile(ctx, reconcile.Request{})).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+
+		proposals := listProposals()
+		Expect(proposals).To(HaveLen(1))
+		Expect(proposals[0].Spec.Approved).To(BeFalse())
+		Expect(proposals[0].Spec.Migrations).To(HaveLen(2))
+		for _, migration := range proposals[0].Spec.Migrations {
+			Expect(migration.SourceSeedName).To(Equal(seedA.Name))
+			Expect(migration.TargetSeedName).To(Equal(seedB.Name))
+		}
+	})
+
+	It("should respect the maximum number of migrations", func() {
+		reconciler.Config.MaxMigrations = new(1)
+		createShoots(seedA.Name, 5)
+
+		Expect(reconciler.Reconcile(ctx, reconcile.Request{})).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+
+		proposals := listProposals()
+		Expect(proposals).To(HaveLen(1))
+		Expect(proposals[0].Spec.Migrations).To(HaveLen(1))
+	})
+
+	It("should not propose anything if the placement is balanced", func() {
+		createShoots(seedA.Name, 2)
+		createShoots(seedB.Name, 2)
+
+		Expect(reconciler.Reconcile(ctx, reconcile.Request{})).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+		Expect(listProposals()).To(BeEmpty())
+	})
+
+	It("should not propose migrations to seeds without backup", func() {
+		seedB.Spec.Backup = nil
+		createShoots(seedA.Name, 5)
+
+		Expect(reconciler.Reconcile(ctx, reconcile.Request{})).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+		Expect(listProposals()).To(BeEmpty())
+	})
+
+	It("should not propose migrations to seeds with a different internal domain", func() {
+		seedB.Spec.DNS.Internal.Domain = "other.example.com"
+		createShoots(seedA.Name, 5)
+
+		Expect(reconciler.Reconcile(ctx, reconcile.Request{})).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+		Expect(listProposals()).To(BeEmpty())
+	})
+
+	It("should not propose migrations if the score improvement is too small", func() {
+		reconciler.Config.MinScoreImprovement = new(int64(101))
+		createShoots(seedA.Name, 5)
+
+		Expect(reconciler.Reconcile(ctx, reconcile.Request{})).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+		Expect(listProposals()).To(BeEmpty())
+	})
+
+	It("should not evaluate the placement while another proposal is unfinished", func() {
+		Expect(fakeClient.Create(ctx, newProposal("rebalance-foo", time.Hour, operationsv1alpha1.SeedRebalanceProposalExecuting))).To(Succeed())
+		createShoots(seedA.Name, 5)
+
+		Expect(reconciler.Reconcile(ctx, reconcile.Request{})).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+		Expect(listProposals()).To(HaveLen(1))
+	})
+
+	It("should not evaluate the placement while another proposal is pending within its time to live", func() {
+		Expect(fakeClient.Create(ctx, newProposal("rebalance-foo", 23*time.Hour, operationsv1alpha1.SeedRebalanceProposalPending))).To(Succeed())
+		createShoots(seedA.Name, 5)
+
+		Expect(reconciler.Reconcile(ctx, reconcile.Request{})).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+		Expect(listProposals()).To(HaveLen(1))
+	})
+
+	It("should evaluate the placement if the unfinished proposal is expired", func() {
+		Expect(fakeClient.Create(ctx, newProposal("rebalance-foo", 25*time.Hour, operationsv1alpha1.SeedRebalanceProposalPending))).To(Succeed())
+		createShoots(seedA.Name, 5)
+
+		Expect(reconciler.Reconcile(ctx, reconcile.Request{})).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+		Expect(listProposals()).To(HaveLen(2))
+	})
+
+	It("should not consider approved proposals as expired", func() {
+		proposal := newProposal("rebalance-foo", 25*time.Hour, operationsv1alpha1.SeedRebalanceProposalPending)
+		proposal.Spec.Approved = true
+		Expect(fakeClient.Create(ctx, proposal)).To(Succeed())
+		createShoots(seedA.Name, 5)
+
+		Expect(reconciler.Reconcile(ctx, reconcile.Request{})).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+		Expect(listProposals()).To(HaveLen(1))
+	})
+
+	It("should delete the oldest finished proposals exceeding the retention count", func() {
+		Expect(fakeClient.Create(ctx, newProposal("rebalance-1", 4*time.Hour, operationsv1alpha1.SeedRebalanceProposalCompleted))).To(Succeed())
+		Expect(fakeClient.Create(ctx, newProposal("rebalance-2", 3*time.Hour, operationsv1alpha1.SeedRebalanceProposalExpired))).To(Succeed())
+		Expect(fakeClient.Create(ctx, newProposal("rebalance-3", 2*time.Hour, operationsv1alpha1.SeedRebalanceProposalCompleted))).To(Succeed())
+		Expect(fakeClient.Create(ctx, newProposal("rebalance-4", time.Hour, operationsv1alpha1.SeedRebalanceProposalExecuting))).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, reconcile.Request{})).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+		Expect(listProposals()).To(ConsistOf(
+			HaveField("Name", "rebalance-2"),
+			HaveField("Name", "rebalance-3"),
+			HaveField("Name", "rebalance-4"),
+		))
+	})
+})
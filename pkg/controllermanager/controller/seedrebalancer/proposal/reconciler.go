@@ -0,0 +1,315 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package proposal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/controllermanager/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+// requeueInterval is the interval in which proposals with pending or running migrations are checked.
+const requeueInterval = time.Minute
+
+// Reconciler executes the migrations of approved SeedRebalanceProposals (or of all proposals if auto-execution is
+// enabled) by changing the seed of the shoots and tracks the progress of the migrations in the status.
+type Reconciler struct {
+	Client client.Client
+	Config controllermanagerconfigv1alpha1.SeedRebalancerControllerConfiguration
+	Clock  clock.Clock
+}
+
+// Reconcile executes the migrations of a SeedRebalanceProposal and tracks their progress.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	proposal := &operationsv1alpha1.SeedRebalanceProposal{}
+	if err := r.Client.Get(ctx, request.NamespacedName, proposal); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if proposal.DeletionTimestamp != nil || IsFinished(proposal) {
+		return reconcile.Result{}, nil
+	}
+
+	if IsExpired(proposal, r.Config.ProposalTTL.Duration, r.Clock.Now()) {
+		log.Info("Seed rebalance proposal expired before it was approved or started")
+		return reconcile.Result{}, r.expire(ctx, proposal)
+	}
+
+	migratingCount, err := r.countMigrating(ctx, proposal.Name)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	patch := client.MergeFrom(proposal.DeepCopy())
+	statuses := initializeStatuses(proposal)
+	for _, status := range statuses {
+		if status.Phase == operationsv1alpha1.SeedRebalanceMigrationMigrating {
+			migratingCount++
+		}
+	}
+
+	for i, migration := range proposal.Spec.Migrations {
+		status := &statuses[i]
+
+		switch status.Phase {
+		case operationsv1alpha1.SeedRebalanceMigrationPending:
+			started, err := r.startMigration(ctx, log, proposal, migration, status, migratingCount)
+			if err != nil {
+				return reconcile.Result{}, err
+			}
+			if started {
+				migratingCount++
+			}
+
+		case operationsv1alpha1.SeedRebalanceMigrationMigrating:
+			if err := r.checkMigration(ctx, migration, status); err != nil {
+				return reconcile.Result{}, err
+			}
+		}
+	}
+
+	proposal.Status.Migrations = statuses
+	proposal.Status.Phase = computePhase(statuses)
+	proposal.Status.ObservedGeneration = &proposal.Generation
+	if err := r.Client.Status().Patch(ctx, proposal, patch); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed updating status of seed rebalance proposal: %w", err)
+	}
+
+	if proposal.Status.Phase == operationsv1alpha1.SeedRebalanceProposalCompleted {
+		log.Info("All migrations of seed rebalance proposal are finished")
+		return reconcile.Result{}, nil
+	}
+
+	return reconcile.Result{RequeueAfter: requeueInterval}, nil
+}
+
+// expire marks the proposal and all of its pending migrations as expired.
+func (r *Reconciler) expire(ctx context.Context, proposal *operationsv1alpha1.SeedRebalanceProposal) error {
+	patch := client.MergeFrom(proposal.DeepCopy())
+
+	statuses := initializeStatuses(proposal)
+	for i := range statuses {
+		if statuses[i].Phase == operationsv1alpha1.SeedRebalanceMigrationPending {
+			r.setPhase(&statuses[i], operationsv1alpha1.SeedRebalanceMigrationSkipped, "Proposal expired before the migration was started")
+		}
+	}
+
+	proposal.Status.Migrations = statuses
+	proposal.Status.Phase = operationsv1alpha1.SeedRebalanceProposalExpired
+	proposal.Status.ObservedGeneration = &proposal.Generation
+	if err := r.Client.Status().Patch(ctx, proposal, patch); err != nil {
+		return fmt.Errorf("failed updating status of seed rebalance proposal: %w", err)
+	}
+	return nil
+}
+
+// countMigrating returns the number of migrations in other proposals which are currently running.
+func (r *Reconciler) countMigrating(ctx context.Context, ownName string) (int, error) {
+	proposalList := &operationsv1alpha1.SeedRebalanceProposalList{}
+	if err := r.Client.List(ctx, proposalList); err != nil {
+		return 0, fmt.Errorf("failed listing seed rebalance proposals: %w", err)
+	}
+
+	var count int
+	for _, proposal := range proposalList.Items {
+		if proposal.Name == ownName {
+			continue
+		}
+
+		for _, status := range proposal.Status.Migrations {
+			if status.Phase == operationsv1alpha1.SeedRebalanceMigrationMigrating {
+				count++
+			}
+		}
+	}
+
+	return count, nil
+}
+
+// startMigration changes the seed of the shoot to the target seed if the migration may be executed. It returns true if
+// the migration was started.
+func (r *Reconciler) startMigration(
+	ctx context.Context,
+	log logr.Logger,
+	proposal *operationsv1alpha1.SeedRebalanceProposal,
+	migration operationsv1alpha1.SeedRebalanceMigration,
+	status *operationsv1alpha1.SeedRebalanceMigrationStatus,
+	migratingCount int,
+) (
+	bool,
+	error,
+) {
+	shoot := &gardencorev1beta1.Shoot{}
+	if err := r.Client.Get(ctx, client.ObjectKey{Namespace: migration.ShootNamespace, Name: migration.ShootName}, shoot); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return false, fmt.Errorf("failed reading shoot %s/%s: %w", migration.ShootNamespace, migration.ShootName, err)
+		}
+		r.setPhase(status, operationsv1alpha1.SeedRebalanceMigrationSkipped, "Shoot does not exist anymore")
+		return false, nil
+	}
+
+	if shoot.DeletionTimestamp != nil {
+		r.setPhase(status, operationsv1alpha1.SeedRebalanceMigrationSkipped, "Shoot is being deleted")
+		return false, nil
+	}
+
+	if ptr.Deref(shoot.Spec.SeedName, "") != migration.SourceSeedName || ptr.Deref(shoot.Status.SeedName, "") != migration.SourceSeedName {
+		r.setPhase(status, operationsv1alpha1.SeedRebalanceMigrationSkipped, "Shoot is not hosted by the source seed anymore")
+		return false, nil
+	}
+
+	if !proposal.Spec.Approved {
+		if !ptr.Deref(r.Config.AutoExecute, false) {
+			return false, nil
+		}
+		if !gardenerutils.IsNowInEffectiveShootMaintenanceTimeWindow(shoot, r.Clock) {
+			return false, nil
+		}
+	}
+
+	if migratingCount >= ptr.Deref(r.Config.MaxConcurrentMigrations, 0) {
+		return false, nil
+	}
+
+	log.Info("Migrating control plane of shoot", "shoot", client.ObjectKeyFromObject(shoot), "sourceSeed", migration.SourceSeedName, "targetSeed", migration.TargetSeedName)
+	shoot.Spec.SeedName = &migration.TargetSeedName
+	if err := r.Client.SubResource("binding").Update(ctx, shoot); err != nil {
+		if apierrors.IsInvalid(err) || apierrors.IsForbidden(err) {
+			r.setPhase(status, operationsv1alpha1.SeedRebalanceMigrationFailed, fmt.Sprintf("Changing the seed of the shoot was rejected: %s", err))
+			return false, nil
+		}
+		return false, fmt.Errorf("failed changing seed of shoot %s: %w", client.ObjectKeyFromObject(shoot), err)
+	}
+
+	r.setPhase(status, operationsv1alpha1.SeedRebalanceMigrationMigrating, "Control plane is being migrated to the target seed")
+	return true, nil
+}
+
+// checkMigration updates the phase of a running migration based on the status of the shoot.
+func (r *Reconciler) checkMigration(ctx context.Context, migration operationsv1alpha1.SeedRebalanceMigration, status *operationsv1alpha1.SeedRebalanceMigrationStatus) error {
+	shoot := &gardencorev1beta1.Shoot{}
+	if err := r.Client.Get(ctx, client.ObjectKey{Namespace: migration.ShootNamespace, Name: migration.ShootName}, shoot); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed reading shoot %s/%s: %w", migration.ShootNamespace, migration.ShootName, err)
+		}
+		r.setPhase(status, operationsv1alpha1.SeedRebalanceMigrationFailed, "Shoot was deleted during the migration")
+		return nil
+	}
+
+	lastOperation := shoot.Status.LastOperation
+	if lastOperation != nil && lastOperation.State == gardencorev1beta1.LastOperationStateFailed {
+		r.setPhase(status, operationsv1alpha1.SeedRebalanceMigrationFailed, fmt.Sprintf("Last operation of type %s failed: %s", lastOperation.Type, lastOperation.Description))
+		return nil
+	}
+
+	if ptr.Deref(shoot.Status.SeedName, "") == migration.TargetSeedName &&
+		lastOperation != nil &&
+		lastOperation.State == gardencorev1beta1.LastOperationStateSucceeded &&
+		!v1beta1helper.ShootHasOperationType(lastOperation, gardencorev1beta1.LastOperationTypeMigrate) {
+		r.setPhase(status, operationsv1alpha1.SeedRebalanceMigrationSucceeded, "Control plane was migrated to the target seed")
+	}
+
+	return nil
+}
+
+func (r *Reconciler) setPhase(status *operationsv1alpha1.SeedRebalanceMigrationStatus, phase operationsv1alpha1.SeedRebalanceMigrationPhase, message string) {
+	status.Phase = phase
+	status.Message = message
+	status.LastTransitionTime = &metav1.Time{Time: r.Clock.Now().UTC()}
+}
+
+// IsFinished returns true if the given proposal is completed or expired, i.e., none of its migrations is executed
+// anymore.
+func IsFinished(proposal *operationsv1alpha1.SeedRebalanceProposal) bool {
+	return proposal.Status.Phase == operationsv1alpha1.SeedRebalanceProposalCompleted ||
+		proposal.Status.Phase == operationsv1alpha1.SeedRebalanceProposalExpired
+}
+
+// IsExpired returns true if the given proposal was neither approved nor was any of its migrations started within the
+// given time to live. Without approval, migrations are only started if auto-execution is enabled, hence such
+// proposals would otherwise stay unfinished forever.
+func IsExpired(proposal *operationsv1alpha1.SeedRebalanceProposal, ttl time.Duration, now time.Time) bool {
+	if proposal.Status.Phase == operationsv1alpha1.SeedRebalanceProposalExpired {
+		return true
+	}
+	if proposal.Spec.Approved || now.Before(proposal.CreationTimestamp.Add(ttl)) {
+		return false
+	}
+
+	for _, status := range proposal.Status.Migrations {
+		switch status.Phase {
+		case operationsv1alpha1.SeedRebalanceMigrationMigrating, operationsv1alpha1.SeedRebalanceMigrationSucceeded, operationsv1alpha1.SeedRebalanceMigrationFailed:
+			return false
+		}
+	}
+
+	return true
+}
+
+// initializeStatuses returns the status of each migration in the spec of the proposal. Migrations without status are
+// considered pending.
+func initializeStatuses(proposal *operationsv1alpha1.SeedRebalanceProposal) []operationsv1alpha1.SeedRebalanceMigrationStatus {
+	existing := make(map[string]operationsv1alpha1.SeedRebalanceMigrationStatus, len(proposal.Status.Migrations))
+	for _, status := range proposal.Status.Migrations {
+		existing[status.ShootNamespace+"/"+status.ShootName] = status
+	}
+
+	statuses := make([]operationsv1alpha1.SeedRebalanceMigrationStatus, 0, len(proposal.Spec.Migrations))
+	for _, migration := range proposal.Spec.Migrations {
+		status, ok := existing[migration.ShootNamespace+"/"+migration.ShootName]
+		if !ok {
+			status = operationsv1alpha1.SeedRebalanceMigrationStatus{
+				ShootNamespace: migration.ShootNamespace,
+				ShootName:      migration.ShootName,
+				Phase:          operationsv1alpha1.SeedRebalanceMigrationPending,
+			}
+		}
+		statuses = append(statuses, status)
+	}
+
+	return statuses
+}
+
+func computePhase(statuses []operationsv1alpha1.SeedRebalanceMigrationStatus) operationsv1alpha1.SeedRebalanceProposalPhase {
+	var pending, finished int
+	for _, status := range statuses {
+		switch status.Phase {
+		case operationsv1alpha1.SeedRebalanceMigrationPending:
+			pending++
+		case operationsv1alpha1.SeedRebalanceMigrationSucceeded, operationsv1alpha1.SeedRebalanceMigrationFailed, operationsv1alpha1.SeedRebalanceMigrationSkipped:
+			finished++
+		}
+	}
+
+	switch {
+	case finished == len(statuses):
+		return operationsv1alpha1.SeedRebalanceProposalCompleted
+	case pending == len(statuses):
+		return operationsv1alpha1.SeedRebalanceProposalPending
+	default:
+		return operationsv1alpha1.SeedRebalanceProposalExecuting
+	}
+}
@@ -0,0 +1,251 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package proposal_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/controllermanager/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/seedrebalancer/proposal"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx        = context.TODO()
+		fakeClient client.Client
+		fakeClock  *testclock.FakeClock
+		reconciler *Reconciler
+		bindings   []string
+
+		proposal *operationsv1alpha1.SeedRebalanceProposal
+		shoot    *gardencorev1beta1.Shoot
+	)
+
+	BeforeEach(func() {
+		bindings = nil
+		fakeClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithStatusSubresource(&operationsv1alpha1.SeedRebalanceProposal{}).
+			WithInterceptorFuncs(interceptor.Funcs{
+				SubResourceUpdate: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, _ ...client.SubResourceUpdateOption) error {
+					Expect(subResourceName).To(Equal("binding"))
+					bindings = append(bindings, client.ObjectKeyFromObject(obj).String())
+					return c.Update(ctx, obj)
+				},
+			}).
+			Build()
+		fakeClock = testclock.NewFakeClock(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC))
+
+		reconciler = &Reconciler{
+			Client: fakeClient,
+			Clock:  fakeClock,
+			Config: controllermanagerconfigv1alpha1.SeedRebalancerControllerConfiguration{
+				AutoExecute:             new(false),
+				MaxConcurrentMigrations: new(1),
+				ProposalTTL:             &metav1.Duration{Duration: 24 * time.Hour},
+			},
+		}
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-dev"},
+			Spec: gardencorev1beta1.ShootSpec{
+				SeedName: new("seed-a"),
+				Maintenance: &gardencorev1beta1.Maintenance{
+					TimeWindow: &gardencorev1beta1.MaintenanceTimeWindow{Begin: "220000+0000", End: "230000+0000"},
+				},
+			},
+			Status: gardencorev1beta1.ShootStatus{
+				SeedName:      new("seed-a"),
+				LastOperation: &gardencorev1beta1.LastOperation{Type: gardencorev1beta1.LastOperationTypeReconcile, State: gardencorev1beta1.LastOperationStateSucceeded},
+			},
+		}
+
+		proposal = &operationsv1alpha1.SeedRebalanceProposal{
+			ObjectMeta: metav1.ObjectMeta{Name: "rebalance-foo", Generation: 1, CreationTimestamp: metav1.NewTime(fakeClock.Now())},
+			Spec: operationsv1alpha1.SeedRebalanceProposalSpec{
+				Migrations: []operationsv1alpha1.SeedRebalanceMigration{
+					{ShootNamespace: shoot.Namespace, ShootName: shoot.Name, SourceSeedName: "seed-a", TargetSeedName: "seed-b"},
+				},
+			},
+		}
+	})
+
+	reconcileProposal := func() reconcile.Result {
+		result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(proposal)})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(proposal), proposal)).To(Succeed())
+		return result
+	}
+
+	It("should not execute migrations of a proposal which is not approved", func() {
+		Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+		Expect(fakeClient.Create(ctx, proposal)).To(Succeed())
+
+		Expect(reconcileProposal()).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+		Expect(bindings).To(BeEmpty())
+		Expect(proposal.Status.Phase).To(Equal(operationsv1alpha1.SeedRebalanceProposalPending))
+		Expect(proposal.Status.Migrations).To(ConsistOf(HaveField("Phase", operationsv1alpha1.SeedRebalanceMigrationPending)))
+	})
+
+	It("should expire a proposal which is not approved within its time to live", func() {
+		Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+		Expect(fakeClient.Create(ctx, proposal)).To(Succeed())
+		fakeClock.Step(24 * time.Hour)
+
+		Expect(reconcileProposal()).To(Equal(reconcile.Result{}))
+		Expect(bindings).To(BeEmpty())
+		Expect(proposal.Status.Phase).To(Equal(operationsv1alpha1.SeedRebalanceProposalExpired))
+		Expect(proposal.Status.Migrations).To(ConsistOf(And(
+			HaveField("Phase", operationsv1alpha1.SeedRebalanceMigrationSkipped),
+			HaveField("Message", "Proposal expired before the migration was started"),
+		)))
+
+		By("Ignore approval of expired proposal")
+		proposal.Spec.Approved = true
+		Expect(fakeClient.Update(ctx, proposal)).To(Succeed())
+
+		Expect(reconcileProposal()).To(Equal(reconcile.Result{}))
+		Expect(bindings).To(BeEmpty())
+		Expect(proposal.Status.Phase).To(Equal(operationsv1alpha1.SeedRebalanceProposalExpired))
+	})
+
+	It("should not expire an approved proposal", func() {
+		proposal.Spec.Approved = true
+		Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+		Expect(fakeClient.Create(ctx, proposal)).To(Succeed())
+		fakeClock.Step(24 * time.Hour)
+
+		Expect(reconcileProposal()).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+		Expect(bindings).To(ConsistOf("garden-dev/shoot"))
+		Expect(proposal.Status.Phase).To(Equal(operationsv1alpha1.SeedRebalanceProposalExecuting))
+	})
+
+	It("should start and complete the migration of an approved proposal", func() {
+		proposal.Spec.Approved = true
+		Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+		Expect(fakeClient.Create(ctx, proposal)).To(Succeed())
+
+		Expect(reconcileProposal()).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+		Expect(bindings).To(ConsistOf("garden-dev/shoot"))
+		Expect(proposal.Status.Phase).To(Equal(operationsv1alpha1.SeedRebalanceProposalExecuting))
+		Expect(proposal.Status.Migrations).To(ConsistOf(HaveField("Phase", operationsv1alpha1.SeedRebalanceMigrationMigrating)))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+		Expect(shoot.Spec.SeedName).To(Equal(new("seed-b")))
+
+		By("Finish migration")
+		shoot.Status.SeedName = new("seed-b")
+		shoot.Status.LastOperation = &gardencorev1beta1.LastOperation{Type: gardencorev1beta1.LastOperationTypeReconcile, State: gardencorev1beta1.LastOperationStateSucceeded}
+		Expect(fakeClient.Update(ctx, shoot)).To(Succeed())
+
+		Expect(reconcileProposal()).To(Equal(reconcile.Result{}))
+		Expect(proposal.Status.Phase).To(Equal(operationsv1alpha1.SeedRebalanceProposalCompleted))
+		Expect(proposal.Status.Migrations).To(ConsistOf(HaveField("Phase", operationsv1alpha1.SeedRebalanceMigrationSucceeded)))
+	})
+
+	It("should mark the migration as failed if the last operation of the shoot failed", func() {
+		proposal.Spec.Approved = true
+		Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+		Expect(fakeClient.Create(ctx, proposal)).To(Succeed())
+		reconcileProposal()
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+		shoot.Status.LastOperation = &gardencorev1beta1.LastOperation{Type: gardencorev1beta1.LastOperationTypeMigrate, State: gardencorev1beta1.LastOperationStateFailed}
+		Expect(fakeClient.Update(ctx, shoot)).To(Succeed())
+
+		Expect(reconcileProposal()).To(Equal(reconcile.Result{}))
+		Expect(proposal.Status.Phase).To(Equal(operationsv1alpha1.SeedRebalanceProposalCompleted))
+		Expect(proposal.Status.Migrations).To(ConsistOf(HaveField("Phase", operationsv1alpha1.SeedRebalanceMigrationFailed)))
+	})
+
+	It("should skip the migration if the shoot is not hosted by the source seed anymore", func() {
+		proposal.Spec.Approved = true
+		shoot.Spec.SeedName = new("seed-c")
+		Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+		Expect(fakeClient.Create(ctx, proposal)).To(Succeed())
+
+		Expect(reconcileProposal()).To(Equal(reconcile.Result{}))
+		Expect(bindings).To(BeEmpty())
+		Expect(proposal.Status.Phase).To(Equal(operationsv1alpha1.SeedRebalanceProposalCompleted))
+		Expect(proposal.Status.Migrations).To(ConsistOf(HaveField("Phase", operationsv1alpha1.SeedRebalanceMigrationSkipped)))
+	})
+
+	It("should skip the migration if the shoot does not exist anymore", func() {
+		proposal.Spec.Approved = true
+		Expect(fakeClient.Create(ctx, proposal)).To(Succeed())
+
+		Expect(reconcileProposal()).To(Equal(reconcile.Result{}))
+		Expect(proposal.Status.Migrations).To(ConsistOf(HaveField("Phase", operationsv1alpha1.SeedRebalanceMigrationSkipped)))
+	})
+
+	It("should respect the maximum number of concurrent migrations", func() {
+		proposal.Spec.Approved = true
+		Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+		Expect(fakeClient.Create(ctx, proposal)).To(Succeed())
+		otherProposal := &operationsv1alpha1.SeedRebalanceProposal{
+			ObjectMeta: metav1.ObjectMeta{Name: "rebalance-bar"},
+			Spec: operationsv1alpha1.SeedRebalanceProposalSpec{Migrations: []operationsv1alpha1.SeedRebalanceMigration{
+				{ShootNamespace: "garden-dev", ShootName: "other", SourceSeedName: "seed-a", TargetSeedName: "seed-b"},
+			}},
+		}
+		Expect(fakeClient.Create(ctx, otherProposal)).To(Succeed())
+		otherProposal.Status = operationsv1alpha1.SeedRebalanceProposalStatus{
+			Phase: operationsv1alpha1.SeedRebalanceProposalExecuting,
+			Migrations: []operationsv1alpha1.SeedRebalanceMigrationStatus{
+				{ShootNamespace: "garden-dev", ShootName: "other", Phase: operationsv1alpha1.SeedRebalanceMigrationMigrating},
+			},
+		}
+		Expect(fakeClient.Status().Update(ctx, otherProposal)).To(Succeed())
+
+		Expect(reconcileProposal()).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+		Expect(bindings).To(BeEmpty())
+		Expect(proposal.Status.Migrations).To(ConsistOf(HaveField("Phase", operationsv1alpha1.SeedRebalanceMigrationPending)))
+	})
+
+	Context("auto-execution", func() {
+		BeforeEach(func() {
+			reconciler.Config.AutoExecute = new(true)
+			Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+			Expect(fakeClient.Create(ctx, proposal)).To(Succeed())
+		})
+
+		It("should not start the migration outside of the maintenance time window of the shoot", func() {
+			Expect(reconcileProposal()).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+			Expect(bindings).To(BeEmpty())
+		})
+
+		It("should start the migration within the maintenance time window of the shoot", func() {
+			fakeClock.SetTime(time.Date(2025, 1, 1, 22, 10, 0, 0, time.UTC))
+
+			Expect(reconcileProposal()).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+			Expect(bindings).To(ConsistOf("garden-dev/shoot"))
+			Expect(proposal.Status.Migrations).To(ConsistOf(HaveField("Phase", operationsv1alpha1.SeedRebalanceMigrationMigrating)))
+		})
+
+		It("should not expire a proposal whose migrations were started", func() {
+			fakeClock.SetTime(time.Date(2025, 1, 1, 22, 10, 0, 0, time.UTC))
+			Expect(reconcileProposal()).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+
+			fakeClock.Step(24 * time.Hour)
+
+			Expect(reconcileProposal()).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+			Expect(proposal.Status.Phase).To(Equal(operationsv1alpha1.SeedRebalanceProposalExecuting))
+			Expect(proposal.Status.Migrations).To(ConsistOf(HaveField("Phase", operationsv1alpha1.SeedRebalanceMigrationMigrating)))
+		})
+	})
+})
@@ -17,6 +17,7 @@ import (
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/quota"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/reference"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/retry"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/softdeletion"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/statuslabel"
 )
 
@@ -52,6 +53,15 @@ func AddToManager(mgr manager.Manager, cfg controllermanagerconfigv1alpha1.Contr
 		return fmt.Errorf("failed adding migration reconciler: %w", err)
 	}
 
+	if cfg.Controllers.ShootMigration.Evacuation != nil {
+		if err := (&migration.EvacuationReconciler{
+			Config:          *cfg.Controllers.ShootMigration.Evacuation,
+			SchedulerConfig: cfg.ShootScheduler,
+		}).AddToManager(mgr); err != nil {
+			return fmt.Errorf("failed adding migration evacuation reconciler: %w", err)
+		}
+	}
+
 	if err := reference.AddToManager(mgr, *cfg.Controllers.ShootReference); err != nil {
 		return fmt.Errorf("failed adding reference reconciler: %w", err)
 	}
@@ -62,6 +72,12 @@ func AddToManager(mgr manager.Manager, cfg controllermanagerconfigv1alpha1.Contr
 		return fmt.Errorf("failed adding retry reconciler: %w", err)
 	}
 
+	if err := (&softdeletion.Reconciler{
+		Config: *cfg.Controllers.ShootSoftDeletion,
+	}).AddToManager(mgr); err != nil {
+		return fmt.Errorf("failed adding soft deletion reconciler: %w", err)
+	}
+
 	if err := (&statuslabel.Reconciler{
 		Config: *cfg.Controllers.ShootStatusLabel,
 	}).AddToManager(mgr); err != nil {
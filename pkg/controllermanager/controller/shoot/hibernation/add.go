@@ -15,6 +15,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/gardener/gardener/pkg/controllerutils"
 )
@@ -53,7 +54,7 @@ func (r *Reconciler) ShootPredicate() predicate.Predicate {
 			if !ok {
 				return false
 			}
-			return len(getShootHibernationSchedules(shoot.Spec.Hibernation)) > 0
+			return len(getShootHibernationSchedules(shoot.Spec.Hibernation)) > 0 || getShootHibernationInactivity(shoot.Spec.Hibernation) != nil
 		},
 		UpdateFunc: func(e event.UpdateEvent) bool {
 			shoot, ok := e.ObjectNew.(*gardencorev1beta1.Shoot)
@@ -71,7 +72,18 @@ func (r *Reconciler) ShootPredicate() predicate.Predicate {
 				newSchedules = getShootHibernationSchedules(shoot.Spec.Hibernation)
 			)
 
-			return !reflect.DeepEqual(oldSchedules, newSchedules) && len(newSchedules) > 0
+			if !reflect.DeepEqual(oldSchedules, newSchedules) && len(newSchedules) > 0 {
+				return true
+			}
+
+			var (
+				oldInactivity = getShootHibernationInactivity(oldShoot.Spec.Hibernation)
+				newInactivity = getShootHibernationInactivity(shoot.Spec.Hibernation)
+			)
+
+			// Shoots which are woken up must be requeued to be hibernated again after their inactivity timeout.
+			return newInactivity != nil && (!reflect.DeepEqual(oldInactivity, newInactivity) ||
+				v1beta1helper.HibernationIsEnabled(oldShoot) != v1beta1helper.HibernationIsEnabled(shoot))
 		},
 	}
 }
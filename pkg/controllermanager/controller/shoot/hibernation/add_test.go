@@ -5,6 +5,8 @@
 package hibernation_test
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -58,6 +60,11 @@ var _ = Describe("Add", func() {
 			It("should return true because shoot has hibernation schedules", func() {
 				Expect(p.Create(event.CreateEvent{Object: shoot})).To(BeTrue())
 			})
+
+			It("should return true because shoot has an inactivity timeout", func() {
+				shoot.Spec.Hibernation = &gardencorev1beta1.Hibernation{Inactivity: &gardencorev1beta1.HibernationInactivity{Timeout: metav1.Duration{Duration: 8 * time.Hour}}}
+				Expect(p.Create(event.CreateEvent{Object: shoot})).To(BeTrue())
+			})
 		})
 
 		Describe("#Update", func() {
@@ -84,6 +91,31 @@ var _ = Describe("Add", func() {
 				shoot.Spec.Hibernation.Schedules[0].Start = new("00 20 * * 1,2,3,4,5,6,7")
 				Expect(p.Update(event.UpdateEvent{ObjectNew: shoot, ObjectOld: oldShoot})).To(BeTrue())
 			})
+
+			Context("inactivity", func() {
+				BeforeEach(func() {
+					shoot.Spec.Hibernation.Inactivity = &gardencorev1beta1.HibernationInactivity{Timeout: metav1.Duration{Duration: 8 * time.Hour}}
+				})
+
+				It("should return true because the inactivity timeout was changed", func() {
+					oldShoot := shoot.DeepCopy()
+					shoot.Spec.Hibernation.Inactivity.Timeout.Duration = 4 * time.Hour
+					Expect(p.Update(event.UpdateEvent{ObjectNew: shoot, ObjectOld: oldShoot})).To(BeTrue())
+				})
+
+				It("should return true because the shoot was woken up", func() {
+					oldShoot := shoot.DeepCopy()
+					oldShoot.Spec.Hibernation.Enabled = new(true)
+					shoot.Spec.Hibernation.Enabled = new(false)
+					Expect(p.Update(event.UpdateEvent{ObjectNew: shoot, ObjectOld: oldShoot})).To(BeTrue())
+				})
+
+				It("should return false because neither the inactivity timeout nor the hibernation was changed", func() {
+					oldShoot := shoot.DeepCopy()
+					shoot.Status.LastActivityTime = &metav1.Time{Time: time.Now()}
+					Expect(p.Update(event.UpdateEvent{ObjectNew: shoot, ObjectOld: oldShoot})).To(BeFalse())
+				})
+			})
 		})
 	})
 })
@@ -0,0 +1,216 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package hibernation
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/robfig/cron"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+// maxCalendarEventDays is the maximum number of days an event of an iCalendar file may cover. Longer events are capped
+// to protect against accidentally huge calendars.
+const maxCalendarEventDays = 366
+
+// calendarSchedule is a cron schedule which skips all activation times on calendar days. The calendar days are formatted
+// as `YYYY-MM-DD` and evaluated in the location of the activation times.
+type calendarSchedule struct {
+	schedule cron.Schedule
+	days     sets.Set[string]
+}
+
+// Next returns the next activation time of the schedule after the given time which is not on a calendar day.
+func (s calendarSchedule) Next(t time.Time) time.Time {
+	for next := s.schedule.Next(t); !next.IsZero(); next = s.schedule.Next(next) {
+		if !s.days.Has(next.Format(time.DateOnly)) {
+			return next
+		}
+	}
+	return time.Time{}
+}
+
+// hibernationCalendarDays returns the days of all hibernation calendars of the project the given Shoot belongs to.
+// Calendars whose referenced resources do not exist or cannot be parsed are skipped, so that a misconfigured calendar
+// does not prevent the hibernation schedules from being executed.
+func (r *Reconciler) hibernationCalendarDays(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot) (sets.Set[string], error) {
+	project, err := gardenerutils.ProjectForNamespaceFromReader(ctx, r.Client, shoot.Namespace)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed reading project for namespace %s: %w", shoot.Namespace, err)
+	}
+
+	days := sets.New[string]()
+	for _, calendar := range project.Spec.HibernationCalendars {
+		days.Insert(calendar.Dates...)
+
+		if calendar.ResourceRef == nil {
+			continue
+		}
+
+		data, err := r.readICalendar(ctx, shoot.Namespace, calendar)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				log.Info("Referenced resource of hibernation calendar not found, skipping it", "calendar", calendar.Name, "resourceRef", calendar.ResourceRef)
+				continue
+			}
+			return nil, fmt.Errorf("failed reading hibernation calendar %q: %w", calendar.Name, err)
+		}
+
+		icalDays, err := parseICalendarDays(data)
+		if err != nil {
+			log.Error(err, "Invalid iCalendar file in hibernation calendar, skipping it", "calendar", calendar.Name, "resourceRef", calendar.ResourceRef)
+			continue
+		}
+		days.Insert(icalDays...)
+	}
+
+	return days, nil
+}
+
+func (r *Reconciler) readICalendar(ctx context.Context, namespace string, calendar gardencorev1beta1.HibernationCalendar) (string, error) {
+	key := client.ObjectKey{Namespace: namespace, Name: calendar.ResourceRef.Name}
+
+	switch calendar.ResourceRef.Kind {
+	case "ConfigMap":
+		configMap := &corev1.ConfigMap{}
+		if err := r.Client.Get(ctx, key, configMap); err != nil {
+			return "", err
+		}
+		return configMap.Data[v1beta1constants.DataKeyHibernationCalendar], nil
+	case "Secret":
+		secret := &corev1.Secret{}
+		if err := r.Client.Get(ctx, key, secret); err != nil {
+			return "", err
+		}
+		return string(secret.Data[v1beta1constants.DataKeyHibernationCalendar]), nil
+	default:
+		return "", fmt.Errorf("unsupported kind %q", calendar.ResourceRef.Kind)
+	}
+}
+
+// parseICalendarDays returns the days covered by the events of the given iCalendar file (RFC 5545), formatted as
+// `YYYY-MM-DD`. The date of an event is taken as it is written, i.e., time zones of date-time values are not converted.
+// Recurrence rules are not supported, hence only the first occurrence of recurring events is considered.
+func parseICalendarDays(data string) ([]string, error) {
+	var (
+		scanner      = bufio.NewScanner(strings.NewReader(data))
+		contentLines []string
+
+		days       []string
+		inEvent    bool
+		start, end string
+	)
+
+	// Long content lines are folded into multiple lines, each continuation line starts with a whitespace.
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if len(contentLines) > 0 && (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) {
+			contentLines[len(contentLines)-1] += line[1:]
+			continue
+		}
+		contentLines = append(contentLines, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, line := range contentLines {
+		name, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		// Strip parameters like `;VALUE=DATE` or `;TZID=Europe/Berlin` from the property name.
+		name, _, _ = strings.Cut(name, ";")
+
+		switch strings.ToUpper(name) {
+		case "BEGIN":
+			if strings.EqualFold(value, "VEVENT") {
+				inEvent, start, end = true, "", ""
+			}
+		case "DTSTART":
+			if inEvent {
+				start = value
+			}
+		case "DTEND":
+			if inEvent {
+				end = value
+			}
+		case "END":
+			if !strings.EqualFold(value, "VEVENT") || !inEvent {
+				continue
+			}
+			inEvent = false
+
+			eventDays, err := iCalendarEventDays(start, end)
+			if err != nil {
+				return nil, err
+			}
+			days = append(days, eventDays...)
+		}
+	}
+
+	return days, nil
+}
+
+// iCalendarEventDays returns the days covered by an event with the given DTSTART and DTEND values. The end of an event
+// is exclusive, hence an event ending at midnight does not cover the day of its end.
+func iCalendarEventDays(start, end string) ([]string, error) {
+	if start == "" {
+		return nil, fmt.Errorf("event without DTSTART")
+	}
+
+	startDay, _, err := parseICalendarDate(start)
+	if err != nil {
+		return nil, err
+	}
+
+	lastDay := startDay
+	if end != "" {
+		endDay, endsAtMidnight, err := parseICalendarDate(end)
+		if err != nil {
+			return nil, err
+		}
+
+		lastDay = endDay
+		if endsAtMidnight && endDay.After(startDay) {
+			lastDay = endDay.AddDate(0, 0, -1)
+		}
+	}
+
+	var days []string
+	for day := startDay; !day.After(lastDay) && len(days) < maxCalendarEventDays; day = day.AddDate(0, 0, 1) {
+		days = append(days, day.Format(time.DateOnly))
+	}
+	return days, nil
+}
+
+// parseICalendarDate parses the date part of a DATE (`YYYYMMDD`) or DATE-TIME (`YYYYMMDDTHHMMSS[Z]`) value. It also
+// returns whether the value denotes the beginning of the day.
+func parseICalendarDate(value string) (time.Time, bool, error) {
+	date, timeOfDay, _ := strings.Cut(value, "T")
+
+	day, err := time.Parse("20060102", date)
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("invalid date %q: %w", value, err)
+	}
+
+	timeOfDay = strings.TrimSuffix(timeOfDay, "Z")
+	return day, timeOfDay == "" || timeOfDay == "000000", nil
+}
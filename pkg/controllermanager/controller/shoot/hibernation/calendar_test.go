@@ -0,0 +1,218 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package hibernation
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/robfig/cron"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener/pkg/api/indexer"
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+)
+
+var _ = Describe("Hibernation Calendars", func() {
+	Describe("calendarSchedule", func() {
+		Describe("#Next", func() {
+			var schedule cron.Schedule
+
+			BeforeEach(func() {
+				var err error
+				schedule, err = cron.ParseStandard("00 07 * * *")
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should skip activation times on calendar days", func() {
+				s := calendarSchedule{schedule: schedule, days: sets.New("2025-12-24", "2025-12-25")}
+
+				Expect(s.Next(time.Date(2025, 12, 23, 12, 0, 0, 0, time.UTC))).To(Equal(time.Date(2025, 12, 26, 7, 0, 0, 0, time.UTC)))
+			})
+
+			It("should evaluate the calendar days in the location of the given time", func() {
+				location, err := time.LoadLocation("America/Los_Angeles")
+				Expect(err).NotTo(HaveOccurred())
+				eveningSchedule, err := cron.ParseStandard("00 20 * * *")
+				Expect(err).NotTo(HaveOccurred())
+				s := calendarSchedule{schedule: eveningSchedule, days: sets.New("2025-12-24")}
+
+				// 2025-12-23T20:00:00 in Los Angeles is already 2025-12-24 in UTC.
+				Expect(s.Next(time.Date(2025, 12, 23, 12, 0, 0, 0, location))).To(Equal(time.Date(2025, 12, 23, 20, 0, 0, 0, location)))
+				Expect(s.Next(time.Date(2025, 12, 23, 21, 0, 0, 0, location))).To(Equal(time.Date(2025, 12, 25, 20, 0, 0, 0, location)))
+			})
+
+			It("should not skip activation times without calendar days", func() {
+				s := calendarSchedule{schedule: schedule, days: sets.New[string]()}
+
+				Expect(s.Next(time.Date(2025, 12, 23, 12, 0, 0, 0, time.UTC))).To(Equal(time.Date(2025, 12, 24, 7, 0, 0, 0, time.UTC)))
+			})
+		})
+	})
+
+	Describe("#parseICalendarDays", func() {
+		It("should return the days of all-day events", func() {
+			Expect(parseICalendarDays(`BEGIN:VCALENDAR
+VERSION:2.0
+BEGIN:VEVENT
+SUMMARY:Christmas Eve
+DTSTART;VALUE=DATE:20251224
+DTEND;VALUE=DATE:20251225
+END:VEVENT
+BEGIN:VEVENT
+SUMMARY:Company Shutdown
+DTSTART;VALUE=DATE:20251229
+DTEND;VALUE=DATE:20260102
+END:VEVENT
+END:VCALENDAR
+`)).To(ConsistOf("2025-12-24", "2025-12-29", "2025-12-30", "2025-12-31", "2026-01-01"))
+		})
+
+		It("should return the days of events with date-times", func() {
+			Expect(parseICalendarDays("BEGIN:VCALENDAR\r\n" +
+				"BEGIN:VEVENT\r\n" +
+				"DTSTART;TZID=Europe/Berlin:20251231T120000\r\n" +
+				"DTEND;TZID=Europe/Berlin:20260101T120000\r\n" +
+				"END:VEVENT\r\n" +
+				"BEGIN:VEVENT\r\n" +
+				"DTSTART:20260106T000000Z\r\n" +
+				"DTEND:20260107T000000Z\r\n" +
+				"END:VEVENT\r\n" +
+				"BEGIN:VEVENT\r\n" +
+				"DTSTART;VALUE=DATE:20260501\r\n" +
+				"END:VEVENT\r\n" +
+				"END:VCALENDAR\r\n",
+			)).To(ConsistOf("2025-12-31", "2026-01-01", "2026-01-06", "2026-05-01"))
+		})
+
+		It("should unfold long content lines", func() {
+			Expect(parseICalendarDays(`BEGIN:VCALENDAR
+BEGIN:VEVENT
+SUMMARY:A very long summary which is folded
+  into two lines
+DTSTART;VALUE=DATE:2025
+ 1224
+END:VEVENT
+END:VCALENDAR
+`)).To(ConsistOf("2025-12-24"))
+		})
+
+		It("should fail for invalid dates", func() {
+			_, err := parseICalendarDays(`BEGIN:VCALENDAR
+BEGIN:VEVENT
+DTSTART;VALUE=DATE:2025-12-24
+END:VEVENT
+END:VCALENDAR
+`)
+			Expect(err).To(MatchError(ContainSubstring(`invalid date "2025-12-24"`)))
+		})
+
+		It("should fail for events without start", func() {
+			_, err := parseICalendarDays(`BEGIN:VCALENDAR
+BEGIN:VEVENT
+DTEND;VALUE=DATE:20251224
+END:VEVENT
+END:VCALENDAR
+`)
+			Expect(err).To(MatchError("event without DTSTART"))
+		})
+	})
+
+	Describe("#hibernationCalendarDays", func() {
+		var (
+			ctx        = context.Background()
+			log        = logr.Discard()
+			fakeClient client.Client
+			reconciler *Reconciler
+
+			project *gardencorev1beta1.Project
+			shoot   *gardencorev1beta1.Shoot
+		)
+
+		BeforeEach(func() {
+			fakeClient = fakeclient.NewClientBuilder().
+				WithScheme(kubernetes.GardenScheme).
+				WithIndex(&gardencorev1beta1.Project{}, core.ProjectNamespace, indexer.ProjectNamespaceIndexerFunc).
+				Build()
+			reconciler = &Reconciler{Client: fakeClient}
+
+			project = &gardencorev1beta1.Project{
+				ObjectMeta: metav1.ObjectMeta{Name: "dev"},
+				Spec: gardencorev1beta1.ProjectSpec{
+					Namespace: new("garden-dev"),
+				},
+			}
+			shoot = &gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "garden-dev"},
+			}
+		})
+
+		It("should return no days if the project does not exist", func() {
+			Expect(reconciler.hibernationCalendarDays(ctx, log, shoot)).To(BeEmpty())
+		})
+
+		It("should return the days of all calendars", func() {
+			Expect(fakeClient.Create(ctx, &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "holidays", Namespace: "garden-dev"},
+				Data: map[string]string{"calendar.ics": `BEGIN:VCALENDAR
+BEGIN:VEVENT
+DTSTART;VALUE=DATE:20251225
+DTEND;VALUE=DATE:20251227
+END:VEVENT
+END:VCALENDAR
+`},
+			})).To(Succeed())
+			Expect(fakeClient.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "shutdown", Namespace: "garden-dev"},
+				Data: map[string][]byte{"calendar.ics": []byte(`BEGIN:VCALENDAR
+BEGIN:VEVENT
+DTSTART;VALUE=DATE:20251231
+END:VEVENT
+END:VCALENDAR
+`)},
+			})).To(Succeed())
+
+			project.Spec.HibernationCalendars = []gardencorev1beta1.HibernationCalendar{
+				{Name: "inline", Dates: []string{"2025-12-24"}},
+				{Name: "holidays", ResourceRef: &autoscalingv1.CrossVersionObjectReference{APIVersion: "v1", Kind: "ConfigMap", Name: "holidays"}},
+				{Name: "shutdown", Dates: []string{"2025-12-30"}, ResourceRef: &autoscalingv1.CrossVersionObjectReference{APIVersion: "v1", Kind: "Secret", Name: "shutdown"}},
+			}
+			Expect(fakeClient.Create(ctx, project)).To(Succeed())
+
+			Expect(reconciler.hibernationCalendarDays(ctx, log, shoot)).To(Equal(sets.New("2025-12-24", "2025-12-25", "2025-12-26", "2025-12-30", "2025-12-31")))
+		})
+
+		It("should skip calendars with missing resources or invalid iCalendar files", func() {
+			Expect(fakeClient.Create(ctx, &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "invalid", Namespace: "garden-dev"},
+				Data: map[string]string{"calendar.ics": `BEGIN:VCALENDAR
+BEGIN:VEVENT
+DTSTART;VALUE=DATE:tomorrow
+END:VEVENT
+END:VCALENDAR
+`},
+			})).To(Succeed())
+
+			project.Spec.HibernationCalendars = []gardencorev1beta1.HibernationCalendar{
+				{Name: "inline", Dates: []string{"2025-12-24"}},
+				{Name: "missing", ResourceRef: &autoscalingv1.CrossVersionObjectReference{APIVersion: "v1", Kind: "ConfigMap", Name: "missing"}},
+				{Name: "invalid", ResourceRef: &autoscalingv1.CrossVersionObjectReference{APIVersion: "v1", Kind: "ConfigMap", Name: "invalid"}},
+			}
+			Expect(fakeClient.Create(ctx, project)).To(Succeed())
+
+			Expect(reconciler.hibernationCalendarDays(ctx, log, shoot)).To(Equal(sets.New("2025-12-24")))
+		})
+	})
+})
@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package hibernation
+
+import (
+	"context"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+// inactivityRetryPeriod is the period after which the inactivity of a shoot is checked again if it could not be
+// hibernated although its inactivity timeout has passed, e.g., because it is failed.
+const inactivityRetryPeriod = 10 * time.Minute
+
+// inactivityDeadline returns the time at which the shoot is hibernated if no further activity is observed. The
+// activity is measured from the last activity reported by the gardenlet, but not before the creation of the shoot.
+func inactivityDeadline(shoot *gardencorev1beta1.Shoot, inactivity *gardencorev1beta1.HibernationInactivity) time.Time {
+	lastActivityTime := shoot.CreationTimestamp.Time
+	if shoot.Status.LastActivityTime != nil && shoot.Status.LastActivityTime.After(lastActivityTime) {
+		lastActivityTime = shoot.Status.LastActivityTime.Time
+	}
+	return lastActivityTime.Add(inactivity.Timeout.Duration)
+}
+
+// nextInactivityCheckDuration returns the duration after which the inactivity of the shoot must be checked again.
+func nextInactivityCheckDuration(shoot *gardencorev1beta1.Shoot, inactivity *gardencorev1beta1.HibernationInactivity, now time.Time) time.Duration {
+	if duration := inactivityDeadline(shoot, inactivity).Sub(now); duration > 0 {
+		return duration
+	}
+	return inactivityRetryPeriod
+}
+
+func (r *Reconciler) hibernateShootDueToInactivity(ctx context.Context, shoot *gardencorev1beta1.Shoot, now time.Time) error {
+	patch := client.MergeFrom(shoot.DeepCopy())
+	shoot.Spec.Hibernation.Enabled = new(true)
+	r.Recorder.Eventf(shoot, nil, corev1.EventTypeNormal, gardencorev1beta1.ShootEventHibernationEnabled, gardencorev1beta1.EventActionReconcile, "Hibernating cluster due to inactivity")
+	if err := r.Client.Patch(ctx, shoot, patch); err != nil {
+		return err
+	}
+
+	patch = client.MergeFrom(shoot.DeepCopy())
+	shoot.Status.LastHibernationTriggerTime = &metav1.Time{Time: now}
+	return r.Client.Status().Patch(ctx, shoot, patch)
+}
+
+func getShootHibernationInactivity(hibernation *gardencorev1beta1.Hibernation) *gardencorev1beta1.HibernationInactivity {
+	if hibernation == nil {
+		return nil
+	}
+	return hibernation.Inactivity
+}
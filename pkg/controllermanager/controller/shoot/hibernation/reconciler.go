@@ -14,12 +14,14 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/events"
 	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
 	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/controllermanager/v1alpha1"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
@@ -66,7 +68,8 @@ func (s *parsedHibernationSchedule) previous(from, to time.Time) *time.Time {
 	return previousActivationTime
 }
 
-// Reconciler reconciles Shoots and hibernates or wakes them up according to their hibernation schedules.
+// Reconciler reconciles Shoots and hibernates or wakes them up according to their hibernation schedules. Shoots are
+// also hibernated after they have been inactive for the configured period of time.
 type Reconciler struct {
 	Client   client.Client
 	Config   controllermanagerconfigv1alpha1.ShootHibernationControllerConfiguration
@@ -92,13 +95,27 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{}, nil
 	}
 
-	schedules := getShootHibernationSchedules(shoot.Spec.Hibernation)
-	if len(schedules) == 0 {
-		log.Info("Hibernation schedules have been removed from shoot, stopping reconciliation")
+	var (
+		schedules  = getShootHibernationSchedules(shoot.Spec.Hibernation)
+		inactivity = getShootHibernationInactivity(shoot.Spec.Hibernation)
+	)
+
+	if len(schedules) == 0 && inactivity == nil {
+		log.Info("Hibernation schedules and inactivity timeout have been removed from shoot, stopping reconciliation")
 		return reconcile.Result{}, nil
 	}
 
-	parsedSchedules, err := parseHibernationSchedules(schedules)
+	// Calendar days are only relevant for wake-ups, hence the project is only read if the shoot is woken up by a schedule.
+	var calendarDays sets.Set[string]
+	if hasWakeUpSchedule(schedules) {
+		days, err := r.hibernationCalendarDays(ctx, log, shoot)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		calendarDays = days
+	}
+
+	parsedSchedules, err := parseHibernationSchedules(schedules, calendarDays)
 	if err != nil {
 		log.Error(err, "Invalid hibernation schedules, stopping reconciliation")
 		return reconcile.Result{}, nil
@@ -106,11 +123,18 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 
 	now := r.Clock.Now()
 	if gardenerutils.IsShootFailedAndUpToDate(shoot) {
-		requeueAfter := nextHibernationTimeDuration(parsedSchedules, now)
+		requeueAfter := nextRequeueDuration(shoot, parsedSchedules, inactivity, now)
 		log.Info("Shoot is in Failed state, requeuing shoot hibernation", "requeueAfter", requeueAfter)
 		return reconcile.Result{RequeueAfter: requeueAfter}, nil
 	}
 
+	// Shoots pending deletion must stay hibernated until they are either deleted or their deletion is reverted.
+	if v1beta1helper.IsShootPendingDeletion(shoot) {
+		requeueAfter := nextRequeueDuration(shoot, parsedSchedules, inactivity, now)
+		log.Info("Shoot is pending deletion, requeuing shoot hibernation", "requeueAfter", requeueAfter)
+		return reconcile.Result{RequeueAfter: requeueAfter}, nil
+	}
+
 	// Get the schedule which caused the current reconciliation and check whether the shoot should be hibernated or woken up.
 	// If no such schedule is found, the hibernation schedules were changed mid-air and the shoot must be
 	// hibernated or wakeup the at a later time.
@@ -122,11 +146,41 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		log.Info("Successfully set hibernation.enabled", "enabled", *shoot.Spec.Hibernation.Enabled)
 	}
 
-	requeueAfter := nextHibernationTimeDuration(parsedSchedules, now)
+	if inactivity != nil && !v1beta1helper.HibernationIsEnabled(shoot) && !now.Before(inactivityDeadline(shoot, inactivity)) {
+		if err := r.hibernateShootDueToInactivity(ctx, shoot, now); err != nil {
+			return reconcile.Result{}, err
+		}
+		log.Info("Successfully hibernated shoot due to inactivity", "inactivityTimeout", inactivity.Timeout.Duration)
+	}
+
+	requeueAfter := nextRequeueDuration(shoot, parsedSchedules, inactivity, now)
+	if requeueAfter == 0 {
+		log.Info("Shoot is hibernated and has no hibernation schedules, stopping reconciliation")
+		return reconcile.Result{}, nil
+	}
+
 	log.Info("Requeuing shoot hibernation", "requeueAfter", requeueAfter)
 	return reconcile.Result{RequeueAfter: requeueAfter}, nil
 }
 
+// nextRequeueDuration returns the duration after which to requeue the shoot based on its hibernation schedules and its
+// inactivity timeout. The inactivity is only relevant if the shoot is not hibernated. Zero is returned if the shoot does
+// not need to be requeued.
+func nextRequeueDuration(shoot *gardencorev1beta1.Shoot, schedules []parsedHibernationSchedule, inactivity *gardencorev1beta1.HibernationInactivity, now time.Time) time.Duration {
+	var requeueAfter time.Duration
+	if len(schedules) > 0 {
+		requeueAfter = nextHibernationTimeDuration(schedules, now)
+	}
+
+	if inactivity != nil && !v1beta1helper.HibernationIsEnabled(shoot) {
+		if inactivityCheck := nextInactivityCheckDuration(shoot, inactivity, now); requeueAfter == 0 || inactivityCheck < requeueAfter {
+			requeueAfter = inactivityCheck
+		}
+	}
+
+	return requeueAfter
+}
+
 func (r *Reconciler) hibernateOrWakeUpShootBasedOnSchedule(ctx context.Context, shoot *gardencorev1beta1.Shoot, schedule *parsedHibernationSchedule, now time.Time) error {
 	patch := client.MergeFrom(shoot.DeepCopy())
 	switch schedule.operation {
@@ -148,7 +202,9 @@ func (r *Reconciler) hibernateOrWakeUpShootBasedOnSchedule(ctx context.Context,
 
 // parseHibernationSchedules parses the given HibernationSchedules and returns an array of ParsedHibernationSchedules
 // If the Location of a HibernationSchedule is `nil`, it is defaulted to UTC.
-func parseHibernationSchedules(schedules []gardencorev1beta1.HibernationSchedule) ([]parsedHibernationSchedule, error) {
+// Wake-ups on the given calendar days (formatted as `YYYY-MM-DD` and evaluated in the location of the schedule) are
+// skipped, i.e., the shoot stays hibernated on these days.
+func parseHibernationSchedules(schedules []gardencorev1beta1.HibernationSchedule, calendarDays sets.Set[string]) ([]parsedHibernationSchedule, error) {
 	var parsedHibernationSchedules []parsedHibernationSchedule
 
 	for _, schedule := range schedules {
@@ -177,6 +233,9 @@ func parseHibernationSchedules(schedules []gardencorev1beta1.HibernationSchedule
 			if err != nil {
 				return nil, err
 			}
+			if calendarDays.Len() > 0 {
+				parsed = calendarSchedule{schedule: parsed, days: calendarDays}
+			}
 			parsedHibernationSchedules = append(parsedHibernationSchedules,
 				parsedHibernationSchedule{location: *location, schedule: parsed, operation: wakeUp},
 			)
@@ -247,6 +306,15 @@ func getScheduleWithMostRecentTime(schedules []parsedHibernationSchedule, trigge
 	return scheduleWithMostRecentTime
 }
 
+func hasWakeUpSchedule(schedules []gardencorev1beta1.HibernationSchedule) bool {
+	for _, schedule := range schedules {
+		if schedule.End != nil {
+			return true
+		}
+	}
+	return false
+}
+
 func getShootHibernationSchedules(hibernation *gardencorev1beta1.Hibernation) []gardencorev1beta1.HibernationSchedule {
 	if hibernation == nil {
 		return nil
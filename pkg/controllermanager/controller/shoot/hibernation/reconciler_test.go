@@ -20,8 +20,11 @@ import (
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	"github.com/gardener/gardener/pkg/api/indexer"
 	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/controllermanager/v1alpha1"
+	"github.com/gardener/gardener/pkg/apis/core"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 )
 
@@ -30,6 +33,7 @@ var _ = Describe("Shoot Hibernation", func() {
 		timeNow                 func() time.Time
 		triggerDeadlineDuration time.Duration
 		shootSettings           func(shoot *gardencorev1beta1.Shoot)
+		hibernationCalendars    []gardencorev1beta1.HibernationCalendar
 
 		triggerHibernationOrWakeup  bool
 		expectedRequeueDurationFunc func(now time.Time) time.Duration
@@ -156,7 +160,11 @@ var _ = Describe("Shoot Hibernation", func() {
 					Status: gardencorev1beta1.ShootStatus{},
 				}
 
-				c = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithStatusSubresource(&gardencorev1beta1.Shoot{}).Build()
+				c = fakeclient.NewClientBuilder().
+					WithScheme(kubernetes.GardenScheme).
+					WithStatusSubresource(&gardencorev1beta1.Shoot{}).
+					WithIndex(&gardencorev1beta1.Project{}, core.ProjectNamespace, indexer.ProjectNamespaceIndexerFunc).
+					Build()
 			})
 
 			DescribeTable("should properly enable or disable hibernation and requeue the shoot", func(t testEntry) {
@@ -172,6 +180,15 @@ var _ = Describe("Shoot Hibernation", func() {
 					t.shootSettings(shoot)
 				}
 
+				By("Create project")
+				Expect(c.Create(ctx, &gardencorev1beta1.Project{
+					ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+					Spec: gardencorev1beta1.ProjectSpec{
+						Namespace:            &shoot.Namespace,
+						HibernationCalendars: t.hibernationCalendars,
+					},
+				})).To(Succeed())
+
 				By("Create shoot")
 				Expect(c.Create(ctx, shoot)).To(Succeed())
 
@@ -415,6 +432,115 @@ var _ = Describe("Shoot Hibernation", func() {
 					triggerDeadlineDuration:     shortDeadline,
 					expectedRequeueDurationFunc: requeueAfterBasedOnSchedule(everyDayAt2, "UTC"),
 				}),
+				Entry("when shoot is pending deletion", testEntry{
+					timeNow: timeWithOffset(weekDayAt7, 1*time.Second),
+					shootSettings: func(shoot *gardencorev1beta1.Shoot) {
+						shoot.CreationTimestamp = metav1.Time{Time: timeWithOffset(weekDayAt7, -24*time.Hour)()}
+						shoot.Annotations = map[string]string{v1beta1constants.ShootPendingDeletionUntil: "2022-04-15T07:00:00Z"}
+						shoot.Spec.Hibernation.Schedules = []gardencorev1beta1.HibernationSchedule{{
+							Start: &everyDayAt7,
+							End:   &everyDayAt2,
+						}}
+					},
+					triggerDeadlineDuration:     longDeadline,
+					expectedRequeueDurationFunc: requeueAfterBasedOnSchedule(everyDayAt2, "UTC"),
+				}),
+				Entry("when shoot is reconciled at wake-up time", testEntry{
+					timeNow: timeWithOffset(weekDayAt7, 1*time.Second),
+					shootSettings: func(shoot *gardencorev1beta1.Shoot) {
+						shoot.CreationTimestamp = metav1.Time{Time: timeWithOffset(weekDayAt7, -24*time.Hour)()}
+						shoot.Spec.Hibernation.Schedules = []gardencorev1beta1.HibernationSchedule{{
+							Start: &everyWeekDayAt19,
+							End:   &everyDayAt7,
+						}}
+						shoot.Status.LastHibernationTriggerTime = &metav1.Time{Time: timeWithOffset(weekDayAt19, -24*time.Hour)()}
+					},
+					triggerDeadlineDuration:     noDeadLine,
+					triggerHibernationOrWakeup:  true,
+					expectedHibernationEnabled:  false,
+					expectedRequeueDurationFunc: requeueAfterBasedOnSchedule(everyWeekDayAt19, "UTC"),
+				}),
+				Entry("when shoot is reconciled at wake-up time on a calendar day", testEntry{
+					timeNow: timeWithOffset(weekDayAt7, 1*time.Second),
+					shootSettings: func(shoot *gardencorev1beta1.Shoot) {
+						shoot.CreationTimestamp = metav1.Time{Time: timeWithOffset(weekDayAt7, -24*time.Hour)()}
+						shoot.Spec.Hibernation.Schedules = []gardencorev1beta1.HibernationSchedule{{
+							Start: &everyWeekDayAt19,
+							End:   &everyDayAt7,
+						}}
+						shoot.Status.LastHibernationTriggerTime = &metav1.Time{Time: timeWithOffset(weekDayAt19, -24*time.Hour)()}
+					},
+					hibernationCalendars: []gardencorev1beta1.HibernationCalendar{{
+						Name:  "holidays",
+						Dates: []string{"2022-04-12"},
+					}},
+					triggerDeadlineDuration:     noDeadLine,
+					expectedRequeueDurationFunc: requeueAfterBasedOnSchedule(everyWeekDayAt19, "UTC"),
+				}),
+				Entry("when the next wake-up is on a calendar day", testEntry{
+					timeNow: timeWithOffset(weekDayAt19, 0),
+					shootSettings: func(shoot *gardencorev1beta1.Shoot) {
+						shoot.CreationTimestamp = metav1.Time{Time: timeWithOffset(weekDayAt19, -time.Hour)()}
+						shoot.Spec.Hibernation.Schedules = []gardencorev1beta1.HibernationSchedule{{End: &everyDayAt7}}
+					},
+					hibernationCalendars: []gardencorev1beta1.HibernationCalendar{{
+						Name:  "holidays",
+						Dates: []string{"2022-04-13"},
+					}},
+					triggerDeadlineDuration:     noDeadLine,
+					expectedRequeueDurationFunc: func(time.Time) time.Duration { return 36*time.Hour + nextScheduleDelta },
+				}),
+				Entry("when shoot has been inactive for longer than the inactivity timeout", testEntry{
+					timeNow: timeWithOffset(weekDayAt19, 0),
+					shootSettings: func(shoot *gardencorev1beta1.Shoot) {
+						shoot.CreationTimestamp = metav1.Time{Time: timeWithOffset(weekDayAt19, -24*time.Hour)()}
+						shoot.Spec.Hibernation.Inactivity = &gardencorev1beta1.HibernationInactivity{Timeout: metav1.Duration{Duration: 8 * time.Hour}}
+						shoot.Status.LastActivityTime = &metav1.Time{Time: timeWithOffset(weekDayAt19, -8*time.Hour)()}
+					},
+					triggerDeadlineDuration:    noDeadLine,
+					triggerHibernationOrWakeup: true,
+					expectedHibernationEnabled: true,
+				}),
+				Entry("when shoot has been inactive for shorter than the inactivity timeout", testEntry{
+					timeNow: timeWithOffset(weekDayAt19, 0),
+					shootSettings: func(shoot *gardencorev1beta1.Shoot) {
+						shoot.CreationTimestamp = metav1.Time{Time: timeWithOffset(weekDayAt19, -24*time.Hour)()}
+						shoot.Spec.Hibernation.Inactivity = &gardencorev1beta1.HibernationInactivity{Timeout: metav1.Duration{Duration: 8 * time.Hour}}
+						shoot.Status.LastActivityTime = &metav1.Time{Time: timeWithOffset(weekDayAt19, -2*time.Hour)()}
+					},
+					triggerDeadlineDuration:     noDeadLine,
+					expectedRequeueDurationFunc: func(time.Time) time.Duration { return 6 * time.Hour },
+				}),
+				Entry("when shoot has been created recently and the inactivity timeout has not passed since then", testEntry{
+					timeNow: timeWithOffset(weekDayAt19, 0),
+					shootSettings: func(shoot *gardencorev1beta1.Shoot) {
+						shoot.CreationTimestamp = metav1.Time{Time: timeWithOffset(weekDayAt19, -time.Hour)()}
+						shoot.Spec.Hibernation.Inactivity = &gardencorev1beta1.HibernationInactivity{Timeout: metav1.Duration{Duration: 8 * time.Hour}}
+					},
+					triggerDeadlineDuration:     noDeadLine,
+					expectedRequeueDurationFunc: func(time.Time) time.Duration { return 7 * time.Hour },
+				}),
+				Entry("when the next hibernation schedule is earlier than the inactivity timeout", testEntry{
+					timeNow: timeWithOffset(weekDayAt19, -time.Hour),
+					shootSettings: func(shoot *gardencorev1beta1.Shoot) {
+						shoot.CreationTimestamp = metav1.Time{Time: timeWithOffset(weekDayAt19, -time.Hour)()}
+						shoot.Spec.Hibernation.Schedules = []gardencorev1beta1.HibernationSchedule{{Start: &everyWeekDayAt19}}
+						shoot.Spec.Hibernation.Inactivity = &gardencorev1beta1.HibernationInactivity{Timeout: metav1.Duration{Duration: 8 * time.Hour}}
+					},
+					triggerDeadlineDuration:     noDeadLine,
+					expectedRequeueDurationFunc: requeueAfterBasedOnSchedule(everyWeekDayAt19, "UTC"),
+				}),
+				Entry("when shoot is in failed state and has been inactive for longer than the inactivity timeout", testEntry{
+					timeNow: timeWithOffset(weekDayAt19, 0),
+					shootSettings: func(shoot *gardencorev1beta1.Shoot) {
+						shoot.CreationTimestamp = metav1.Time{Time: timeWithOffset(weekDayAt19, -24*time.Hour)()}
+						shoot.Spec.Hibernation.Inactivity = &gardencorev1beta1.HibernationInactivity{Timeout: metav1.Duration{Duration: 8 * time.Hour}}
+						shoot.Status.LastOperation = &gardencorev1beta1.LastOperation{State: gardencorev1beta1.LastOperationStateFailed}
+						shoot.Status.Gardener.Version = version.Get().GitVersion
+					},
+					triggerDeadlineDuration:     noDeadLine,
+					expectedRequeueDurationFunc: func(time.Time) time.Duration { return inactivityRetryPeriod },
+				}),
 			)
 		})
 	})
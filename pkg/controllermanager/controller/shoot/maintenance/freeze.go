@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package maintenance
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+// activeMaintenanceFreeze returns the maintenance freeze which is active for the given Shoot at the given time, or nil
+// if there is none. The landscape-wide maintenance freezes of the controller configuration are considered before the
+// ones of the Shoot's project.
+func (r *Reconciler) activeMaintenanceFreeze(ctx context.Context, shoot *gardencorev1beta1.Shoot, t time.Time) (*gardencorev1beta1.MaintenanceFreeze, error) {
+	for _, freeze := range r.Config.MaintenanceFreezes {
+		if isMaintenanceFreezeActive(freeze.Begin.Time, freeze.End.Time, t) {
+			return &gardencorev1beta1.MaintenanceFreeze{Name: freeze.Name, Begin: freeze.Begin, End: freeze.End}, nil
+		}
+	}
+
+	project, err := r.projectForShoot(ctx, shoot)
+	if err != nil || project == nil {
+		return nil, err
+	}
+
+	for _, freeze := range project.Spec.MaintenanceFreezes {
+		if isMaintenanceFreezeActive(freeze.Begin.Time, freeze.End.Time, t) {
+			return &freeze, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// projectForShoot returns the project the given Shoot belongs to, or nil if it does not exist.
+func (r *Reconciler) projectForShoot(ctx context.Context, shoot *gardencorev1beta1.Shoot) (*gardencorev1beta1.Project, error) {
+	project, err := gardenerutils.ProjectForNamespaceFromReader(ctx, r.Client, shoot.Namespace)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed reading project for namespace %s: %w", shoot.Namespace, err)
+	}
+	return project, nil
+}
+
+func isMaintenanceFreezeActive(begin, end, t time.Time) bool {
+	return !t.Before(begin) && t.Before(end)
+}
+
+// skippedMaintenanceOperations returns descriptions of the maintenance operations which are skipped because of the
+// given maintenance freeze, i.e., the operations which are part of <unfrozen> but not of <frozen>.
+func skippedMaintenanceOperations(frozen, unfrozen *maintenanceResult, freeze *gardencorev1beta1.MaintenanceFreeze) []string {
+	var (
+		skipped []string
+		reason  = fmt.Sprintf("Reason: Maintenance freeze %q is active until %s", freeze.Name, freeze.End.UTC().Format(time.RFC3339))
+	)
+
+	if unfrozen.kubernetesControlPlaneUpdate != nil && frozen.kubernetesControlPlaneUpdate == nil {
+		skipped = append(skipped, "Control Plane: Kubernetes version update skipped. "+reason)
+	}
+	for _, worker := range slices.Sorted(maps.Keys(unfrozen.workerToKubernetesUpdate)) {
+		if _, ok := frozen.workerToKubernetesUpdate[worker]; !ok {
+			skipped = append(skipped, fmt.Sprintf("Worker pool %q: Kubernetes version update skipped. %s", worker, reason))
+		}
+	}
+	for _, worker := range slices.Sorted(maps.Keys(unfrozen.workerToMachineImageUpdate)) {
+		if _, ok := frozen.workerToMachineImageUpdate[worker]; !ok {
+			skipped = append(skipped, fmt.Sprintf("Worker pool %q: Machine image version update skipped. %s", worker, reason))
+		}
+	}
+	for _, credentials := range slices.Sorted(maps.Keys(unfrozen.credentialsToRotationUpdate)) {
+		if _, ok := frozen.credentialsToRotationUpdate[credentials]; !ok {
+			skipped = append(skipped, fmt.Sprintf("Credentials %q: Automatic rotation skipped. %s", credentials, reason))
+		}
+	}
+
+	return skipped
+}
@@ -0,0 +1,184 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package maintenance
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener/pkg/api/indexer"
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/controllermanager/v1alpha1"
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+)
+
+var _ = Describe("Maintenance freeze", func() {
+	var (
+		ctx        = context.TODO()
+		fakeClient client.Client
+		fakeClock  *testclock.FakeClock
+		reconciler *Reconciler
+
+		project *gardencorev1beta1.Project
+		shoot   *gardencorev1beta1.Shoot
+
+		now = time.Date(2025, 12, 24, 10, 0, 0, 0, time.UTC)
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithStatusSubresource(&gardencorev1beta1.Shoot{}).
+			WithIndex(&gardencorev1beta1.Project{}, core.ProjectNamespace, indexer.ProjectNamespaceIndexerFunc).
+			Build()
+		fakeClock = testclock.NewFakeClock(now)
+		reconciler = &Reconciler{
+			Client: fakeClient,
+			Clock:  fakeClock,
+		}
+
+		project = &gardencorev1beta1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: "dev"},
+			Spec: gardencorev1beta1.ProjectSpec{
+				Namespace: new("garden-dev"),
+				MaintenanceFreezes: []gardencorev1beta1.MaintenanceFreeze{
+					{
+						Name:  "release",
+						Begin: metav1.NewTime(time.Date(2025, 11, 1, 0, 0, 0, 0, time.UTC)),
+						End:   metav1.NewTime(time.Date(2025, 11, 8, 0, 0, 0, 0, time.UTC)),
+					},
+					{
+						Name:  "year-end",
+						Begin: metav1.NewTime(time.Date(2025, 12, 20, 0, 0, 0, 0, time.UTC)),
+						End:   metav1.NewTime(time.Date(2026, 1, 6, 0, 0, 0, 0, time.UTC)),
+					},
+				},
+			},
+		}
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-dev"},
+			Spec: gardencorev1beta1.ShootSpec{
+				CloudProfileName: new("profile"),
+				Kubernetes:       gardencorev1beta1.Kubernetes{Version: "1.33.1"},
+				Maintenance: &gardencorev1beta1.Maintenance{
+					AutoUpdate: &gardencorev1beta1.MaintenanceAutoUpdate{KubernetesVersion: true},
+					TimeWindow: &gardencorev1beta1.MaintenanceTimeWindow{Begin: "220000+0000", End: "230000+0000"},
+				},
+			},
+		}
+	})
+
+	Describe("#activeMaintenanceFreeze", func() {
+		It("should return nil if there is no project", func() {
+			Expect(reconciler.activeMaintenanceFreeze(ctx, shoot, now)).To(BeNil())
+		})
+
+		It("should return the active maintenance freeze of the project", func() {
+			Expect(fakeClient.Create(ctx, project)).To(Succeed())
+
+			freeze, err := reconciler.activeMaintenanceFreeze(ctx, shoot, now)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(freeze).To(HaveField("Name", "year-end"))
+		})
+
+		It("should consider the begin as inclusive and the end as exclusive", func() {
+			Expect(fakeClient.Create(ctx, project)).To(Succeed())
+
+			Expect(reconciler.activeMaintenanceFreeze(ctx, shoot, time.Date(2025, 11, 1, 0, 0, 0, 0, time.UTC))).To(HaveField("Name", "release"))
+			Expect(reconciler.activeMaintenanceFreeze(ctx, shoot, time.Date(2025, 11, 8, 0, 0, 0, 0, time.UTC))).To(BeNil())
+		})
+
+		It("should return nil if no maintenance freeze is active", func() {
+			Expect(fakeClient.Create(ctx, project)).To(Succeed())
+
+			Expect(reconciler.activeMaintenanceFreeze(ctx, shoot, time.Date(2026, 1, 6, 0, 0, 0, 0, time.UTC))).To(BeNil())
+		})
+
+		It("should prefer the maintenance freezes of the controller configuration", func() {
+			Expect(fakeClient.Create(ctx, project)).To(Succeed())
+			reconciler.Config.MaintenanceFreezes = []controllermanagerconfigv1alpha1.MaintenanceFreeze{{
+				Name:  "landscape-upgrade",
+				Begin: metav1.NewTime(now.Add(-time.Hour)),
+				End:   metav1.NewTime(now.Add(time.Hour)),
+			}}
+
+			freeze, err := reconciler.activeMaintenanceFreeze(ctx, shoot, now)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(freeze).To(HaveField("Name", "landscape-upgrade"))
+		})
+	})
+
+	Describe("#updateMaintenancePreview", func() {
+		BeforeEach(func() {
+			Expect(fakeClient.Create(ctx, &gardencorev1beta1.CloudProfile{
+				ObjectMeta: metav1.ObjectMeta{Name: "profile"},
+				Spec: gardencorev1beta1.CloudProfileSpec{
+					Kubernetes: gardencorev1beta1.KubernetesSettings{
+						Versions: []gardencorev1beta1.ExpirableVersion{{Version: "1.33.1"}, {Version: "1.33.2"}},
+					},
+				},
+			})).To(Succeed())
+			Expect(fakeClient.Create(ctx, project)).To(Succeed())
+			Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+		})
+
+		It("should report the automatic updates skipped because of the maintenance freeze", func() {
+			Expect(reconciler.updateMaintenancePreview(ctx, logr.Discard(), shoot)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Spec.Kubernetes.Version).To(Equal("1.33.1"))
+			Expect(shoot.Status.MaintenancePreview).NotTo(BeNil())
+			Expect(shoot.Status.MaintenancePreview.PlannedChanges).To(Equal([]string{
+				`Control Plane: Kubernetes version update skipped. Reason: Maintenance freeze "year-end" is active until 2026-01-06T00:00:00Z`,
+			}))
+		})
+
+		It("should still report forceful updates of expired versions", func() {
+			cloudProfile := &gardencorev1beta1.CloudProfile{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Name: "profile"}, cloudProfile)).To(Succeed())
+			cloudProfile.Spec.Kubernetes.Versions[0].ExpirationDate = &metav1.Time{Time: now.Add(-time.Hour)}
+			Expect(fakeClient.Update(ctx, cloudProfile)).To(Succeed())
+
+			Expect(reconciler.updateMaintenancePreview(ctx, logr.Discard(), shoot)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Status.MaintenancePreview.PlannedChanges).To(Equal([]string{
+				`Control Plane: Updated Kubernetes version from "1.33.1" to "1.33.2". Reason: Kubernetes version expired - force update required`,
+			}))
+		})
+	})
+
+	Describe("#skippedMaintenanceOperations", func() {
+		It("should describe the operations which are only part of the unfrozen result", func() {
+			freeze := &gardencorev1beta1.MaintenanceFreeze{Name: "year-end", End: metav1.NewTime(time.Date(2026, 1, 6, 0, 0, 0, 0, time.UTC))}
+			frozen := &maintenanceResult{
+				workerToMachineImageUpdate: map[string]updateResult{"worker-a": {isSuccessful: true}},
+			}
+			unfrozen := &maintenanceResult{
+				kubernetesControlPlaneUpdate: &updateResult{isSuccessful: true},
+				workerToKubernetesUpdate:     map[string]updateResult{"worker-a": {isSuccessful: true}},
+				workerToMachineImageUpdate:   map[string]updateResult{"worker-b": {isSuccessful: true}, "worker-a": {isSuccessful: true}},
+				credentialsToRotationUpdate:  map[string]updateResult{"rotate-ssh-keypair": {isSuccessful: true}},
+			}
+
+			Expect(skippedMaintenanceOperations(frozen, unfrozen, freeze)).To(Equal([]string{
+				`Control Plane: Kubernetes version update skipped. Reason: Maintenance freeze "year-end" is active until 2026-01-06T00:00:00Z`,
+				`Worker pool "worker-a": Kubernetes version update skipped. Reason: Maintenance freeze "year-end" is active until 2026-01-06T00:00:00Z`,
+				`Worker pool "worker-b": Machine image version update skipped. Reason: Maintenance freeze "year-end" is active until 2026-01-06T00:00:00Z`,
+				`Credentials "rotate-ssh-keypair": Automatic rotation skipped. Reason: Maintenance freeze "year-end" is active until 2026-01-06T00:00:00Z`,
+			}))
+		})
+	})
+})
@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package maintenance
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+// maintenancePreviewRefreshPeriod is the period after which the maintenance preview of a Shoot is recomputed.
+const maintenancePreviewRefreshPeriod = 24 * time.Hour
+
+// updateMaintenancePreview computes the changes that the next maintenance is expected to perform on the Shoot and
+// reports them in the Shoot status. The preview is only recomputed if it refers to another maintenance time window or
+// if it is older than the refresh period.
+func (r *Reconciler) updateMaintenancePreview(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot) error {
+	var (
+		now                 = r.Clock.Now()
+		nextMaintenanceTime = gardenerutils.EffectiveShootMaintenanceTimeWindow(shoot).NextBegin(now)
+	)
+
+	if preview := shoot.Status.MaintenancePreview; preview != nil &&
+		preview.NextMaintenanceTime.Time.Equal(nextMaintenanceTime) &&
+		now.Sub(preview.LastUpdateTime.Time) < maintenancePreviewRefreshPeriod {
+		return nil
+	}
+
+	freeze, err := r.activeMaintenanceFreeze(ctx, shoot, nextMaintenanceTime)
+	if err != nil {
+		return fmt.Errorf("failed determining maintenance freeze: %w", err)
+	}
+
+	// The maintenance is computed as of the begin of the next maintenance time window, e.g., to account for credentials
+	// whose rotation period passes until then.
+	result, err := r.computeMaintenance(ctx, log.V(1), shoot, nextMaintenanceTime, true, freeze)
+	if err != nil {
+		return fmt.Errorf("failed computing maintenance preview: %w", err)
+	}
+
+	log.V(1).Info("Updating maintenance preview", "nextMaintenanceTime", nextMaintenanceTime)
+	patch := client.MergeFrom(shoot.DeepCopy())
+	shoot.Status.MaintenancePreview = &gardencorev1beta1.MaintenancePreview{
+		NextMaintenanceTime: metav1.Time{Time: nextMaintenanceTime},
+		PlannedChanges:      result.plannedChanges(),
+		LastUpdateTime:      metav1.Time{Time: now},
+	}
+	return r.Client.Status().Patch(ctx, shoot, patch)
+}
+
+// plannedChanges returns human-readable descriptions of the computed maintenance operations. They are phrased like the
+// description of the last maintenance in the Shoot status.
+func (m *maintenanceResult) plannedChanges() []string {
+	var changes []string
+
+	if m.kubernetesControlPlaneUpdate != nil {
+		changes = append(changes, describePlannedUpdate("Control Plane", "Kubernetes version update", *m.kubernetesControlPlaneUpdate))
+	}
+	for _, worker := range slices.Sorted(maps.Keys(m.workerToKubernetesUpdate)) {
+		changes = append(changes, describePlannedUpdate(fmt.Sprintf("Worker pool %q", worker), "Kubernetes version update", m.workerToKubernetesUpdate[worker]))
+	}
+	for _, worker := range slices.Sorted(maps.Keys(m.workerToMachineImageUpdate)) {
+		changes = append(changes, describePlannedUpdate(fmt.Sprintf("Worker pool %q", worker), "Machine image version update", m.workerToMachineImageUpdate[worker]))
+	}
+	for _, credentials := range slices.Sorted(maps.Keys(m.credentialsToRotationUpdate)) {
+		changes = append(changes, describePlannedUpdate(fmt.Sprintf("Credentials %q", credentials), "Automatic rotation", m.credentialsToRotationUpdate[credentials]))
+	}
+
+	return append(changes, m.operations...)
+}
+
+func describePlannedUpdate(subject, operation string, result updateResult) string {
+	if result.isSuccessful {
+		return fmt.Sprintf("%s: %s. Reason: %s", subject, result.description, result.reason)
+	}
+	return fmt.Sprintf("%s: %s is expected to fail: %s. Reason for update: %s", subject, operation, result.description, result.reason)
+}
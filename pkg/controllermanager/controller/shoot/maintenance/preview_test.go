@@ -0,0 +1,176 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package maintenance
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener/pkg/api/indexer"
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+)
+
+var _ = Describe("Maintenance preview", func() {
+	Describe("#updateMaintenancePreview", func() {
+		var (
+			ctx        = context.TODO()
+			log        = logr.Discard()
+			fakeClient client.Client
+			fakeClock  *testclock.FakeClock
+			reconciler *Reconciler
+
+			shoot *gardencorev1beta1.Shoot
+
+			nextMaintenanceTime = time.Date(2025, 1, 1, 22, 0, 0, 0, time.UTC)
+		)
+
+		BeforeEach(func() {
+			fakeClient = fakeclient.NewClientBuilder().
+				WithScheme(kubernetes.GardenScheme).
+				WithStatusSubresource(&gardencorev1beta1.Shoot{}).
+				WithIndex(&gardencorev1beta1.Project{}, core.ProjectNamespace, indexer.ProjectNamespaceIndexerFunc).
+				Build()
+			fakeClock = testclock.NewFakeClock(time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC))
+			reconciler = &Reconciler{
+				Client: fakeClient,
+				Clock:  fakeClock,
+			}
+
+			Expect(fakeClient.Create(ctx, &gardencorev1beta1.CloudProfile{
+				ObjectMeta: metav1.ObjectMeta{Name: "profile"},
+				Spec: gardencorev1beta1.CloudProfileSpec{
+					Kubernetes: gardencorev1beta1.KubernetesSettings{
+						Versions: []gardencorev1beta1.ExpirableVersion{{Version: "1.33.1"}, {Version: "1.33.2"}},
+					},
+				},
+			})).To(Succeed())
+
+			shoot = &gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-dev"},
+				Spec: gardencorev1beta1.ShootSpec{
+					CloudProfileName: new("profile"),
+					Kubernetes:       gardencorev1beta1.Kubernetes{Version: "1.33.1"},
+					Maintenance: &gardencorev1beta1.Maintenance{
+						AutoUpdate: &gardencorev1beta1.MaintenanceAutoUpdate{KubernetesVersion: true},
+						TimeWindow: &gardencorev1beta1.MaintenanceTimeWindow{Begin: "220000+0000", End: "230000+0000"},
+					},
+				},
+			}
+		})
+
+		JustBeforeEach(func() {
+			Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+		})
+
+		It("should report the changes of the next maintenance without changing the shoot spec", func() {
+			Expect(reconciler.updateMaintenancePreview(ctx, log, shoot)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Spec.Kubernetes.Version).To(Equal("1.33.1"))
+			Expect(shoot.Status.MaintenancePreview).NotTo(BeNil())
+			Expect(shoot.Status.MaintenancePreview.NextMaintenanceTime.Time).To(BeTemporally("==", nextMaintenanceTime))
+			Expect(shoot.Status.MaintenancePreview.PlannedChanges).To(Equal([]string{
+				`Control Plane: Updated Kubernetes version from "1.33.1" to "1.33.2". Reason: Automatic update of Kubernetes version configured`,
+			}))
+			Expect(shoot.Status.MaintenancePreview.LastUpdateTime.Time).To(BeTemporally("==", fakeClock.Now()))
+		})
+
+		It("should report that no changes are planned", func() {
+			shoot.Spec.Kubernetes.Version = "1.33.2"
+
+			Expect(reconciler.updateMaintenancePreview(ctx, log, shoot)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Status.MaintenancePreview).NotTo(BeNil())
+			Expect(shoot.Status.MaintenancePreview.NextMaintenanceTime.Time).To(BeTemporally("==", nextMaintenanceTime))
+			Expect(shoot.Status.MaintenancePreview.PlannedChanges).To(BeEmpty())
+		})
+
+		Context("preview exists", func() {
+			var lastUpdateTime time.Time
+
+			JustBeforeEach(func() {
+				lastUpdateTime = fakeClock.Now().Add(-time.Hour)
+				shoot.Status.MaintenancePreview = &gardencorev1beta1.MaintenancePreview{
+					NextMaintenanceTime: metav1.NewTime(nextMaintenanceTime),
+					PlannedChanges:      []string{"foo"},
+					LastUpdateTime:      metav1.NewTime(lastUpdateTime),
+				}
+				Expect(fakeClient.Status().Update(ctx, shoot)).To(Succeed())
+			})
+
+			It("should not recompute an up-to-date preview", func() {
+				Expect(reconciler.updateMaintenancePreview(ctx, log, shoot)).To(Succeed())
+
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+				Expect(shoot.Status.MaintenancePreview.PlannedChanges).To(ConsistOf("foo"))
+				Expect(shoot.Status.MaintenancePreview.LastUpdateTime.Time).To(BeTemporally("==", lastUpdateTime))
+			})
+
+			It("should recompute the preview after the refresh period", func() {
+				fakeClock.Step(maintenancePreviewRefreshPeriod)
+
+				Expect(reconciler.updateMaintenancePreview(ctx, log, shoot)).To(Succeed())
+
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+				Expect(shoot.Status.MaintenancePreview.NextMaintenanceTime.Time).To(BeTemporally("==", nextMaintenanceTime.AddDate(0, 0, 1)))
+				Expect(shoot.Status.MaintenancePreview.PlannedChanges).To(HaveLen(1))
+				Expect(shoot.Status.MaintenancePreview.LastUpdateTime.Time).To(BeTemporally("==", fakeClock.Now()))
+			})
+
+			It("should recompute the preview if the maintenance time window changed", func() {
+				shoot.Spec.Maintenance.TimeWindow = &gardencorev1beta1.MaintenanceTimeWindow{Begin: "030000+0000", End: "040000+0000"}
+
+				Expect(reconciler.updateMaintenancePreview(ctx, log, shoot)).To(Succeed())
+
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+				Expect(shoot.Status.MaintenancePreview.NextMaintenanceTime.Time).To(BeTemporally("==", time.Date(2025, 1, 2, 3, 0, 0, 0, time.UTC)))
+				Expect(shoot.Status.MaintenancePreview.PlannedChanges).To(HaveLen(1))
+			})
+		})
+	})
+
+	Describe("#plannedChanges", func() {
+		It("should describe all planned changes in a stable order", func() {
+			result := &maintenanceResult{
+				kubernetesControlPlaneUpdate: &updateResult{description: "Updated Kubernetes version from \"1.33.1\" to \"1.33.2\"", reason: "Kubernetes version expired - force update required", isSuccessful: true},
+				workerToKubernetesUpdate: map[string]updateResult{
+					"worker-b": {description: "could not determine higher suitable version", reason: "Kubernetes version expired - force update required"},
+				},
+				workerToMachineImageUpdate: map[string]updateResult{
+					"worker-b": {description: "Updated machine image \"gardenlinux\" from \"1.0.0\" to \"1.1.0\"", reason: "Automatic update of the machine image version is configured", isSuccessful: true},
+					"worker-a": {description: "Updated machine image \"gardenlinux\" from \"1.0.0\" to \"1.1.0\"", reason: "Automatic update of the machine image version is configured", isSuccessful: true},
+				},
+				credentialsToRotationUpdate: map[string]updateResult{
+					"rotate-ssh-keypair": {description: "SSH keypair rotation started", reason: "Automatic rotation of SSH keypair configured", isSuccessful: true},
+				},
+				operations: []string{".spec.addons was removed"},
+			}
+
+			Expect(result.plannedChanges()).To(Equal([]string{
+				`Control Plane: Updated Kubernetes version from "1.33.1" to "1.33.2". Reason: Kubernetes version expired - force update required`,
+				`Worker pool "worker-b": Kubernetes version update is expected to fail: could not determine higher suitable version. Reason for update: Kubernetes version expired - force update required`,
+				`Worker pool "worker-a": Updated machine image "gardenlinux" from "1.0.0" to "1.1.0". Reason: Automatic update of the machine image version is configured`,
+				`Worker pool "worker-b": Updated machine image "gardenlinux" from "1.0.0" to "1.1.0". Reason: Automatic update of the machine image version is configured`,
+				`Credentials "rotate-ssh-keypair": SSH keypair rotation started. Reason: Automatic rotation of SSH keypair configured`,
+				".spec.addons was removed",
+			}))
+		})
+
+		It("should return nothing if no changes are planned", func() {
+			Expect((&maintenanceResult{}).plannedChanges()).To(BeEmpty())
+		})
+	})
+})
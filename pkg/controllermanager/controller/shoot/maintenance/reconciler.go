@@ -68,16 +68,26 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 
 	if !mustMaintainNow(shoot, r.Clock) {
 		log.V(1).Info("Skipping Shoot because it doesn't need to be maintained now")
+
+		if err := r.updateMaintenancePreview(ctx, log, shoot); err != nil {
+			return reconcile.Result{}, err
+		}
+
 		log.V(1).Info("Scheduled next maintenance for Shoot", "duration", requeueAfter.Round(time.Minute), "nextMaintenance", nextMaintenance.Round(time.Minute))
-		return reconcile.Result{RequeueAfter: requeueAfter}, nil
+		return reconcile.Result{RequeueAfter: min(requeueAfter, maintenancePreviewRefreshPeriod)}, nil
 	}
 
 	if err := r.reconcile(ctx, log, shoot); err != nil {
 		return reconcile.Result{}, err
 	}
 
+	// The maintenance must not be repeated because of a failing preview, hence the error is only logged.
+	if err := r.updateMaintenancePreview(ctx, log, shoot); err != nil {
+		log.Error(err, "Failed updating maintenance preview")
+	}
+
 	log.V(1).Info("Scheduled next maintenance for Shoot", "duration", requeueAfter.Round(time.Minute), "nextMaintenance", nextMaintenance.Round(time.Minute))
-	return reconcile.Result{RequeueAfter: requeueAfter}, nil
+	return reconcile.Result{RequeueAfter: min(requeueAfter, maintenancePreviewRefreshPeriod)}, nil
 }
 
 func requeueAfterDuration(shoot *gardencorev1beta1.Shoot) (time.Duration, time.Time) {
@@ -100,9 +110,23 @@ type updateResult struct {
 	isSuccessful bool
 }
 
-func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot) error {
-	log.Info("Maintaining Shoot")
+// maintenanceResult contains the results of the maintenance operations computed for a Shoot.
+type maintenanceResult struct {
+	// maintainedShoot is a copy of the Shoot with all maintenance changes applied.
+	maintainedShoot *gardencorev1beta1.Shoot
+	// operations are maintenance operations unrelated to machine images, Kubernetes versions and credentials rotations.
+	operations []string
+
+	kubernetesControlPlaneUpdate *updateResult
+	workerToKubernetesUpdate     map[string]updateResult
+	workerToMachineImageUpdate   map[string]updateResult
+	credentialsToRotationUpdate  map[string]updateResult
+}
 
+// computeMaintenance computes the maintenance operations for the given Shoot at the given time and applies them to a
+// copy of the Shoot. If <preview> is true, no objects are changed in the garden cluster. If a maintenance <freeze> is
+// given, only forceful updates of expired versions are performed and the skipped operations are reported.
+func (r *Reconciler) computeMaintenance(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot, now time.Time, preview bool, freeze *gardencorev1beta1.MaintenanceFreeze) (*maintenanceResult, error) {
 	var (
 		maintainedShoot = shoot.DeepCopy()
 		// for maintenance operations unrelated to machine images and Kubernetes versions
@@ -115,18 +139,37 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gard
 
 	cloudProfile, err := gardenerutils.GetCloudProfile(ctx, r.Client, shoot)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	soakTime, err := r.soakTime(ctx, shoot)
+	if err != nil {
+		return nil, err
+	}
+	if soakTime > 0 {
+		// Versions which have not been offered for the configured soak time are not considered for updates yet.
+		cloudProfileStatus, err := r.cloudProfileStatus(ctx, cloudProfile)
+		if err != nil {
+			return nil, err
+		}
+		cloudProfile = withoutUnsoakedVersions(cloudProfile, cloudProfileStatus, shoot, soakTime, now)
 	}
 
+	// During a maintenance freeze, only forceful updates of expired versions are performed.
+	var (
+		autoUpdateKubernetesVersion   = maintainedShoot.Spec.Maintenance.AutoUpdate.KubernetesVersion && freeze == nil
+		autoUpdateMachineImageVersion = ptr.Deref(maintainedShoot.Spec.Maintenance.AutoUpdate.MachineImageVersion, false) && freeze == nil
+	)
+
 	if !v1beta1helper.IsWorkerless(shoot) {
-		workerToMachineImageUpdate, err = maintainMachineImages(log, maintainedShoot, cloudProfile)
+		workerToMachineImageUpdate, err = maintainMachineImages(log, maintainedShoot, autoUpdateMachineImageVersion, cloudProfile)
 		if err != nil {
 			// continue execution to allow the kubernetes version update
 			log.Error(err, "Failed to maintain Shoot machine images")
 		}
 	}
 
-	kubernetesControlPlaneUpdate, err := maintainKubernetesVersion(log, maintainedShoot.Spec.Kubernetes.Version, maintainedShoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, func(v string) (string, error) {
+	kubernetesControlPlaneUpdate, err := maintainKubernetesVersion(log, maintainedShoot.Spec.Kubernetes.Version, autoUpdateKubernetesVersion, cloudProfile, func(v string) (string, error) {
 		maintainedShoot.Spec.Kubernetes.Version = v
 		return v, nil
 	})
@@ -135,16 +178,19 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gard
 		log.Error(err, "Failed to maintain Shoot kubernetes version")
 	}
 
-	credentialsToRotationUpdate := computeCredentialsToRotationResults(log, maintainedShoot, metav1.Time{Time: r.Clock.Now()})
+	var credentialsToRotationUpdate map[string]updateResult
+	if freeze == nil {
+		credentialsToRotationUpdate = computeCredentialsToRotationResults(log, maintainedShoot, metav1.Time{Time: now})
+	}
 
 	oldShootKubernetesVersion, err := semver.NewVersion(shoot.Spec.Kubernetes.Version)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	shootKubernetesVersion, err := semver.NewVersion(maintainedShoot.Spec.Kubernetes.Version)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// Set the .spec.kubernetes.kubeControllerManager.podEvictionTimeout field to nil, when Shoot cluster is being forcefully updated to K8s >= 1.33.
@@ -198,11 +244,14 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gard
 		oldK8sLess134 := versionutils.ConstraintK8sLess134.Check(oldShootKubernetesVersion)
 		newK8sGreaterEqual134 := versionutils.ConstraintK8sGreaterEqual134.Check(shootKubernetesVersion)
 		if oldK8sLess134 && newK8sGreaterEqual134 && maintainedShoot.Spec.SecretBindingName != nil && maintainedShoot.Spec.CredentialsBindingName == nil {
-			if err := r.migrateSecretBindingToCredentialsBinding(ctx, maintainedShoot); err != nil {
+			reason := ".spec.secretBindingName was migrated to .spec.credentialsBindingName. Reason: SecretBinding is deprecated and can no longer be used for Shoot clusters using Kubernetes version 1.34+"
+			if preview {
+				// The migration might create a CredentialsBinding, hence it is only reported in a preview.
+				operations = append(operations, reason)
+			} else if err := r.migrateSecretBindingToCredentialsBinding(ctx, maintainedShoot); err != nil {
 				log.Error(err, "Failed to migrate SecretBinding to CredentialsBinding")
 				operations = append(operations, fmt.Sprintf("Failed to migrate from secretBindingName to credentialsBindingName: %v", err))
 			} else {
-				reason := ".spec.secretBindingName was migrated to .spec.credentialsBindingName. Reason: SecretBinding is deprecated and can no longer be used for Shoot clusters using Kubernetes version 1.34+"
 				operations = append(operations, reason)
 			}
 		}
@@ -265,7 +314,7 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gard
 		}
 
 		workerLog := log.WithValues("worker", pool.Name)
-		workerKubernetesUpdate, err := maintainKubernetesVersion(workerLog, *pool.Kubernetes.Version, maintainedShoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, func(v string) (string, error) {
+		workerKubernetesUpdate, err := maintainKubernetesVersion(workerLog, *pool.Kubernetes.Version, autoUpdateKubernetesVersion, cloudProfile, func(v string) (string, error) {
 			workerPoolSemver, err := semver.NewVersion(v)
 			if err != nil {
 				return "", err
@@ -308,6 +357,54 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gard
 
 	operations = append(operations, maintainAddons(maintainedShoot)...)
 
+	result := &maintenanceResult{
+		maintainedShoot:              maintainedShoot,
+		operations:                   operations,
+		kubernetesControlPlaneUpdate: kubernetesControlPlaneUpdate,
+		workerToKubernetesUpdate:     workerToKubernetesUpdate,
+		workerToMachineImageUpdate:   workerToMachineImageUpdate,
+		credentialsToRotationUpdate:  credentialsToRotationUpdate,
+	}
+
+	if freeze != nil {
+		// The maintenance is computed once more without the freeze to determine which operations are skipped.
+		unfrozenResult, err := r.computeMaintenance(ctx, log.V(1), shoot, now, true, nil)
+		if err != nil {
+			return nil, err
+		}
+		result.operations = append(result.operations, skippedMaintenanceOperations(result, unfrozenResult, freeze)...)
+	}
+
+	return result, nil
+}
+
+func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot) error {
+	log.Info("Maintaining Shoot")
+
+	now := r.Clock.Now()
+
+	freeze, err := r.activeMaintenanceFreeze(ctx, shoot, now)
+	if err != nil {
+		return err
+	}
+	if freeze != nil {
+		log.Info("Maintenance freeze is active, only forceful updates of expired versions are performed", "maintenanceFreeze", freeze.Name)
+	}
+
+	result, err := r.computeMaintenance(ctx, log, shoot, now, false, freeze)
+	if err != nil {
+		return err
+	}
+
+	var (
+		maintainedShoot              = result.maintainedShoot
+		operations                   = result.operations
+		kubernetesControlPlaneUpdate = result.kubernetesControlPlaneUpdate
+		workerToKubernetesUpdate     = result.workerToKubernetesUpdate
+		workerToMachineImageUpdate   = result.workerToMachineImageUpdate
+		credentialsToRotationUpdate  = result.credentialsToRotationUpdate
+	)
+
 	requirePatch := len(operations) > 0 || kubernetesControlPlaneUpdate != nil || len(workerToKubernetesUpdate) > 0 || len(workerToMachineImageUpdate) > 0 || len(credentialsToRotationUpdate) > 0
 	if requirePatch {
 		patch := client.MergeFrom(shoot.DeepCopy())
@@ -553,7 +650,7 @@ func maintainTasks(shoot *gardencorev1beta1.Shoot, config controllermanagerconfi
 }
 
 // maintainMachineImages updates the machine images of a Shoot's worker pools if necessary
-func maintainMachineImages(log logr.Logger, shoot *gardencorev1beta1.Shoot, cloudProfile *gardencorev1beta1.CloudProfile) (map[string]updateResult, error) {
+func maintainMachineImages(log logr.Logger, shoot *gardencorev1beta1.Shoot, autoUpdate bool, cloudProfile *gardencorev1beta1.CloudProfile) (map[string]updateResult, error) {
 	maintenanceResults := make(map[string]updateResult)
 
 	controlPlaneVersion, err := semver.NewVersion(shoot.Spec.Kubernetes.Version)
@@ -583,7 +680,7 @@ func maintainMachineImages(log logr.Logger, shoot *gardencorev1beta1.Shoot, clou
 		filteredMachineImageVersionsFromCloudProfile := helper.FilterMachineImageVersions(&machineImageFromCloudProfile, worker, kubeletVersion, machineTypeFromCloudProfile, cloudProfile.Spec.MachineCapabilities)
 
 		// first check if the machine image version should be updated
-		shouldBeUpdated, reason, isExpired := shouldMachineImageVersionBeUpdated(workerImage, filteredMachineImageVersionsFromCloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
+		shouldBeUpdated, reason, isExpired := shouldMachineImageVersionBeUpdated(workerImage, filteredMachineImageVersionsFromCloudProfile, autoUpdate)
 		if !shouldBeUpdated {
 			continue
 		}
@@ -156,7 +156,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			})
 
 			It("should update machine image version to overall latest. Auto update: already on latest patch for minor, and there is an overall higher version available", func() {
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, true, cloudProfile)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", overallLatestVersion)
@@ -165,7 +165,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			It("should update machine image version to overall latest. Auto update: already on latest patch for minor, and there is an overall higher version available for in-place updates", func() {
 				shoot.Spec.Provider.Workers[0].Machine.Image.Version = new(shootCurrentImageVersion + "-inplace")
 				shoot.Spec.Provider.Workers[0].UpdateStrategy = new(gardencorev1beta1.AutoInPlaceUpdate)
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, true, cloudProfile)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", overallLatestVersion+"-inplace")
@@ -201,7 +201,7 @@ var _ = Describe("Shoot Maintenance", func() {
 
 				shoot.Spec.Provider.Workers[0].Machine.Architecture = new("arm64")
 
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, true, cloudProfile)
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", expectedVersion)
 			})
@@ -244,7 +244,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				}
 
 				shoot.Spec.Provider.Workers = append(shoot.Spec.Provider.Workers, otherWorker)
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, true, cloudProfile)
 
 				Expect(err).NotTo(HaveOccurred())
 
@@ -264,17 +264,16 @@ var _ = Describe("Shoot Maintenance", func() {
 
 				cloudProfile.Spec.MachineImages[0].Versions = append(cloudProfile.Spec.MachineImages[0].Versions, highestForMinor)
 
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, true, cloudProfile)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", expectedVersion)
 			})
 
 			It("should update machine image version to overall latest. ForceUpdate: expiration date in the past", func() {
-				shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion = new(false)
 				cloudProfile.Spec.MachineImages[0].Versions[0].ExpirationDate = &expirationDateInThePast
 
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, false, cloudProfile)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", overallLatestVersion)
@@ -309,7 +308,7 @@ var _ = Describe("Shoot Maintenance", func() {
 					},
 				}
 
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, true, cloudProfile)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", expectedVersion)
@@ -325,7 +324,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				}
 				cloudProfile.Spec.MachineImages[0].Versions = append(cloudProfile.Spec.MachineImages[0].Versions, highestExpiredVersion)
 				shoot.Spec.Provider.Workers[0].Machine.Image.Version = &highestExpiredVersion.Version
-				results, err := maintainMachineImages(log, shoot, cloudProfile)
+				results, err := maintainMachineImages(log, shoot, true, cloudProfile)
 
 				Expect(results[shoot.Spec.Provider.Workers[0].Name].isSuccessful).To(BeFalse())
 				Expect(err).ToNot(HaveOccurred())
@@ -333,7 +332,7 @@ var _ = Describe("Shoot Maintenance", func() {
 
 			It("should not change version: already on highest version.", func() {
 				shoot.Spec.Provider.Workers[0].Machine.Image.Version = &overallLatestVersion
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, true, cloudProfile)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", overallLatestVersion)
@@ -357,7 +356,7 @@ var _ = Describe("Shoot Maintenance", func() {
 					},
 				)
 				shoot.Spec.Provider.Workers[0].Machine.Image.Version = new("1")
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, true, cloudProfile)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", "2")
@@ -388,7 +387,7 @@ var _ = Describe("Shoot Maintenance", func() {
 					},
 				)
 				shoot.Spec.Provider.Workers[0].Machine.Image.Version = new("1")
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, true, cloudProfile)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", "2.0.1")
@@ -423,7 +422,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				cloudProfile.Spec.MachineImages[0].Versions = versions
 
 				// the shoots patch version is expired and there is no higher non-expired & non-preview patch version of the same minor -> force update
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, true, cloudProfile)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", expectedVersion)
@@ -479,7 +478,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				}
 
 				// the shoots patch version is expired and there is no higher non-expired & non-preview patch version of the same minor -> force update
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, true, cloudProfile)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", expectedVersion)
@@ -522,7 +521,7 @@ var _ = Describe("Shoot Maintenance", func() {
 					},
 				}
 
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, true, cloudProfile)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", highestPatchNextMinor)
@@ -558,7 +557,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				cloudProfile.Spec.MachineImages[0].Versions = versions
 
 				// the shoots patch version is expired and there is no higher non-expired & non-preview patch version of the same minor -> force update
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, true, cloudProfile)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", highestNonPreviewPatchVersionNplusTwoMinor.Version)
@@ -603,7 +602,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				cloudProfile.Spec.MachineImages[0].Versions = versions
 
 				// the shoots patch version is expired and there is no higher non-expired & non-preview patch version of the same minor -> force update
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, true, cloudProfile)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", expiredPatchVersionNextMinor.Version)
@@ -637,7 +636,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				}
 				shoot.Spec.Provider.Workers[0].Machine.Image.Version = &highestVersionForMinor
 				expected := shoot.Spec.Provider.Workers[0].Machine.Image.DeepCopy()
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, true, cloudProfile)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(shoot.Spec.Provider.Workers[0].Machine.Image).To(Equal(expected))
 			})
@@ -652,7 +651,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				}
 				cloudProfile.Spec.MachineImages[0].Versions = append(cloudProfile.Spec.MachineImages[0].Versions, highestExpiredVersion)
 				shoot.Spec.Provider.Workers[0].Machine.Image.Version = &highestExpiredVersion.Version
-				results, err := maintainMachineImages(log, shoot, cloudProfile)
+				results, err := maintainMachineImages(log, shoot, true, cloudProfile)
 
 				Expect(results[shoot.Spec.Provider.Workers[0].Name].isSuccessful).To(BeFalse())
 				Expect(err).ToNot(HaveOccurred())
@@ -676,7 +675,7 @@ var _ = Describe("Shoot Maintenance", func() {
 					},
 				)
 				shoot.Spec.Provider.Workers[0].Machine.Image.Version = new("1.7")
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, true, cloudProfile)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", "1.7")
@@ -700,7 +699,7 @@ var _ = Describe("Shoot Maintenance", func() {
 					},
 				)
 				shoot.Spec.Provider.Workers[0].Machine.Image.Version = new("1.7.2")
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, true, cloudProfile)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", "1.7.3")
@@ -752,7 +751,7 @@ var _ = Describe("Shoot Maintenance", func() {
 					},
 				}
 
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, true, cloudProfile)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", highestPatchCurrentMinor)
@@ -801,7 +800,7 @@ var _ = Describe("Shoot Maintenance", func() {
 					},
 				}
 
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, true, cloudProfile)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", highestVersionForCurrentMajor)
@@ -851,7 +850,7 @@ var _ = Describe("Shoot Maintenance", func() {
 					},
 				}
 
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, true, cloudProfile)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", highestVersionForCurrentMajor)
@@ -897,7 +896,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				shoot.Spec.Provider.Workers[0].Machine.Image.Version = &latestVersionForCurrentMajor
 
 				// the shoots patch version is expired and there is no higher non-expired & non-preview patch version of the same minor -> force update
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, true, cloudProfile)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", latestVersionNextMajor)
@@ -933,7 +932,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				cloudProfile.Spec.MachineImages[0].Versions = versions
 
 				// the shoots patch version is expired and there is no higher non-expired & non-preview patch version of the same minor -> force update
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, true, cloudProfile)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", highestNonPreviewVersionNplusTwoMajor.Version)
@@ -949,7 +948,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				}
 				cloudProfile.Spec.MachineImages[0].Versions = append(cloudProfile.Spec.MachineImages[0].Versions, highestExpiredVersion)
 				shoot.Spec.Provider.Workers[0].Machine.Image.Version = &highestExpiredVersion.Version
-				results, err := maintainMachineImages(log, shoot, cloudProfile)
+				results, err := maintainMachineImages(log, shoot, true, cloudProfile)
 
 				Expect(results[shoot.Spec.Provider.Workers[0].Name].isSuccessful).To(BeFalse())
 				Expect(err).ToNot(HaveOccurred())
@@ -985,7 +984,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				}
 
 				shoot.Spec.Provider.Workers[0].Machine.Image.Version = &highestVersionForMajor
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, true, cloudProfile)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", highestVersionForMajor)
@@ -1076,21 +1075,21 @@ var _ = Describe("Shoot Maintenance", func() {
 
 			It("should update to the latest version with supported capabilities", func() {
 				// the latest overall version does not support the workers' capabilities, hence it should not be updated to
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, true, cloudProfile)
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", latestVersionWithSupportedCapabilities)
 			})
 
 			It("should update to the latest version as all capabilities are supported", func() {
 				shoot.Spec.Provider.Workers[0].Machine.Type = "someOtherMachineType"
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, true, cloudProfile)
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", overallLatestVersion)
 			})
 
 			It("should not update if the current version is the latest/only that supports the machine type capabilities", func() {
 				shoot.Spec.Provider.Workers[0].Machine.Type = "anotherMachineType"
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, true, cloudProfile)
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", shootCurrentImageVersion)
 			})
@@ -1113,7 +1112,7 @@ var _ = Describe("Shoot Maintenance", func() {
 					},
 				)
 				shoot.Spec.Provider.Workers[0].Machine.Image.Version = new("1.7.3")
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, true, cloudProfile)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", "1.8")
@@ -1137,7 +1136,7 @@ var _ = Describe("Shoot Maintenance", func() {
 					},
 				)
 				shoot.Spec.Provider.Workers[0].Machine.Image.Version = new("1.7")
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, true, cloudProfile)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", "1.8")
@@ -1148,16 +1147,14 @@ var _ = Describe("Shoot Maintenance", func() {
 			cloudProfile.Spec.MachineImages[0].Versions[1].CRI = []gardencorev1beta1.CRI{{Name: gardencorev1beta1.CRIName("other")}}
 			cloudProfile.Spec.MachineImages[0].Versions[3].CRI = []gardencorev1beta1.CRI{{Name: gardencorev1beta1.CRIName("other")}}
 
-			_, err := maintainMachineImages(log, shoot, cloudProfile)
+			_, err := maintainMachineImages(log, shoot, true, cloudProfile)
 			Expect(err).NotTo(HaveOccurred())
 			assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", "1.0.0")
 		})
 
 		It("should determine that the shoot worker machine images must NOT to be maintained - ForceUpdate not required & MaintenanceAutoUpdate set to false", func() {
-			shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion = new(false)
-
 			expected := shoot.Spec.Provider.Workers[0].Machine.Image.DeepCopy()
-			_, err := maintainMachineImages(log, shoot, cloudProfile)
+			_, err := maintainMachineImages(log, shoot, false, cloudProfile)
 
 			Expect(err).NotTo(HaveOccurred())
 			Expect(shoot.Spec.Provider.Workers[0].Machine.Image).To(Equal(expected))
@@ -1169,7 +1166,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			shoot.Spec.Provider.Workers[0].CRI = &gardencorev1beta1.CRI{Name: gardencorev1beta1.CRINameContainerD}
 
 			expected := shoot.Spec.Provider.Workers[0].Machine.Image.DeepCopy()
-			_, err := maintainMachineImages(log, shoot, cloudProfile)
+			_, err := maintainMachineImages(log, shoot, true, cloudProfile)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(shoot.Spec.Provider.Workers[0].Machine.Image).To(Equal(expected))
 		})
@@ -1182,7 +1179,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			// add another pool without CRI constraints -> should be updated via auto-update
 			shoot.Spec.Provider.Workers = append(shoot.Spec.Provider.Workers, gardencorev1beta1.Worker{Name: "worker-without-cri-config", Machine: gardencorev1beta1.Machine{Image: shootCurrentImage.DeepCopy(), Architecture: new("amd64")}})
 
-			_, err := maintainMachineImages(log, shoot, cloudProfile)
+			_, err := maintainMachineImages(log, shoot, true, cloudProfile)
 			Expect(err).NotTo(HaveOccurred())
 
 			assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", "1.0.0")
@@ -1198,7 +1195,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			// add another pool without CRI constraints -> should be updated via auto-update to the highest patch version of the same minor
 			shoot.Spec.Provider.Workers = append(shoot.Spec.Provider.Workers, gardencorev1beta1.Worker{Name: "worker-without-containerruntime", CRI: &gardencorev1beta1.CRI{Name: gardencorev1beta1.CRINameContainerD}, Machine: gardencorev1beta1.Machine{Image: shootCurrentImage.DeepCopy(), Architecture: new("amd64")}})
 
-			_, err := maintainMachineImages(log, shoot, cloudProfile)
+			_, err := maintainMachineImages(log, shoot, true, cloudProfile)
 			Expect(err).NotTo(HaveOccurred())
 
 			assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", "1.0.0")
@@ -1213,7 +1210,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			shoot.Spec.Provider.Workers = append(shoot.Spec.Provider.Workers, gardencorev1beta1.Worker{Name: "worker-with-gvisor-and-kata", CRI: &gardencorev1beta1.CRI{Name: gardencorev1beta1.CRINameContainerD, ContainerRuntimes: []gardencorev1beta1.ContainerRuntime{{Type: "gvisor"}, {Type: "kata-container"}}}, Machine: gardencorev1beta1.Machine{Image: shootCurrentImage.DeepCopy(), Architecture: new("amd64")}})
 			shoot.Spec.Provider.Workers = append(shoot.Spec.Provider.Workers, gardencorev1beta1.Worker{Name: "worker-with-gvisor", CRI: &gardencorev1beta1.CRI{Name: gardencorev1beta1.CRINameContainerD, ContainerRuntimes: []gardencorev1beta1.ContainerRuntime{{Type: "gvisor"}}}, Machine: gardencorev1beta1.Machine{Image: shootCurrentImage.DeepCopy(), Architecture: new("amd64")}})
 
-			_, err := maintainMachineImages(log, shoot, cloudProfile)
+			_, err := maintainMachineImages(log, shoot, true, cloudProfile)
 			Expect(err).NotTo(HaveOccurred())
 
 			assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", "1.0.0")
@@ -1227,7 +1224,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			shoot.Spec.Kubernetes.Version = "1.26.0"
 
 			expected := shoot.Spec.Provider.Workers[0].Machine.Image.DeepCopy()
-			_, err := maintainMachineImages(log, shoot, cloudProfile)
+			_, err := maintainMachineImages(log, shoot, true, cloudProfile)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(shoot.Spec.Provider.Workers[0].Machine.Image).To(Equal(expected))
 		})
@@ -1236,7 +1233,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			cloudProfile.Spec.MachineImages[0].Versions[1].KubeletVersionConstraint = new("< 1.26")
 			shoot.Spec.Kubernetes.Version = "1.25.1"
 
-			_, err := maintainMachineImages(log, shoot, cloudProfile)
+			_, err := maintainMachineImages(log, shoot, true, cloudProfile)
 			Expect(err).NotTo(HaveOccurred())
 			assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", overallLatestVersion)
 		})
@@ -1250,7 +1247,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			}
 
 			expected := shoot.Spec.Provider.Workers[0].Machine.Image.DeepCopy()
-			_, err := maintainMachineImages(log, shoot, cloudProfile)
+			_, err := maintainMachineImages(log, shoot, true, cloudProfile)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(shoot.Spec.Provider.Workers[0].Machine.Image).To(Equal(expected))
 		})
@@ -1263,7 +1260,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				Version: new("1.26.0"),
 			}
 
-			_, err := maintainMachineImages(log, shoot, cloudProfile)
+			_, err := maintainMachineImages(log, shoot, true, cloudProfile)
 			Expect(err).NotTo(HaveOccurred())
 			assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", cloudProfile.Spec.MachineImages[0].Versions[1].Version)
 		})
@@ -1271,7 +1268,7 @@ var _ = Describe("Shoot Maintenance", func() {
 		It("should return an error - cloud profile has no matching (machineImage.name) machine image defined", func() {
 			cloudProfile.Spec.MachineImages = cloudProfile.Spec.MachineImages[1:]
 
-			_, err := maintainMachineImages(log, shoot, cloudProfile)
+			_, err := maintainMachineImages(log, shoot, true, cloudProfile)
 
 			Expect(err).To(HaveOccurred())
 
@@ -1280,7 +1277,7 @@ var _ = Describe("Shoot Maintenance", func() {
 		It("should return an error - cloud profile has no matching (machineImage.type) machine type defined", func() {
 			shoot.Spec.Provider.Workers[0].Machine.Type = "non-existing-machine-type"
 
-			_, err := maintainMachineImages(log, shoot, cloudProfile)
+			_, err := maintainMachineImages(log, shoot, true, cloudProfile)
 
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring("machine type \"non-existing-machine-type\" of worker \"cpu-worker\" does not exist in cloudprofile"))
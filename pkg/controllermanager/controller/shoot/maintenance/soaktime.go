@@ -0,0 +1,159 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package maintenance
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+// soakTime returns the duration for which versions must have been offered by the CloudProfile before they are
+// considered for automatic updates of the given Shoot. The auto-update policy of the Shoot takes precedence over the
+// one of its project. Update channels are resolved with the soak times of the controller configuration.
+func (r *Reconciler) soakTime(ctx context.Context, shoot *gardencorev1beta1.Shoot) (time.Duration, error) {
+	var policy *gardencorev1beta1.AutoUpdatePolicy
+	if shoot.Spec.Maintenance != nil && shoot.Spec.Maintenance.AutoUpdate != nil {
+		policy = shoot.Spec.Maintenance.AutoUpdate.Policy
+	}
+
+	if policy == nil {
+		project, err := r.projectForShoot(ctx, shoot)
+		if err != nil {
+			return 0, err
+		}
+		if project != nil {
+			policy = project.Spec.AutoUpdatePolicy
+		}
+	}
+
+	switch {
+	case policy == nil:
+		return 0, nil
+	case policy.SoakTime != nil:
+		return policy.SoakTime.Duration, nil
+	case policy.Channel != nil:
+		return r.Config.UpdateChannels[string(*policy.Channel)].Duration, nil
+	}
+
+	return 0, nil
+}
+
+// cloudProfileStatus returns the status of the given CloudProfile. For NamespacedCloudProfiles, the status of the parent
+// CloudProfile is returned since only the latter records when versions were added.
+func (r *Reconciler) cloudProfileStatus(ctx context.Context, cloudProfile *gardencorev1beta1.CloudProfile) (*gardencorev1beta1.CloudProfileStatus, error) {
+	if cloudProfile.Namespace == "" {
+		return &cloudProfile.Status, nil
+	}
+
+	namespacedCloudProfile := &gardencorev1beta1.NamespacedCloudProfile{}
+	if err := r.Client.Get(ctx, client.ObjectKey{Name: cloudProfile.Name, Namespace: cloudProfile.Namespace}, namespacedCloudProfile); err != nil {
+		return nil, fmt.Errorf("failed reading NamespacedCloudProfile %s: %w", client.ObjectKeyFromObject(cloudProfile), err)
+	}
+
+	parentCloudProfile := &gardencorev1beta1.CloudProfile{}
+	if err := r.Client.Get(ctx, client.ObjectKey{Name: namespacedCloudProfile.Spec.Parent.Name}, parentCloudProfile); err != nil {
+		return nil, fmt.Errorf("failed reading parent CloudProfile %s: %w", namespacedCloudProfile.Spec.Parent.Name, err)
+	}
+
+	return &parentCloudProfile.Status, nil
+}
+
+// withoutUnsoakedVersions returns a copy of the given CloudProfile without the versions which were added less than
+// <soakTime> before <now>. Versions with an unknown age and the versions currently used by the Shoot are kept. If a
+// version used by the Shoot is expired or not offered anymore, no versions of the respective kind are removed to not
+// prevent forceful updates.
+func withoutUnsoakedVersions(cloudProfile *gardencorev1beta1.CloudProfile, status *gardencorev1beta1.CloudProfileStatus, shoot *gardencorev1beta1.Shoot, soakTime time.Duration, now time.Time) *gardencorev1beta1.CloudProfile {
+	var (
+		soakedCloudProfile       = cloudProfile.DeepCopy()
+		usedKubernetesVersions   = sets.New(shoot.Spec.Kubernetes.Version)
+		usedMachineImageVersions = make(map[string]sets.Set[string])
+	)
+
+	for _, worker := range shoot.Spec.Provider.Workers {
+		if worker.Kubernetes != nil && worker.Kubernetes.Version != nil {
+			usedKubernetesVersions.Insert(*worker.Kubernetes.Version)
+		}
+		if worker.Machine.Image != nil && worker.Machine.Image.Version != nil {
+			if _, ok := usedMachineImageVersions[worker.Machine.Image.Name]; !ok {
+				usedMachineImageVersions[worker.Machine.Image.Name] = sets.New[string]()
+			}
+			usedMachineImageVersions[worker.Machine.Image.Name].Insert(*worker.Machine.Image.Version)
+		}
+	}
+
+	if !anyKubernetesVersionExpired(soakedCloudProfile, usedKubernetesVersions) {
+		var kubernetesVersionStatuses []gardencorev1beta1.ExpirableVersionStatus
+		if status.Kubernetes != nil {
+			kubernetesVersionStatuses = status.Kubernetes.Versions
+		}
+
+		versions := soakedCloudProfile.Spec.Kubernetes.Versions
+		soakedCloudProfile.Spec.Kubernetes.Versions = nil
+		for _, version := range versions {
+			if usedKubernetesVersions.Has(version.Version) || isVersionSoaked(kubernetesVersionStatuses, version.Version, soakTime, now) {
+				soakedCloudProfile.Spec.Kubernetes.Versions = append(soakedCloudProfile.Spec.Kubernetes.Versions, version)
+			}
+		}
+	}
+
+	for i, machineImage := range soakedCloudProfile.Spec.MachineImages {
+		usedVersions := usedMachineImageVersions[machineImage.Name]
+		if anyMachineImageVersionExpired(machineImage, usedVersions) {
+			continue
+		}
+
+		var machineImageVersionStatuses []gardencorev1beta1.ExpirableVersionStatus
+		for _, machineImageStatus := range status.MachineImages {
+			if machineImageStatus.Name == machineImage.Name {
+				machineImageVersionStatuses = machineImageStatus.Versions
+			}
+		}
+
+		soakedCloudProfile.Spec.MachineImages[i].Versions = nil
+		for _, version := range machineImage.Versions {
+			if usedVersions.Has(version.Version) || isVersionSoaked(machineImageVersionStatuses, version.Version, soakTime, now) {
+				soakedCloudProfile.Spec.MachineImages[i].Versions = append(soakedCloudProfile.Spec.MachineImages[i].Versions, version)
+			}
+		}
+	}
+
+	return soakedCloudProfile
+}
+
+func isVersionSoaked(statuses []gardencorev1beta1.ExpirableVersionStatus, version string, soakTime time.Duration, now time.Time) bool {
+	for _, status := range statuses {
+		if status.Version == version {
+			return status.AddedTime == nil || !status.AddedTime.Add(soakTime).After(now)
+		}
+	}
+	return true
+}
+
+func anyKubernetesVersionExpired(cloudProfile *gardencorev1beta1.CloudProfile, versions sets.Set[string]) bool {
+	for version := range versions {
+		exists, expirableVersion, err := v1beta1helper.KubernetesVersionExistsInCloudProfile(cloudProfile, version)
+		if err != nil || !exists || v1beta1helper.CurrentLifecycleClassification(expirableVersion) == gardencorev1beta1.ClassificationExpired {
+			return true
+		}
+	}
+	return false
+}
+
+func anyMachineImageVersionExpired(machineImage gardencorev1beta1.MachineImage, versions sets.Set[string]) bool {
+	for version := range versions {
+		machineImageVersion, exists := v1beta1helper.FindMachineImageVersion([]gardencorev1beta1.MachineImage{machineImage}, machineImage.Name, version)
+		if !exists || v1beta1helper.CurrentLifecycleClassification(machineImageVersion.ExpirableVersion) == gardencorev1beta1.ClassificationExpired {
+			return true
+		}
+	}
+	return false
+}
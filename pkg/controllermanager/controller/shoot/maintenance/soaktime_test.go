@@ -0,0 +1,199 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package maintenance
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener/pkg/api/indexer"
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/controllermanager/v1alpha1"
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+)
+
+var _ = Describe("Soak time", func() {
+	var (
+		ctx        = context.TODO()
+		fakeClient client.Client
+		reconciler *Reconciler
+
+		project *gardencorev1beta1.Project
+		shoot   *gardencorev1beta1.Shoot
+
+		now = time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC)
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithStatusSubresource(&gardencorev1beta1.Shoot{}, &gardencorev1beta1.CloudProfile{}).
+			WithIndex(&gardencorev1beta1.Project{}, core.ProjectNamespace, indexer.ProjectNamespaceIndexerFunc).
+			Build()
+		reconciler = &Reconciler{
+			Client: fakeClient,
+			Clock:  testclock.NewFakeClock(now),
+			Config: controllermanagerconfigv1alpha1.ShootMaintenanceControllerConfiguration{
+				UpdateChannels: map[string]metav1.Duration{
+					"early":  {},
+					"stable": {Duration: 7 * 24 * time.Hour},
+				},
+			},
+		}
+
+		project = &gardencorev1beta1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: "dev"},
+			Spec: gardencorev1beta1.ProjectSpec{
+				Namespace:        new("garden-dev"),
+				AutoUpdatePolicy: &gardencorev1beta1.AutoUpdatePolicy{Channel: new(gardencorev1beta1.UpdateChannelStable)},
+			},
+		}
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-dev"},
+			Spec: gardencorev1beta1.ShootSpec{
+				CloudProfileName: new("profile"),
+				Kubernetes:       gardencorev1beta1.Kubernetes{Version: "1.33.1"},
+				Maintenance: &gardencorev1beta1.Maintenance{
+					AutoUpdate: &gardencorev1beta1.MaintenanceAutoUpdate{KubernetesVersion: true},
+					TimeWindow: &gardencorev1beta1.MaintenanceTimeWindow{Begin: "220000+0000", End: "230000+0000"},
+				},
+			},
+		}
+	})
+
+	Describe("#soakTime", func() {
+		It("should return zero if no auto-update policy is configured", func() {
+			Expect(reconciler.soakTime(ctx, shoot)).To(BeZero())
+		})
+
+		It("should use the update channel of the project", func() {
+			Expect(fakeClient.Create(ctx, project)).To(Succeed())
+
+			Expect(reconciler.soakTime(ctx, shoot)).To(Equal(7 * 24 * time.Hour))
+		})
+
+		It("should prefer the auto-update policy of the shoot", func() {
+			Expect(fakeClient.Create(ctx, project)).To(Succeed())
+			shoot.Spec.Maintenance.AutoUpdate.Policy = &gardencorev1beta1.AutoUpdatePolicy{SoakTime: &metav1.Duration{Duration: 72 * time.Hour}}
+
+			Expect(reconciler.soakTime(ctx, shoot)).To(Equal(72 * time.Hour))
+		})
+
+		It("should return zero for update channels unknown to the controller configuration", func() {
+			shoot.Spec.Maintenance.AutoUpdate.Policy = &gardencorev1beta1.AutoUpdatePolicy{Channel: new(gardencorev1beta1.UpdateChannel("nightly"))}
+
+			Expect(reconciler.soakTime(ctx, shoot)).To(BeZero())
+		})
+	})
+
+	Describe("#withoutUnsoakedVersions", func() {
+		var (
+			cloudProfile *gardencorev1beta1.CloudProfile
+			status       *gardencorev1beta1.CloudProfileStatus
+		)
+
+		BeforeEach(func() {
+			cloudProfile = &gardencorev1beta1.CloudProfile{
+				Spec: gardencorev1beta1.CloudProfileSpec{
+					Kubernetes: gardencorev1beta1.KubernetesSettings{
+						Versions: []gardencorev1beta1.ExpirableVersion{{Version: "1.33.1"}, {Version: "1.33.2"}, {Version: "1.33.3"}, {Version: "1.33.4"}},
+					},
+					MachineImages: []gardencorev1beta1.MachineImage{{
+						Name: "gardenlinux",
+						Versions: []gardencorev1beta1.MachineImageVersion{
+							{ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "1.0.0"}},
+							{ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "1.1.0"}},
+						},
+					}},
+				},
+			}
+			status = &gardencorev1beta1.CloudProfileStatus{
+				Kubernetes: &gardencorev1beta1.KubernetesStatus{Versions: []gardencorev1beta1.ExpirableVersionStatus{
+					{Version: "1.33.1", AddedTime: &metav1.Time{Time: now.Add(-30 * 24 * time.Hour)}},
+					{Version: "1.33.2", AddedTime: &metav1.Time{Time: now.Add(-8 * 24 * time.Hour)}},
+					{Version: "1.33.3", AddedTime: &metav1.Time{Time: now.Add(-24 * time.Hour)}},
+				}},
+				MachineImages: []gardencorev1beta1.MachineImageStatus{{
+					Name: "gardenlinux",
+					Versions: []gardencorev1beta1.ExpirableVersionStatus{
+						{Version: "1.0.0", AddedTime: &metav1.Time{Time: now.Add(-30 * 24 * time.Hour)}},
+						{Version: "1.1.0", AddedTime: &metav1.Time{Time: now.Add(-time.Hour)}},
+					},
+				}},
+			}
+			shoot.Spec.Provider.Workers = []gardencorev1beta1.Worker{{
+				Name:    "worker",
+				Machine: gardencorev1beta1.Machine{Image: &gardencorev1beta1.ShootMachineImage{Name: "gardenlinux", Version: new("1.0.0")}},
+			}}
+		})
+
+		It("should remove the versions which were added within the soak time", func() {
+			result := withoutUnsoakedVersions(cloudProfile, status, shoot, 7*24*time.Hour, now)
+
+			Expect(result.Spec.Kubernetes.Versions).To(Equal([]gardencorev1beta1.ExpirableVersion{{Version: "1.33.1"}, {Version: "1.33.2"}, {Version: "1.33.4"}}))
+			Expect(result.Spec.MachineImages[0].Versions).To(Equal([]gardencorev1beta1.MachineImageVersion{{ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "1.0.0"}}}))
+			Expect(cloudProfile.Spec.Kubernetes.Versions).To(HaveLen(4))
+		})
+
+		It("should keep the versions currently used by the shoot", func() {
+			shoot.Spec.Kubernetes.Version = "1.33.3"
+
+			result := withoutUnsoakedVersions(cloudProfile, status, shoot, 7*24*time.Hour, now)
+
+			Expect(result.Spec.Kubernetes.Versions).To(ContainElement(gardencorev1beta1.ExpirableVersion{Version: "1.33.3"}))
+		})
+
+		It("should not remove any versions if a version used by the shoot is expired", func() {
+			cloudProfile.Spec.Kubernetes.Versions[0].ExpirationDate = &metav1.Time{Time: time.Now().Add(-time.Hour)}
+			cloudProfile.Spec.MachineImages[0].Versions[0].ExpirationDate = &metav1.Time{Time: time.Now().Add(-time.Hour)}
+
+			result := withoutUnsoakedVersions(cloudProfile, status, shoot, 7*24*time.Hour, now)
+
+			Expect(result.Spec.Kubernetes.Versions).To(HaveLen(4))
+			Expect(result.Spec.MachineImages[0].Versions).To(HaveLen(2))
+		})
+	})
+
+	Describe("#updateMaintenancePreview", func() {
+		BeforeEach(func() {
+			cloudProfile := &gardencorev1beta1.CloudProfile{
+				ObjectMeta: metav1.ObjectMeta{Name: "profile"},
+				Spec: gardencorev1beta1.CloudProfileSpec{
+					Kubernetes: gardencorev1beta1.KubernetesSettings{
+						Versions: []gardencorev1beta1.ExpirableVersion{{Version: "1.33.1"}, {Version: "1.33.2"}, {Version: "1.33.3"}},
+					},
+				},
+			}
+			Expect(fakeClient.Create(ctx, cloudProfile)).To(Succeed())
+			cloudProfile.Status.Kubernetes = &gardencorev1beta1.KubernetesStatus{Versions: []gardencorev1beta1.ExpirableVersionStatus{
+				{Version: "1.33.1", AddedTime: &metav1.Time{Time: now.Add(-30 * 24 * time.Hour)}},
+				{Version: "1.33.2", AddedTime: &metav1.Time{Time: now.Add(-8 * 24 * time.Hour)}},
+				{Version: "1.33.3", AddedTime: &metav1.Time{Time: now.Add(-24 * time.Hour)}},
+			}}
+			Expect(fakeClient.Status().Update(ctx, cloudProfile)).To(Succeed())
+			Expect(fakeClient.Create(ctx, project)).To(Succeed())
+			Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+		})
+
+		It("should only consider the versions which were offered for the soak time of the update channel", func() {
+			Expect(reconciler.updateMaintenancePreview(ctx, logr.Discard(), shoot)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Status.MaintenancePreview.PlannedChanges).To(Equal([]string{
+				`Control Plane: Updated Kubernetes version from "1.33.1" to "1.33.2". Reason: Automatic update of Kubernetes version configured`,
+			}))
+		})
+	})
+})
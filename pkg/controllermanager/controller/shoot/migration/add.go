@@ -5,8 +5,10 @@
 package migration
 
 import (
+	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
@@ -14,11 +16,24 @@ import (
 
 	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/controllerutils"
+	predicateutils "github.com/gardener/gardener/pkg/controllerutils/predicate"
+	shootcontroller "github.com/gardener/gardener/pkg/scheduler/controller/shoot"
 )
 
-// ControllerName is the name of this controller.
-const ControllerName = "shoot-migration"
+const (
+	// ControllerName is the name of this controller.
+	ControllerName = "shoot-migration"
+	// EvacuationControllerName is the name of the controller evacuating control planes from unhealthy seeds.
+	EvacuationControllerName = "shoot-migration-evacuation"
+)
+
+// evacuationConditionTypes are the seed conditions whose outage triggers the evacuation of control planes.
+var evacuationConditionTypes = []gardencorev1beta1.ConditionType{
+	gardencorev1beta1.GardenletReady,
+	gardencorev1beta1.SeedSystemComponentsHealthy,
+}
 
 // AddToManager adds Reconciler to the given manager.
 func (r *Reconciler) AddToManager(mgr manager.Manager) error {
@@ -73,3 +88,53 @@ func (r *Reconciler) ShootPredicate() predicate.Predicate {
 		},
 	}
 }
+
+// AddToManager adds EvacuationReconciler to the given manager.
+func (r *EvacuationReconciler) AddToManager(mgr manager.Manager) error {
+	if r.Client == nil {
+		r.Client = mgr.GetClient()
+	}
+	if r.Clock == nil {
+		r.Clock = clock.RealClock{}
+	}
+	if r.Framework == nil {
+		fw, err := shootcontroller.NewFramework(r.SchedulerConfig)
+		if err != nil {
+			return err
+		}
+		r.Framework = fw
+	}
+
+	return builder.
+		ControllerManagedBy(mgr).
+		Named(EvacuationControllerName).
+		For(&gardencorev1beta1.Seed{}, builder.WithPredicates(r.SeedPredicate())).
+		WithOptions(controller.Options{
+			// Seeds are reconciled sequentially since the limit of concurrent evacuations applies across all seeds.
+			MaxConcurrentReconciles: 1,
+			ReconciliationTimeout:   controllerutils.DefaultReconciliationTimeout,
+		}).
+		Complete(r)
+}
+
+// SeedPredicate reacts on Seed events that indicate that the GardenletReady or SeedSystemComponentsHealthy condition
+// or the evacuation annotation changed.
+func (r *EvacuationReconciler) SeedPredicate() predicate.Predicate {
+	return predicate.Or(
+		predicateutils.RelevantConditionsChanged(
+			func(obj client.Object) []gardencorev1beta1.Condition {
+				seed, ok := obj.(*gardencorev1beta1.Seed)
+				if !ok {
+					return nil
+				}
+				return seed.Status.Conditions
+			},
+			evacuationConditionTypes...,
+		),
+		predicate.Funcs{
+			UpdateFunc: func(e event.UpdateEvent) bool {
+				return e.ObjectOld.GetAnnotations()[v1beta1constants.AnnotationSeedEvacuation] != e.ObjectNew.GetAnnotations()[v1beta1constants.AnnotationSeedEvacuation]
+			},
+		},
+	)
+}
@@ -7,6 +7,7 @@ package migration_test
 import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
@@ -106,4 +107,58 @@ var _ = Describe("Add", func() {
 			})
 		})
 	})
+
+	Describe("EvacuationReconciler", func() {
+		Describe("#SeedPredicate", func() {
+			var (
+				predicate predicate.Predicate
+				seed      *gardencorev1beta1.Seed
+			)
+
+			BeforeEach(func() {
+				predicate = (&EvacuationReconciler{}).SeedPredicate()
+				seed = &gardencorev1beta1.Seed{
+					Status: gardencorev1beta1.SeedStatus{
+						Conditions: []gardencorev1beta1.Condition{
+							{Type: gardencorev1beta1.GardenletReady, Status: gardencorev1beta1.ConditionTrue},
+							{Type: gardencorev1beta1.SeedSystemComponentsHealthy, Status: gardencorev1beta1.ConditionTrue},
+							{Type: gardencorev1beta1.SeedBackupBucketsReady, Status: gardencorev1beta1.ConditionTrue},
+						},
+					},
+				}
+			})
+
+			It("should return true for create events", func() {
+				Expect(predicate.Create(event.CreateEvent{Object: seed})).To(BeTrue())
+			})
+
+			It("should return false because no relevant condition changed", func() {
+				seedNew := seed.DeepCopy()
+				seedNew.Status.Conditions[2].Status = gardencorev1beta1.ConditionFalse
+
+				Expect(predicate.Update(event.UpdateEvent{ObjectNew: seedNew, ObjectOld: seed})).To(BeFalse())
+			})
+
+			It("should return true because the GardenletReady condition changed", func() {
+				seedNew := seed.DeepCopy()
+				seedNew.Status.Conditions[0].Status = gardencorev1beta1.ConditionUnknown
+
+				Expect(predicate.Update(event.UpdateEvent{ObjectNew: seedNew, ObjectOld: seed})).To(BeTrue())
+			})
+
+			It("should return true because the SeedSystemComponentsHealthy condition changed", func() {
+				seedNew := seed.DeepCopy()
+				seedNew.Status.Conditions[1].Status = gardencorev1beta1.ConditionFalse
+
+				Expect(predicate.Update(event.UpdateEvent{ObjectNew: seedNew, ObjectOld: seed})).To(BeTrue())
+			})
+
+			It("should return true because the evacuation annotation changed", func() {
+				seedNew := seed.DeepCopy()
+				metav1.SetMetaDataAnnotation(&seedNew.ObjectMeta, "seed.gardener.cloud/evacuation", "enabled")
+
+				Expect(predicate.Update(event.UpdateEvent{ObjectNew: seedNew, ObjectOld: seed})).To(BeTrue())
+			})
+		})
+	})
 })
@@ -0,0 +1,390 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package migration
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/go-logr/logr"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/controllermanager/v1alpha1"
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/scheduler/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	shootcontroller "github.com/gardener/gardener/pkg/scheduler/controller/shoot"
+	"github.com/gardener/gardener/pkg/scheduler/framework"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+// EvacuationReconciler reconciles Seeds whose GardenletReady or SeedSystemComponentsHealthy condition indicates an
+// outage. Once the outage lasts longer than the configured duration, it migrates the control planes of the shoots with
+// recent backups to other seeds, given that operators enabled the evacuation for the seed.
+type EvacuationReconciler struct {
+	Client          client.Client
+	Config          controllermanagerconfigv1alpha1.ShootEvacuationConfiguration
+	Clock           clock.Clock
+	SchedulerConfig *schedulerconfigv1alpha1.ShootSchedulerConfiguration
+	Framework       *framework.Framework
+}
+
+// Reconcile evacuates the control planes of the shoots from a seed with a long-lasting outage.
+func (r *EvacuationReconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	seed := &gardencorev1beta1.Seed{}
+	if err := r.Client.Get(ctx, request.NamespacedName, seed); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	shootList := &gardencorev1beta1.ShootList{}
+	if err := r.Client.List(ctx, shootList); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed listing shoots: %w", err)
+	}
+
+	var (
+		shoots     = v1beta1helper.ConvertShootList(shootList.Items)
+		candidates []*gardencorev1beta1.Shoot
+
+		evacuating, evacuatingFromSeed int
+	)
+
+	for _, shoot := range shoots {
+		sourceSeedName, ok := shoot.Annotations[v1beta1constants.ShootEvacuatedFromSeed]
+		if !ok {
+			if ptr.Deref(shoot.Spec.SeedName, "") == seed.Name && ptr.Deref(shoot.Status.SeedName, "") == seed.Name && shoot.DeletionTimestamp == nil {
+				candidates = append(candidates, shoot)
+			}
+			continue
+		}
+
+		if sourceSeedName == seed.Name && evacuationFinished(shoot, seed.Name) {
+			log.Info("Control plane of shoot was evacuated successfully, removing annotation", "shoot", client.ObjectKeyFromObject(shoot), "seed", shoot.Spec.SeedName)
+			if err := r.removeEvacuationAnnotation(ctx, shoot); err != nil {
+				return reconcile.Result{}, err
+			}
+			continue
+		}
+
+		evacuating++
+		if sourceSeedName == seed.Name {
+			evacuatingFromSeed++
+		}
+	}
+
+	var (
+		evacuationMode = seed.Annotations[v1beta1constants.AnnotationSeedEvacuation]
+		outageStart    = outageStartTime(seed)
+	)
+
+	if outageStart == nil || (evacuationMode != v1beta1constants.SeedEvacuationEnabled && evacuationMode != v1beta1constants.SeedEvacuationForced) {
+		if outageStart != nil {
+			log.Info("Seed has an outage but the evacuation of its control planes is not enabled", "annotation", v1beta1constants.AnnotationSeedEvacuation)
+		}
+		if evacuatingFromSeed > 0 {
+			return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+		}
+		return reconcile.Result{}, nil
+	}
+
+	if remaining := outageStart.Add(r.Config.OutageDuration.Duration).Sub(r.Clock.Now()); remaining > 0 {
+		log.V(1).Info("Outage of seed does not last long enough for evacuating control planes yet", "outageStart", outageStart.Time, "remaining", remaining)
+		return reconcile.Result{RequeueAfter: remaining}, nil
+	}
+
+	// Without a ready gardenlet, the `Migrate` operation cannot be executed and the old control planes cannot be shut
+	// down. Hence, the restoration on other seeds is only forced if operators explicitly allowed it and if the gardenlet
+	// indeed stopped renewing its lease.
+	forceRestore := !isConditionTrue(seed, gardencorev1beta1.GardenletReady)
+	if forceRestore {
+		if evacuationMode != v1beta1constants.SeedEvacuationForced {
+			log.Info("Gardenlet of seed is not ready, evacuating control planes requires forcing their restoration which is not enabled", "annotation", v1beta1constants.AnnotationSeedEvacuation)
+			return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+		}
+
+		renewed, err := r.gardenletLeaseRenewedSince(ctx, seed)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		if renewed {
+			log.Info("Gardenlet of seed is not ready but still renews its lease, not forcing the restoration of control planes")
+			return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+		}
+	}
+
+	if len(candidates) == 0 {
+		return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+	}
+
+	seedList := &gardencorev1beta1.SeedList{}
+	if err := r.Client.List(ctx, seedList); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed listing seeds: %w", err)
+	}
+
+	slices.SortFunc(candidates, func(a, b *gardencorev1beta1.Shoot) int {
+		return cmp.Or(cmp.Compare(a.Namespace, b.Namespace), cmp.Compare(a.Name, b.Name))
+	})
+
+	seedUsage := v1beta1helper.CalculateSeedUsage(shoots)
+
+	for _, shoot := range candidates {
+		if evacuating >= ptr.Deref(r.Config.MaxConcurrentEvacuations, 0) {
+			log.Info("Maximum number of concurrent evacuations reached, postponing remaining evacuations", "maxConcurrentEvacuations", ptr.Deref(r.Config.MaxConcurrentEvacuations, 0))
+			break
+		}
+
+		shootLog := log.WithValues("shoot", client.ObjectKeyFromObject(shoot))
+
+		backupEntry, err := r.getBackupEntry(ctx, shoot)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		if backupEntry == nil || backupEntry.Status.Usage == nil || backupEntry.Status.Usage.LastBackupTime == nil {
+			shootLog.Info("Skipping evacuation of control plane since time of last backup is unknown")
+			continue
+		}
+		if age := outageStart.Sub(backupEntry.Status.Usage.LastBackupTime.Time); age > r.Config.MaxBackupAge.Duration {
+			shootLog.Info("Skipping evacuation of control plane since last backup is too old", "lastBackupTime", backupEntry.Status.Usage.LastBackupTime.Time, "outageStart", outageStart.Time)
+			continue
+		}
+
+		targetSeed, err := r.findTargetSeed(ctx, shootLog, shoot, seed, seedList.Items, shoots, seedUsage)
+		if err != nil {
+			shootLog.Info("Skipping evacuation of control plane since no target seed was found", "reason", err.Error())
+			continue
+		}
+
+		evacuated, err := r.evacuate(ctx, shootLog, shoot, backupEntry, seed.Name, targetSeed.Name, forceRestore)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		if !evacuated {
+			continue
+		}
+
+		// Account for the started evacuation so that the following shoots are scheduled against the resulting placement.
+		seedUsage[seed.Name]--
+		seedUsage[targetSeed.Name]++
+		evacuating++
+	}
+
+	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+}
+
+// evacuate changes the seed of the shoot to the target seed. If the gardenlet of the source seed is not ready, the
+// `Migrate` operation cannot be executed, hence the restoration of the control plane on the target seed is forced. It
+// returns false if the change of the seed was rejected.
+func (r *EvacuationReconciler) evacuate(
+	ctx context.Context,
+	log logr.Logger,
+	shoot *gardencorev1beta1.Shoot,
+	backupEntry *gardencorev1beta1.BackupEntry,
+	sourceSeedName, targetSeedName string,
+	forceRestore bool,
+) (
+	bool,
+	error,
+) {
+	log.Info("Evacuating control plane of shoot", "sourceSeed", sourceSeedName, "targetSeed", targetSeedName, "forceRestore", forceRestore)
+
+	patch := client.MergeFrom(shoot.DeepCopy())
+	metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.ShootEvacuatedFromSeed, sourceSeedName)
+	if err := r.Client.Patch(ctx, shoot, patch); err != nil {
+		return false, fmt.Errorf("failed adding evacuation annotation to shoot: %w", err)
+	}
+
+	shoot.Spec.SeedName = &targetSeedName
+	if err := r.Client.SubResource("binding").Update(ctx, shoot); err != nil {
+		if !apierrors.IsInvalid(err) && !apierrors.IsForbidden(err) {
+			return false, fmt.Errorf("failed changing seed of shoot: %w", err)
+		}
+
+		log.Info("Changing the seed of the shoot was rejected", "reason", err.Error())
+		if err := r.Client.Get(ctx, client.ObjectKeyFromObject(shoot), shoot); err != nil {
+			return false, fmt.Errorf("failed reading shoot: %w", err)
+		}
+		return false, r.removeEvacuationAnnotation(ctx, shoot)
+	}
+
+	if !forceRestore {
+		return true, nil
+	}
+
+	// The gardenlet of the source seed would usually set `.status.seedName` to nil after the `Migrate` operation
+	// succeeded. Since it is not ready, the gardenlet of the target seed is made responsible right away so that it
+	// restores the control plane from the backup.
+	patch = client.MergeFrom(shoot.DeepCopy())
+	shoot.Status.SeedName = nil
+	shoot.Status.LastOperation = &gardencorev1beta1.LastOperation{
+		Type:           gardencorev1beta1.LastOperationTypeMigrate,
+		State:          gardencorev1beta1.LastOperationStateAborted,
+		Description:    fmt.Sprintf("Migration of the control plane was forced since the gardenlet of seed %s is not ready.", sourceSeedName),
+		LastUpdateTime: metav1.NewTime(r.Clock.Now()),
+	}
+	if err := r.Client.Status().Patch(ctx, shoot, patch); err != nil {
+		return false, fmt.Errorf("failed forcing restoration of shoot: %w", err)
+	}
+
+	if ptr.Deref(backupEntry.Status.SeedName, "") == sourceSeedName {
+		patch = client.MergeFrom(backupEntry.DeepCopy())
+		backupEntry.Status.SeedName = nil
+		if err := r.Client.Status().Patch(ctx, backupEntry, patch); err != nil {
+			return false, fmt.Errorf("failed forcing restoration of backup entry %s: %w", client.ObjectKeyFromObject(backupEntry), err)
+		}
+	}
+
+	return true, nil
+}
+
+func (r *EvacuationReconciler) removeEvacuationAnnotation(ctx context.Context, shoot *gardencorev1beta1.Shoot) error {
+	patch := client.MergeFrom(shoot.DeepCopy())
+	delete(shoot.Annotations, v1beta1constants.ShootEvacuatedFromSeed)
+	if err := r.Client.Patch(ctx, shoot, patch); err != nil {
+		return fmt.Errorf("failed removing evacuation annotation from shoot %s: %w", client.ObjectKeyFromObject(shoot), err)
+	}
+	return nil
+}
+
+func (r *EvacuationReconciler) getBackupEntry(ctx context.Context, shoot *gardencorev1beta1.Shoot) (*gardencorev1beta1.BackupEntry, error) {
+	if shoot.Status.TechnicalID == "" {
+		return nil, nil
+	}
+
+	name, err := gardenerutils.GenerateBackupEntryName(shoot.Status.TechnicalID, shoot.Status.UID, shoot.UID)
+	if err != nil {
+		return nil, err
+	}
+
+	backupEntry := &gardencorev1beta1.BackupEntry{}
+	if err := r.Client.Get(ctx, client.ObjectKey{Namespace: shoot.Namespace, Name: name}, backupEntry); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed reading backup entry of shoot %s: %w", client.ObjectKeyFromObject(shoot), err)
+	}
+
+	return backupEntry, nil
+}
+
+// findTargetSeed runs the scheduler for the shoot among the seeds its control plane can be migrated to and returns the
+// seed with the highest score.
+func (r *EvacuationReconciler) findTargetSeed(
+	ctx context.Context,
+	log logr.Logger,
+	shoot *gardencorev1beta1.Shoot,
+	sourceSeed *gardencorev1beta1.Seed,
+	seeds []gardencorev1beta1.Seed,
+	shoots []*gardencorev1beta1.Shoot,
+	seedUsage map[string]int,
+) (
+	*gardencorev1beta1.Seed,
+	error,
+) {
+	candidates := slices.DeleteFunc(slices.Clone(seeds), func(seed gardencorev1beta1.Seed) bool {
+		return seed.Name == sourceSeed.Name || !v1beta1helper.ControlPlaneMigrationPossible(sourceSeed, &seed)
+	})
+	if len(candidates) == 0 {
+		return nil, fmt.Errorf("none of the seeds supports a control plane migration from seed %s (backup configured and same internal domain)", sourceSeed.Name)
+	}
+
+	cloudProfile, err := gardenerutils.GetCloudProfile(ctx, r.Client, shoot)
+	if err != nil {
+		return nil, err
+	}
+	project, err := gardenerutils.ProjectForNamespaceFromReader(ctx, r.Client, shoot.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	regionConfig, err := shootcontroller.GetRegionConfig(ctx, log, r.Client, v1beta1constants.GardenNamespace, cloudProfile.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	state := &framework.CycleState{
+		Log:          log,
+		CloudProfile: cloudProfile,
+		Project:      project,
+		RegionConfig: regionConfig,
+		Shoots:       shoots,
+		SeedUsage:    seedUsage,
+	}
+
+	return r.Framework.Schedule(ctx, state, shoot, candidates)
+}
+
+// evacuationFinished returns true if the control plane of the shoot was restored successfully on a seed other than the
+// source seed.
+func evacuationFinished(shoot *gardencorev1beta1.Shoot, sourceSeedName string) bool {
+	lastOperation := shoot.Status.LastOperation
+
+	return shoot.Status.SeedName != nil &&
+		ptr.Equal(shoot.Spec.SeedName, shoot.Status.SeedName) &&
+		*shoot.Status.SeedName != sourceSeedName &&
+		lastOperation != nil &&
+		lastOperation.Type != gardencorev1beta1.LastOperationTypeMigrate &&
+		lastOperation.State == gardencorev1beta1.LastOperationStateSucceeded
+}
+
+// gardenletLeaseRenewedSince returns true if the gardenlet of the seed renewed its lease after the GardenletReady
+// condition changed its status.
+func (r *EvacuationReconciler) gardenletLeaseRenewedSince(ctx context.Context, seed *gardencorev1beta1.Seed) (bool, error) {
+	lease := &coordinationv1.Lease{}
+	if err := r.Client.Get(ctx, client.ObjectKey{Namespace: gardencorev1beta1.GardenerSeedLeaseNamespace, Name: seed.Name}, lease); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed reading lease of gardenlet: %w", err)
+	}
+
+	condition := v1beta1helper.GetCondition(seed.Status.Conditions, gardencorev1beta1.GardenletReady)
+	return lease.Spec.RenewTime != nil && (condition == nil || lease.Spec.RenewTime.After(condition.LastTransitionTime.Time)), nil
+}
+
+// outageStartTime returns the earliest transition time of the conditions relevant for the evacuation which indicate an
+// outage, or nil if there is no outage. The SeedSystemComponentsHealthy condition only indicates an outage if it is
+// `False`, since it is `Unknown` if the health of the system components could not be determined.
+func outageStartTime(seed *gardencorev1beta1.Seed) *metav1.Time {
+	var start *metav1.Time
+
+	for _, conditionType := range evacuationConditionTypes {
+		condition := v1beta1helper.GetCondition(seed.Status.Conditions, conditionType)
+		if condition == nil || !indicatesOutage(condition) {
+			continue
+		}
+
+		if start == nil || condition.LastTransitionTime.Before(start) {
+			start = condition.LastTransitionTime.DeepCopy()
+		}
+	}
+
+	return start
+}
+
+func indicatesOutage(condition *gardencorev1beta1.Condition) bool {
+	if condition.Type == gardencorev1beta1.SeedSystemComponentsHealthy {
+		return condition.Status == gardencorev1beta1.ConditionFalse
+	}
+	return condition.Status == gardencorev1beta1.ConditionFalse || condition.Status == gardencorev1beta1.ConditionUnknown
+}
+
+func isConditionTrue(seed *gardencorev1beta1.Seed, conditionType gardencorev1beta1.ConditionType) bool {
+	condition := v1beta1helper.GetCondition(seed.Status.Conditions, conditionType)
+	return condition != nil && condition.Status == gardencorev1beta1.ConditionTrue
+}
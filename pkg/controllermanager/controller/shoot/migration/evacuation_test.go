@@ -0,0 +1,336 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package migration_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	testclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/api/indexer"
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/controllermanager/v1alpha1"
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/scheduler/v1alpha1"
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/shoot/migration"
+	shootcontroller "github.com/gardener/gardener/pkg/scheduler/controller/shoot"
+	"github.com/gardener/gardener/pkg/scheduler/framework"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+var _ = Describe("EvacuationReconciler", func() {
+	var (
+		ctx        = context.TODO()
+		fakeClient client.Client
+		fakeClock  *testclock.FakeClock
+		reconciler *EvacuationReconciler
+		bindings   []string
+
+		namespace   = "garden-dev"
+		now         = time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+		outageStart = metav1.NewTime(now.Add(-2 * time.Hour))
+		seed        *gardencorev1beta1.Seed
+		otherSeed   *gardencorev1beta1.Seed
+		request     reconcile.Request
+	)
+
+	newSeed := func(name string) *gardencorev1beta1.Seed {
+		return &gardencorev1beta1.Seed{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: gardencorev1beta1.SeedSpec{
+				Backup: &gardencorev1beta1.Backup{Provider: "local"},
+				DNS:    gardencorev1beta1.SeedDNS{Internal: &gardencorev1beta1.SeedDNSProviderConfig{Domain: "internal.example.com"}},
+			},
+			Status: gardencorev1beta1.SeedStatus{
+				Conditions: []gardencorev1beta1.Condition{
+					{Type: gardencorev1beta1.GardenletReady, Status: gardencorev1beta1.ConditionTrue},
+					{Type: gardencorev1beta1.SeedSystemComponentsHealthy, Status: gardencorev1beta1.ConditionTrue},
+				},
+			},
+		}
+	}
+
+	setCondition := func(conditionType gardencorev1beta1.ConditionType, status gardencorev1beta1.ConditionStatus) {
+		for i := range seed.Status.Conditions {
+			if seed.Status.Conditions[i].Type == conditionType {
+				seed.Status.Conditions[i].Status = status
+				seed.Status.Conditions[i].LastTransitionTime = outageStart
+			}
+		}
+	}
+
+	// createShoot creates a shoot on the given seed and its backup entry with the given time of the last backup.
+	createShoot := func(name, seedName string, lastBackupTime *time.Time) *gardencorev1beta1.Shoot {
+		shoot := &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec: gardencorev1beta1.ShootSpec{
+				CloudProfileName: new("cloudprofile"),
+				SeedName:         &seedName,
+			},
+		}
+		Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+
+		shoot.Status = gardencorev1beta1.ShootStatus{
+			SeedName:      &seedName,
+			TechnicalID:   "shoot--dev--" + name,
+			UID:           types.UID(name + "-uid"),
+			LastOperation: &gardencorev1beta1.LastOperation{Type: gardencorev1beta1.LastOperationTypeReconcile, State: gardencorev1beta1.LastOperationStateSucceeded},
+		}
+		Expect(fakeClient.Status().Update(ctx, shoot)).To(Succeed())
+
+		backupEntryName, err := gardenerutils.GenerateBackupEntryName(shoot.Status.TechnicalID, shoot.Status.UID, shoot.UID)
+		Expect(err).NotTo(HaveOccurred())
+
+		backupEntry := &gardencorev1beta1.BackupEntry{
+			ObjectMeta: metav1.ObjectMeta{Name: backupEntryName, Namespace: namespace},
+			Spec:       gardencorev1beta1.BackupEntrySpec{SeedName: &seedName},
+		}
+		Expect(fakeClient.Create(ctx, backupEntry)).To(Succeed())
+
+		backupEntry.Status.SeedName = &seedName
+		if lastBackupTime != nil {
+			backupEntry.Status.Usage = &gardencorev1beta1.BackupEntryUsage{LastBackupTime: &metav1.Time{Time: *lastBackupTime}}
+		}
+		Expect(fakeClient.Status().Update(ctx, backupEntry)).To(Succeed())
+
+		return shoot
+	}
+
+	createShoots := func(count int, lastBackupTime time.Time) {
+		for i := range count {
+			createShoot(fmt.Sprintf("shoot-%d", i), seed.Name, &lastBackupTime)
+		}
+	}
+
+	getShoot := func(name string) *gardencorev1beta1.Shoot {
+		shoot := &gardencorev1beta1.Shoot{}
+		Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, shoot)).To(Succeed())
+		return shoot
+	}
+
+	BeforeEach(func() {
+		bindings = nil
+		fakeClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithStatusSubresource(&gardencorev1beta1.Seed{}, &gardencorev1beta1.Shoot{}, &gardencorev1beta1.BackupEntry{}).
+			WithIndex(&gardencorev1beta1.Project{}, core.ProjectNamespace, indexer.ProjectNamespaceIndexerFunc).
+			WithInterceptorFuncs(interceptor.Funcs{
+				SubResourceUpdate: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, _ ...client.SubResourceUpdateOption) error {
+					Expect(subResourceName).To(Equal("binding"))
+					bindings = append(bindings, client.ObjectKeyFromObject(obj).String()+"->"+*obj.(*gardencorev1beta1.Shoot).Spec.SeedName)
+					return c.Update(ctx, obj)
+				},
+			}).
+			Build()
+		fakeClock = testclock.NewFakeClock(now)
+
+		fw, err := framework.New(
+			shootcontroller.NewRegistry(&schedulerconfigv1alpha1.ShootSchedulerConfiguration{}),
+			nil,
+			[]schedulerconfigv1alpha1.Plugin{{Name: shootcontroller.PluginNameLeastShootsDeployed}},
+		)
+		Expect(err).NotTo(HaveOccurred())
+
+		reconciler = &EvacuationReconciler{
+			Client:    fakeClient,
+			Clock:     fakeClock,
+			Framework: fw,
+			Config: controllermanagerconfigv1alpha1.ShootEvacuationConfiguration{
+				SyncPeriod:               &metav1.Duration{Duration: time.Minute},
+				OutageDuration:           &metav1.Duration{Duration: time.Hour},
+				MaxBackupAge:             &metav1.Duration{Duration: time.Hour},
+				MaxConcurrentEvacuations: new(2),
+			},
+		}
+
+		Expect(fakeClient.Create(ctx, &gardencorev1beta1.CloudProfile{ObjectMeta: metav1.ObjectMeta{Name: "cloudprofile"}})).To(Succeed())
+		Expect(fakeClient.Create(ctx, &gardencorev1beta1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: "dev"},
+			Spec:       gardencorev1beta1.ProjectSpec{Namespace: &namespace},
+		})).To(Succeed())
+
+		seed = newSeed("seed-a")
+		seed.Annotations = map[string]string{"seed.gardener.cloud/evacuation": "enabled"}
+		otherSeed = newSeed("seed-b")
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(seed)}
+	})
+
+	JustBeforeEach(func() {
+		for _, s := range []*gardencorev1beta1.Seed{seed, otherSeed} {
+			status := s.Status
+			Expect(fakeClient.Create(ctx, s)).To(Succeed())
+			s.Status = status
+			Expect(fakeClient.Status().Update(ctx, s)).To(Succeed())
+		}
+	})
+
+	It("should do nothing if the seed is gone", func() {
+		Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKey{Name: "foo"}})).To(Equal(reconcile.Result{}))
+	})
+
+	It("should not evacuate shoots from a healthy seed", func() {
+		createShoots(1, now.Add(-time.Hour))
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(bindings).To(BeEmpty())
+	})
+
+	Context("seed system components are unhealthy", func() {
+		BeforeEach(func() {
+			setCondition(gardencorev1beta1.SeedSystemComponentsHealthy, gardencorev1beta1.ConditionFalse)
+		})
+
+		It("should not evacuate shoots if the evacuation is not enabled for the seed", func() {
+			delete(seed.Annotations, "seed.gardener.cloud/evacuation")
+			Expect(fakeClient.Update(ctx, seed)).To(Succeed())
+			createShoots(1, now.Add(-time.Hour))
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+			Expect(bindings).To(BeEmpty())
+		})
+
+		It("should wait until the outage lasts long enough", func() {
+			reconciler.Config.OutageDuration = &metav1.Duration{Duration: 3 * time.Hour}
+			createShoots(1, now.Add(-time.Hour))
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+			Expect(bindings).To(BeEmpty())
+		})
+
+		It("should evacuate the shoots with recent backups", func() {
+			createShoot("recent", seed.Name, new(outageStart.Add(-30*time.Minute)))
+			createShoot("outdated", seed.Name, new(outageStart.Add(-2*time.Hour)))
+			createShoot("unknown", seed.Name, nil)
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+			Expect(bindings).To(ConsistOf("garden-dev/recent->seed-b"))
+
+			shoot := getShoot("recent")
+			Expect(shoot.Annotations).To(HaveKeyWithValue("shoot.gardener.cloud/evacuated-from-seed", "seed-a"))
+			Expect(shoot.Status.SeedName).To(PointTo(Equal("seed-a")))
+			Expect(shoot.Status.LastOperation.Type).To(Equal(gardencorev1beta1.LastOperationTypeReconcile))
+		})
+
+		It("should not evacuate more shoots than allowed at the same time", func() {
+			shoot := createShoot("evacuating", otherSeed.Name, nil)
+			metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "shoot.gardener.cloud/evacuated-from-seed", "seed-c")
+			Expect(fakeClient.Update(ctx, shoot)).To(Succeed())
+			createShoots(3, now.Add(-time.Hour))
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+			Expect(bindings).To(ConsistOf("garden-dev/shoot-0->seed-b"))
+		})
+
+		It("should not evacuate shoots if no target seed is available", func() {
+			otherSeed.Spec.Backup = nil
+			Expect(fakeClient.Update(ctx, otherSeed)).To(Succeed())
+			createShoots(1, now.Add(-time.Hour))
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+			Expect(bindings).To(BeEmpty())
+			Expect(getShoot("shoot-0").Annotations).To(BeEmpty())
+		})
+	})
+
+	It("should not evacuate shoots if the system components health is unknown", func() {
+		setCondition(gardencorev1beta1.SeedSystemComponentsHealthy, gardencorev1beta1.ConditionUnknown)
+		Expect(fakeClient.Status().Update(ctx, seed)).To(Succeed())
+		createShoots(1, now.Add(-time.Hour))
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(bindings).To(BeEmpty())
+	})
+
+	Context("gardenlet is not ready", func() {
+		var lease *coordinationv1.Lease
+
+		BeforeEach(func() {
+			setCondition(gardencorev1beta1.GardenletReady, gardencorev1beta1.ConditionUnknown)
+			seed.Annotations["seed.gardener.cloud/evacuation"] = "forced"
+
+			lease = &coordinationv1.Lease{
+				ObjectMeta: metav1.ObjectMeta{Name: seed.Name, Namespace: "gardener-system-seed-lease"},
+				Spec:       coordinationv1.LeaseSpec{RenewTime: &metav1.MicroTime{Time: outageStart.Add(-time.Minute)}},
+			}
+		})
+
+		JustBeforeEach(func() {
+			Expect(fakeClient.Create(ctx, lease)).To(Succeed())
+		})
+
+		It("should not force the restoration if it is not enabled for the seed", func() {
+			seed.Annotations["seed.gardener.cloud/evacuation"] = "enabled"
+			Expect(fakeClient.Update(ctx, seed)).To(Succeed())
+			createShoots(1, now.Add(-2*time.Hour))
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+			Expect(bindings).To(BeEmpty())
+		})
+
+		It("should not force the restoration if the gardenlet still renews its lease", func() {
+			lease.Spec.RenewTime = &metav1.MicroTime{Time: now}
+			Expect(fakeClient.Update(ctx, lease)).To(Succeed())
+			createShoots(1, now.Add(-2*time.Hour))
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+			Expect(bindings).To(BeEmpty())
+		})
+
+		It("should force the restoration of the control plane on the target seed", func() {
+			shoot := createShoot("shoot", seed.Name, new(now.Add(-2*time.Hour)))
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+			Expect(bindings).To(ConsistOf("garden-dev/shoot->seed-b"))
+
+			shoot = getShoot("shoot")
+			Expect(shoot.Annotations).To(HaveKeyWithValue("shoot.gardener.cloud/evacuated-from-seed", "seed-a"))
+			Expect(shoot.Spec.SeedName).To(PointTo(Equal("seed-b")))
+			Expect(shoot.Status.SeedName).To(BeNil())
+			Expect(shoot.Status.LastOperation.Type).To(Equal(gardencorev1beta1.LastOperationTypeMigrate))
+			Expect(shoot.Status.LastOperation.State).To(Equal(gardencorev1beta1.LastOperationStateAborted))
+
+			backupEntryName, err := gardenerutils.GenerateBackupEntryName(shoot.Status.TechnicalID, shoot.Status.UID, shoot.UID)
+			Expect(err).NotTo(HaveOccurred())
+			backupEntry := &gardencorev1beta1.BackupEntry{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: backupEntryName}, backupEntry)).To(Succeed())
+			Expect(backupEntry.Status.SeedName).To(BeNil())
+		})
+	})
+
+	It("should remove the annotation once the evacuation finished", func() {
+		shoot := createShoot("shoot", otherSeed.Name, nil)
+		metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "shoot.gardener.cloud/evacuated-from-seed", seed.Name)
+		Expect(fakeClient.Update(ctx, shoot)).To(Succeed())
+		shoot.Status.LastOperation = &gardencorev1beta1.LastOperation{Type: gardencorev1beta1.LastOperationTypeRestore, State: gardencorev1beta1.LastOperationStateSucceeded}
+		Expect(fakeClient.Status().Update(ctx, shoot)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(getShoot("shoot").Annotations).NotTo(HaveKey("shoot.gardener.cloud/evacuated-from-seed"))
+	})
+
+	It("should keep the annotation while the evacuation is in progress", func() {
+		shoot := createShoot("shoot", otherSeed.Name, nil)
+		metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "shoot.gardener.cloud/evacuated-from-seed", seed.Name)
+		Expect(fakeClient.Update(ctx, shoot)).To(Succeed())
+		shoot.Status.LastOperation = &gardencorev1beta1.LastOperation{Type: gardencorev1beta1.LastOperationTypeRestore, State: gardencorev1beta1.LastOperationStateProcessing}
+		Expect(fakeClient.Status().Update(ctx, shoot)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+		Expect(getShoot("shoot").Annotations).To(HaveKeyWithValue("shoot.gardener.cloud/evacuated-from-seed", "seed-a"))
+	})
+})
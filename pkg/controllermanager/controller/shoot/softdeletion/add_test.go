@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package softdeletion_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/shoot/softdeletion"
+)
+
+var _ = Describe("Add", func() {
+	var reconciler *Reconciler
+
+	BeforeEach(func() {
+		reconciler = &Reconciler{}
+	})
+
+	Describe("ShootPredicate", func() {
+		var (
+			p            predicate.Predicate
+			shoot        *gardencorev1beta1.Shoot
+			pendingShoot *gardencorev1beta1.Shoot
+		)
+
+		BeforeEach(func() {
+			p = reconciler.ShootPredicate()
+			shoot = &gardencorev1beta1.Shoot{}
+			pendingShoot = shoot.DeepCopy()
+			metav1.SetMetaDataAnnotation(&pendingShoot.ObjectMeta, v1beta1constants.ShootPendingDeletionUntil, "2026-01-01T00:00:00Z")
+		})
+
+		Describe("#Create", func() {
+			It("should return false because object is no shoot", func() {
+				Expect(p.Create(event.CreateEvent{})).To(BeFalse())
+			})
+
+			It("should return false because shoot is not pending deletion", func() {
+				Expect(p.Create(event.CreateEvent{Object: shoot})).To(BeFalse())
+			})
+
+			It("should return true because shoot is pending deletion", func() {
+				Expect(p.Create(event.CreateEvent{Object: pendingShoot})).To(BeTrue())
+			})
+		})
+
+		Describe("#Update", func() {
+			It("should return false because new object is no shoot", func() {
+				Expect(p.Update(event.UpdateEvent{})).To(BeFalse())
+			})
+
+			It("should return false because shoot is not pending deletion", func() {
+				Expect(p.Update(event.UpdateEvent{ObjectOld: pendingShoot, ObjectNew: shoot})).To(BeFalse())
+			})
+
+			It("should return true because shoot is pending deletion", func() {
+				Expect(p.Update(event.UpdateEvent{ObjectOld: shoot, ObjectNew: pendingShoot})).To(BeTrue())
+			})
+		})
+
+		Describe("#Delete", func() {
+			It("should return false", func() {
+				Expect(p.Delete(event.DeleteEvent{})).To(BeFalse())
+			})
+		})
+
+		Describe("#Generic", func() {
+			It("should return false", func() {
+				Expect(p.Generic(event.GenericEvent{})).To(BeFalse())
+			})
+		})
+	})
+})
@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package softdeletion
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/controllermanager/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+// recheckInterval is the interval after which a shoot is checked again after it was deleted. The gardener-apiserver
+// keeps the shoot pending deletion if its clock is behind, hence the deletion is retried until it succeeds.
+const recheckInterval = time.Minute
+
+// Reconciler reconciles Shoots which are pending deletion and deletes them once their grace period has passed.
+type Reconciler struct {
+	Client client.Client
+	Config controllermanagerconfigv1alpha1.ShootSoftDeletionControllerConfiguration
+	Clock  clock.Clock
+}
+
+// Reconcile reconciles Shoots which are pending deletion and deletes them once their grace period has passed.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	shoot := &gardencorev1beta1.Shoot{}
+	if err := r.Client.Get(ctx, request.NamespacedName, shoot); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if shoot.DeletionTimestamp != nil || !v1beta1helper.IsShootPendingDeletion(shoot) {
+		return reconcile.Result{}, nil
+	}
+
+	until, err := v1beta1helper.GetShootPendingDeletionUntil(shoot)
+	if err != nil {
+		log.Error(err, "Invalid pending deletion deadline, stopping reconciliation")
+		return reconcile.Result{}, nil
+	}
+
+	if now := r.Clock.Now(); now.Before(until) {
+		requeueAfter := until.Sub(now)
+		log.V(1).Info("Grace period of shoot deletion has not passed yet, requeuing", "requeueAfter", requeueAfter.Round(time.Second))
+		return reconcile.Result{RequeueAfter: requeueAfter}, nil
+	}
+
+	log.Info("Grace period of shoot deletion has passed, deleting shoot")
+
+	if err := gardenerutils.ConfirmDeletion(ctx, r.Client, shoot); err != nil {
+		if apierrors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("failed confirming deletion of shoot: %w", err)
+	}
+
+	if err := r.Client.Delete(ctx, shoot); client.IgnoreNotFound(err) != nil {
+		return reconcile.Result{}, fmt.Errorf("failed deleting shoot: %w", err)
+	}
+
+	return reconcile.Result{RequeueAfter: recheckInterval}, nil
+}
@@ -0,0 +1,42 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	runtimemetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// Namespace is the metric namespace for the gardener-controller-manager.
+const Namespace = "gardener_controller_manager"
+
+var (
+	// factory is used for registering metrics in the controller-runtime metrics registry.
+	factory = promauto.With(runtimemetrics.Registry)
+
+	// ProjectBackupSizeBytes defines the gauge project_backup_size_bytes.
+	ProjectBackupSizeBytes = factory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "project_backup_size_bytes",
+			Help:      "Total size in bytes of the backups of all BackupEntries of a project.",
+		},
+		[]string{
+			"project",
+		},
+	)
+	// ProjectBackupObjects defines the gauge project_backup_objects.
+	ProjectBackupObjects = factory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "project_backup_objects",
+			Help:      "Total number of backup objects of all BackupEntries of a project.",
+		},
+		[]string{
+			"project",
+		},
+	)
+)
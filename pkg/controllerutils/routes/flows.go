@@ -0,0 +1,18 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package routes
+
+import (
+	"net/http"
+
+	"github.com/gardener/gardener/pkg/utils/flow"
+)
+
+var (
+	// FlowHandlers is list of endpoints exposing the state of the currently running flows.
+	FlowHandlers = map[string]http.Handler{
+		"/debug/flows": flow.DefaultTracker,
+	}
+)
@@ -28,6 +28,7 @@ import (
 	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
 	unstructuredutils "github.com/gardener/gardener/pkg/utils/kubernetes/unstructured"
 	"github.com/gardener/gardener/pkg/utils/retry"
+	"github.com/gardener/gardener/pkg/utils/tracing"
 )
 
 // TimeNow returns the current time. Exposed for testing.
@@ -482,6 +483,7 @@ func AnnotateObjectWithOperation(ctx context.Context, w client.Writer, obj clien
 	patch := client.MergeFrom(obj.DeepCopyObject().(client.Object))
 	kubernetesutils.SetMetaDataAnnotation(obj, v1beta1constants.GardenerOperation, operation)
 	kubernetesutils.SetMetaDataAnnotation(obj, v1beta1constants.GardenerTimestamp, TimeNow().UTC().Format(time.RFC3339Nano))
+	tracing.InjectIntoAnnotations(ctx, obj)
 	return w.Patch(ctx, obj, patch)
 }
 
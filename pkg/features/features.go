@@ -134,6 +134,13 @@ const (
 	// owner: @rfranzke
 	// alpha: v1.142.0
 	BackupEntryForGarden featuregate.Feature = "BackupEntryForGarden"
+
+	// ShootReconcileCheckpoints enables persisting the succeeded tasks of the Shoot reconciliation flow in a ConfigMap in
+	// the control plane namespace. If the flow is interrupted or fails, the next reconciliation of the same generation
+	// skips the tasks which already succeeded.
+	// owner: @rfranzke
+	// alpha: v1.147.0
+	ShootReconcileCheckpoints featuregate.Feature = "ShootReconcileCheckpoints"
 )
 
 // DefaultFeatureGate is the central feature gate map used by all gardener components.
@@ -179,6 +186,7 @@ var AllFeatureGates = map[featuregate.Feature]featuregate.FeatureSpec{
 	DisableNginxIngressInShoot:     {Default: false, PreRelease: featuregate.Alpha},
 	LiveControlPlaneMigration:      {Default: false, PreRelease: featuregate.Alpha},
 	BackupEntryForGarden:           {Default: false, PreRelease: featuregate.Alpha},
+	ShootReconcileCheckpoints:      {Default: false, PreRelease: featuregate.Alpha},
 }
 
 // GetFeatures returns a feature gate map with the respective specifications. Non-existing feature gates are ignored.
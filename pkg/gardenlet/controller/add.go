@@ -24,6 +24,7 @@ import (
 	"github.com/gardener/gardener/pkg/controller/tokenrequestor"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/backupbucket"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/backupentry"
+	"github.com/gardener/gardener/pkg/gardenlet/controller/backupvalidation"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/bastion"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/controllerinstallation"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/gardenlet"
@@ -31,6 +32,7 @@ import (
 	"github.com/gardener/gardener/pkg/gardenlet/controller/networkpolicy"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/seed"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot"
+	"github.com/gardener/gardener/pkg/gardenlet/controller/shootrestore"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/tokenrequestor/workloadidentity"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/vpaevictionrequirements"
 	"github.com/gardener/gardener/pkg/healthz"
@@ -87,6 +89,12 @@ func AddToManager(
 		return fmt.Errorf("failed adding BackupEntry controller: %w", err)
 	}
 
+	if err := (&backupvalidation.Reconciler{
+		SeedName: seedName(cfg),
+	}).AddToManager(mgr, gardenCluster, seedCluster, seedClientSet); err != nil {
+		return fmt.Errorf("failed adding BackupValidation controller: %w", err)
+	}
+
 	if err := (&bastion.Reconciler{
 		Config: *cfg.Controllers.Bastion,
 	}).AddToManager(mgr, gardenCluster, seedCluster); err != nil {
@@ -139,6 +147,14 @@ func AddToManager(
 		return fmt.Errorf("failed adding Shoot controller: %w", err)
 	}
 
+	if !gardenletutils.IsResponsibleForSelfHostedShoot() {
+		if err := (&shootrestore.Reconciler{
+			Config: *cfg.Controllers.ShootRestore,
+		}).AddToManager(mgr, gardenCluster, seedCluster); err != nil {
+			return fmt.Errorf("failed adding ShootRestore controller: %w", err)
+		}
+	}
+
 	if err := vpaevictionrequirements.AddToManager(ctx, mgr, gardenletCancel, *cfg.Controllers.VPAEvictionRequirements, seedCluster); err != nil {
 		return fmt.Errorf("failed adding VPAEvictionRequirements controller: %w", err)
 	}
@@ -129,6 +129,15 @@ func (r *Reconciler) reconcileBackupBucket(
 		}
 	}
 
+	// The bucket of an imported BackupBucket is managed by the garden the shoots were imported from. Hence, no extension
+	// BackupBucket is deployed, the BackupBucket is only used by the BackupEntries to access the backups of the shoots.
+	if v1beta1helper.IsBackupBucketImported(backupBucket) {
+		if updateErr := r.updateBackupBucketStatusSucceeded(gardenCtx, backupBucket, "Imported Backup Bucket is managed by another garden and has not been reconciled."); updateErr != nil {
+			return fmt.Errorf("could not update status after reconciliation success: %w", updateErr)
+		}
+		return nil
+	}
+
 	var (
 		mustReconcileExtensionBackupBucket = false
 		// we should reconcile the secret only when the data has changed, since now we depend on
@@ -150,6 +159,12 @@ func (r *Reconciler) reconcileBackupBucket(
 		}
 	)
 
+	if retention := backupBucket.Spec.Retention; retention != nil && retention.ColdStorageTransitionPeriod != nil {
+		extensionBackupBucketSpec.Lifecycle = &extensionsv1alpha1.BackupBucketLifecycle{
+			ColdStorageTransitionPeriod: retention.ColdStorageTransitionPeriod,
+		}
+	}
+
 	if err := r.SeedClient.Get(seedCtx, client.ObjectKeyFromObject(extensionSecret), extensionSecret); err != nil {
 		if !apierrors.IsNotFound(err) {
 			return err
@@ -302,6 +317,52 @@ func (r *Reconciler) deleteBackupBucket(
 		return reconcile.Result{}, err
 	}
 
+	// Deleting an imported BackupBucket must not delete the bucket which is still managed by another garden.
+	if !v1beta1helper.IsBackupBucketImported(backupBucket) {
+		if result, err := r.deleteExtensionBackupBucket(gardenCtx, seedCtx, log, backupBucket, extensionBackupBucket, backupCredentials); err != nil || !result.IsZero() {
+			return result, err
+		}
+	}
+
+	if updateErr := r.updateBackupBucketStatusSucceeded(gardenCtx, backupBucket, "Backup Bucket has been successfully deleted."); updateErr != nil {
+		return reconcile.Result{}, fmt.Errorf("could not update status after deletion success: %w", updateErr)
+	}
+
+	log.Info("Successfully deleted")
+
+	// TODO(dimityrmirchev): Remove the handling of gardencorev1beta1.ExternalGardenerName in a future release
+	if controllerutil.ContainsFinalizer(backupCredentials, gardencorev1beta1.ExternalGardenerName) || controllerutil.ContainsFinalizer(backupCredentials, finalizerName) {
+		log.Info("Removing finalizers from credentials",
+			"finalizers", []string{gardencorev1beta1.ExternalGardenerName, finalizerName},
+			"gvk", backupCredentials.GetObjectKind().GroupVersionKind().String(),
+			"credentials", client.ObjectKeyFromObject(backupCredentials),
+		)
+		if err := controllerutils.RemoveFinalizers(gardenCtx, r.GardenClient, backupCredentials, gardencorev1beta1.ExternalGardenerName, finalizerName); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed to remove finalizer from credentials: %w", err)
+		}
+	}
+
+	if controllerutil.ContainsFinalizer(backupBucket, gardencorev1beta1.GardenerName) {
+		log.Info("Removing finalizer")
+		if err := controllerutils.RemoveFinalizers(gardenCtx, r.GardenClient, backupBucket, gardencorev1beta1.GardenerName); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed to remove finalizer: %w", err)
+		}
+	}
+
+	return reconcile.Result{}, nil
+}
+
+func (r *Reconciler) deleteExtensionBackupBucket(
+	gardenCtx context.Context,
+	seedCtx context.Context,
+	log logr.Logger,
+	backupBucket *gardencorev1beta1.BackupBucket,
+	extensionBackupBucket *extensionsv1alpha1.BackupBucket,
+	backupCredentials client.Object,
+) (
+	reconcile.Result,
+	error,
+) {
 	extensionSecret := r.emptyExtensionSecret(backupBucket.Name)
 	if err := r.reconcileBackupBucketExtensionSecret(seedCtx, extensionSecret, backupCredentials, backupBucket); err != nil {
 		return reconcile.Result{}, err
@@ -332,31 +393,6 @@ func (r *Reconciler) deleteBackupBucket(
 		return reconcile.Result{}, err
 	}
 
-	if updateErr := r.updateBackupBucketStatusSucceeded(gardenCtx, backupBucket, "Backup Bucket has been successfully deleted."); updateErr != nil {
-		return reconcile.Result{}, fmt.Errorf("could not update status after deletion success: %w", updateErr)
-	}
-
-	log.Info("Successfully deleted")
-
-	// TODO(dimityrmirchev): Remove the handling of gardencorev1beta1.ExternalGardenerName in a future release
-	if controllerutil.ContainsFinalizer(backupCredentials, gardencorev1beta1.ExternalGardenerName) || controllerutil.ContainsFinalizer(backupCredentials, finalizerName) {
-		log.Info("Removing finalizers from credentials",
-			"finalizers", []string{gardencorev1beta1.ExternalGardenerName, finalizerName},
-			"gvk", backupCredentials.GetObjectKind().GroupVersionKind().String(),
-			"credentials", client.ObjectKeyFromObject(backupCredentials),
-		)
-		if err := controllerutils.RemoveFinalizers(gardenCtx, r.GardenClient, backupCredentials, gardencorev1beta1.ExternalGardenerName, finalizerName); err != nil {
-			return reconcile.Result{}, fmt.Errorf("failed to remove finalizer from credentials: %w", err)
-		}
-	}
-
-	if controllerutil.ContainsFinalizer(backupBucket, gardencorev1beta1.GardenerName) {
-		log.Info("Removing finalizer")
-		if err := controllerutils.RemoveFinalizers(gardenCtx, r.GardenClient, backupBucket, gardencorev1beta1.GardenerName); err != nil {
-			return reconcile.Result{}, fmt.Errorf("failed to remove finalizer: %w", err)
-		}
-	}
-
 	return reconcile.Result{}, nil
 }
 
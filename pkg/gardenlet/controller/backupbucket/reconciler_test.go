@@ -242,6 +242,23 @@ var _ = Describe("Controller", func() {
 			}))
 		})
 
+		It("should pass the cold storage transition period to the extension BackupBucket", func() {
+			backupBucket.Spec.Retention = &gardencorev1beta1.BackupRetention{
+				MaxFullSnapshots:            new(int32(3)),
+				ColdStorageTransitionPeriod: &metav1.Duration{Duration: 30 * 24 * time.Hour},
+			}
+			Expect(gardenClient.Update(ctx, backupBucket)).To(Succeed())
+
+			result, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(reconcile.Result{}))
+
+			Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(extensionBackupBucket), extensionBackupBucket)).To(Succeed())
+			Expect(extensionBackupBucket.Spec.Lifecycle).To(Equal(&extensionsv1alpha1.BackupBucketLifecycle{
+				ColdStorageTransitionPeriod: &metav1.Duration{Duration: 30 * 24 * time.Hour},
+			}))
+		})
+
 		It("should not reconcile the extension BackupBucket if the secret data or extension spec hasn't changed", func() {
 			Expect(seedClient.Create(ctx, extensionSecret)).To(Succeed())
 			Expect(seedClient.Create(ctx, extensionBackupBucket)).To(Succeed())
@@ -443,6 +460,57 @@ var _ = Describe("Controller", func() {
 			Expect(extensionBackupBucket.Annotations).To(HaveKeyWithValue("gardener.cloud/operation", "reconcile"))
 		})
 	})
+	Describe("#Imported BackupBucket", func() {
+		BeforeEach(func() {
+			metav1.SetMetaDataAnnotation(&backupBucket.ObjectMeta, v1beta1constants.BackupBucketImported, "true")
+			backupBucket.Spec.CredentialsRef = &corev1.ObjectReference{
+				APIVersion: "v1",
+				Kind:       "Secret",
+				Namespace:  gardenSecret.Namespace,
+				Name:       gardenSecret.Name,
+			}
+			Expect(gardenClient.Create(ctx, backupBucket)).To(Succeed())
+			Expect(gardenClient.Create(ctx, gardenSecret)).To(Succeed())
+		})
+
+		It("should not create the extension secret and extension BackupBucket", func() {
+			result, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(reconcile.Result{}))
+
+			Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(extensionSecret), extensionSecret)).To(BeNotFoundError())
+			Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(extensionBackupBucket), extensionBackupBucket)).To(BeNotFoundError())
+
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(gardenSecret), gardenSecret)).To(Succeed())
+			Expect(gardenSecret.Finalizers).To(Equal([]string{"core.gardener.cloud/backupbucket"}))
+
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(backupBucket), backupBucket)).To(Succeed())
+			Expect(backupBucket.Finalizers).To(Equal([]string{"gardener"}))
+			Expect(backupBucket.Status.LastOperation.State).To(Equal(gardencorev1beta1.LastOperationStateSucceeded))
+			Expect(backupBucket.Status.ObservedGeneration).To(Equal(backupBucket.Generation))
+		})
+
+		It("should not delete the extension BackupBucket", func() {
+			Expect(seedClient.Create(ctx, extensionBackupBucket)).To(Succeed())
+
+			result, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(reconcile.Result{}))
+
+			Expect(gardenClient.Delete(ctx, backupBucket)).To(Succeed())
+
+			result, err = reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(reconcile.Result{}))
+
+			Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(extensionBackupBucket), extensionBackupBucket)).To(Succeed())
+			Expect(extensionBackupBucket.DeletionTimestamp).To(BeNil())
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(backupBucket), backupBucket)).To(BeNotFoundError())
+
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(gardenSecret), gardenSecret)).To(Succeed())
+			Expect(gardenSecret.Finalizers).To(BeEmpty())
+		})
+	})
 })
 
 func generateBackupBucketSecretName(backupBucketName string) string {
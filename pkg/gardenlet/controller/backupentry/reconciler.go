@@ -279,7 +279,7 @@ func (r *Reconciler) reconcileBackupEntry(
 	}
 
 	if extensionBackupEntry.Status.LastOperation != nil && extensionBackupEntry.Status.LastOperation.State == gardencorev1beta1.LastOperationStateSucceeded {
-		if updateErr := r.updateBackupEntryStatusSucceeded(gardenCtx, backupEntry, operationType); updateErr != nil {
+		if updateErr := r.updateBackupEntryStatusSucceeded(gardenCtx, backupEntry, operationType, extensionBackupEntry.Status.Usage); updateErr != nil {
 			return fmt.Errorf("could not update status after reconciliation success: %w", updateErr)
 		}
 
@@ -307,7 +307,11 @@ func (r *Reconciler) deleteBackupEntry(
 		return reconcile.Result{}, nil
 	}
 
-	gracePeriod := computeGracePeriod(*r.Config.DeletionGracePeriodHours, r.Config.DeletionGracePeriodShootPurposes, gardencorev1beta1.ShootPurpose(backupEntry.Annotations[v1beta1constants.ShootPurpose]))
+	gracePeriod, err := r.deletionGracePeriod(gardenCtx, backupEntry)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
 	present, _ := strconv.ParseBool(backupEntry.Annotations[gardencorev1beta1.BackupEntryForceDeletion])
 	if present || r.Clock.Since(backupEntry.DeletionTimestamp.Local()) > gracePeriod {
 		operationType := v1beta1helper.ComputeOperationType(backupEntry.ObjectMeta, backupEntry.Status.LastOperation)
@@ -379,7 +383,7 @@ func (r *Reconciler) deleteBackupEntry(
 			return reconcile.Result{}, err
 		}
 
-		if updateErr := r.updateBackupEntryStatusSucceeded(gardenCtx, backupEntry, operationType); updateErr != nil {
+		if updateErr := r.updateBackupEntryStatusSucceeded(gardenCtx, backupEntry, operationType, nil); updateErr != nil {
 			return reconcile.Result{}, fmt.Errorf("could not update status after deletion success: %w", updateErr)
 		}
 
@@ -497,7 +501,7 @@ func (r *Reconciler) migrateBackupEntry(
 		return reconcile.Result{}, err
 	}
 
-	if updateErr := r.updateBackupEntryStatusSucceeded(gardenCtx, backupEntry, gardencorev1beta1.LastOperationTypeMigrate); updateErr != nil {
+	if updateErr := r.updateBackupEntryStatusSucceeded(gardenCtx, backupEntry, gardencorev1beta1.LastOperationTypeMigrate, nil); updateErr != nil {
 		return reconcile.Result{}, fmt.Errorf("could not update status after migration success: %w", updateErr)
 	}
 
@@ -553,7 +557,9 @@ func (r *Reconciler) updateBackupEntryStatusError(ctx context.Context, be *garde
 	return r.GardenClient.Status().Patch(ctx, be, patch)
 }
 
-func (r *Reconciler) updateBackupEntryStatusSucceeded(ctx context.Context, be *gardencorev1beta1.BackupEntry, operationType gardencorev1beta1.LastOperationType) error {
+// updateBackupEntryStatusSucceeded marks the last operation of the BackupEntry as succeeded. If the given usage is not
+// nil, it is propagated to the status as well.
+func (r *Reconciler) updateBackupEntryStatusSucceeded(ctx context.Context, be *gardencorev1beta1.BackupEntry, operationType gardencorev1beta1.LastOperationType, usage *gardencorev1beta1.BackupEntryUsage) error {
 	var description string
 
 	switch operationType {
@@ -583,6 +589,9 @@ func (r *Reconciler) updateBackupEntryStatusSucceeded(ctx context.Context, be *g
 	if operationType == gardencorev1beta1.LastOperationTypeMigrate {
 		be.Status.SeedName = nil
 	}
+	if usage != nil {
+		be.Status.Usage = usage.DeepCopy()
+	}
 
 	return r.GardenClient.Status().Patch(ctx, be, patch)
 }
@@ -711,17 +720,34 @@ func isRestorePhase(backupEntry *gardencorev1beta1.BackupEntry) bool {
 	return backupEntry.Status.LastOperation != nil && backupEntry.Status.LastOperation.Type == gardencorev1beta1.LastOperationTypeRestore
 }
 
-func computeGracePeriod(deletionGracePeriodHours int, deletionGracePeriodShootPurposes []gardencorev1beta1.ShootPurpose, shootPurpose gardencorev1beta1.ShootPurpose) time.Duration {
+// deletionGracePeriod returns the duration for which the given BackupEntry is kept after its deletion. The retention
+// policy of the associated BackupBucket takes precedence over the grace period configured for the controller.
+func (r *Reconciler) deletionGracePeriod(ctx context.Context, backupEntry *gardencorev1beta1.BackupEntry) (time.Duration, error) {
+	deletionGracePeriod := time.Hour * time.Duration(*r.Config.DeletionGracePeriodHours)
+
+	backupBucket := &gardencorev1beta1.BackupBucket{}
+	if err := r.GardenClient.Get(ctx, client.ObjectKey{Name: backupEntry.Spec.BucketName}, backupBucket); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return 0, fmt.Errorf("failed getting associated BackupBucket %q: %w", backupEntry.Spec.BucketName, err)
+		}
+	} else if retention := backupBucket.Spec.Retention; retention != nil && retention.DeletedEntriesGracePeriod != nil {
+		deletionGracePeriod = retention.DeletedEntriesGracePeriod.Duration
+	}
+
+	return computeGracePeriod(deletionGracePeriod, r.Config.DeletionGracePeriodShootPurposes, gardencorev1beta1.ShootPurpose(backupEntry.Annotations[v1beta1constants.ShootPurpose])), nil
+}
+
+func computeGracePeriod(deletionGracePeriod time.Duration, deletionGracePeriodShootPurposes []gardencorev1beta1.ShootPurpose, shootPurpose gardencorev1beta1.ShootPurpose) time.Duration {
 	// If no dedicated list of purposes is provided then the grace period applies for all purposes. If the shoot purpose
 	// is empty then it was not yet updated with the purpose annotation or the corresponding `Shoot` is already deleted
 	// from the system. In this case, for backwards-compatibility, the grace period applies as well.
 	if len(deletionGracePeriodShootPurposes) == 0 || len(shootPurpose) == 0 {
-		return time.Hour * time.Duration(deletionGracePeriodHours)
+		return deletionGracePeriod
 	}
 
 	// Otherwise, the grace period only applies for the purposes in the list.
 	if slices.Contains(deletionGracePeriodShootPurposes, shootPurpose) {
-		return time.Hour * time.Duration(deletionGracePeriodHours)
+		return deletionGracePeriod
 	}
 
 	// If the shoot purpose was not found in the list then the grace period does not apply.
@@ -276,6 +276,29 @@ var _ = Describe("Controller", func() {
 			Expect(extensionBackupEntry.Annotations).NotTo(HaveKey(v1beta1constants.GardenerOperation))
 		})
 
+		It("should propagate the usage reported by the extension BackupEntry", func() {
+			usage := &gardencorev1beta1.BackupEntryUsage{
+				SizeBytes:      2048,
+				ObjectCount:    4,
+				LastUpdateTime: metav1.NewTime(now.Truncate(time.Second)),
+			}
+			extensionBackupEntry.Status.Usage = usage
+
+			Expect(seedClient.Create(ctx, extensionSecret)).To(Succeed())
+			Expect(seedClient.Create(ctx, extensionBackupEntry)).To(Succeed())
+
+			result, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(reconcile.Result{}))
+
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(backupEntry), backupEntry)).To(Succeed())
+			Expect(backupEntry.Status.LastOperation.State).To(Equal(gardencorev1beta1.LastOperationStateSucceeded))
+			Expect(backupEntry.Status.Usage).NotTo(BeNil())
+			Expect(backupEntry.Status.Usage.SizeBytes).To(Equal(int64(2048)))
+			Expect(backupEntry.Status.Usage.ObjectCount).To(Equal(int64(4)))
+			Expect(backupEntry.Status.Usage.LastUpdateTime.Equal(&usage.LastUpdateTime)).To(BeTrue())
+		})
+
 		It("should reconcile the extension secret and extension BackupEntry if the secret currently doesn't have a timestamp", func() {
 			extensionSecret.Annotations = nil
 			Expect(seedClient.Create(ctx, extensionSecret)).To(Succeed())
@@ -475,4 +498,34 @@ var _ = Describe("Controller", func() {
 			Expect(extensionBackupEntry.Annotations).To(HaveKeyWithValue("gardener.cloud/operation", "reconcile"))
 		})
 	})
+
+	Describe("#Deletion", func() {
+		BeforeEach(func() {
+			Expect(gardenClient.Create(ctx, backupBucket)).To(Succeed())
+
+			backupEntry.Finalizers = []string{gardencorev1beta1.GardenerName}
+			Expect(gardenClient.Create(ctx, backupEntry)).To(Succeed())
+			Expect(gardenClient.Delete(ctx, backupEntry)).To(Succeed())
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(backupEntry), backupEntry)).To(Succeed())
+		})
+
+		It("should keep the BackupEntry for the configured deletion grace period", func() {
+			fakeClock.SetTime(backupEntry.DeletionTimestamp.Add(12 * time.Hour))
+
+			result, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(12 * time.Hour))
+		})
+
+		It("should keep the BackupEntry for the grace period of the BackupBucket's retention policy", func() {
+			backupBucket.Spec.Retention = &gardencorev1beta1.BackupRetention{DeletedEntriesGracePeriod: &metav1.Duration{Duration: 48 * time.Hour}}
+			Expect(gardenClient.Update(ctx, backupBucket)).To(Succeed())
+
+			fakeClock.SetTime(backupEntry.DeletionTimestamp.Add(30 * time.Hour))
+
+			result, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(18 * time.Hour))
+		})
+	})
 })
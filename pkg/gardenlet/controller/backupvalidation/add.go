@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backupvalidation
+
+import (
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	predicateutils "github.com/gardener/gardener/pkg/controllerutils/predicate"
+)
+
+// ControllerName is the name of this controller.
+const ControllerName = "backup-validation"
+
+// AddToManager adds Reconciler to the given manager.
+func (r *Reconciler) AddToManager(mgr manager.Manager, gardenCluster, seedCluster cluster.Cluster, seedClientSet kubernetes.Interface) error {
+	if r.GardenClient == nil {
+		r.GardenClient = gardenCluster.GetClient()
+	}
+	if r.SeedClient == nil {
+		r.SeedClient = seedCluster.GetClient()
+	}
+	if r.SeedClientSet == nil {
+		r.SeedClientSet = seedClientSet
+	}
+	if r.Clock == nil {
+		r.Clock = clock.RealClock{}
+	}
+
+	return builder.
+		ControllerManagedBy(mgr).
+		Named(ControllerName).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: 1,
+		}).
+		WatchesRawSource(source.Kind[client.Object](
+			gardenCluster.GetCache(),
+			&gardencorev1beta1.Seed{},
+			&handler.EnqueueRequestForObject{},
+			predicateutils.HasName(r.SeedName),
+			r.SeedPredicate(),
+		)).
+		Complete(r)
+}
+
+// SeedPredicate returns a predicate which returns 'true' for create events, and for update events in case the backup
+// validation settings of the seed have changed. All further reconciliations are triggered by requeueing the seed after
+// the configured validation interval.
+func (r *Reconciler) SeedPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(event.CreateEvent) bool { return true },
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			seed, ok := e.ObjectNew.(*gardencorev1beta1.Seed)
+			if !ok {
+				return false
+			}
+
+			oldSeed, ok := e.ObjectOld.(*gardencorev1beta1.Seed)
+			if !ok {
+				return false
+			}
+
+			return !equality.Semantic.DeepEqual(backupValidation(oldSeed), backupValidation(seed))
+		},
+		DeleteFunc:  func(event.DeleteEvent) bool { return false },
+		GenericFunc: func(event.GenericEvent) bool { return false },
+	}
+}
+
+func backupValidation(seed *gardencorev1beta1.Seed) *gardencorev1beta1.BackupValidation {
+	if seed.Spec.Backup == nil {
+		return nil
+	}
+	return seed.Spec.Backup.Validation
+}
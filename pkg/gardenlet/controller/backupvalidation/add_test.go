@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backupvalidation_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/backupvalidation"
+)
+
+var _ = Describe("Add", func() {
+	Describe("#SeedPredicate", func() {
+		var (
+			p    predicate.Predicate
+			seed *gardencorev1beta1.Seed
+		)
+
+		BeforeEach(func() {
+			p = (&Reconciler{}).SeedPredicate()
+			seed = &gardencorev1beta1.Seed{
+				Spec: gardencorev1beta1.SeedSpec{
+					Backup: &gardencorev1beta1.Backup{
+						Validation: &gardencorev1beta1.BackupValidation{Interval: &metav1.Duration{Duration: time.Hour}},
+					},
+				},
+			}
+		})
+
+		It("should return true for create events", func() {
+			Expect(p.Create(event.CreateEvent{Object: seed})).To(BeTrue())
+		})
+
+		It("should return false for update events if the backup validation settings did not change", func() {
+			newSeed := seed.DeepCopy()
+			newSeed.Labels = map[string]string{"foo": "bar"}
+			Expect(p.Update(event.UpdateEvent{ObjectOld: seed, ObjectNew: newSeed})).To(BeFalse())
+		})
+
+		It("should return true for update events if the backup validation settings changed", func() {
+			newSeed := seed.DeepCopy()
+			newSeed.Spec.Backup.Validation.Interval = &metav1.Duration{Duration: 2 * time.Hour}
+			Expect(p.Update(event.UpdateEvent{ObjectOld: seed, ObjectNew: newSeed})).To(BeTrue())
+		})
+
+		It("should return true for update events if the backup validation was enabled", func() {
+			oldSeed := seed.DeepCopy()
+			oldSeed.Spec.Backup = nil
+			Expect(p.Update(event.UpdateEvent{ObjectOld: oldSeed, ObjectNew: seed})).To(BeTrue())
+		})
+
+		It("should return false for delete events", func() {
+			Expect(p.Delete(event.DeleteEvent{Object: seed})).To(BeFalse())
+		})
+
+		It("should return false for generic events", func() {
+			Expect(p.Generic(event.GenericEvent{Object: seed})).To(BeFalse())
+		})
+	})
+})
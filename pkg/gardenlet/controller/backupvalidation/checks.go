@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backupvalidation
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// endpointStatus is the relevant part of the output of `etcdctl endpoint status --write-out=json`.
+type endpointStatus struct {
+	Status struct {
+		Header struct {
+			Revision int64 `json:"revision"`
+		} `json:"header"`
+	} `json:"Status"`
+}
+
+// keyCount is the relevant part of the output of `etcdctl get --count-only --write-out=json`.
+type keyCount struct {
+	Count int64 `json:"count"`
+}
+
+// parseCheckResults parses the outputs of the etcdctl commands run by the check pod.
+func parseCheckResults(endpointStatusOutput, keyCountOutput []byte) (*restoreResult, error) {
+	var statuses []endpointStatus
+	if err := json.Unmarshal(endpointStatusOutput, &statuses); err != nil {
+		return nil, fmt.Errorf("failed parsing endpoint status of restored etcd: %w", err)
+	}
+	if len(statuses) != 1 {
+		return nil, fmt.Errorf("expected endpoint status of exactly one member of restored etcd, got %d", len(statuses))
+	}
+
+	var count keyCount
+	if err := json.Unmarshal(keyCountOutput, &count); err != nil {
+		return nil, fmt.Errorf("failed parsing key count of restored etcd: %w", err)
+	}
+
+	return &restoreResult{revision: statuses[0].Status.Header.Revision, keys: count.Count}, nil
+}
+
+// verify performs basic consistency checks of the restored etcd. Every write increases the revision of etcd, hence the
+// revision can never be lower than the number of keys. Also, the restored etcd must at least contain the revision of the
+// latest full snapshot which was taken before the backups were copied.
+func (r *restoreResult) verify(minRevision int64) error {
+	if r.keys == 0 {
+		return fmt.Errorf("restored etcd does not contain any keys")
+	}
+	if r.revision < r.keys {
+		return fmt.Errorf("revision %d of restored etcd is lower than its number of keys %d", r.revision, r.keys)
+	}
+	if r.revision < minRevision {
+		return fmt.Errorf("revision %d of restored etcd is lower than revision %d of the latest full snapshot", r.revision, minRevision)
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backupvalidation
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Checks", func() {
+	Describe("#parseCheckResults", func() {
+		It("should parse the outputs of etcdctl", func() {
+			result, err := parseCheckResults(
+				[]byte(`[{"Endpoint":"http://etcd-backup-validation-client:2379","Status":{"header":{"cluster_id":1,"member_id":2,"revision":1234,"raft_term":3},"version":"3.5.27","dbSize":4096}}]`),
+				[]byte(`{"header":{"cluster_id":1,"member_id":2,"revision":1234,"raft_term":3},"count":567}`),
+			)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(&restoreResult{revision: 1234, keys: 567}))
+		})
+
+		It("should fail if the endpoint status cannot be parsed", func() {
+			_, err := parseCheckResults([]byte(`Error: context deadline exceeded`), []byte(`{"count":567}`))
+			Expect(err).To(MatchError(ContainSubstring("failed parsing endpoint status")))
+		})
+
+		It("should fail if the endpoint status does not contain exactly one member", func() {
+			_, err := parseCheckResults([]byte(`[]`), []byte(`{"count":567}`))
+			Expect(err).To(MatchError(ContainSubstring("exactly one member")))
+		})
+
+		It("should fail if the key count cannot be parsed", func() {
+			_, err := parseCheckResults([]byte(`[{"Status":{"header":{"revision":1234}}}]`), []byte(`foo`))
+			Expect(err).To(MatchError(ContainSubstring("failed parsing key count")))
+		})
+	})
+
+	Describe("#verify", func() {
+		It("should succeed for a consistent result", func() {
+			Expect((&restoreResult{revision: 1234, keys: 567}).verify(1000)).To(Succeed())
+		})
+
+		It("should fail if the restored etcd does not contain any keys", func() {
+			Expect((&restoreResult{revision: 1234}).verify(0)).To(MatchError(ContainSubstring("does not contain any keys")))
+		})
+
+		It("should fail if the revision is lower than the number of keys", func() {
+			Expect((&restoreResult{revision: 10, keys: 567}).verify(0)).To(MatchError(ContainSubstring("lower than its number of keys")))
+		})
+
+		It("should fail if the revision is lower than the revision of the latest full snapshot", func() {
+			Expect((&restoreResult{revision: 1234, keys: 567}).verify(2000)).To(MatchError(ContainSubstring("lower than revision 2000 of the latest full snapshot")))
+		})
+	})
+})
@@ -0,0 +1,214 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backupvalidation
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	druidcorev1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	gardenletmetrics "github.com/gardener/gardener/pkg/gardenlet/metrics"
+	"github.com/gardener/gardener/pkg/utils/flow"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+const (
+	// validationTimeout is the maximum duration of the validation of a single backup.
+	validationTimeout = 45 * time.Minute
+	// cleanupTimeout is the maximum duration for removing the temporary resources of a validation.
+	cleanupTimeout = 10 * time.Minute
+
+	// ReasonBackupRestored is the reason of the Restorable condition if the latest backup could be restored.
+	ReasonBackupRestored = "BackupRestored"
+	// ReasonBackupRestoreFailed is the reason of the Restorable condition if the latest backup could not be restored.
+	ReasonBackupRestoreFailed = "BackupRestoreFailed"
+)
+
+// Reconciler periodically validates the backups of a sample of the BackupEntries of the seed by restoring their latest
+// snapshots into a temporary etcd.
+type Reconciler struct {
+	GardenClient  client.Client
+	SeedClient    client.Client
+	SeedClientSet kubernetes.Interface
+	SeedName      string
+	Clock         clock.Clock
+}
+
+// Reconcile validates the backups of a sample of the BackupEntries of the seed and requeues the seed after the
+// configured validation interval.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	seed := &gardencorev1beta1.Seed{}
+	if err := r.GardenClient.Get(ctx, request.NamespacedName, seed); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	validation := backupValidation(seed)
+	if validation == nil {
+		log.V(1).Info("Backup validation is not enabled for seed, nothing to be done")
+		return reconcile.Result{}, nil
+	}
+	interval := ptr.Deref(validation.Interval, metav1.Duration{Duration: 24 * time.Hour}).Duration
+
+	backupEntries, err := r.backupEntriesForValidation(ctx)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if lastValidation := lastValidationTime(backupEntries); !lastValidation.IsZero() {
+		if nextValidation := lastValidation.Add(interval); r.Clock.Now().Before(nextValidation) {
+			requeueAfter := nextValidation.Sub(r.Clock.Now())
+			log.V(1).Info("Backups were validated recently, requeueing", "requeueAfter", requeueAfter)
+			return reconcile.Result{RequeueAfter: requeueAfter}, nil
+		}
+	}
+
+	sample := sampleBackupEntries(backupEntries, ptr.Deref(validation.SamplingPercentage, 10))
+	log.Info("Validating backups", "backupEntries", len(backupEntries), "sample", len(sample))
+
+	var fns []flow.TaskFn
+	for _, backupEntry := range sample {
+		fns = append(fns, func(ctx context.Context) error {
+			return r.validateBackupEntry(ctx, log.WithValues("backupEntry", client.ObjectKeyFromObject(backupEntry)), backupEntry)
+		})
+	}
+
+	if err := flow.ParallelN(int(ptr.Deref(validation.Concurrency, 1)), fns...)(ctx); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{RequeueAfter: interval}, nil
+}
+
+// backupEntriesForValidation returns the BackupEntries of the seed whose backups can be validated, i.e., those which
+// were reconciled successfully and whose shoot has an etcd with a backup store in the seed.
+func (r *Reconciler) backupEntriesForValidation(ctx context.Context) ([]*gardencorev1beta1.BackupEntry, error) {
+	backupEntryList := &gardencorev1beta1.BackupEntryList{}
+	if err := r.GardenClient.List(ctx, backupEntryList); err != nil {
+		return nil, fmt.Errorf("failed listing BackupEntries: %w", err)
+	}
+
+	var backupEntries []*gardencorev1beta1.BackupEntry
+	for _, backupEntry := range backupEntryList.Items {
+		if ptr.Deref(backupEntry.Spec.SeedName, "") != r.SeedName ||
+			ptr.Deref(backupEntry.Status.SeedName, "") != r.SeedName ||
+			backupEntry.DeletionTimestamp != nil ||
+			strings.HasPrefix(backupEntry.Name, v1beta1constants.BackupSourcePrefix+"-") ||
+			backupEntry.Status.LastOperation == nil ||
+			backupEntry.Status.LastOperation.State != gardencorev1beta1.LastOperationStateSucceeded {
+			continue
+		}
+
+		controlPlaneNamespace, _ := gardenerutils.ExtractShootDetailsFromBackupEntryName(backupEntry.Name)
+		etcdMain := &druidcorev1alpha1.Etcd{}
+		if err := r.SeedClient.Get(ctx, client.ObjectKey{Namespace: controlPlaneNamespace, Name: v1beta1constants.ETCDMain}, etcdMain); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed getting etcd of BackupEntry %s: %w", client.ObjectKeyFromObject(&backupEntry), err)
+		}
+		if etcdMain.DeletionTimestamp != nil || etcdMain.Spec.Backup.Store == nil {
+			continue
+		}
+
+		backupEntries = append(backupEntries, backupEntry.DeepCopy())
+	}
+
+	return backupEntries, nil
+}
+
+// lastValidationTime returns the time of the most recent validation of any of the given BackupEntries.
+func lastValidationTime(backupEntries []*gardencorev1beta1.BackupEntry) time.Time {
+	var last time.Time
+	for _, backupEntry := range backupEntries {
+		if t := validationTime(backupEntry); t.After(last) {
+			last = t
+		}
+	}
+	return last
+}
+
+func validationTime(backupEntry *gardencorev1beta1.BackupEntry) time.Time {
+	if condition := v1beta1helper.GetCondition(backupEntry.Status.Conditions, gardencorev1beta1.BackupEntryRestorable); condition != nil {
+		return condition.LastUpdateTime.Time
+	}
+	return time.Time{}
+}
+
+// sampleBackupEntries returns the given percentage of the given BackupEntries, rounded up. BackupEntries whose last
+// validation is the oldest are returned first, so that all BackupEntries are validated eventually.
+func sampleBackupEntries(backupEntries []*gardencorev1beta1.BackupEntry, percentage int32) []*gardencorev1beta1.BackupEntry {
+	sorted := slices.Clone(backupEntries)
+	slices.SortStableFunc(sorted, func(a, b *gardencorev1beta1.BackupEntry) int {
+		if c := validationTime(a).Compare(validationTime(b)); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Namespace+"/"+a.Name, b.Namespace+"/"+b.Name)
+	})
+
+	count := int(math.Ceil(float64(len(sorted)) * float64(percentage) / 100))
+	return sorted[:min(count, len(sorted))]
+}
+
+// validateBackupEntry validates the backup of the given BackupEntry and reports the result as condition and metrics.
+func (r *Reconciler) validateBackupEntry(ctx context.Context, log logr.Logger, backupEntry *gardencorev1beta1.BackupEntry) error {
+	log.Info("Validating backup")
+
+	var (
+		start     = r.Clock.Now()
+		condition = v1beta1helper.GetOrInitConditionWithClock(r.Clock, backupEntry.Status.Conditions, gardencorev1beta1.BackupEntryRestorable)
+	)
+
+	validationCtx, cancel := context.WithTimeout(ctx, validationTimeout)
+	result, err := r.restore(validationCtx, log, backupEntry)
+	cancel()
+
+	resultLabel, restorable := "succeeded", 1.0
+	if err != nil {
+		log.Error(err, "Backup validation failed")
+		resultLabel, restorable = "failed", 0.0
+		condition = v1beta1helper.UpdatedConditionWithClock(r.Clock, condition, gardencorev1beta1.ConditionFalse, ReasonBackupRestoreFailed, fmt.Sprintf("The latest backup could not be restored: %s", err.Error()))
+	} else {
+		log.Info("Backup validation succeeded", "revision", result.revision, "keys", result.keys)
+		condition = v1beta1helper.UpdatedConditionWithClock(r.Clock, condition, gardencorev1beta1.ConditionTrue, ReasonBackupRestored, fmt.Sprintf("The latest backup was restored into a temporary etcd, it contains %d keys at revision %d.", result.keys, result.revision))
+	}
+	// The last update time is used to determine the time of the last validation, hence it is updated even if the
+	// message did not change.
+	condition.LastUpdateTime = metav1.NewTime(r.Clock.Now())
+
+	gardenletmetrics.BackupValidationDurationSeconds.WithLabelValues(resultLabel).Observe(r.Clock.Since(start).Seconds())
+	gardenletmetrics.BackupValidationRestorable.WithLabelValues(backupEntry.Namespace, backupEntry.Name).Set(restorable)
+	gardenletmetrics.BackupValidationTimestampSeconds.WithLabelValues(backupEntry.Namespace, backupEntry.Name).Set(float64(r.Clock.Now().Unix()))
+
+	patch := client.StrategicMergeFrom(backupEntry.DeepCopy())
+	backupEntry.Status.Conditions = v1beta1helper.MergeConditions(backupEntry.Status.Conditions, condition)
+	if err := r.GardenClient.Status().Patch(ctx, backupEntry, patch); err != nil {
+		return fmt.Errorf("failed updating %s condition of BackupEntry %s: %w", gardencorev1beta1.BackupEntryRestorable, client.ObjectKeyFromObject(backupEntry), err)
+	}
+
+	return nil
+}
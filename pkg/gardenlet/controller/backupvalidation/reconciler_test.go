@@ -0,0 +1,172 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backupvalidation_test
+
+import (
+	"context"
+	"time"
+
+	druidcorev1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/backupvalidation"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx          = context.TODO()
+		gardenClient client.Client
+		seedClient   client.Client
+		fakeClock    *testclock.FakeClock
+		reconciler   *Reconciler
+
+		seed    *gardencorev1beta1.Seed
+		request reconcile.Request
+
+		newBackupEntry func(name string, seedName string) *gardencorev1beta1.BackupEntry
+		newEtcd        func(namespace string) *druidcorev1alpha1.Etcd
+		restorable     func(backupEntry *gardencorev1beta1.BackupEntry) *gardencorev1beta1.Condition
+	)
+
+	BeforeEach(func() {
+		gardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithStatusSubresource(&gardencorev1beta1.BackupEntry{}).Build()
+		seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		fakeClock = testclock.NewFakeClock(time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC))
+
+		reconciler = &Reconciler{
+			GardenClient: gardenClient,
+			SeedClient:   seedClient,
+			SeedName:     "seed",
+			Clock:        fakeClock,
+		}
+
+		seed = &gardencorev1beta1.Seed{
+			ObjectMeta: metav1.ObjectMeta{Name: "seed"},
+			Spec: gardencorev1beta1.SeedSpec{
+				Backup: &gardencorev1beta1.Backup{
+					Provider: "local",
+					Validation: &gardencorev1beta1.BackupValidation{
+						Interval:           &metav1.Duration{Duration: 24 * time.Hour},
+						SamplingPercentage: new(int32(50)),
+						Concurrency:        new(int32(1)),
+					},
+				},
+			},
+		}
+		Expect(gardenClient.Create(ctx, seed)).To(Succeed())
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(seed)}
+
+		newBackupEntry = func(name string, seedName string) *gardencorev1beta1.BackupEntry {
+			return &gardencorev1beta1.BackupEntry{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "garden-project"},
+				Spec:       gardencorev1beta1.BackupEntrySpec{SeedName: &seedName},
+				Status: gardencorev1beta1.BackupEntryStatus{
+					SeedName: &seedName,
+					LastOperation: &gardencorev1beta1.LastOperation{
+						Type:  gardencorev1beta1.LastOperationTypeReconcile,
+						State: gardencorev1beta1.LastOperationStateSucceeded,
+					},
+				},
+			}
+		}
+
+		newEtcd = func(namespace string) *druidcorev1alpha1.Etcd {
+			return &druidcorev1alpha1.Etcd{
+				ObjectMeta: metav1.ObjectMeta{Name: "etcd-main", Namespace: namespace},
+				Spec: druidcorev1alpha1.EtcdSpec{
+					Backup: druidcorev1alpha1.BackupSpec{
+						Store: &druidcorev1alpha1.StoreSpec{Prefix: namespace + "/etcd-main"},
+					},
+				},
+			}
+		}
+
+		restorable = func(backupEntry *gardencorev1beta1.BackupEntry) *gardencorev1beta1.Condition {
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(backupEntry), backupEntry)).To(Succeed())
+			return v1beta1helper.GetCondition(backupEntry.Status.Conditions, gardencorev1beta1.BackupEntryRestorable)
+		}
+	})
+
+	It("should do nothing if backup validation is not enabled", func() {
+		seed.Spec.Backup.Validation = nil
+		Expect(gardenClient.Update(ctx, seed)).To(Succeed())
+
+		backupEntry := newBackupEntry("shoot--project--foo--1234", "seed")
+		Expect(gardenClient.Create(ctx, backupEntry)).To(Succeed())
+		Expect(seedClient.Create(ctx, newEtcd("shoot--project--foo"))).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(restorable(backupEntry)).To(BeNil())
+	})
+
+	It("should requeue without validating if the backups were validated recently", func() {
+		backupEntry := newBackupEntry("shoot--project--foo--1234", "seed")
+		backupEntry.Status.Conditions = []gardencorev1beta1.Condition{{
+			Type:           gardencorev1beta1.BackupEntryRestorable,
+			Status:         gardencorev1beta1.ConditionTrue,
+			LastUpdateTime: metav1.NewTime(fakeClock.Now().Add(-time.Hour)),
+		}}
+		Expect(gardenClient.Create(ctx, backupEntry)).To(Succeed())
+		Expect(seedClient.Create(ctx, newEtcd("shoot--project--foo"))).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: 23 * time.Hour}))
+		Expect(restorable(backupEntry).Status).To(Equal(gardencorev1beta1.ConditionTrue))
+	})
+
+	It("should validate the sampled BackupEntries which were not validated for the longest time", func() {
+		validatedBackupEntry := newBackupEntry("shoot--project--foo--1234", "seed")
+		validatedBackupEntry.Status.Conditions = []gardencorev1beta1.Condition{{
+			Type:           gardencorev1beta1.BackupEntryRestorable,
+			Status:         gardencorev1beta1.ConditionTrue,
+			LastUpdateTime: metav1.NewTime(fakeClock.Now().Add(-48 * time.Hour)),
+		}}
+		Expect(gardenClient.Create(ctx, validatedBackupEntry)).To(Succeed())
+		Expect(seedClient.Create(ctx, newEtcd("shoot--project--foo"))).To(Succeed())
+
+		// The extension BackupEntry does not exist in the seed, hence the validation fails.
+		backupEntry := newBackupEntry("shoot--project--bar--5678", "seed")
+		Expect(gardenClient.Create(ctx, backupEntry)).To(Succeed())
+		Expect(seedClient.Create(ctx, newEtcd("shoot--project--bar"))).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: 24 * time.Hour}))
+
+		Expect(restorable(backupEntry)).To(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Status":         Equal(gardencorev1beta1.ConditionFalse),
+			"Reason":         Equal(ReasonBackupRestoreFailed),
+			"Message":        ContainSubstring("failed getting extension BackupEntry"),
+			"LastUpdateTime": Equal(metav1.NewTime(fakeClock.Now())),
+		})))
+		Expect(restorable(validatedBackupEntry).Status).To(Equal(gardencorev1beta1.ConditionTrue))
+	})
+
+	It("should not validate BackupEntries which cannot be restored in this seed", func() {
+		otherSeedBackupEntry := newBackupEntry("shoot--project--foo--1234", "other-seed")
+		Expect(gardenClient.Create(ctx, otherSeedBackupEntry)).To(Succeed())
+		Expect(seedClient.Create(ctx, newEtcd("shoot--project--foo"))).To(Succeed())
+
+		sourceBackupEntry := newBackupEntry("source-shoot--project--bar--5678", "seed")
+		Expect(gardenClient.Create(ctx, sourceBackupEntry)).To(Succeed())
+		Expect(seedClient.Create(ctx, newEtcd("shoot--project--bar"))).To(Succeed())
+
+		backupEntryWithoutEtcd := newBackupEntry("shoot--project--baz--9012", "seed")
+		Expect(gardenClient.Create(ctx, backupEntryWithoutEtcd)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: 24 * time.Hour}))
+
+		Expect(restorable(otherSeedBackupEntry)).To(BeNil())
+		Expect(restorable(sourceBackupEntry)).To(BeNil())
+		Expect(restorable(backupEntryWithoutEtcd)).To(BeNil())
+	})
+})
@@ -0,0 +1,363 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backupvalidation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	druidcorev1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	"github.com/go-logr/logr"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener/imagevector"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/component/etcd/copybackupstask"
+	"github.com/gardener/gardener/pkg/component/etcd/etcd"
+	etcdconstants "github.com/gardener/gardener/pkg/component/etcd/etcd/constants"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/extensions"
+	"github.com/gardener/gardener/pkg/utils"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
+	"github.com/gardener/gardener/pkg/utils/retry"
+)
+
+const (
+	// name is the name of the temporary resources which are created in the control plane namespace of the shoot for
+	// validating its backup.
+	name = "etcd-backup-validation"
+	// checkPodName is the name of the pod running the consistency checks against the temporary etcd.
+	checkPodName = name + "-check"
+
+	// defaultInterval is the default interval for retry operations.
+	defaultInterval = 5 * time.Second
+	// defaultSevereThreshold is the default threshold until an error reported by another component is treated as
+	// 'severe'.
+	defaultSevereThreshold = 3 * time.Minute
+	// etcdRestoreTimeout is the maximum duration for the temporary etcd to become ready, i.e., the time which is
+	// available for etcd-backup-restore to restore the snapshots.
+	etcdRestoreTimeout = 20 * time.Minute
+	// checkTimeout is the maximum duration of the consistency checks.
+	checkTimeout = 5 * time.Minute
+)
+
+const (
+	containerNameEndpointStatus = "endpoint-status"
+	containerNameKeyCount       = "key-count"
+	containerNameReadiness      = "readiness"
+)
+
+// restoreResult contains the results of the consistency checks against the restored etcd.
+type restoreResult struct {
+	revision int64
+	keys     int64
+}
+
+// restore copies the latest backup of the given BackupEntry to a temporary prefix in the same bucket, restores it into a
+// temporary etcd, and runs the consistency checks against it. All temporary resources are removed afterwards.
+func (r *Reconciler) restore(ctx context.Context, log logr.Logger, backupEntry *gardencorev1beta1.BackupEntry) (*restoreResult, error) {
+	controlPlaneNamespace, _ := gardenerutils.ExtractShootDetailsFromBackupEntryName(backupEntry.Name)
+
+	etcdMain := &druidcorev1alpha1.Etcd{}
+	if err := r.SeedClient.Get(ctx, client.ObjectKey{Namespace: controlPlaneNamespace, Name: v1beta1constants.ETCDMain}, etcdMain); err != nil {
+		return nil, fmt.Errorf("failed getting etcd: %w", err)
+	}
+
+	extensionBackupEntry := &extensionsv1alpha1.BackupEntry{}
+	if err := r.SeedClient.Get(ctx, client.ObjectKey{Name: backupEntry.Name}, extensionBackupEntry); err != nil {
+		return nil, fmt.Errorf("failed getting extension BackupEntry: %w", err)
+	}
+
+	// The revision of the latest full snapshot is read before the backups are copied. The restored etcd must at least
+	// contain this revision.
+	minRevision, err := r.latestFullSnapshotRevision(ctx, controlPlaneNamespace)
+	if err != nil {
+		return nil, err
+	}
+
+	// The backups are copied to a prefix of a dedicated extension BackupEntry. Deleting this BackupEntry makes the
+	// provider extension remove the copied backups.
+	var (
+		validationBackupEntry = &extensionsv1alpha1.BackupEntry{ObjectMeta: metav1.ObjectMeta{Name: v1beta1constants.BackupValidationPrefix + "-" + backupEntry.Name}}
+		targetStore           = etcdMain.Spec.Backup.Store.DeepCopy()
+	)
+	targetStore.Prefix = fmt.Sprintf("%s/etcd-%s", validationBackupEntry.Name, v1beta1constants.ETCDRoleMain)
+
+	copyTask := copybackupstask.New(log, r.SeedClient, &copybackupstask.Values{
+		Name:        name,
+		Namespace:   controlPlaneNamespace,
+		SourceStore: *etcdMain.Spec.Backup.Store,
+		TargetStore: *targetStore,
+		MaxBackups:  new(uint32(1)),
+	}, copybackupstask.DefaultInterval, copybackupstask.DefaultSevereThreshold, copybackupstask.DefaultTimeout)
+
+	defer func() {
+		cleanupCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), cleanupTimeout)
+		defer cancel()
+
+		if err := r.cleanup(cleanupCtx, log, controlPlaneNamespace, copyTask, validationBackupEntry); err != nil {
+			log.Error(err, "Failed cleaning up temporary resources of backup validation")
+		}
+	}()
+
+	if err := r.deployValidationBackupEntry(ctx, log, extensionBackupEntry, validationBackupEntry); err != nil {
+		return nil, err
+	}
+
+	if err := copyTask.Destroy(ctx); err != nil {
+		return nil, fmt.Errorf("failed deleting previous EtcdCopyBackupsTask: %w", err)
+	}
+	if err := copyTask.WaitCleanup(ctx); err != nil {
+		return nil, fmt.Errorf("failed waiting for deletion of previous EtcdCopyBackupsTask: %w", err)
+	}
+	if err := copyTask.Deploy(ctx); err != nil {
+		return nil, fmt.Errorf("failed deploying EtcdCopyBackupsTask: %w", err)
+	}
+	if err := copyTask.Wait(ctx); err != nil {
+		return nil, fmt.Errorf("failed copying latest backup: %w", err)
+	}
+
+	if err := r.deployEtcd(ctx, log, etcdMain, targetStore); err != nil {
+		return nil, fmt.Errorf("failed restoring latest backup into temporary etcd: %w", err)
+	}
+
+	result, err := r.runChecks(ctx, controlPlaneNamespace)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, result.verify(minRevision)
+}
+
+// latestFullSnapshotRevision returns the revision of the latest full snapshot which etcd-backup-restore records in the
+// holder identity of the full snapshot lease.
+func (r *Reconciler) latestFullSnapshotRevision(ctx context.Context, namespace string) (int64, error) {
+	lease := &coordinationv1.Lease{}
+	if err := r.SeedClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: v1beta1constants.ETCDMain + "-full-snap"}, lease); err != nil {
+		if apierrors.IsNotFound(err) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("failed getting full snapshot lease: %w", err)
+	}
+
+	revision, err := strconv.ParseInt(ptr.Deref(lease.Spec.HolderIdentity, ""), 10, 64)
+	if err != nil {
+		// The lease does not contain a revision before the first full snapshot was taken.
+		return 0, nil
+	}
+	return revision, nil
+}
+
+func (r *Reconciler) deployValidationBackupEntry(ctx context.Context, log logr.Logger, extensionBackupEntry, validationBackupEntry *extensionsv1alpha1.BackupEntry) error {
+	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, r.SeedClient, validationBackupEntry, func() error {
+		metav1.SetMetaDataAnnotation(&validationBackupEntry.ObjectMeta, v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationReconcile)
+		metav1.SetMetaDataAnnotation(&validationBackupEntry.ObjectMeta, v1beta1constants.GardenerTimestamp, r.Clock.Now().UTC().Format(time.RFC3339Nano))
+		validationBackupEntry.Spec = *extensionBackupEntry.Spec.DeepCopy()
+		return nil
+	}); err != nil {
+		return fmt.Errorf("failed deploying extension BackupEntry %q: %w", validationBackupEntry.Name, err)
+	}
+
+	return extensions.WaitUntilExtensionObjectReady(ctx, r.SeedClient, log, validationBackupEntry, extensionsv1alpha1.BackupEntryResource, defaultInterval, defaultSevereThreshold, copybackupstask.DefaultTimeout, nil)
+}
+
+// deployEtcd creates a temporary single-node etcd which restores the copied backup from the given store and waits until
+// it is ready.
+func (r *Reconciler) deployEtcd(ctx context.Context, log logr.Logger, etcdMain *druidcorev1alpha1.Etcd, store *druidcorev1alpha1.StoreSpec) error {
+	tempEtcd := emptyEtcd(etcdMain.Namespace)
+	labels := map[string]string{v1beta1constants.LabelApp: name}
+
+	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, r.SeedClient, tempEtcd, func() error {
+		metav1.SetMetaDataAnnotation(&tempEtcd.ObjectMeta, v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationReconcile)
+		metav1.SetMetaDataAnnotation(&tempEtcd.ObjectMeta, v1beta1constants.GardenerTimestamp, r.Clock.Now().UTC().Format(time.RFC3339Nano))
+
+		tempEtcd.Spec.Replicas = 1
+		tempEtcd.Spec.PriorityClassName = etcdMain.Spec.PriorityClassName
+		tempEtcd.Spec.Selector = &metav1.LabelSelector{MatchLabels: labels}
+		tempEtcd.Spec.Labels = utils.MergeStringMaps(labels, map[string]string{
+			v1beta1constants.LabelNetworkPolicyToDNS:              v1beta1constants.LabelNetworkPolicyAllowed,
+			v1beta1constants.LabelNetworkPolicyToPublicNetworks:   v1beta1constants.LabelNetworkPolicyAllowed,
+			v1beta1constants.LabelNetworkPolicyToPrivateNetworks:  v1beta1constants.LabelNetworkPolicyAllowed,
+			v1beta1constants.LabelNetworkPolicyToRuntimeAPIServer: v1beta1constants.LabelNetworkPolicyAllowed,
+		})
+		tempEtcd.Spec.Etcd = druidcorev1alpha1.EtcdConfig{
+			Quota: etcdMain.Spec.Etcd.Quota,
+		}
+		tempEtcd.Spec.Backup = druidcorev1alpha1.BackupSpec{
+			Store: store,
+		}
+		tempEtcd.Spec.StorageClass = etcdMain.Spec.StorageClass
+		tempEtcd.Spec.StorageCapacity = etcdMain.Spec.StorageCapacity
+		tempEtcd.Spec.VolumeClaimTemplate = new(name)
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	return extensions.WaitUntilObjectReadyWithHealthFunction(ctx, r.SeedClient, log, checkEtcdReady, tempEtcd, "Etcd", defaultInterval, etcd.DefaultSevereThreshold, etcdRestoreTimeout, nil)
+}
+
+func checkEtcdReady(obj client.Object) error {
+	if err := etcd.CheckEtcdObject(obj); err != nil {
+		return err
+	}
+
+	etcdObj, ok := obj.(*druidcorev1alpha1.Etcd)
+	if !ok {
+		return fmt.Errorf("expected *druidcorev1alpha1.Etcd but got %T", obj)
+	}
+
+	return health.CheckEtcd(etcdObj)
+}
+
+// runChecks runs a pod which queries the status and the number of keys of the temporary etcd, and performs the same
+// read which kube-apiserver uses for checking the readiness of its etcd.
+func (r *Reconciler) runChecks(ctx context.Context, namespace string) (*restoreResult, error) {
+	image, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameEtcd)
+	if err != nil {
+		return nil, fmt.Errorf("failed finding etcd image: %w", err)
+	}
+
+	pod := emptyCheckPod(namespace)
+	if err := kubernetesutils.DeleteObject(ctx, r.SeedClient, pod); err != nil {
+		return nil, fmt.Errorf("failed deleting previous check pod: %w", err)
+	}
+	if err := kubernetesutils.WaitUntilResourceDeleted(ctx, r.SeedClient, pod, defaultInterval); err != nil {
+		return nil, fmt.Errorf("failed waiting for deletion of previous check pod: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("--endpoints=http://%s-client:%d", name, etcdconstants.PortEtcdClient)
+	container := func(containerName string, args ...string) corev1.Container {
+		return corev1.Container{
+			Name:                     containerName,
+			Image:                    image.String(),
+			Command:                  append([]string{"/usr/local/bin/etcdctl", endpoint, "--command-timeout=30s"}, args...),
+			TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
+			SecurityContext:          &corev1.SecurityContext{AllowPrivilegeEscalation: new(false)},
+		}
+	}
+
+	pod.Labels = map[string]string{
+		v1beta1constants.LabelApp:                checkPodName,
+		v1beta1constants.LabelNetworkPolicyToDNS: v1beta1constants.LabelNetworkPolicyAllowed,
+		gardenerutils.NetworkPolicyLabel(name+"-client", etcdconstants.PortEtcdClient): v1beta1constants.LabelNetworkPolicyAllowed,
+	}
+	pod.Spec = corev1.PodSpec{
+		AutomountServiceAccountToken: new(false),
+		RestartPolicy:                corev1.RestartPolicyNever,
+		SecurityContext: &corev1.PodSecurityContext{
+			RunAsNonRoot:   new(true),
+			RunAsUser:      new(int64(65532)),
+			SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
+		},
+		Containers: []corev1.Container{
+			container(containerNameEndpointStatus, "endpoint", "status", "--write-out=json"),
+			container(containerNameKeyCount, "get", "/registry", "--prefix", "--count-only", "--write-out=json"),
+			// kube-apiserver checks the readiness of etcd with a linearizable read of this key.
+			container(containerNameReadiness, "get", "/registry/health", "--consistency=l"),
+		},
+	}
+
+	if err := r.SeedClient.Create(ctx, pod); err != nil {
+		return nil, fmt.Errorf("failed creating check pod: %w", err)
+	}
+
+	if err := retry.UntilTimeout(ctx, defaultInterval, checkTimeout, func(ctx context.Context) (bool, error) {
+		if err := r.SeedClient.Get(ctx, client.ObjectKeyFromObject(pod), pod); err != nil {
+			return retry.SevereError(err)
+		}
+
+		switch pod.Status.Phase {
+		case corev1.PodSucceeded:
+			return retry.Ok()
+		case corev1.PodFailed:
+			return retry.SevereError(fmt.Errorf("consistency checks failed: %w", failedContainersError(pod)))
+		default:
+			return retry.MinorError(fmt.Errorf("check pod is in phase %q", pod.Status.Phase))
+		}
+	}); err != nil {
+		return nil, err
+	}
+
+	endpointStatus, err := r.containerLogs(ctx, pod, containerNameEndpointStatus)
+	if err != nil {
+		return nil, err
+	}
+	keyCount, err := r.containerLogs(ctx, pod, containerNameKeyCount)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseCheckResults(endpointStatus, keyCount)
+}
+
+func (r *Reconciler) containerLogs(ctx context.Context, pod *corev1.Pod, containerName string) ([]byte, error) {
+	logs, err := kubernetesutils.GetPodLogs(ctx, r.SeedClientSet.Kubernetes().CoreV1().Pods(pod.Namespace), pod.Name, &corev1.PodLogOptions{Container: containerName})
+	if err != nil {
+		return nil, fmt.Errorf("failed reading logs of container %q of check pod: %w", containerName, err)
+	}
+	return logs, nil
+}
+
+func failedContainersError(pod *corev1.Pod) error {
+	var errs []error
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.State.Terminated != nil && status.State.Terminated.ExitCode != 0 {
+			errs = append(errs, fmt.Errorf("container %q exited with code %d: %s", status.Name, status.State.Terminated.ExitCode, strings.TrimSpace(status.State.Terminated.Message)))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// cleanup removes all temporary resources of the validation. The copied backups are removed by the provider extension
+// when the extension BackupEntry is deleted.
+func (r *Reconciler) cleanup(ctx context.Context, log logr.Logger, namespace string, copyTask copybackupstask.Interface, validationBackupEntry *extensionsv1alpha1.BackupEntry) error {
+	tempEtcd := emptyEtcd(namespace)
+	if err := kubernetesutils.DeleteObjects(ctx, r.SeedClient, emptyCheckPod(namespace), tempEtcd); err != nil {
+		return err
+	}
+	if err := kubernetesutils.WaitUntilResourceDeleted(ctx, r.SeedClient, tempEtcd, defaultInterval); err != nil {
+		return fmt.Errorf("failed waiting for deletion of temporary etcd: %w", err)
+	}
+
+	// The volume of the temporary etcd is not deleted together with its StatefulSet.
+	pvc := &corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: name + "-" + name + "-0", Namespace: namespace}}
+	if err := kubernetesutils.DeleteObject(ctx, r.SeedClient, pvc); err != nil {
+		return err
+	}
+
+	if err := copyTask.Destroy(ctx); err != nil {
+		return err
+	}
+	if err := copyTask.WaitCleanup(ctx); err != nil {
+		return err
+	}
+
+	if err := extensions.DeleteExtensionObject(ctx, r.SeedClient, validationBackupEntry); err != nil {
+		return err
+	}
+	return extensions.WaitUntilExtensionObjectDeleted(ctx, r.SeedClient, log, validationBackupEntry, extensionsv1alpha1.BackupEntryResource, defaultInterval, copybackupstask.DefaultTimeout)
+}
+
+func emptyEtcd(namespace string) *druidcorev1alpha1.Etcd {
+	return &druidcorev1alpha1.Etcd{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}}
+}
+
+func emptyCheckPod(namespace string) *corev1.Pod {
+	return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: checkPodName, Namespace: namespace}}
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/gardener/gardener/pkg/gardenlet/controller/seed/care"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/seed/lease"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/seed/seed"
+	"github.com/gardener/gardener/pkg/gardenlet/controller/seed/utilization"
 	"github.com/gardener/gardener/pkg/healthz"
 	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
 )
@@ -54,6 +55,13 @@ func AddToManager(
 		return fmt.Errorf("failed adding lease reconciler: %w", err)
 	}
 
+	if err := (&utilization.Reconciler{
+		Config:   *cfg.Controllers.SeedUtilization,
+		SeedName: cfg.SeedConfig.Name,
+	}).AddToManager(mgr, gardenCluster, seedCluster); err != nil {
+		return fmt.Errorf("failed adding utilization reconciler: %w", err)
+	}
+
 	if err := (&seed.Reconciler{
 		SeedClientSet:         seedClientSet,
 		Config:                cfg,
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/go-logr/logr"
+	"go.opentelemetry.io/otel/attribute"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -26,6 +27,7 @@ import (
 	"github.com/gardener/gardener/pkg/utils/flow"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	"github.com/gardener/gardener/pkg/utils/managedresources"
+	"github.com/gardener/gardener/pkg/utils/tracing"
 )
 
 func (r *Reconciler) delete(
@@ -429,6 +431,8 @@ func (r *Reconciler) runDeleteSeedFlow(
 	if err := g.Compile().Run(ctx, flow.Opts{
 		Log:              log,
 		ProgressReporter: r.reportProgress(log, seed.GetInfo()),
+		TrackingKey:      seed.GetInfo().Name,
+		SpanAttributes:   []attribute.KeyValue{tracing.AttributeKeySeedName.String(seed.GetInfo().Name)},
 	}); err != nil {
 		return flow.Errors(err)
 	}
@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/go-logr/logr"
+	"go.opentelemetry.io/otel/attribute"
 	istiov1beta1 "istio.io/client-go/pkg/apis/networking/v1beta1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -42,6 +43,7 @@ import (
 	"github.com/gardener/gardener/pkg/utils/managedresources"
 	"github.com/gardener/gardener/pkg/utils/retry"
 	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
+	"github.com/gardener/gardener/pkg/utils/tracing"
 	"github.com/gardener/gardener/pkg/utils/validation/kubernetesversion"
 	versionutils "github.com/gardener/gardener/pkg/utils/version"
 )
@@ -577,6 +579,8 @@ func (r *Reconciler) runReconcileSeedFlow(
 	if err := g.Compile().Run(ctx, flow.Opts{
 		Log:              log,
 		ProgressReporter: r.reportProgress(log, seed.GetInfo()),
+		TrackingKey:      seed.GetInfo().Name,
+		SpanAttributes:   []attribute.KeyValue{tracing.AttributeKeySeedName.String(seed.GetInfo().Name)},
 	}); err != nil {
 		return flow.Errors(err)
 	}
@@ -0,0 +1,195 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utilization
+
+import (
+	"context"
+	"fmt"
+
+	druidcorev1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	metricsv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/gardenlet/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/controllerutils"
+)
+
+// Reconciler reconciles Seed resources and reports the aggregated resource requests and usage of the shoot control
+// planes hosted by the seed in its status.
+type Reconciler struct {
+	GardenClient  client.Client
+	SeedClient    client.Client
+	SeedAPIReader client.Reader
+	Config        gardenletconfigv1alpha1.SeedUtilizationControllerConfiguration
+	Clock         clock.Clock
+	SeedName      string
+}
+
+// Reconcile reconciles Seed resources and reports the aggregated resource requests and usage of the shoot control
+// planes hosted by the seed in its status.
+func (r *Reconciler) Reconcile(reconcileCtx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(reconcileCtx)
+
+	seed := &gardencorev1beta1.Seed{}
+	if err := r.GardenClient.Get(reconcileCtx, req.NamespacedName, seed); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	ctx, cancel := controllerutils.GetChildReconciliationContext(reconcileCtx, r.Config.SyncPeriod.Duration)
+	defer cancel()
+
+	utilization, err := r.computeUtilization(ctx, log, seed)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	log.V(1).Info("Updating seed utilization", "requests", utilization.Requests, "allocatable", utilization.Allocatable)
+	patch := client.MergeFrom(seed.DeepCopy())
+	seed.Status.Utilization = utilization
+	if err := r.GardenClient.Status().Patch(ctx, seed, patch); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed updating seed utilization: %w", err)
+	}
+
+	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+}
+
+func (r *Reconciler) computeUtilization(ctx context.Context, log logr.Logger, seed *gardencorev1beta1.Seed) (*gardencorev1beta1.SeedUtilization, error) {
+	utilization := &gardencorev1beta1.SeedUtilization{
+		Allocatable:    corev1.ResourceList{},
+		Requests:       corev1.ResourceList{},
+		LastUpdateTime: &metav1.Time{Time: r.Clock.Now().UTC()},
+	}
+
+	nodeList := &corev1.NodeList{}
+	if err := r.SeedClient.List(ctx, nodeList); err != nil {
+		return nil, fmt.Errorf("failed listing nodes: %w", err)
+	}
+
+	for _, node := range nodeList.Items {
+		addResources(utilization.Allocatable, node.Status.Allocatable)
+	}
+
+	// The storage available for etcd volumes cannot be derived from the nodes, hence it is taken from the configured
+	// seed resources (see `.resources.capacity` in the gardenlet configuration).
+	if etcdVolume, ok := seed.Status.Allocatable[gardencorev1beta1.ResourceEtcdVolume]; ok {
+		utilization.Allocatable[gardencorev1beta1.ResourceEtcdVolume] = etcdVolume
+	}
+
+	shootNamespaces, err := r.shootNamespaces(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for namespace := range shootNamespaces {
+		podList := &corev1.PodList{}
+		if err := r.SeedClient.List(ctx, podList, client.InNamespace(namespace)); err != nil {
+			return nil, fmt.Errorf("failed listing pods in namespace %s: %w", namespace, err)
+		}
+
+		for _, pod := range podList.Items {
+			if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+				continue
+			}
+
+			for _, container := range pod.Spec.Containers {
+				addResources(utilization.Requests, container.Resources.Requests)
+			}
+		}
+
+		etcdList := &druidcorev1alpha1.EtcdList{}
+		if err := r.SeedClient.List(ctx, etcdList, client.InNamespace(namespace)); err != nil {
+			return nil, fmt.Errorf("failed listing etcds in namespace %s: %w", namespace, err)
+		}
+
+		for _, etcd := range etcdList.Items {
+			if etcd.Spec.StorageCapacity == nil {
+				continue
+			}
+
+			addResources(utilization.Requests, corev1.ResourceList{gardencorev1beta1.ResourceEtcdVolume: multiply(*etcd.Spec.StorageCapacity, etcd.Spec.Replicas)})
+		}
+	}
+
+	usage, err := r.computeUsage(ctx, shootNamespaces)
+	if err != nil {
+		if !meta.IsNoMatchError(err) && !apierrors.IsNotFound(err) && !apierrors.IsServiceUnavailable(err) {
+			return nil, err
+		}
+		log.V(1).Info("Metrics API is not available in the seed cluster, skipping usage computation", "reason", err.Error())
+	}
+	utilization.Usage = usage
+
+	return utilization, nil
+}
+
+func (r *Reconciler) shootNamespaces(ctx context.Context) (sets.Set[string], error) {
+	namespaceList := &corev1.NamespaceList{}
+	if err := r.SeedClient.List(ctx, namespaceList, client.MatchingLabels{v1beta1constants.GardenRole: v1beta1constants.GardenRoleShoot}); err != nil {
+		return nil, fmt.Errorf("failed listing shoot namespaces: %w", err)
+	}
+
+	namespaces := sets.New[string]()
+	for _, namespace := range namespaceList.Items {
+		namespaces.Insert(namespace.Name)
+	}
+
+	return namespaces, nil
+}
+
+func (r *Reconciler) computeUsage(ctx context.Context, shootNamespaces sets.Set[string]) (corev1.ResourceList, error) {
+	podMetricsList := &metricsv1beta1.PodMetricsList{}
+	if err := r.SeedAPIReader.List(ctx, podMetricsList); err != nil {
+		return nil, fmt.Errorf("failed listing pod metrics: %w", err)
+	}
+
+	usage := corev1.ResourceList{}
+	for _, podMetrics := range podMetricsList.Items {
+		if !shootNamespaces.Has(podMetrics.Namespace) {
+			continue
+		}
+
+		for _, container := range podMetrics.Containers {
+			addResources(usage, container.Usage)
+		}
+	}
+
+	return usage, nil
+}
+
+func addResources(total, resources corev1.ResourceList) {
+	for name, quantity := range resources {
+		if name != corev1.ResourceCPU && name != corev1.ResourceMemory && name != gardencorev1beta1.ResourceEtcdVolume {
+			continue
+		}
+
+		sum := total[name]
+		sum.Add(quantity)
+		total[name] = sum
+	}
+}
+
+func multiply(quantity resource.Quantity, factor int32) resource.Quantity {
+	result := resource.Quantity{Format: quantity.Format}
+	for range factor {
+		result.Add(quantity)
+	}
+	return result
+}
@@ -0,0 +1,194 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utilization_test
+
+import (
+	"context"
+	"time"
+
+	druidcorev1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	metricsv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/gardenlet/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/seed/utilization"
+)
+
+var _ = Describe("Reconciler", func() {
+	const (
+		seedName   = "seed"
+		syncPeriod = time.Minute
+	)
+
+	var (
+		ctx = context.Background()
+
+		gardenClient  client.Client
+		seedClient    client.Client
+		seedAPIReader client.Client
+		fakeClock     *testclock.FakeClock
+
+		reconciler *Reconciler
+		request    reconcile.Request
+		seed       *gardencorev1beta1.Seed
+	)
+
+	BeforeEach(func() {
+		gardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithStatusSubresource(&gardencorev1beta1.Seed{}).Build()
+		seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		seedAPIReader = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		fakeClock = testclock.NewFakeClock(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+
+		seed = &gardencorev1beta1.Seed{
+			ObjectMeta: metav1.ObjectMeta{Name: seedName},
+			Status: gardencorev1beta1.SeedStatus{
+				Allocatable: corev1.ResourceList{gardencorev1beta1.ResourceEtcdVolume: resource.MustParse("100Gi")},
+			},
+		}
+		Expect(gardenClient.Create(ctx, seed)).To(Succeed())
+
+		for _, obj := range []client.Object{
+			&corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: "node-1"},
+				Status: corev1.NodeStatus{Allocatable: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("4"),
+					corev1.ResourceMemory: resource.MustParse("16Gi"),
+					corev1.ResourcePods:   resource.MustParse("110"),
+				}},
+			},
+			&corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: "node-2"},
+				Status: corev1.NodeStatus{Allocatable: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("4"),
+					corev1.ResourceMemory: resource.MustParse("16Gi"),
+				}},
+			},
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "shoot--foo--bar", Labels: map[string]string{v1beta1constants.GardenRole: v1beta1constants.GardenRoleShoot}}},
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "garden"}},
+			newPod("shoot--foo--bar", "kube-apiserver", corev1.PodRunning, "1", "2Gi"),
+			newPod("shoot--foo--bar", "completed-job", corev1.PodSucceeded, "1", "1Gi"),
+			newPod("garden", "gardener-resource-manager", corev1.PodRunning, "1", "1Gi"),
+			&druidcorev1alpha1.Etcd{
+				ObjectMeta: metav1.ObjectMeta{Name: "etcd-main", Namespace: "shoot--foo--bar"},
+				Spec:       druidcorev1alpha1.EtcdSpec{Replicas: 3, StorageCapacity: ptr.To(resource.MustParse("10Gi"))},
+			},
+			&druidcorev1alpha1.Etcd{
+				ObjectMeta: metav1.ObjectMeta{Name: "etcd-events", Namespace: "shoot--foo--bar"},
+				Spec:       druidcorev1alpha1.EtcdSpec{Replicas: 1, StorageCapacity: ptr.To(resource.MustParse("5Gi"))},
+			},
+		} {
+			Expect(seedClient.Create(ctx, obj)).To(Succeed())
+		}
+
+		for _, obj := range []client.Object{
+			newPodMetrics("shoot--foo--bar", "kube-apiserver", "500m", "1Gi"),
+			newPodMetrics("garden", "gardener-resource-manager", "100m", "100Mi"),
+		} {
+			Expect(seedAPIReader.Create(ctx, obj)).To(Succeed())
+		}
+
+		request = reconcile.Request{NamespacedName: client.ObjectKey{Name: seedName}}
+	})
+
+	JustBeforeEach(func() {
+		reconciler = &Reconciler{
+			GardenClient:  gardenClient,
+			SeedClient:    seedClient,
+			SeedAPIReader: seedAPIReader,
+			Config:        gardenletconfigv1alpha1.SeedUtilizationControllerConfiguration{SyncPeriod: &metav1.Duration{Duration: syncPeriod}},
+			Clock:         fakeClock,
+			SeedName:      seedName,
+		}
+	})
+
+	It("should do nothing if the seed is gone", func() {
+		Expect(gardenClient.Delete(ctx, seed)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+	})
+
+	It("should report the aggregated requests and usage of the shoot control planes", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(seed), seed)).To(Succeed())
+		Expect(seed.Status.Utilization).NotTo(BeNil())
+		Expect(seed.Status.Utilization.LastUpdateTime).To(HaveValue(Equal(metav1.Time{Time: fakeClock.Now()})))
+		expectResources(seed.Status.Utilization.Allocatable, "8", "32Gi", "100Gi")
+		expectResources(seed.Status.Utilization.Requests, "1", "2Gi", "35Gi")
+		expectResources(seed.Status.Utilization.Usage, "500m", "1Gi", "")
+	})
+
+	Context("metrics API is not available", func() {
+		BeforeEach(func() {
+			seedAPIReader = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithInterceptorFuncs(interceptor.Funcs{
+				List: func(_ context.Context, _ client.WithWatch, _ client.ObjectList, _ ...client.ListOption) error {
+					return &meta.NoKindMatchError{GroupKind: schema.GroupKind{Group: "metrics.k8s.io", Kind: "PodMetrics"}}
+				},
+			}).Build()
+		})
+
+		It("should report the requests without usage", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(seed), seed)).To(Succeed())
+			expectResources(seed.Status.Utilization.Requests, "1", "2Gi", "35Gi")
+			Expect(seed.Status.Utilization.Usage).To(BeEmpty())
+		})
+	})
+})
+
+func newPod(namespace, name string, phase corev1.PodPhase, cpu, memory string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec: corev1.PodSpec{Containers: []corev1.Container{{
+			Name: name,
+			Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse(cpu),
+				corev1.ResourceMemory: resource.MustParse(memory),
+			}},
+		}}},
+		Status: corev1.PodStatus{Phase: phase},
+	}
+}
+
+func newPodMetrics(namespace, name, cpu, memory string) *metricsv1beta1.PodMetrics {
+	return &metricsv1beta1.PodMetrics{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Containers: []metricsv1beta1.ContainerMetrics{{
+			Name: name,
+			Usage: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse(cpu),
+				corev1.ResourceMemory: resource.MustParse(memory),
+			},
+		}},
+	}
+}
+
+func expectResources(resources corev1.ResourceList, cpu, memory, etcdVolume string) {
+	ExpectWithOffset(1, resources.Cpu().Cmp(resource.MustParse(cpu))).To(BeZero(), "cpu is %s", resources.Cpu())
+	ExpectWithOffset(1, resources.Memory().Cmp(resource.MustParse(memory))).To(BeZero(), "memory is %s", resources.Memory())
+
+	if etcdVolume == "" {
+		ExpectWithOffset(1, resources).NotTo(HaveKey(gardencorev1beta1.ResourceEtcdVolume))
+		return
+	}
+	quantity := resources[gardencorev1beta1.ResourceEtcdVolume]
+	ExpectWithOffset(1, quantity.Cmp(resource.MustParse(etcdVolume))).To(BeZero(), "etcd-volume is %s", quantity.String())
+}
@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package care
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	prom "github.com/prometheus/client_golang/api"
+	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+)
+
+// activityQuery sums up the requests to the kube-apiserver of the shoot which were dispatched in the last minutes for
+// the `global-default` flow schema. Requests of system components (e.g. nodes, controllers, leader election, service
+// accounts in the kube-system namespace) and of Gardener are classified by other flow schemas and hence do not count as
+// activity.
+const activityQuery = `sum(increase(apiserver_flowcontrol_dispatched_requests_total{job="kube-apiserver",flow_schema="global-default"}[10m]))`
+
+// Activity contains required information for measuring the activity of a shoot.
+type Activity struct {
+	address string
+	clock   clock.Clock
+}
+
+// NewActivity creates a new instance for measuring the activity of a shoot based on the metrics of the Prometheus
+// reachable at the given address.
+func NewActivity(address string, clock clock.Clock) *Activity {
+	return &Activity{
+		address: address,
+		clock:   clock,
+	}
+}
+
+// Check returns the current time if user requests to the kube-apiserver of the shoot were observed recently. Nil is
+// returned if no such requests were observed.
+func (a *Activity) Check(ctx context.Context) (*metav1.Time, error) {
+	client, err := prom.NewClient(prom.Config{Address: a.address})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Prometheus client: %w", err)
+	}
+
+	// set a maximum timeout for the query, but callers can set a shorter timeout via the context
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	now := a.clock.Now()
+	result, warnings, err := promv1.NewAPI(client).Query(ctx, activityQuery, now)
+	if err != nil {
+		return nil, fmt.Errorf("query failed: %w", err)
+	}
+
+	if len(warnings) > 0 {
+		return nil, fmt.Errorf("query returned warnings: %s", strings.Join(warnings, ", "))
+	}
+
+	vector, ok := result.(model.Vector)
+	if !ok {
+		return nil, fmt.Errorf("query returned an unexpected result type: %s", result.Type())
+	}
+
+	for _, sample := range vector {
+		if sample.Value > 0 {
+			return &metav1.Time{Time: now}, nil
+		}
+	}
+
+	return nil, nil
+}
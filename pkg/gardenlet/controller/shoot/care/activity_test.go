@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package care_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	testclock "k8s.io/utils/clock/testing"
+
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/care"
+)
+
+var _ = Describe("Activity", func() {
+	var (
+		ctx       = context.Background()
+		fakeClock = testclock.NewFakeClock(time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC))
+
+		response string
+		server   *httptest.Server
+		activity *Activity
+	)
+
+	BeforeEach(func() {
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(r.ParseForm()).To(Succeed())
+			Expect(r.Form.Get("query")).To(ContainSubstring(`flow_schema="global-default"`))
+
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, response)
+		}))
+		DeferCleanup(server.Close)
+
+		activity = NewActivity(server.URL, fakeClock)
+	})
+
+	Describe("#Check", func() {
+		It("should return the current time if user requests were observed", func() {
+			response = `{"status":"success","data":{"resultType":"vector","result":[{"metric":{},"value":[1748779200,"42"]}]}}`
+
+			lastActivityTime, err := activity.Check(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(lastActivityTime).NotTo(BeNil())
+			Expect(lastActivityTime.Time).To(Equal(fakeClock.Now()))
+		})
+
+		It("should return nil if no user requests were observed", func() {
+			response = `{"status":"success","data":{"resultType":"vector","result":[{"metric":{},"value":[1748779200,"0"]}]}}`
+
+			Expect(activity.Check(ctx)).To(BeNil())
+		})
+
+		It("should return nil if the metrics are not available", func() {
+			response = `{"status":"success","data":{"resultType":"vector","result":[]}}`
+
+			Expect(activity.Check(ctx)).To(BeNil())
+		})
+
+		It("should return an error if the query returns warnings", func() {
+			response = `{"status":"success","warnings":["foo"],"data":{"resultType":"vector","result":[]}}`
+
+			_, err := activity.Check(ctx)
+			Expect(err).To(MatchError("query returned warnings: foo"))
+		})
+	})
+})
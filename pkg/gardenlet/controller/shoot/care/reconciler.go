@@ -32,6 +32,9 @@ import (
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 )
 
+// lastActivityTimeUpdateInterval is the minimum interval in which the last activity time of shoots is updated.
+const lastActivityTimeUpdateInterval = 5 * time.Minute
+
 var (
 	// NewOperation is used to create a new `operation.Operation` instance.
 	NewOperation = defaultNewOperationFunc
@@ -43,6 +46,8 @@ var (
 	NewGarbageCollector = defaultNewGarbageCollector
 	// NewWebhookRemediator is used to create a new webhook remediation instance.
 	NewWebhookRemediator = defaultNewWebhookRemediator
+	// NewActivityCheck is used to create a new instance for measuring the activity of a shoot.
+	NewActivityCheck = defaultNewActivityCheck
 )
 
 // Reconciler reconciles Shoot resources and executes care operations, e.g. health checks or garbage collection.
@@ -88,6 +93,12 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 		return reconcile.Result{}, nil
 	}
 
+	// if shoot was exported for the migration to another garden landscape then its control plane does not exist anymore,
+	// hence don't requeue.
+	if v1beta1helper.IsShootExported(shoot) {
+		return reconcile.Result{}, nil
+	}
+
 	careCtx, cancel := controllerutils.GetChildReconciliationContext(ctx, r.Config.Controllers.ShootCare.SyncPeriod.Duration)
 	defer cancel()
 
@@ -159,7 +170,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 	)
 	if err != nil {
 		updatedConditions, updatedConstraints := r.setStatusToUnknown("Precondition failed: operation could not be initialized", shootConditions.ConvertToSlice(), shootConstraints.ConvertToSlice())
-		if err := r.patchStatus(ctx, log, shoot, shootConditions, updatedConditions, shootConstraints, updatedConstraints); err != nil {
+		if err := r.patchStatus(ctx, log, shoot, shootConditions, updatedConditions, shootConstraints, updatedConstraints, nil); err != nil {
 			log.Error(err, "Error when trying to update the shoot status after failed operation initialization")
 		}
 		return reconcile.Result{}, err
@@ -169,6 +180,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 		staleExtensionHealthCheckThreshold    = gardenlethelper.StaleExtensionHealthChecksThreshold(r.Config.Controllers.ShootCare.StaleExtensionHealthChecks)
 		initializeShootClients                = shootClientInitializer(careCtx, o)
 		updatedConditions, updatedConstraints []gardencorev1beta1.Condition
+		lastActivityTime                      *metav1.Time
 	)
 
 	if err := flow.Parallel(
@@ -219,11 +231,31 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 			}
 			return nil
 		},
+		// Measure activity for the automatic hibernation based on inactivity
+		func(ctx context.Context) error {
+			if !needsActivityCheck(shoot) {
+				return nil
+			}
+
+			observedActivityTime, err := NewActivityCheck(o.Shoot, r.Clock).Check(ctx)
+			if err != nil {
+				// errors during activity measurement do not cause the care operation to fail, but the shoot is considered to
+				// be active so that it is not hibernated just because its activity cannot be measured
+				log.Error(err, "Failed measuring the activity of the shoot, considering it active")
+				observedActivityTime = &metav1.Time{Time: r.Clock.Now()}
+			}
+
+			// Avoid updating the shoot status with every sync for shoots which are continuously used.
+			if observedActivityTime != nil && (shoot.Status.LastActivityTime == nil || observedActivityTime.Sub(shoot.Status.LastActivityTime.Time) >= lastActivityTimeUpdateInterval) {
+				lastActivityTime = observedActivityTime
+			}
+			return nil
+		},
 	)(careCtx); err != nil {
 		return reconcile.Result{}, err
 	}
 
-	if err := r.patchStatus(ctx, log, shoot, shootConditions, updatedConditions, shootConstraints, updatedConstraints); err != nil {
+	if err := r.patchStatus(ctx, log, shoot, shootConditions, updatedConditions, shootConstraints, updatedConstraints, lastActivityTime); err != nil {
 		log.Error(err, "Error when trying to update the shoot status")
 		return reconcile.Result{}, err
 	}
@@ -239,9 +271,21 @@ func (r *Reconciler) conditionThresholdsToProgressingMapping() map[gardencorev1b
 	return out
 }
 
-func (r *Reconciler) patchStatus(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot, existingConditions ShootConditions, updatedConditions []gardencorev1beta1.Condition, existingConstraints ShootConstraints, updatedConstraints []gardencorev1beta1.Condition) error {
-	// Update Shoot status (conditions, constraints) only if necessary
-	if !v1beta1helper.ConditionsNeedUpdate(existingConditions.ConvertToSlice(), updatedConditions) && !v1beta1helper.ConditionsNeedUpdate(existingConstraints.ConvertToSlice(), updatedConstraints) {
+// needsActivityCheck returns true if the shoot is configured to be hibernated after a period of inactivity and is not
+// hibernated yet.
+func needsActivityCheck(shoot *gardencorev1beta1.Shoot) bool {
+	return shoot.Spec.Hibernation != nil &&
+		shoot.Spec.Hibernation.Inactivity != nil &&
+		!v1beta1helper.HibernationIsEnabled(shoot) &&
+		!shoot.Status.IsHibernated &&
+		!v1beta1helper.IsShootSelfHosted(shoot.Spec.Provider.Workers)
+}
+
+func (r *Reconciler) patchStatus(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot, existingConditions ShootConditions, updatedConditions []gardencorev1beta1.Condition, existingConstraints ShootConstraints, updatedConstraints []gardencorev1beta1.Condition, lastActivityTime *metav1.Time) error {
+	// Update Shoot status (conditions, constraints, last activity time) only if necessary
+	if !v1beta1helper.ConditionsNeedUpdate(existingConditions.ConvertToSlice(), updatedConditions) &&
+		!v1beta1helper.ConditionsNeedUpdate(existingConstraints.ConvertToSlice(), updatedConstraints) &&
+		lastActivityTime == nil {
 		return nil
 	}
 
@@ -250,11 +294,14 @@ func (r *Reconciler) patchStatus(ctx context.Context, log logr.Logger, shoot *ga
 	mergedConditions := v1beta1helper.BuildConditions(shoot.Status.Conditions, updatedConditions, existingConditions.ConditionTypes())
 	mergedConstraints := v1beta1helper.BuildConditions(shoot.Status.Constraints, updatedConstraints, existingConstraints.ConstraintTypes())
 
-	log.V(1).Info("Updating status conditions and constraints")
+	log.V(1).Info("Updating status conditions, constraints and last activity time")
 
 	patch := client.StrategicMergeFrom(shoot.DeepCopy())
 	shoot.Status.Conditions = mergedConditions
 	shoot.Status.Constraints = mergedConstraints
+	if lastActivityTime != nil {
+		shoot.Status.LastActivityTime = lastActivityTime
+	}
 	return r.GardenClient.Status().Patch(ctx, shoot, patch)
 }
 
@@ -278,6 +278,16 @@ var _ = Describe("Shoot Care Control", func() {
 				shoot = nil
 			})
 
+			Context("when shoot was exported", func() {
+				BeforeEach(func() {
+					metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.ShootExported, "true")
+				})
+
+				It("should not requeue", func() {
+					Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{}))
+				})
+			})
+
 			Context("when no conditions / constraints are returned", func() {
 				BeforeEach(func() {
 					DeferCleanup(test.WithVars(
@@ -541,6 +551,75 @@ var _ = Describe("Shoot Care Control", func() {
 					})
 				})
 			})
+
+			Context("when shoot is hibernated after a period of inactivity", func() {
+				var (
+					activityCheckCalled bool
+					observedActivity    *metav1.Time
+					activityCheckErr    error
+				)
+
+				BeforeEach(func() {
+					activityCheckCalled = false
+					observedActivity = &metav1.Time{Time: fakeClock.Now().Round(time.Second)}
+					activityCheckErr = nil
+					shoot.Spec.Hibernation = &gardencorev1beta1.Hibernation{Inactivity: &gardencorev1beta1.HibernationInactivity{Timeout: metav1.Duration{Duration: 8 * time.Hour}}}
+
+					DeferCleanup(test.WithVars(
+						&NewHealthCheck, healthCheckFunc(func(_ ShootConditions) []gardencorev1beta1.Condition { return nil }),
+						&NewConstraintCheck, constraintCheckFunc(func(_ ShootConstraints) []gardencorev1beta1.Condition { return nil }),
+						&NewActivityCheck, NewActivityCheckFunc(func(_ *shootpkg.Shoot, _ clock.Clock) ActivityCheck {
+							return activityCheckFunc(func() (*metav1.Time, error) {
+								activityCheckCalled = true
+								return observedActivity, activityCheckErr
+							})
+						}),
+					))
+				})
+
+				It("should record the observed activity", func() {
+					Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{RequeueAfter: careSyncPeriod}))
+
+					updatedShoot := &gardencorev1beta1.Shoot{}
+					Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), updatedShoot)).To(Succeed())
+					Expect(updatedShoot.Status.LastActivityTime).NotTo(BeNil())
+					Expect(updatedShoot.Status.LastActivityTime.Time).To(BeTemporally("==", observedActivity.Time))
+				})
+
+				It("should consider the shoot active if the activity cannot be measured", func() {
+					observedActivity = nil
+					activityCheckErr = errors.New("prometheus unavailable")
+
+					Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{RequeueAfter: careSyncPeriod}))
+
+					updatedShoot := &gardencorev1beta1.Shoot{}
+					Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), updatedShoot)).To(Succeed())
+					Expect(updatedShoot.Status.LastActivityTime).NotTo(BeNil())
+					Expect(updatedShoot.Status.LastActivityTime.Time).To(BeTemporally("~", fakeClock.Now(), time.Second))
+				})
+
+				It("should not record the observed activity if the last activity time was updated recently", func() {
+					lastActivityTime := metav1.Time{Time: observedActivity.Add(-time.Minute)}
+					shoot.Status.LastActivityTime = &lastActivityTime
+					Expect(gardenClient.Status().Update(ctx, shoot)).To(Succeed())
+
+					Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{RequeueAfter: careSyncPeriod}))
+
+					updatedShoot := &gardencorev1beta1.Shoot{}
+					Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), updatedShoot)).To(Succeed())
+					Expect(updatedShoot.Status.LastActivityTime).NotTo(BeNil())
+					Expect(updatedShoot.Status.LastActivityTime.Time).To(BeTemporally("==", lastActivityTime.Time))
+				})
+
+				It("should not measure the activity if the shoot is hibernated", func() {
+					shoot.Spec.Hibernation.Enabled = new(true)
+					Expect(gardenClient.Update(ctx, shoot)).To(Succeed())
+
+					Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{RequeueAfter: careSyncPeriod}))
+
+					Expect(activityCheckCalled).To(BeFalse())
+				})
+			})
 		})
 	})
 })
@@ -603,6 +682,12 @@ func opFunc(op *operation.Operation, err error) NewOperationFunc {
 	}
 }
 
+type activityCheckFunc func() (*metav1.Time, error)
+
+func (a activityCheckFunc) Check(_ context.Context) (*metav1.Time, error) {
+	return a()
+}
+
 type nopGarbageCollector struct{}
 
 func (n *nopGarbageCollector) Collect(_ context.Context) {}
@@ -6,6 +6,7 @@ package care
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -19,6 +20,8 @@ import (
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/client/kubernetes/clientmap"
+	"github.com/gardener/gardener/pkg/component/observability/monitoring/prometheus"
+	shootprometheus "github.com/gardener/gardener/pkg/component/observability/monitoring/prometheus/shoot"
 	"github.com/gardener/gardener/pkg/gardenlet/operation"
 	"github.com/gardener/gardener/pkg/gardenlet/operation/seed"
 	"github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
@@ -125,6 +128,20 @@ var defaultNewWebhookRemediator = func(log logr.Logger, shoot *gardencorev1beta1
 	return NewWebhookRemediation(log, shoot, init)
 }
 
+// ActivityCheck is an interface used to measure the activity of a shoot.
+type ActivityCheck interface {
+	Check(ctx context.Context) (*metav1.Time, error)
+}
+
+// NewActivityCheckFunc is a function used to create a new instance for measuring the activity of a shoot.
+type NewActivityCheckFunc func(shoot *shoot.Shoot, clock clock.Clock) ActivityCheck
+
+// defaultNewActivityCheck is the default function to create a new instance for measuring the activity of a shoot. It
+// queries the Prometheus in the control plane namespace of the shoot.
+var defaultNewActivityCheck NewActivityCheckFunc = func(shoot *shoot.Shoot, clock clock.Clock) ActivityCheck {
+	return NewActivity(fmt.Sprintf("http://prometheus-%s.%s.svc.cluster.local:%d", shootprometheus.Label, shoot.ControlPlaneNamespace, prometheus.ServicePorts().Web.Port), clock)
+}
+
 // NewOperationFunc is a function used to create a new `operation.Operation` instance.
 type NewOperationFunc func(
 	ctx context.Context,
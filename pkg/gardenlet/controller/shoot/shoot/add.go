@@ -24,7 +24,9 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/shoot/helper"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 )
 
 // ControllerName is the name of this controller.
@@ -50,7 +52,7 @@ func (r *Reconciler) AddToManager(mgr manager.Manager, gardenCluster cluster.Clu
 			gardenCluster.GetCache(),
 			&gardencorev1beta1.Shoot{},
 			r.EventHandler(mgr.GetLogger().WithValues("controller", ControllerName)),
-			&predicate.GenerationChangedPredicate{},
+			predicate.Or(predicate.GenerationChangedPredicate{}, r.DryRunRequested()),
 		)).
 		Complete(r)
 }
@@ -95,3 +97,17 @@ func (r *Reconciler) EventHandler(log logr.Logger) handler.EventHandler {
 		},
 	}
 }
+
+// DryRunRequested returns a predicate which returns true when the dry-run operation annotation was added to a Shoot.
+// Such updates do not change the generation of the Shoot, hence they are not covered by the GenerationChangedPredicate.
+func (r *Reconciler) DryRunRequested() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(_ event.CreateEvent) bool { return false },
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return !kubernetesutils.HasMetaDataAnnotation(e.ObjectOld, v1beta1constants.GardenerOperation, v1beta1constants.ShootOperationDryRun) &&
+				kubernetesutils.HasMetaDataAnnotation(e.ObjectNew, v1beta1constants.GardenerOperation, v1beta1constants.ShootOperationDryRun)
+		},
+		DeleteFunc:  func(_ event.DeleteEvent) bool { return false },
+		GenericFunc: func(_ event.GenericEvent) bool { return false },
+	}
+}
@@ -17,6 +17,7 @@ import (
 	testclock "k8s.io/utils/clock/testing"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/gardenlet/v1alpha1"
@@ -108,4 +109,46 @@ var _ = Describe("Add", func() {
 			Expect(queue.AddedAfter).To(BeEmpty())
 		})
 	})
+
+	Describe("#DryRunRequested", func() {
+		var (
+			p   predicate.Predicate
+			obj *gardencorev1beta1.Shoot
+		)
+
+		BeforeEach(func() {
+			p = (&Reconciler{}).DryRunRequested()
+			obj = &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "namespace"}}
+		})
+
+		It("should return false for Create events", func() {
+			metav1.SetMetaDataAnnotation(&obj.ObjectMeta, "gardener.cloud/operation", "dry-run")
+			Expect(p.Create(event.CreateEvent{Object: obj})).To(BeFalse())
+		})
+
+		It("should return true for Update events adding the dry-run operation annotation", func() {
+			objOld := obj.DeepCopy()
+			metav1.SetMetaDataAnnotation(&obj.ObjectMeta, "gardener.cloud/operation", "dry-run")
+			Expect(p.Update(event.UpdateEvent{ObjectOld: objOld, ObjectNew: obj})).To(BeTrue())
+		})
+
+		It("should return false for Update events adding another operation annotation", func() {
+			objOld := obj.DeepCopy()
+			metav1.SetMetaDataAnnotation(&obj.ObjectMeta, "gardener.cloud/operation", "reconcile")
+			Expect(p.Update(event.UpdateEvent{ObjectOld: objOld, ObjectNew: obj})).To(BeFalse())
+		})
+
+		It("should return false for Update events keeping the dry-run operation annotation", func() {
+			metav1.SetMetaDataAnnotation(&obj.ObjectMeta, "gardener.cloud/operation", "dry-run")
+			Expect(p.Update(event.UpdateEvent{ObjectOld: obj.DeepCopy(), ObjectNew: obj})).To(BeFalse())
+		})
+
+		It("should return false for Delete events", func() {
+			Expect(p.Delete(event.DeleteEvent{Object: obj})).To(BeFalse())
+		})
+
+		It("should return false for Generic events", func() {
+			Expect(p.Generic(event.GenericEvent{Object: obj})).To(BeFalse())
+		})
+	})
 })
@@ -15,7 +15,7 @@ import (
 
 // ComputeOperationType determines which operation should be executed when acting on the given shoot.
 func ComputeOperationType(shoot *gardencorev1beta1.Shoot) gardencorev1beta1.LastOperationType {
-	if v1beta1helper.ShouldPrepareShootForMigration(shoot) {
+	if v1beta1helper.ShouldPrepareShootForMigration(shoot) || v1beta1helper.ShouldPrepareShootForExport(shoot) {
 		return gardencorev1beta1.LastOperationTypeMigrate
 	}
 
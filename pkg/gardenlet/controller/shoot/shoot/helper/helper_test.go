@@ -12,6 +12,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/component/etcd/etcd"
 	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/shoot/helper"
 	"github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
@@ -78,6 +79,13 @@ var _ = Describe("ComputeOperationType", func() {
 		Expect(ComputeOperationType(shoot)).To(Equal(gardencorev1beta1.LastOperationTypeMigrate))
 	})
 
+	It("should return Migrate if the export operation is requested", func() {
+		shoot.Status.LastOperation.Type = gardencorev1beta1.LastOperationTypeReconcile
+		shoot.Status.LastOperation.State = gardencorev1beta1.LastOperationStateSucceeded
+		metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.GardenerOperation, v1beta1constants.ShootOperationExport)
+		Expect(ComputeOperationType(shoot)).To(Equal(gardencorev1beta1.LastOperationTypeMigrate))
+	})
+
 	It("should return Migrate if last operation is Migrate Error", func() {
 		shoot.Status.LastOperation.Type = gardencorev1beta1.LastOperationTypeMigrate
 		shoot.Status.LastOperation.State = gardencorev1beta1.LastOperationStateError
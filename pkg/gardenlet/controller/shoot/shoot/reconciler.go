@@ -50,13 +50,20 @@ import (
 	errorsutils "github.com/gardener/gardener/pkg/utils/errors"
 	"github.com/gardener/gardener/pkg/utils/flow"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	gardenletutils "github.com/gardener/gardener/pkg/utils/gardener/gardenlet"
+	"github.com/gardener/gardener/pkg/utils/gardener/shootstate"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
 	retryutils "github.com/gardener/gardener/pkg/utils/retry"
 	versionutils "github.com/gardener/gardener/pkg/utils/version"
 )
 
-const taskID = "initializeOperation"
+const (
+	taskID = "initializeOperation"
+
+	// requeueAfterShootRestoreInProgress is the duration after which a shoot is requeued while its etcd is restored.
+	requeueAfterShootRestoreInProgress = 30 * time.Second
+)
 
 // Reconciler implements the main shoot reconciliation logic, i.e., creation, hibernation, migration and deletion.
 type Reconciler struct {
@@ -93,10 +100,19 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return r.deleteShoot(ctx, log, shoot)
 	}
 
-	if v1beta1helper.ShouldPrepareShootForMigration(shoot) {
+	if v1beta1helper.IsShootExported(shoot) {
+		log.Info("Skipping because Shoot was exported for the migration to another garden landscape")
+		return reconcile.Result{}, nil
+	}
+
+	if v1beta1helper.ShouldPrepareShootForMigration(shoot) || v1beta1helper.ShouldPrepareShootForExport(shoot) {
 		return r.migrateShoot(ctx, log, shoot)
 	}
 
+	if kubernetesutils.HasMetaDataAnnotation(shoot, v1beta1constants.GardenerOperation, v1beta1constants.ShootOperationDryRun) {
+		return r.planShoot(ctx, log, shoot)
+	}
+
 	return r.reconcileShoot(ctx, log, shoot)
 }
 
@@ -114,6 +130,13 @@ func (r *Reconciler) reconcileShoot(ctx context.Context, log logr.Logger, shoot
 		}
 	}
 
+	if restoreInProgress, err := r.shootRestoreInProgress(ctx, shoot); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed checking for ShootRestores in progress: %w", err)
+	} else if restoreInProgress {
+		log.Info("Skipping shoot because its etcd is currently being restored", "requeueAfter", requeueAfterShootRestoreInProgress)
+		return reconcile.Result{RequeueAfter: requeueAfterShootRestoreInProgress}, nil
+	}
+
 	formerRetryCycleStartTime := new(metav1.NewTime(r.Clock.Now().UTC()))
 	if shoot.Status.RetryCycleStartTime != nil {
 		formerRetryCycleStartTime = shoot.Status.RetryCycleStartTime.DeepCopy()
@@ -125,7 +148,7 @@ func (r *Reconciler) reconcileShoot(ctx context.Context, log logr.Logger, shoot
 	}
 
 	r.Recorder.Eventf(shoot, nil, corev1.EventTypeNormal, gardencorev1beta1.EventReconciling, gardencorev1beta1.EventActionReconcile, "%s Shoot cluster", utils.IifString(isRestoring, "Restoring", "Reconciling"))
-	if flowErr := r.runReconcileShootFlow(ctx, o, operationType); flowErr != nil {
+	if flowErr := r.runReconcileShootFlow(ctx, o, operationType, false); flowErr != nil {
 		r.Recorder.Eventf(shoot, nil, corev1.EventTypeWarning, gardencorev1beta1.EventReconcileError, gardencorev1beta1.EventActionReconcile, flowErr.Description)
 		updateErr := r.patchShootStatusOperationError(ctx, shoot, flowErr.Description, operationType, false, flowErr.LastErrors...)
 		return reconcile.Result{}, errorsutils.WithSuppressed(errors.New(flowErr.Description), updateErr)
@@ -164,12 +187,17 @@ func (r *Reconciler) reconcileShoot(ctx context.Context, log logr.Logger, shoot
 func (r *Reconciler) migrateShoot(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot) (reconcile.Result, error) {
 	log = log.WithValues("operation", "migrate")
 
-	readyForMigrationConstraint := v1beta1helper.GetCondition(shoot.Status.Constraints, gardencorev1beta1.ShootReadyForMigration)
-	if readyForMigrationConstraint == nil {
-		return reconcile.Result{}, fmt.Errorf("waiting for confirmation that destination Seed is available to host the control plane of Shoot %s", shoot.GetName())
-	}
-	if readyForMigrationConstraint.Status != gardencorev1beta1.ConditionTrue {
-		return reconcile.Result{}, fmt.Errorf("destination Seed is not available to host the control plane of Shoot %s: %s", shoot.GetName(), readyForMigrationConstraint.Message)
+	// When the shoot is exported for the migration to another garden landscape, there is no destination seed in this
+	// garden which could confirm to be available.
+	isExport := v1beta1helper.ShouldPrepareShootForExport(shoot)
+	if !isExport {
+		readyForMigrationConstraint := v1beta1helper.GetCondition(shoot.Status.Constraints, gardencorev1beta1.ShootReadyForMigration)
+		if readyForMigrationConstraint == nil {
+			return reconcile.Result{}, fmt.Errorf("waiting for confirmation that destination Seed is available to host the control plane of Shoot %s", shoot.GetName())
+		}
+		if readyForMigrationConstraint.Status != gardencorev1beta1.ConditionTrue {
+			return reconcile.Result{}, fmt.Errorf("destination Seed is not available to host the control plane of Shoot %s: %s", shoot.GetName(), readyForMigrationConstraint.Message)
+		}
 	}
 
 	hasBastions, err := r.shootHasBastions(ctx, shoot)
@@ -187,6 +215,12 @@ func (r *Reconciler) migrateShoot(ctx context.Context, log logr.Logger, shoot *g
 		return result, err
 	}
 
+	if isExport && o.Seed.GetInfo().Spec.Backup == nil {
+		exportErr := errors.New("shoot cannot be exported because its seed does not have backups configured")
+		updateErr := r.patchShootStatusOperationError(ctx, shoot, exportErr.Error(), gardencorev1beta1.LastOperationTypeMigrate, false, shoot.Status.LastErrors...)
+		return reconcile.Result{}, errorsutils.WithSuppressed(exportErr, updateErr)
+	}
+
 	r.Recorder.Eventf(shoot, nil, corev1.EventTypeNormal, gardencorev1beta1.EventPrepareMigration, gardencorev1beta1.EventActionMigrate, "Preparing Shoot cluster for migration")
 	if flowErr := r.runMigrateShootFlow(ctx, o); flowErr != nil {
 		r.Recorder.Eventf(shoot, nil, corev1.EventTypeWarning, gardencorev1beta1.EventMigrationPreparationFailed, gardencorev1beta1.EventActionMigrate, flowErr.Description)
@@ -212,6 +246,19 @@ func (r *Reconciler) deleteShoot(ctx context.Context, log logr.Logger, shoot *ga
 		return r.finalizeShootDeletion(ctx, log, shoot)
 	}
 
+	// If the shoot was exported, its control plane was already removed from the seed and the remaining resources (e.g.,
+	// the infrastructure) are now managed by the garden landscape the shoot was imported into. Hence, only the resources
+	// in this garden are cleaned up.
+	if v1beta1helper.IsShootExported(shoot) {
+		log.Info("Shoot was exported for the migration to another garden landscape, deletion accepted without cleaning up its infrastructure")
+		if err := r.cleanupExportedShoot(ctx, shoot); err != nil {
+			lastErr := v1beta1helper.LastError(fmt.Sprintf("Could not clean up exported Shoot: %s", err))
+			updateErr := r.patchShootStatusOperationError(ctx, shoot, lastErr.Description, gardencorev1beta1.LastOperationTypeDelete, false, *lastErr)
+			return reconcile.Result{}, errorsutils.WithSuppressed(errors.New(lastErr.Description), updateErr)
+		}
+		return r.finalizeShootDeletion(ctx, log, shoot)
+	}
+
 	operationType := v1beta1helper.ComputeOperationType(shoot.ObjectMeta, shoot.Status.LastOperation)
 
 	hasBastions, err := r.shootHasBastions(ctx, shoot)
@@ -264,36 +311,45 @@ func (r *Reconciler) deleteShoot(ctx context.Context, log logr.Logger, shoot *ga
 	return r.finalizeShootDeletion(ctx, log, shoot)
 }
 
-func (r *Reconciler) prepareOperation(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot) (*operation.Operation, reconcile.Result, error) {
-	// fetch related objects required for shoot operation
+// getRelatedObjects fetches the objects related to the given shoot which are required for a shoot operation.
+func (r *Reconciler) getRelatedObjects(ctx context.Context, shoot *gardencorev1beta1.Shoot) (*gardencorev1beta1.Project, *gardencorev1beta1.CloudProfile, *gardencorev1beta1.Seed, *gardencorev1beta1.ExposureClass, error) {
 	project, _, err := gardenerutils.ProjectAndNamespaceFromReader(ctx, r.GardenClient, shoot.Namespace)
 	if err != nil {
-		return nil, reconcile.Result{}, err
+		return nil, nil, nil, nil, err
 	}
 	if project == nil {
-		return nil, reconcile.Result{}, fmt.Errorf("cannot find Project for namespace '%s'", shoot.Namespace)
+		return nil, nil, nil, nil, fmt.Errorf("cannot find Project for namespace '%s'", shoot.Namespace)
 	}
 
 	cloudProfile, err := gardenerutils.GetCloudProfile(ctx, r.GardenClient, shoot)
 	if err != nil {
-		return nil, reconcile.Result{}, err
+		return nil, nil, nil, nil, err
 	}
 
 	seed := &gardencorev1beta1.Seed{}
 	// always fetch the seed that this gardenlet is responsible for (instead of using spec.seedName),
 	// it is never acting on a foreign seed (e.g., during control plane migration)
 	if err := r.GardenClient.Get(ctx, client.ObjectKey{Name: r.Config.SeedConfig.Name}, seed); err != nil {
-		return nil, reconcile.Result{}, err
+		return nil, nil, nil, nil, err
 	}
 
 	var exposureClass *gardencorev1beta1.ExposureClass
 	if shoot.Spec.ExposureClassName != nil {
 		exposureClass = &gardencorev1beta1.ExposureClass{}
 		if err := r.GardenClient.Get(ctx, client.ObjectKey{Name: *shoot.Spec.ExposureClassName}, exposureClass); err != nil {
-			return nil, reconcile.Result{}, err
+			return nil, nil, nil, nil, err
 		}
 	}
 
+	return project, cloudProfile, seed, exposureClass, nil
+}
+
+func (r *Reconciler) prepareOperation(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot) (*operation.Operation, reconcile.Result, error) {
+	project, cloudProfile, seed, exposureClass, err := r.getRelatedObjects(ctx, shoot)
+	if err != nil {
+		return nil, reconcile.Result{}, err
+	}
+
 	i := helper.CalculateControllerInfos(seed, shoot, r.Clock, *r.Config.Controllers.Shoot)
 	log.V(1).Info("Calculated infos", "infos", i)
 
@@ -526,6 +582,10 @@ func (r *Reconciler) finalizeShootMigration(ctx context.Context, shoot *gardenco
 
 	metaPatch := client.MergeFrom(shoot.DeepCopy())
 	controllerutils.RemoveAllTasks(shoot.Annotations)
+	if v1beta1helper.ShouldPrepareShootForExport(shoot) {
+		delete(shoot.Annotations, v1beta1constants.GardenerOperation)
+		metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.ShootExported, "true")
+	}
 	if err := r.GardenClient.Patch(ctx, shoot, metaPatch); err != nil {
 		return reconcile.Result{}, err
 	}
@@ -545,6 +605,32 @@ func (r *Reconciler) finalizeShootDeletion(ctx context.Context, log logr.Logger,
 	return reconcile.Result{}, r.removeFinalizerFromShoot(ctx, log, shoot)
 }
 
+// cleanupExportedShoot deletes the resources of an exported shoot in the garden cluster which are usually deleted by the
+// deletion flow, i.e., the ShootState and the public service account issuer information. The infrastructure, the DNS
+// records, and the etcd backups are not touched since they are still used by the imported shoot.
+func (r *Reconciler) cleanupExportedShoot(ctx context.Context, shoot *gardencorev1beta1.Shoot) error {
+	project, err := gardenerutils.ProjectForNamespaceFromReader(ctx, r.GardenClient, shoot.Namespace)
+	if err != nil {
+		return fmt.Errorf("failed reading project for namespace %s: %w", shoot.Namespace, err)
+	}
+
+	issuerSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      gardenerutils.ComputeManagedShootIssuerSecretName(project.Name, shoot.UID),
+			Namespace: gardencorev1beta1.GardenerShootIssuerNamespace,
+		},
+	}
+	if err := r.GardenClient.Delete(ctx, issuerSecret); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("failed deleting service account issuer secret: %w", err)
+	}
+
+	if err := shootstate.Delete(ctx, r.GardenClient, shoot); err != nil {
+		return fmt.Errorf("failed deleting ShootState: %w", err)
+	}
+
+	return nil
+}
+
 // deleteClusterResourceFromSeed deletes the `Cluster` extension resource for the shoot in the seed cluster.
 func (r *Reconciler) deleteClusterResourceFromSeed(ctx context.Context, shoot *gardencorev1beta1.Shoot) error {
 	if shoot.Status.TechnicalID == "" {
@@ -1112,6 +1198,33 @@ func removeNonExistentPoolsFromPendingWorkersRollouts(shoot *gardencorev1beta1.S
 	}
 }
 
+// shootRestoreInProgress checks whether a ShootRestore for the given shoot has not completed yet. An operation which is
+// already processing is not blocked, the ShootRestore waits for it to finish instead.
+func (r *Reconciler) shootRestoreInProgress(ctx context.Context, shoot *gardencorev1beta1.Shoot) (bool, error) {
+	if gardenletutils.IsResponsibleForSelfHostedShoot() {
+		return false, nil
+	}
+
+	if shoot.Status.LastOperation != nil && shoot.Status.LastOperation.State == gardencorev1beta1.LastOperationStateProcessing {
+		return false, nil
+	}
+
+	shootRestoreList := &operationsv1alpha1.ShootRestoreList{}
+	if err := r.GardenClient.List(ctx, shootRestoreList, client.InNamespace(shoot.Namespace), client.MatchingFields{operations.ShootRestoreShootName: shoot.Name}); err != nil {
+		return false, err
+	}
+
+	for _, shootRestore := range shootRestoreList.Items {
+		if shootRestore.DeletionTimestamp == nil &&
+			shootRestore.Status.Phase != operationsv1alpha1.ShootRestoreSucceeded &&
+			shootRestore.Status.Phase != operationsv1alpha1.ShootRestoreFailed {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
 func (r *Reconciler) shootHasBastions(ctx context.Context, shoot *gardencorev1beta1.Shoot) (bool, error) {
 	return kubernetesutils.ResourcesExist(ctx, r.GardenClient, &operationsv1alpha1.BastionList{}, r.GardenClient.Scheme(), client.MatchingFields{operations.BastionShootName: shoot.Name})
 }
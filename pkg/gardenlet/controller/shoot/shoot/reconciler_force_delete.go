@@ -11,6 +11,7 @@ import (
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
 	"github.com/gardener/gardener/pkg/client/kubernetes/clientmap/keys"
@@ -20,6 +21,7 @@ import (
 	"github.com/gardener/gardener/pkg/utils/flow"
 	"github.com/gardener/gardener/pkg/utils/gardener/shootstate"
 	retryutils "github.com/gardener/gardener/pkg/utils/retry"
+	"github.com/gardener/gardener/pkg/utils/tracing"
 )
 
 // runForceDeleteShootFlow force deletes a Shoot cluster.
@@ -69,7 +71,7 @@ func (r *Reconciler) runForceDeleteShootFlow(ctx context.Context, log logr.Logge
 	}
 
 	var (
-		defaultInterval         = 5 * time.Second
+		retryPolicy             = &flow.RetryPolicy{Interval: 5 * time.Second}
 		defaultTimeout          = 30 * time.Second
 		nonTerminatingNamespace = botanist.SeedNamespaceObject.UID != "" && botanist.SeedNamespaceObject.Status.Phase != corev1.NamespaceTerminating
 
@@ -78,8 +80,10 @@ func (r *Reconciler) runForceDeleteShootFlow(ctx context.Context, log logr.Logge
 		g = flow.NewGraph("Shoot cluster force deletion")
 
 		deleteExtensionObjects = g.Add(flow.Task{
-			Name: "Deleting extension resources",
-			Fn:   flow.TaskFn(cleaner.DeleteExtensionObjects).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			Name:    "Deleting extension resources",
+			Fn:      cleaner.DeleteExtensionObjects,
+			Timeout: defaultTimeout,
+			Retry:   retryPolicy,
 		})
 		waitUntilExtensionObjectsDeleted = g.Add(flow.Task{
 			Name:         "Waiting until extension resources have been deleted",
@@ -118,24 +122,31 @@ func (r *Reconciler) runForceDeleteShootFlow(ctx context.Context, log logr.Logge
 		})
 		deleteMachineResources = g.Add(flow.Task{
 			Name:         "Deleting machine resources",
-			Fn:           flow.TaskFn(cleaner.DeleteMachineResources).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			Fn:           cleaner.DeleteMachineResources,
+			Timeout:      defaultTimeout,
+			Retry:        retryPolicy,
 			SkipIf:       botanist.Shoot.IsWorkerless,
 			Dependencies: flow.NewTaskIDs(waitUntilMachineControllerManagerDeleted),
 		})
 		waitUntilMachineResourcesDeleted = g.Add(flow.Task{
 			Name:         "Waiting until machine resources have been deleted",
-			Fn:           flow.TaskFn(cleaner.WaitUntilMachineResourcesDeleted).Timeout(defaultTimeout),
+			Fn:           cleaner.WaitUntilMachineResourcesDeleted,
+			Timeout:      defaultTimeout,
 			SkipIf:       botanist.Shoot.IsWorkerless,
 			Dependencies: flow.NewTaskIDs(deleteMachineResources),
 		})
 		setKeepObjectsForManagedResources = g.Add(flow.Task{
 			Name:         "Configuring managed resources to not keep their objects when deleted",
-			Fn:           flow.TaskFn(cleaner.SetKeepObjectsForManagedResources).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			Fn:           cleaner.SetKeepObjectsForManagedResources,
+			Timeout:      defaultTimeout,
+			Retry:        retryPolicy,
 			Dependencies: flow.NewTaskIDs(waitUntilExtensionObjectsDeleted),
 		})
 		deleteManagedResources = g.Add(flow.Task{
 			Name:         "Deleting managed resources",
-			Fn:           flow.TaskFn(cleaner.DeleteManagedResources).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			Fn:           cleaner.DeleteManagedResources,
+			Timeout:      defaultTimeout,
+			Retry:        retryPolicy,
 			Dependencies: flow.NewTaskIDs(setKeepObjectsForManagedResources),
 		})
 		waitUntilManagedResourcesDeleted = g.Add(flow.Task{
@@ -145,7 +156,9 @@ func (r *Reconciler) runForceDeleteShootFlow(ctx context.Context, log logr.Logge
 		})
 		deleteCluster = g.Add(flow.Task{
 			Name:         "Deleting Cluster resource",
-			Fn:           flow.TaskFn(cleaner.DeleteCluster).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			Fn:           cleaner.DeleteCluster,
+			Timeout:      defaultTimeout,
+			Retry:        retryPolicy,
 			Dependencies: flow.NewTaskIDs(waitUntilExtensionObjectsDeleted, destroyIngressDomainDNSRecord, destroyExternalDomainDNSRecord, destroyInternalDomainDNSRecord, waitUntilManagedResourcesDeleted),
 		})
 
@@ -158,7 +171,9 @@ func (r *Reconciler) runForceDeleteShootFlow(ctx context.Context, log logr.Logge
 
 		deleteEtcds = g.Add(flow.Task{
 			Name:         "Deleting Etcd resources",
-			Fn:           flow.TaskFn(botanist.DestroyEtcd).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			Fn:           botanist.DestroyEtcd,
+			Timeout:      defaultTimeout,
+			Retry:        retryPolicy,
 			Dependencies: flow.NewTaskIDs(syncPoint),
 		})
 		waitUntilEtcdsDeleted = g.Add(flow.Task{
@@ -168,12 +183,16 @@ func (r *Reconciler) runForceDeleteShootFlow(ctx context.Context, log logr.Logge
 		})
 		deleteKubernetesResources = g.Add(flow.Task{
 			Name:         "Deleting Kubernetes resources",
-			Fn:           flow.TaskFn(cleaner.DeleteKubernetesResources).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			Fn:           cleaner.DeleteKubernetesResources,
+			Timeout:      defaultTimeout,
+			Retry:        retryPolicy,
 			Dependencies: flow.NewTaskIDs(syncPoint, waitUntilEtcdsDeleted),
 		})
 		deleteNamespace = g.Add(flow.Task{
 			Name:         "Deleting shoot namespace",
-			Fn:           flow.TaskFn(botanist.DeleteSeedNamespace).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			Fn:           botanist.DeleteSeedNamespace,
+			Timeout:      defaultTimeout,
+			Retry:        retryPolicy,
 			Dependencies: flow.NewTaskIDs(syncPoint, waitUntilEtcdsDeleted, deleteKubernetesResources),
 		})
 		_ = g.Add(flow.Task{
@@ -202,6 +221,8 @@ func (r *Reconciler) runForceDeleteShootFlow(ctx context.Context, log logr.Logge
 		ProgressReporter: r.newProgressReporter(o.ReportShootProgress),
 		ErrorCleaner:     o.CleanShootTaskError,
 		ErrorContext:     errorContext,
+		TrackingKey:      client.ObjectKeyFromObject(o.Shoot.GetInfo()).String(),
+		SpanAttributes:   tracing.ShootAttributes(o.Shoot.GetInfo()),
 	}); err != nil {
 		return v1beta1helper.NewWrappedLastErrors(v1beta1helper.FormatLastErrDescription(err), flow.Errors(err))
 	}
@@ -0,0 +1,146 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/client/kubernetes/dryrun"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/shoot/helper"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+const (
+	// DataKeyPlanShootGeneration is the key in the data of the plan ConfigMap which contains the generation of the
+	// shoot the plan was computed for.
+	DataKeyPlanShootGeneration = "shootGeneration"
+	// DataKeyPlanTimestamp is the key in the data of the plan ConfigMap which contains the time the plan was computed.
+	DataKeyPlanTimestamp = "timestamp"
+	// DataKeyPlanSummary is the key in the data of the plan ConfigMap which contains the number of changes per cluster.
+	DataKeyPlanSummary = "summary"
+	// DataKeyPlanDiff is the key in the data of the plan ConfigMap which contains the changes including their diffs.
+	DataKeyPlanDiff = "diff"
+	// DataKeyPlanError is the key in the data of the plan ConfigMap which contains the error if the dry-run failed. In
+	// this case, the other keys contain the changes computed until the error occurred.
+	DataKeyPlanError = "error"
+
+	// dryRunTimeout limits the overall duration of a dry-run.
+	dryRunTimeout = 10 * time.Minute
+	// maxPlanDiffSize limits the size of the diff stored in the plan ConfigMap, which must not exceed 1 MiB in total.
+	maxPlanDiffSize = 768 * 1024
+)
+
+// planShoot runs the reconciliation flow with clients which only perform dry-run writes and stores the changes which
+// would be applied to the garden, seed and shoot clusters in the `<shoot>.plan` ConfigMap in the project namespace.
+// Afterwards, the dry-run operation annotation is removed.
+func (r *Reconciler) planShoot(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot) (reconcile.Result, error) {
+	log = log.WithValues("operation", "dry-run")
+
+	project, cloudProfile, seed, exposureClass, err := r.getRelatedObjects(ctx, shoot)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	var (
+		recorder         = dryrun.NewRecorder()
+		dryRunReconciler = *r
+		planErr          error
+	)
+
+	dryRunReconciler.GardenClient = dryrun.NewClient(r.GardenClient, "garden", recorder)
+	dryRunReconciler.SeedClientSet = dryrun.NewClientSet(r.SeedClientSet, "seed", recorder)
+	dryRunReconciler.ShootClientMap = dryrun.NewClientMap(r.ShootClientMap, "shoot", recorder)
+
+	log.Info("Computing changes of Shoot reconciliation in dry-run mode")
+	planCtx, cancel := context.WithTimeout(ctx, dryRunTimeout)
+	defer cancel()
+
+	// The operation works on a copy of the shoot since the dry-run responses of the API server are written back to it.
+	if o, err := dryRunReconciler.initializeOperation(planCtx, log, shoot.DeepCopy(), project, cloudProfile, seed, exposureClass); err != nil {
+		planErr = fmt.Errorf("could not initialize a new operation for Shoot cluster: %w", err)
+	} else if err := dryRunReconciler.syncClusterResourceToSeed(planCtx, o.Shoot.GetInfo(), project, cloudProfile, seed); err != nil {
+		planErr = fmt.Errorf("shoot cannot be synced with seed: %w", err)
+	} else if flowErr := dryRunReconciler.runReconcileShootFlow(planCtx, o, helper.ComputeOperationType(shoot), true); flowErr != nil {
+		planErr = errors.New(flowErr.Description)
+	}
+
+	changes, err := recorder.Changes()
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	configMapName := gardenerutils.ComputeShootProjectResourceName(shoot.Name, gardenerutils.ShootProjectConfigMapSuffixPlan)
+	if err := r.storePlan(ctx, shoot, changes, planErr); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed storing plan in ConfigMap %s: %w", configMapName, err)
+	}
+
+	if planErr != nil {
+		log.Error(planErr, "Dry-run of Shoot reconciliation failed, stored the changes computed so far", "configMapName", configMapName)
+		r.Recorder.Eventf(shoot, nil, corev1.EventTypeWarning, gardencorev1beta1.EventPlanError, gardencorev1beta1.EventActionReconcile, "Dry-run failed, stored the changes computed so far in ConfigMap %s: %s", configMapName, planErr.Error())
+	} else {
+		log.Info("Stored changes of Shoot reconciliation computed in dry-run mode", "configMapName", configMapName, "changes", len(changes))
+		r.Recorder.Eventf(shoot, nil, corev1.EventTypeNormal, gardencorev1beta1.EventPlanned, gardencorev1beta1.EventActionReconcile, "Stored changes computed in dry-run mode in ConfigMap %s", configMapName)
+	}
+
+	log.Info("Removing operation annotation")
+	patch := client.MergeFrom(shoot.DeepCopy())
+	delete(shoot.Annotations, v1beta1constants.GardenerOperation)
+	if err := r.GardenClient.Patch(ctx, shoot, patch); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed removing operation annotation: %w", err)
+	}
+
+	// Keep the regular reconciliation schedule of the shoot.
+	return helper.CalculateControllerInfos(seed, shoot, r.Clock, *r.Config.Controllers.Shoot).RequeueAfter, nil
+}
+
+func (r *Reconciler) storePlan(ctx context.Context, shoot *gardencorev1beta1.Shoot, changes []dryrun.Change, planErr error) error {
+	diff := dryrun.Report(changes)
+	if len(diff) > maxPlanDiffSize {
+		diff = diff[:maxPlanDiffSize] + "\n... (truncated)\n"
+	}
+
+	data := map[string]string{
+		DataKeyPlanShootGeneration: strconv.FormatInt(shoot.Generation, 10),
+		DataKeyPlanTimestamp:       r.Clock.Now().UTC().Format(time.RFC3339),
+		DataKeyPlanSummary:         dryrun.Summary(changes),
+		DataKeyPlanDiff:            diff,
+	}
+	if planErr != nil {
+		data[DataKeyPlanError] = planErr.Error()
+	}
+
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      gardenerutils.ComputeShootProjectResourceName(shoot.Name, gardenerutils.ShootProjectConfigMapSuffixPlan),
+			Namespace: shoot.Namespace,
+		},
+	}
+
+	_, err := controllerutils.GetAndCreateOrStrategicMergePatch(ctx, r.GardenClient, configMap, func() error {
+		configMap.OwnerReferences = []metav1.OwnerReference{
+			*metav1.NewControllerRef(shoot, gardencorev1beta1.SchemeGroupVersion.WithKind("Shoot")),
+		}
+		configMap.Labels = map[string]string{
+			v1beta1constants.LabelShootName: shoot.Name,
+			v1beta1constants.LabelShootUID:  string(shoot.UID),
+		}
+		configMap.Data = data
+		return nil
+	})
+	return err
+}
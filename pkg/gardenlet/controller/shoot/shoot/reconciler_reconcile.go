@@ -12,6 +12,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/component-base/version"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
@@ -23,6 +24,7 @@ import (
 	kubeapiserver "github.com/gardener/gardener/pkg/component/kubernetes/apiserver"
 	"github.com/gardener/gardener/pkg/component/shared"
 	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/features"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/shoot/helper"
 	"github.com/gardener/gardener/pkg/gardenlet/operation"
 	botanistpkg "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
@@ -36,11 +38,35 @@ import (
 	"github.com/gardener/gardener/pkg/utils/gardener/tokenrequest"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 	retryutils "github.com/gardener/gardener/pkg/utils/retry"
+	"github.com/gardener/gardener/pkg/utils/tracing"
 )
 
+// configMapNameFlowCheckpoint is the name of the ConfigMap in the control plane namespace which stores the checkpoint
+// of the reconciliation flow.
+const configMapNameFlowCheckpoint = "shoot-reconcile-flow-checkpoint"
+
+// dryRunTaskTimeout limits the duration of tasks without an explicit timeout when the flow is run in dry-run mode, so
+// that tasks which wait for objects that are not actually applied fail fast.
+const dryRunTaskTimeout = time.Minute
+
+// flowCheckpointRevision returns the revision of the checkpoint of the reconciliation flow. Besides the operation type
+// and the Shoot generation, it covers the gardenlet version, its configuration and the Seed generation since all of them
+// affect the objects deployed by the skipped tasks.
+func (r *Reconciler) flowCheckpointRevision(o *operation.Operation, operationType gardencorev1beta1.LastOperationType) string {
+	checksum := utils.ComputeChecksum(map[string]any{
+		"gardenletVersion": version.Get().GitVersion,
+		"gardenletConfig":  r.Config,
+		"seedGeneration":   o.Seed.GetInfo().Generation,
+	})
+
+	return fmt.Sprintf("%s-%d-%s", operationType, o.Shoot.GetInfo().Generation, checksum[:16])
+}
+
 // runReconcileShootFlow reconciles the Shoot cluster.
-// It receives an Operation object <o> which stores the Shoot object.
-func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Operation, operationType gardencorev1beta1.LastOperationType) *v1beta1helper.WrappedLastErrors {
+// It receives an Operation object <o> which stores the Shoot object. If dryRun is true, the tasks waiting for the
+// applied objects are skipped and neither the progress nor errors are reported. The clients of the operation are
+// expected to only perform dry-run writes in this case.
+func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Operation, operationType gardencorev1beta1.LastOperationType, dryRun bool) *v1beta1helper.WrappedLastErrors {
 	// We create the botanists (which will do the actual work).
 	var (
 		botanist                *botanistpkg.Botanist
@@ -117,6 +143,7 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		shootSSHAccessEnabled          = v1beta1helper.ShootEnablesSSHAccess(o.Shoot.GetInfo())
 		isRestoringHAControlPlane      = botanist.IsRestorePhase() && v1beta1helper.IsHAControlPlaneConfigured(o.Shoot.GetInfo())
 		isHibernatingShootWithWorkers  = o.Shoot.HibernationEnabled && !o.Shoot.GetInfo().Status.IsHibernated && !o.Shoot.IsWorkerless
+		isCloningFromSourceShoot       = botanist.IsCloningFromSourceShoot()
 	)
 
 	// During the 'Preparing' phase of different rotation operations, components are deployed twice. Also, the
@@ -179,6 +206,14 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			Fn:           flow.TaskFn(botanist.InitializeSecretsManagement).RetryUntilTimeout(defaultInterval, defaultTimeout),
 			Dependencies: flow.NewTaskIDs(deployNamespace, reconcileIstioInternalLoadbalancingConfigMap),
 		})
+		// The etcd is restored from the backup of the source shoot, hence the kube-apiserver must use the ETCD encryption
+		// key of the source shoot to decrypt the restored resources.
+		deploySourceShootETCDEncryptionKey = g.Add(flow.Task{
+			Name:         "Taking over ETCD encryption key from source shoot",
+			Fn:           flow.TaskFn(botanist.DeploySourceShootETCDEncryptionKey).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			SkipIf:       !isCloningFromSourceShoot,
+			Dependencies: flow.NewTaskIDs(deployNamespace),
+		})
 		initialValiDeployment = g.Add(flow.Task{
 			Name:         "Deploying initial shoot logging stack in Seed",
 			Fn:           flow.TaskFn(botanist.DeployLogging).RetryUntilTimeout(defaultInterval, defaultTimeout),
@@ -207,6 +242,7 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 				return removeTaskAnnotation(ctx, o, generation, v1beta1constants.ShootTaskDeployInfrastructure)
 			}),
 			SkipIf:       o.Shoot.IsWorkerless,
+			SkipInDryRun: true,
 			Dependencies: flow.NewTaskIDs(deployInfrastructure),
 		})
 		deployKubeAPIServerService = g.Add(flow.Task{
@@ -218,6 +254,7 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			Name:         "Waiting until Kubernetes API server service in the Seed cluster has reported readiness",
 			Fn:           botanist.Shoot.Components.ControlPlane.KubeAPIServerService.Wait,
 			SkipIf:       o.Shoot.HibernationEnabled,
+			SkipInDryRun: true,
 			Dependencies: flow.NewTaskIDs(deployKubeAPIServerService),
 		})
 		_ = g.Add(flow.Task{
@@ -257,6 +294,8 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			Name:         "Waiting until the source backup entry has been reconciled",
 			Fn:           botanist.Shoot.Components.SourceBackupEntry.Wait,
 			SkipIf:       skipReadiness || !isCopyOfBackupsRequired,
+			Resumable:    true,
+			SkipInDryRun: true,
 			Dependencies: flow.NewTaskIDs(deploySourceBackupEntry),
 		})
 		deployBackupEntryInGarden = g.Add(flow.Task{
@@ -269,6 +308,8 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			Name:         "Waiting until the backup entry has been reconciled",
 			Fn:           botanist.Shoot.Components.BackupEntry.Wait,
 			SkipIf:       skipReadiness || !allowBackup,
+			Resumable:    true,
+			SkipInDryRun: true,
 			Dependencies: flow.NewTaskIDs(deployBackupEntryInGarden),
 		})
 		copyEtcdBackups = g.Add(flow.Task{
@@ -281,6 +322,8 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			Name:         "Waiting until etcd backups are copied",
 			Fn:           botanist.Shoot.Components.ControlPlane.EtcdCopyBackupsTask.Wait,
 			SkipIf:       skipReadiness || !isCopyOfBackupsRequired,
+			Resumable:    true,
+			SkipInDryRun: true,
 			Dependencies: flow.NewTaskIDs(copyEtcdBackups),
 		})
 		_ = g.Add(flow.Task{
@@ -297,19 +340,22 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		destroySourceBackupEntry = g.Add(flow.Task{
 			Name:         "Destroying source backup entry",
 			Fn:           botanist.DestroySourceBackupEntry,
-			SkipIf:       !allowBackup || !botanist.IsRestorePhase(),
+			SkipIf:       !allowBackup || !(botanist.IsRestorePhase() || isCloningFromSourceShoot),
 			Dependencies: flow.NewTaskIDs(deployETCD),
 		})
 		_ = g.Add(flow.Task{
 			Name:         "Waiting until source backup entry has been deleted",
 			Fn:           botanist.Shoot.Components.SourceBackupEntry.WaitCleanup,
-			SkipIf:       !allowBackup || skipReadiness || !botanist.IsRestorePhase(),
+			SkipIf:       !allowBackup || skipReadiness || !(botanist.IsRestorePhase() || isCloningFromSourceShoot),
+			SkipInDryRun: true,
 			Dependencies: flow.NewTaskIDs(destroySourceBackupEntry),
 		})
 		waitUntilEtcdReady = g.Add(flow.Task{
 			Name:         "Waiting until main and event etcd report readiness",
 			Fn:           botanist.WaitUntilEtcdsReady,
 			SkipIf:       (!isRestoringHAControlPlane && o.Shoot.HibernationEnabled) || skipReadiness,
+			Resumable:    true,
+			SkipInDryRun: true,
 			Dependencies: flow.NewTaskIDs(deployETCD),
 		})
 		deployExtensionResourcesBeforeKAPI = g.Add(flow.Task{
@@ -322,6 +368,7 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			Name:         "Waiting until extension resources handled before kube-apiserver are ready",
 			Fn:           botanist.Shoot.Components.Extensions.Extension.WaitBeforeKubeAPIServer,
 			SkipIf:       o.Shoot.HibernationEnabled || skipReadiness,
+			SkipInDryRun: true,
 			Dependencies: flow.NewTaskIDs(deployExtensionResourcesBeforeKAPI),
 		})
 		deployKubeAPIServer = g.Add(flow.Task{
@@ -335,12 +382,20 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 				waitUntilEtcdReady,
 				waitUntilKubeAPIServerServiceIsReady,
 				waitUntilExtensionResourcesBeforeKAPIReady,
-			).InsertIf(!hasNodesCIDR, waitUntilInfrastructureReady),
+			).InsertIf(!hasNodesCIDR, waitUntilInfrastructureReady).InsertIf(isCloningFromSourceShoot, deploySourceShootETCDEncryptionKey),
+		})
+		_ = g.Add(flow.Task{
+			Name:         "Deleting ETCD encryption key taken over from source shoot",
+			Fn:           flow.TaskFn(botanist.DestroySourceShootETCDEncryptionKey).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			SkipIf:       !isCloningFromSourceShoot,
+			Dependencies: flow.NewTaskIDs(deployKubeAPIServer),
 		})
 		waitUntilKubeAPIServerIsReady = g.Add(flow.Task{
 			Name:         "Waiting until Kubernetes API server rolled out",
 			Fn:           botanist.Shoot.Components.ControlPlane.KubeAPIServer.Wait,
 			SkipIf:       o.Shoot.HibernationEnabled || skipReadiness,
+			Resumable:    true,
+			SkipInDryRun: true,
 			Dependencies: flow.NewTaskIDs(deployKubeAPIServer),
 		})
 		_ = g.Add(flow.Task{
@@ -358,6 +413,7 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			Name:         "Waiting until main and events etcd scaled up after kube-apiserver is ready",
 			Fn:           flow.TaskFn(botanist.WaitUntilEtcdsReady),
 			SkipIf:       !isRestoringHAControlPlane || skipReadiness,
+			SkipInDryRun: true,
 			Dependencies: flow.NewTaskIDs(scaleEtcdAfterRestore),
 		})
 		deployGardenerResourceManager = g.Add(flow.Task{
@@ -369,6 +425,8 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			Name:         "Waiting until gardener-resource-manager reports readiness",
 			Fn:           botanist.Shoot.Components.ControlPlane.ResourceManager.Wait,
 			SkipIf:       o.Shoot.HibernationEnabled || skipReadiness,
+			Resumable:    true,
+			SkipInDryRun: true,
 			Dependencies: flow.NewTaskIDs(deployGardenerResourceManager),
 		})
 		_ = g.Add(flow.Task{
@@ -397,6 +455,7 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 				return botanist.Shoot.Components.Extensions.ControlPlane.Wait(ctx)
 			}),
 			SkipIf:       o.Shoot.IsWorkerless || skipReadiness,
+			SkipInDryRun: true,
 			Dependencies: flow.NewTaskIDs(deployControlPlane),
 		})
 		deployShootNamespaces = g.Add(flow.Task{
@@ -408,6 +467,8 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			Name:         "Waiting until shoot namespaces have been reconciled",
 			Fn:           botanist.Shoot.Components.SystemComponents.Namespaces.Wait,
 			SkipIf:       o.Shoot.HibernationEnabled || skipReadiness,
+			Resumable:    true,
+			SkipInDryRun: true,
 			Dependencies: flow.NewTaskIDs(waitUntilGardenerResourceManagerReady, deployShootNamespaces),
 		})
 		deployVPNSeedServer = g.Add(flow.Task{
@@ -426,6 +487,15 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			Fn:           flow.TaskFn(botanist.InitializeDesiredShootClients).RetryUntilTimeout(defaultInterval, 2*time.Minute),
 			Dependencies: flow.NewTaskIDs(deployInternalDomainDNSRecord, deployGardenerAccess),
 		})
+		// Nodes, VolumeAttachments and PersistentVolumes restored from the backup of the source shoot must be removed before
+		// any controller acting on them is deployed, otherwise they would try to detach or release the volumes of the source
+		// shoot.
+		resetNodeSpecificState = g.Add(flow.Task{
+			Name:         "Resetting node-specific state restored from the source shoot",
+			Fn:           flow.TaskFn(botanist.ResetNodeSpecificState).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			SkipIf:       !isCloningFromSourceShoot,
+			Dependencies: flow.NewTaskIDs(initializeShootClients),
+		})
 		_ = g.Add(flow.Task{
 			Name: "Sync public service account signing keys to Garden cluster",
 			Fn:   botanist.SyncPublicServiceAccountKeys,
@@ -453,6 +523,7 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 				(v1beta1helper.GetShootETCDEncryptionKeyRotationPhase(o.Shoot.GetInfo().Status.Credentials) != gardencorev1beta1.RotationPreparing &&
 					sets.New(o.Shoot.ResourcesToEncrypt...).Equal(sets.New(o.Shoot.EncryptedResources...)) && (o.Shoot.EncryptionProviderToUse == o.Shoot.UsedEncryptionProvider ||
 					v1beta1helper.GetShootETCDEncryptionKeyRotationPhase(o.Shoot.GetInfo().Status.Credentials) == gardencorev1beta1.RotationCompleting)),
+			SkipInDryRun: true,
 			Dependencies: flow.NewTaskIDs(rewriteResourcesAddLabel),
 		})
 		_ = g.Add(flow.Task{
@@ -527,7 +598,7 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		deployKubeControllerManager = g.Add(flow.Task{
 			Name:         "Deploying Kubernetes controller manager",
 			Fn:           flow.TaskFn(botanist.DeployKubeControllerManager).RetryUntilTimeout(defaultInterval, defaultTimeout),
-			Dependencies: flow.NewTaskIDs(initializeSecretsManagement, deployCloudProviderSecret, waitUntilGardenerResourceManagerReady),
+			Dependencies: flow.NewTaskIDs(initializeSecretsManagement, deployCloudProviderSecret, waitUntilGardenerResourceManagerReady).InsertIf(isCloningFromSourceShoot, resetNodeSpecificState),
 		})
 		waitUntilKubeControllerManagerReady = g.Add(flow.Task{
 			Name: "Waiting until kube-controller-manager reports readiness",
@@ -536,6 +607,8 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 				gardencorev1beta1.RotationPreparing,
 				gardencorev1beta1.RotationPreparingWithoutWorkersRollout,
 			).Has(v1beta1helper.GetShootServiceAccountKeyRotationPhase(o.Shoot.GetInfo().Status.Credentials)),
+			Resumable:    true,
+			SkipInDryRun: true,
 			Dependencies: flow.NewTaskIDs(deployKubeControllerManager),
 		})
 		createNewServiceAccountSecrets = g.Add(flow.Task{
@@ -572,6 +645,7 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			Name:         waitExtensionAfterKAPIMsg,
 			Fn:           botanist.Shoot.Components.Extensions.Extension.WaitAfterKubeAPIServer,
 			SkipIf:       skipReadiness,
+			SkipInDryRun: true,
 			Dependencies: flow.NewTaskIDs(deployExtensionResourcesAfterKAPI),
 		})
 		deployOperatingSystemConfig = g.Add(flow.Task{
@@ -586,6 +660,7 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 				return botanist.Shoot.Components.Extensions.OperatingSystemConfig.Wait(ctx)
 			}),
 			SkipIf:       o.Shoot.IsWorkerless,
+			SkipInDryRun: true,
 			Dependencies: flow.NewTaskIDs(deployOperatingSystemConfig),
 		})
 		deleteStaleOperatingSystemConfigResources = g.Add(flow.Task{
@@ -602,6 +677,7 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 				return botanist.Shoot.Components.Extensions.OperatingSystemConfig.WaitCleanupStaleResources(ctx)
 			}),
 			SkipIf:       o.Shoot.IsWorkerless || o.Shoot.HibernationEnabled || skipReadiness,
+			SkipInDryRun: true,
 			Dependencies: flow.NewTaskIDs(deleteStaleOperatingSystemConfigResources),
 		})
 		deployNetwork = g.Add(flow.Task{
@@ -616,6 +692,7 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 				return botanist.Shoot.Components.Extensions.Network.Wait(ctx)
 			}),
 			SkipIf:       o.Shoot.IsWorkerless || skipReadiness,
+			SkipInDryRun: true,
 			Dependencies: flow.NewTaskIDs(deployNetwork),
 		})
 		_ = g.Add(flow.Task{
@@ -786,7 +863,7 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			Name:         "Deploying machine-controller-manager",
 			Fn:           flow.TaskFn(botanist.DeployMachineControllerManager),
 			SkipIf:       o.Shoot.IsWorkerless,
-			Dependencies: flow.NewTaskIDs(deployCloudProviderSecret, deployReferencedResources, waitUntilInfrastructureReady, initializeShootClients, waitUntilOperatingSystemConfigReady, waitUntilNetworkIsReady, createNewServiceAccountSecrets, scaleClusterAutoscalerToZero),
+			Dependencies: flow.NewTaskIDs(deployCloudProviderSecret, deployReferencedResources, waitUntilInfrastructureReady, initializeShootClients, waitUntilOperatingSystemConfigReady, waitUntilNetworkIsReady, createNewServiceAccountSecrets, scaleClusterAutoscalerToZero).InsertIf(isCloningFromSourceShoot, resetNodeSpecificState),
 		})
 		deployWorker = g.Add(flow.Task{
 			Name:         "Configuring shoot worker pools",
@@ -800,6 +877,7 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 				return botanist.Shoot.Components.Extensions.Worker.WaitUntilWorkerStatusMachineDeploymentsUpdated(ctx)
 			}),
 			SkipIf:       o.Shoot.IsWorkerless || o.Shoot.HibernationEnabled,
+			SkipInDryRun: true,
 			Dependencies: flow.NewTaskIDs(deployWorker),
 		})
 		deployExtensionResourcesAfterWorker = g.Add(flow.Task{
@@ -853,6 +931,7 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 				return nil
 			}),
 			SkipIf:       o.Shoot.IsWorkerless || skipReadiness,
+			SkipInDryRun: true,
 			Dependencies: flow.NewTaskIDs(deployWorker, waitUntilWorkerStatusUpdate, deployManagedResourceForGardenerNodeAgent),
 		})
 		_ = g.Add(flow.Task{
@@ -865,6 +944,8 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			Name:         "Waiting until extension resources handled after workers are ready",
 			Fn:           botanist.Shoot.Components.Extensions.Extension.WaitAfterWorker,
 			SkipIf:       o.Shoot.IsWorkerless || skipReadiness,
+			Resumable:    true,
+			SkipInDryRun: true,
 			Dependencies: flow.NewTaskIDs(deployExtensionResourcesAfterWorker),
 		})
 		_ = g.Add(flow.Task{
@@ -888,6 +969,7 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			Name:         "Waiting until nginx ingress LoadBalancer is ready",
 			Fn:           botanist.WaitUntilNginxIngressServiceIsReady,
 			SkipIf:       o.Shoot.IsWorkerless || o.Shoot.HibernationEnabled || !v1beta1helper.NginxIngressEnabled(botanist.Shoot.GetInfo().Spec.Addons),
+			SkipInDryRun: true,
 			Dependencies: flow.NewTaskIDs(initializeShootClients, waitUntilWorkerReady, ensureShootClusterIdentity),
 		})
 		_ = g.Add(flow.Task{
@@ -905,6 +987,8 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			Name:         "Waiting until the Kubernetes API server can connect to the Shoot workers",
 			Fn:           botanist.WaitUntilTunnelConnectionExists,
 			SkipIf:       o.Shoot.IsWorkerless || o.Shoot.HibernationEnabled || skipReadiness,
+			Resumable:    true,
+			SkipInDryRun: true,
 			Dependencies: flow.NewTaskIDs(syncPointAllSystemComponentsDeployed, waitUntilNetworkIsReady, waitUntilWorkerReady),
 		})
 		_ = g.Add(flow.Task{
@@ -913,6 +997,8 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 				return botanist.WaitUntilOperatingSystemConfigUpdatedForAllWorkerPools(ctx, false)
 			},
 			SkipIf:       o.Shoot.IsWorkerless || o.Shoot.HibernationEnabled,
+			Resumable:    true,
+			SkipInDryRun: true,
 			Dependencies: flow.NewTaskIDs(waitUntilWorkerReady, waitUntilTunnelConnectionExists),
 		})
 		deployAlertmanager = g.Add(flow.Task{
@@ -923,6 +1009,8 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		waitUntilAlertmanagerReconciled = g.Add(flow.Task{
 			Name:         "Waiting until Shoot Alertmanager is reconciled",
 			Fn:           botanist.WaitForAlertManager,
+			Resumable:    true,
+			SkipInDryRun: true,
 			Dependencies: flow.NewTaskIDs(deployAlertmanager),
 		})
 		deployPrometheus = g.Add(flow.Task{
@@ -933,6 +1021,8 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		waitUntilPrometheusReconciled = g.Add(flow.Task{
 			Name:         "Waiting until Shoot Prometheus is reconciled",
 			Fn:           botanist.WaitForPrometheus,
+			Resumable:    true,
+			SkipInDryRun: true,
 			Dependencies: flow.NewTaskIDs(deployPrometheus),
 		})
 		_ = g.Add(flow.Task{
@@ -954,6 +1044,8 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		waitUntilPlutonoReconciled = g.Add(flow.Task{
 			Name:         "Waiting until Plutono for Shoot in Seed is reconciled",
 			Fn:           botanist.WaitForPlutono,
+			Resumable:    true,
+			SkipInDryRun: true,
 			Dependencies: flow.NewTaskIDs(deployPlutonoForLogging, deployPlutonoForMonitoring),
 		})
 		_ = g.Add(flow.Task{
@@ -981,6 +1073,7 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			Name:         "Waiting until extension resources hibernated after kube-apiserver hibernation are ready",
 			Fn:           botanist.Shoot.Components.Extensions.Extension.WaitBeforeKubeAPIServer,
 			SkipIf:       skipReadiness || !o.Shoot.HibernationEnabled,
+			SkipInDryRun: true,
 			Dependencies: flow.NewTaskIDs(hibernateExtensionResourcesAfterKAPIHibernation),
 		})
 		_ = g.Add(flow.Task{
@@ -1010,6 +1103,7 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			Name:         "Waiting until stale extension resources are deleted",
 			Fn:           botanist.Shoot.Components.Extensions.Extension.WaitCleanupStaleResources,
 			SkipIf:       o.Shoot.HibernationEnabled || skipReadiness,
+			SkipInDryRun: true,
 			Dependencies: flow.NewTaskIDs(deleteStaleExtensionResources),
 		})
 		deployContainerRuntimeResources = g.Add(flow.Task{
@@ -1024,6 +1118,7 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 				return botanist.Shoot.Components.Extensions.ContainerRuntime.Wait(ctx)
 			}),
 			SkipIf:       o.Shoot.IsWorkerless || skipReadiness,
+			SkipInDryRun: true,
 			Dependencies: flow.NewTaskIDs(deployContainerRuntimeResources),
 		})
 		deleteStaleContainerRuntimeResources = g.Add(flow.Task{
@@ -1040,6 +1135,7 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 				return botanist.Shoot.Components.Extensions.ContainerRuntime.WaitCleanupStaleResources(ctx)
 			}),
 			SkipIf:       o.Shoot.IsWorkerless || o.Shoot.HibernationEnabled || skipReadiness,
+			SkipInDryRun: true,
 			Dependencies: flow.NewTaskIDs(deleteStaleContainerRuntimeResources),
 		})
 		_ = g.Add(flow.Task{
@@ -1057,12 +1153,26 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 
 	f := g.Compile()
 
-	if err := f.Run(ctx, flow.Opts{
-		Log:              o.Logger,
-		ProgressReporter: r.newProgressReporter(o.ReportShootProgress),
-		ErrorContext:     errorContext,
-		ErrorCleaner:     o.CleanShootTaskError,
-	}); err != nil {
+	opts := flow.Opts{
+		Log:            o.Logger,
+		TrackingKey:    client.ObjectKeyFromObject(o.Shoot.GetInfo()).String(),
+		SpanAttributes: tracing.ShootAttributes(o.Shoot.GetInfo()),
+		DryRun:         dryRun,
+	}
+
+	if dryRun {
+		opts.TaskTimeout = dryRunTaskTimeout
+	} else {
+		opts.ProgressReporter = r.newProgressReporter(o.ReportShootProgress)
+		opts.ErrorContext = errorContext
+		opts.ErrorCleaner = o.CleanShootTaskError
+
+		if features.DefaultFeatureGate.Enabled(features.ShootReconcileCheckpoints) {
+			opts.Checkpointer = flow.NewConfigMapCheckpointer(o.SeedClientSet.Client(), client.ObjectKey{Namespace: o.Shoot.ControlPlaneNamespace, Name: configMapNameFlowCheckpoint}, r.flowCheckpointRevision(o, operationType))
+		}
+	}
+
+	if err := f.Run(ctx, opts); err != nil {
 		return v1beta1helper.NewWrappedLastErrors(v1beta1helper.FormatLastErrDescription(err), flow.Errors(err))
 	}
 
@@ -1072,6 +1182,10 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		return v1beta1helper.NewWrappedLastErrors(v1beta1helper.FormatLastErrDescription(err), err)
 	}
 
+	if dryRun {
+		return nil
+	}
+
 	if !r.ShootStateControllerEnabled && botanist.IsRestorePhase() {
 		o.Logger.Info("Deleting Shoot State after successful restoration")
 		if err := shootstate.Delete(ctx, botanist.GardenClient, botanist.Shoot.GetInfo()); err != nil {
@@ -10,15 +10,21 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	gomegatypes "github.com/onsi/gomega/types"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	testclock "k8s.io/utils/clock/testing"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	"github.com/gardener/gardener/pkg/api/indexer"
+	"github.com/gardener/gardener/pkg/apis/core"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/apis/operations"
+	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
 )
 
 var _ = Describe("Reconciler", func() {
@@ -242,4 +248,105 @@ var _ = Describe("Reconciler", func() {
 			Expect(shoot.Status.Credentials.Rotation.ServiceAccountKey.LastInitiationFinishedTime.UTC()).To(Equal(fakeClock.Now()))
 		})
 	})
+
+	Describe("#shootRestoreInProgress", func() {
+		var shootRestore *operationsv1alpha1.ShootRestore
+
+		BeforeEach(func() {
+			gardenClient = fakeclient.NewClientBuilder().
+				WithScheme(kubernetes.GardenScheme).
+				WithIndex(&operationsv1alpha1.ShootRestore{}, operations.ShootRestoreShootName, indexer.ShootRestoreShootNameIndexerFunc).
+				Build()
+
+			reconciler = &Reconciler{GardenClient: gardenClient}
+
+			shootRestore = &operationsv1alpha1.ShootRestore{
+				ObjectMeta: metav1.ObjectMeta{Name: "restore", Namespace: shoot.Namespace},
+				Spec:       operationsv1alpha1.ShootRestoreSpec{ShootRef: corev1.LocalObjectReference{Name: shoot.Name}},
+			}
+		})
+
+		It("should return false if there is no ShootRestore", func() {
+			Expect(reconciler.shootRestoreInProgress(ctx, shoot)).To(BeFalse())
+		})
+
+		It("should return false if the ShootRestore references another shoot", func() {
+			shootRestore.Spec.ShootRef.Name = "other"
+			Expect(gardenClient.Create(ctx, shootRestore)).To(Succeed())
+
+			Expect(reconciler.shootRestoreInProgress(ctx, shoot)).To(BeFalse())
+		})
+
+		DescribeTable("should consider the phase of the ShootRestore",
+			func(phase operationsv1alpha1.ShootRestorePhase, matcher gomegatypes.GomegaMatcher) {
+				shootRestore.Status.Phase = phase
+				Expect(gardenClient.Create(ctx, shootRestore)).To(Succeed())
+
+				Expect(reconciler.shootRestoreInProgress(ctx, shoot)).To(matcher)
+			},
+
+			Entry("no phase", operationsv1alpha1.ShootRestorePhase(""), BeTrue()),
+			Entry("pending", operationsv1alpha1.ShootRestorePending, BeTrue()),
+			Entry("restoring", operationsv1alpha1.ShootRestoreRestoring, BeTrue()),
+			Entry("succeeded", operationsv1alpha1.ShootRestoreSucceeded, BeFalse()),
+			Entry("failed", operationsv1alpha1.ShootRestoreFailed, BeFalse()),
+		)
+
+		It("should return false if the shoot operation is already processing", func() {
+			Expect(gardenClient.Create(ctx, shootRestore)).To(Succeed())
+			shoot.Status.LastOperation = &gardencorev1beta1.LastOperation{State: gardencorev1beta1.LastOperationStateProcessing}
+
+			Expect(reconciler.shootRestoreInProgress(ctx, shoot)).To(BeFalse())
+		})
+	})
+
+	Describe("#cleanupExportedShoot", func() {
+		var (
+			project      *gardencorev1beta1.Project
+			shootState   *gardencorev1beta1.ShootState
+			issuerSecret *corev1.Secret
+		)
+
+		BeforeEach(func() {
+			gardenClient = fakeclient.NewClientBuilder().
+				WithScheme(kubernetes.GardenScheme).
+				WithIndex(&gardencorev1beta1.Project{}, core.ProjectNamespace, indexer.ProjectNamespaceIndexerFunc).
+				Build()
+
+			reconciler = &Reconciler{GardenClient: gardenClient}
+
+			shoot.UID = "shoot-uid"
+			project = &gardencorev1beta1.Project{
+				ObjectMeta: metav1.ObjectMeta{Name: "project"},
+				Spec:       gardencorev1beta1.ProjectSpec{Namespace: &shoot.Namespace},
+			}
+			shootState = &gardencorev1beta1.ShootState{
+				ObjectMeta: metav1.ObjectMeta{Name: shoot.Name, Namespace: shoot.Namespace},
+			}
+			issuerSecret = &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "project--shoot-uid", Namespace: "gardener-system-shoot-issuer"},
+			}
+		})
+
+		It("should delete the ShootState and the service account issuer secret", func() {
+			Expect(gardenClient.Create(ctx, project)).To(Succeed())
+			Expect(gardenClient.Create(ctx, shootState)).To(Succeed())
+			Expect(gardenClient.Create(ctx, issuerSecret)).To(Succeed())
+
+			Expect(reconciler.cleanupExportedShoot(ctx, shoot)).To(Succeed())
+
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shootState), shootState)).To(BeNotFoundError())
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(issuerSecret), issuerSecret)).To(BeNotFoundError())
+		})
+
+		It("should succeed if the resources are already gone", func() {
+			Expect(gardenClient.Create(ctx, project)).To(Succeed())
+
+			Expect(reconciler.cleanupExportedShoot(ctx, shoot)).To(Succeed())
+		})
+
+		It("should fail if the project does not exist", func() {
+			Expect(reconciler.cleanupExportedShoot(ctx, shoot)).To(MatchError(ContainSubstring("failed reading project for namespace")))
+		})
+	})
 })
@@ -0,0 +1,369 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shootrestore
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	druidcorev1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/gardenlet/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
+	"github.com/gardener/gardener/pkg/component/etcd/etcd"
+	"github.com/gardener/gardener/pkg/extensions"
+	"github.com/gardener/gardener/pkg/utils/flow"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
+)
+
+const (
+	// requeueAfterShootOperationInProgress is the duration after which a pending ShootRestore is checked again while
+	// the shoot is still being reconciled.
+	requeueAfterShootOperationInProgress = 30 * time.Second
+	// restoreTimeout is the maximum duration of the restoration flow.
+	restoreTimeout = time.Hour
+	// etcdRestoreTimeout is the maximum duration for the etcd to become ready after it was scaled up with empty
+	// volumes, i.e., the time which is available for etcd-backup-restore to restore the snapshots.
+	etcdRestoreTimeout = 30 * time.Minute
+	// defaultInterval is the default interval for retry operations.
+	defaultInterval = 5 * time.Second
+)
+
+// Reconciler reconciles ShootRestores and restores the etcd of the referenced shoot from its backup.
+type Reconciler struct {
+	GardenClient client.Client
+	SeedClient   client.Client
+	Config       gardenletconfigv1alpha1.ShootRestoreControllerConfiguration
+	Clock        clock.Clock
+	Recorder     events.EventRecorder
+}
+
+// Reconcile reconciles ShootRestores and restores the etcd of the referenced shoot from its backup.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	shootRestore := &operationsv1alpha1.ShootRestore{}
+	if err := r.GardenClient.Get(ctx, request.NamespacedName, shootRestore); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if shootRestore.DeletionTimestamp != nil {
+		log.V(1).Info("Skipping ShootRestore because it is being deleted")
+		return reconcile.Result{}, nil
+	}
+
+	if phase := shootRestore.Status.Phase; phase == operationsv1alpha1.ShootRestoreSucceeded || phase == operationsv1alpha1.ShootRestoreFailed {
+		log.V(1).Info("Skipping ShootRestore because it has already finished", "phase", phase)
+		return reconcile.Result{}, nil
+	}
+
+	shoot := &gardencorev1beta1.Shoot{}
+	if err := r.GardenClient.Get(ctx, client.ObjectKey{Namespace: shootRestore.Namespace, Name: shootRestore.Spec.ShootRef.Name}, shoot); err != nil {
+		if apierrors.IsNotFound(err) {
+			return reconcile.Result{}, r.fail(ctx, shootRestore, nil, fmt.Sprintf("Shoot %q does not exist", shootRestore.Spec.ShootRef.Name))
+		}
+		return reconcile.Result{}, fmt.Errorf("failed getting shoot: %w", err)
+	}
+	log = log.WithValues("shoot", client.ObjectKeyFromObject(shoot))
+
+	// The preflight checks are only performed before the restoration has started. After a restart of gardenlet, an
+	// interrupted restoration must be continued even if the checks would fail now, otherwise the control plane stays
+	// scaled down.
+	if shootRestore.Status.Phase != operationsv1alpha1.ShootRestoreRestoring {
+		if reason, err := r.preflightCheck(ctx, shootRestore, shoot); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed performing preflight checks: %w", err)
+		} else if reason != "" {
+			return reconcile.Result{}, r.fail(ctx, shootRestore, shoot, reason)
+		}
+
+		if shoot.Status.LastOperation != nil && shoot.Status.LastOperation.State == gardencorev1beta1.LastOperationStateProcessing {
+			log.Info("Shoot is currently being processed, requeueing", "requeueAfter", requeueAfterShootOperationInProgress)
+			if shootRestore.Status.Phase != operationsv1alpha1.ShootRestorePending {
+				r.Recorder.Eventf(shootRestore, shoot, corev1.EventTypeNormal, gardencorev1beta1.EventRestorePending, gardencorev1beta1.EventActionRestore, "Waiting for the current operation of the shoot to finish")
+				if err := r.updateStatus(ctx, shootRestore, operationsv1alpha1.ShootRestorePending, "Waiting for the current operation of the shoot to finish"); err != nil {
+					return reconcile.Result{}, err
+				}
+			}
+			return reconcile.Result{RequeueAfter: requeueAfterShootOperationInProgress}, nil
+		}
+
+		r.Recorder.Eventf(shootRestore, shoot, corev1.EventTypeNormal, gardencorev1beta1.EventRestoring, gardencorev1beta1.EventActionRestore, "Restoring etcd of shoot from backup")
+		if err := r.updateStatus(ctx, shootRestore, operationsv1alpha1.ShootRestoreRestoring, "The control plane is scaled down and the etcd is being restored"); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	log.Info("Restoring etcd of shoot from backup")
+
+	restoreCtx, cancel := context.WithTimeout(ctx, restoreTimeout)
+	defer cancel()
+
+	if err := r.restore(restoreCtx, log, shoot); err != nil {
+		log.Error(err, "Restoration of etcd failed")
+
+		// The etcd volumes might already be gone, hence a reconciliation of the shoot is triggered in any case to bring
+		// back the control plane. etcd-backup-restore restores the latest snapshot when it finds empty volumes.
+		if err := r.triggerShootReconciliation(ctx, shoot); err != nil {
+			log.Error(err, "Failed triggering reconciliation of shoot after failed restoration")
+		}
+
+		return reconcile.Result{}, r.fail(ctx, shootRestore, shoot, fmt.Sprintf("Restoration of etcd failed: %s", err.Error()))
+	}
+
+	log.Info("Successfully restored etcd of shoot from backup")
+	r.Recorder.Eventf(shootRestore, shoot, corev1.EventTypeNormal, gardencorev1beta1.EventRestored, gardencorev1beta1.EventActionRestore, "Restored etcd of shoot from backup")
+	return reconcile.Result{}, r.updateStatus(ctx, shootRestore, operationsv1alpha1.ShootRestoreSucceeded, "The etcd was restored, the control plane is brought back by a reconciliation of the shoot")
+}
+
+// preflightCheck checks whether the restoration can be performed. If not, it returns a non-empty reason.
+func (r *Reconciler) preflightCheck(ctx context.Context, shootRestore *operationsv1alpha1.ShootRestore, shoot *gardencorev1beta1.Shoot) (string, error) {
+	// etcd-backup-restore always restores the latest snapshots. Without a target time, the restoration would not revert
+	// any change but only cause a downtime of the control plane.
+	if shootRestore.Spec.TargetTime == nil {
+		return "No target time is set", nil
+	}
+
+	if shoot.DeletionTimestamp != nil {
+		return "Shoot is being deleted", nil
+	}
+
+	if v1beta1helper.HibernationIsEnabled(shoot) || shoot.Status.IsHibernated {
+		return "Shoot is hibernated", nil
+	}
+
+	if len(shoot.Status.TechnicalID) == 0 {
+		return "Shoot has not been created yet", nil
+	}
+
+	seedName := shootRestore.Spec.SeedName
+	if seedName == nil {
+		seedName = shoot.Spec.SeedName
+	}
+	if seedName == nil {
+		return "Shoot is not scheduled to a seed", nil
+	}
+
+	seed := &gardencorev1beta1.Seed{}
+	if err := r.GardenClient.Get(ctx, client.ObjectKey{Name: *seedName}, seed); err != nil {
+		return "", fmt.Errorf("failed getting seed %q: %w", *seedName, err)
+	}
+	if seed.Spec.Backup == nil {
+		return fmt.Sprintf("Seed %q has no backup configured", seed.Name), nil
+	}
+
+	backupEntryName, err := gardenerutils.GenerateBackupEntryName(shoot.Status.TechnicalID, shoot.Status.UID, shoot.UID)
+	if err != nil {
+		return "", fmt.Errorf("failed generating BackupEntry name: %w", err)
+	}
+	if err := r.GardenClient.Get(ctx, client.ObjectKey{Namespace: shoot.Namespace, Name: backupEntryName}, &gardencorev1beta1.BackupEntry{}); err != nil {
+		if apierrors.IsNotFound(err) {
+			return fmt.Sprintf("BackupEntry %q of shoot does not exist", backupEntryName), nil
+		}
+		return "", fmt.Errorf("failed getting BackupEntry %q: %w", backupEntryName, err)
+	}
+
+	return "", nil
+}
+
+func (r *Reconciler) restore(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot) error {
+	var (
+		namespace = shoot.Status.TechnicalID
+		etcdMain  = &druidcorev1alpha1.Etcd{ObjectMeta: metav1.ObjectMeta{Name: v1beta1constants.ETCDMain, Namespace: namespace}}
+
+		g                    = flow.NewGraph("ShootRestore")
+		scaleDownControllers = g.Add(flow.Task{
+			Name: "Scaling down controllers of the control plane",
+			Fn:   func(ctx context.Context) error { return r.scaleDownControllers(ctx, namespace) },
+		})
+		scaleDownKubeAPIServer = g.Add(flow.Task{
+			Name:         "Scaling down kube-apiserver",
+			Fn:           func(ctx context.Context) error { return r.scaleDownKubeAPIServer(ctx, namespace) },
+			Dependencies: flow.NewTaskIDs(scaleDownControllers),
+		})
+		scaleDownEtcd = g.Add(flow.Task{
+			Name:         "Scaling down etcd main",
+			Fn:           func(ctx context.Context) error { return r.scaleEtcd(ctx, etcdMain, 0) },
+			Dependencies: flow.NewTaskIDs(scaleDownKubeAPIServer),
+		})
+		waitUntilEtcdScaledDown = g.Add(flow.Task{
+			Name: "Waiting until etcd main is scaled down",
+			Fn: func(ctx context.Context) error {
+				return kubernetesutils.WaitUntilStatefulSetScaledToDesiredReplicas(ctx, r.SeedClient, client.ObjectKeyFromObject(etcdMain), 0)
+			},
+			Dependencies: flow.NewTaskIDs(scaleDownEtcd),
+		})
+		deleteEtcdVolumes = g.Add(flow.Task{
+			Name:         "Deleting volumes of etcd main",
+			Fn:           func(ctx context.Context) error { return r.deleteEtcdVolumes(ctx, etcdMain) },
+			Dependencies: flow.NewTaskIDs(waitUntilEtcdScaledDown),
+		})
+		scaleUpEtcd = g.Add(flow.Task{
+			Name:         "Scaling up etcd main",
+			Fn:           func(ctx context.Context) error { return r.scaleEtcd(ctx, etcdMain, 1) },
+			Dependencies: flow.NewTaskIDs(deleteEtcdVolumes),
+		})
+		waitUntilEtcdRestored = g.Add(flow.Task{
+			Name: "Waiting until etcd main is restored from backup",
+			Fn: func(ctx context.Context) error {
+				return extensions.WaitUntilObjectReadyWithHealthFunction(ctx, r.SeedClient, log, checkEtcdRestored, etcdMain, "Etcd", defaultInterval, etcd.DefaultSevereThreshold, etcdRestoreTimeout, nil)
+			},
+			Dependencies: flow.NewTaskIDs(scaleUpEtcd),
+		})
+		_ = g.Add(flow.Task{
+			Name:         "Triggering reconciliation of shoot",
+			Fn:           func(ctx context.Context) error { return r.triggerShootReconciliation(ctx, shoot) },
+			Dependencies: flow.NewTaskIDs(waitUntilEtcdRestored),
+		})
+	)
+
+	if err := g.Compile().Run(ctx, flow.Opts{Log: log}); err != nil {
+		return flow.Errors(err)
+	}
+
+	return nil
+}
+
+func (r *Reconciler) scaleDownControllers(ctx context.Context, namespace string) error {
+	for _, name := range []string{
+		v1beta1constants.DeploymentNameKubeControllerManager,
+		v1beta1constants.DeploymentNameKubeScheduler,
+		v1beta1constants.DeploymentNameMachineControllerManager,
+		v1beta1constants.DeploymentNameClusterAutoscaler,
+	} {
+		if err := kubernetesutils.ScaleDeployment(ctx, r.SeedClient, client.ObjectKey{Namespace: namespace, Name: name}, 0); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed scaling down deployment %q: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+func (r *Reconciler) scaleDownKubeAPIServer(ctx context.Context, namespace string) error {
+	key := client.ObjectKey{Namespace: namespace, Name: v1beta1constants.DeploymentNameKubeAPIServer}
+
+	if err := kubernetesutils.ScaleDeployment(ctx, r.SeedClient, key, 0); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed scaling down kube-apiserver: %w", err)
+	}
+
+	return kubernetesutils.WaitUntilDeploymentScaledToDesiredReplicas(ctx, r.SeedClient, key, 0)
+}
+
+func (r *Reconciler) scaleEtcd(ctx context.Context, etcdMain *druidcorev1alpha1.Etcd, replicas int32) error {
+	if err := r.SeedClient.Get(ctx, client.ObjectKeyFromObject(etcdMain), etcdMain); err != nil {
+		return err
+	}
+
+	patch := client.MergeFrom(etcdMain.DeepCopy())
+	metav1.SetMetaDataAnnotation(&etcdMain.ObjectMeta, v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationReconcile)
+	metav1.SetMetaDataAnnotation(&etcdMain.ObjectMeta, v1beta1constants.GardenerTimestamp, r.Clock.Now().UTC().Format(time.RFC3339Nano))
+	etcdMain.Spec.Replicas = replicas
+
+	return r.SeedClient.Patch(ctx, etcdMain, patch)
+}
+
+func (r *Reconciler) deleteEtcdVolumes(ctx context.Context, etcdMain *druidcorev1alpha1.Etcd) error {
+	statefulSet := &appsv1.StatefulSet{}
+	if err := r.SeedClient.Get(ctx, client.ObjectKeyFromObject(etcdMain), statefulSet); err != nil {
+		return fmt.Errorf("failed getting StatefulSet of etcd: %w", err)
+	}
+
+	pvcList := &corev1.PersistentVolumeClaimList{}
+	if err := r.SeedClient.List(ctx, pvcList, client.InNamespace(statefulSet.Namespace)); err != nil {
+		return fmt.Errorf("failed listing PersistentVolumeClaims: %w", err)
+	}
+
+	var pvcs []client.Object
+	for _, volumeClaimTemplate := range statefulSet.Spec.VolumeClaimTemplates {
+		prefix := volumeClaimTemplate.Name + "-" + statefulSet.Name + "-"
+		for _, pvc := range pvcList.Items {
+			if strings.HasPrefix(pvc.Name, prefix) {
+				pvcs = append(pvcs, pvc.DeepCopy())
+			}
+		}
+	}
+
+	if err := kubernetesutils.DeleteObjects(ctx, r.SeedClient, pvcs...); err != nil {
+		return fmt.Errorf("failed deleting PersistentVolumeClaims of etcd: %w", err)
+	}
+
+	for _, pvc := range pvcs {
+		if err := kubernetesutils.WaitUntilResourceDeleted(ctx, r.SeedClient, pvc, defaultInterval); err != nil {
+			return fmt.Errorf("failed waiting for deletion of PersistentVolumeClaim %q: %w", pvc.GetName(), err)
+		}
+	}
+
+	return nil
+}
+
+func checkEtcdRestored(obj client.Object) error {
+	if err := etcd.CheckEtcdObject(obj); err != nil {
+		return err
+	}
+
+	etcdObj, ok := obj.(*druidcorev1alpha1.Etcd)
+	if !ok {
+		return fmt.Errorf("expected *druidcorev1alpha1.Etcd but got %T", obj)
+	}
+
+	return health.CheckEtcd(etcdObj)
+}
+
+func (r *Reconciler) triggerShootReconciliation(ctx context.Context, shoot *gardencorev1beta1.Shoot) error {
+	patch := client.MergeFrom(shoot.DeepCopy())
+	metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationReconcile)
+	return r.GardenClient.Patch(ctx, shoot, patch)
+}
+
+func (r *Reconciler) fail(ctx context.Context, shootRestore *operationsv1alpha1.ShootRestore, related runtime.Object, message string) error {
+	r.Recorder.Eventf(shootRestore, related, corev1.EventTypeWarning, gardencorev1beta1.EventRestoreError, gardencorev1beta1.EventActionRestore, "%s", message)
+	return r.updateStatus(ctx, shootRestore, operationsv1alpha1.ShootRestoreFailed, message)
+}
+
+func (r *Reconciler) updateStatus(ctx context.Context, shootRestore *operationsv1alpha1.ShootRestore, phase operationsv1alpha1.ShootRestorePhase, message string) error {
+	patch := client.MergeFrom(shootRestore.DeepCopy())
+
+	now := metav1.NewTime(r.Clock.Now())
+	switch phase {
+	case operationsv1alpha1.ShootRestoreRestoring:
+		shootRestore.Status.StartTime = &now
+	case operationsv1alpha1.ShootRestoreSucceeded, operationsv1alpha1.ShootRestoreFailed:
+		shootRestore.Status.CompletionTime = &now
+	}
+
+	shootRestore.Status.Phase = phase
+	shootRestore.Status.Message = message
+	shootRestore.Status.ObservedGeneration = &shootRestore.Generation
+
+	if err := r.GardenClient.Status().Patch(ctx, shootRestore, patch); err != nil {
+		return fmt.Errorf("failed updating status of ShootRestore to phase %q: %w", phase, err)
+	}
+
+	return nil
+}
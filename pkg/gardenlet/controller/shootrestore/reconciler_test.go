@@ -0,0 +1,172 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shootrestore_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	testclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/shootrestore"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx          = context.TODO()
+		gardenClient client.Client
+		seedClient   client.Client
+		fakeClock    *testclock.FakeClock
+		recorder     *events.FakeRecorder
+		reconciler   *Reconciler
+
+		seed         *gardencorev1beta1.Seed
+		shoot        *gardencorev1beta1.Shoot
+		backupEntry  *gardencorev1beta1.BackupEntry
+		shootRestore *operationsv1alpha1.ShootRestore
+		request      reconcile.Request
+	)
+
+	BeforeEach(func() {
+		gardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithStatusSubresource(&operationsv1alpha1.ShootRestore{}).Build()
+		seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		fakeClock = testclock.NewFakeClock(time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC))
+		recorder = events.NewFakeRecorder(10)
+
+		reconciler = &Reconciler{
+			GardenClient: gardenClient,
+			SeedClient:   seedClient,
+			Clock:        fakeClock,
+			Recorder:     recorder,
+		}
+
+		seed = &gardencorev1beta1.Seed{
+			ObjectMeta: metav1.ObjectMeta{Name: "seed"},
+			Spec: gardencorev1beta1.SeedSpec{
+				Backup: &gardencorev1beta1.Backup{Provider: "local"},
+			},
+		}
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-project"},
+			Spec:       gardencorev1beta1.ShootSpec{SeedName: new("seed")},
+			Status: gardencorev1beta1.ShootStatus{
+				TechnicalID: "shoot--project--shoot",
+				UID:         "1234",
+				LastOperation: &gardencorev1beta1.LastOperation{
+					Type:  gardencorev1beta1.LastOperationTypeReconcile,
+					State: gardencorev1beta1.LastOperationStateSucceeded,
+				},
+			},
+		}
+		backupEntry = &gardencorev1beta1.BackupEntry{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot--project--shoot--1234", Namespace: "garden-project"},
+		}
+		shootRestore = &operationsv1alpha1.ShootRestore{
+			ObjectMeta: metav1.ObjectMeta{Name: "restore", Namespace: "garden-project", Generation: 1},
+			Spec: operationsv1alpha1.ShootRestoreSpec{
+				ShootRef:   corev1.LocalObjectReference{Name: "shoot"},
+				SeedName:   new("seed"),
+				TargetTime: &metav1.Time{Time: time.Date(2026, 10, 1, 11, 0, 0, 0, time.UTC)},
+			},
+		}
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shootRestore)}
+	})
+
+	JustBeforeEach(func() {
+		Expect(gardenClient.Create(ctx, seed)).To(Succeed())
+		Expect(gardenClient.Create(ctx, shoot)).To(Succeed())
+		Expect(gardenClient.Create(ctx, shootRestore)).To(Succeed())
+	})
+
+	expectPhase := func(phase operationsv1alpha1.ShootRestorePhase, messageMatcher any) {
+		ExpectWithOffset(1, gardenClient.Get(ctx, client.ObjectKeyFromObject(shootRestore), shootRestore)).To(Succeed())
+		ExpectWithOffset(1, shootRestore.Status.Phase).To(Equal(phase))
+		ExpectWithOffset(1, shootRestore.Status.Message).To(messageMatcher)
+		ExpectWithOffset(1, shootRestore.Status.ObservedGeneration).To(PointTo(Equal(int64(1))))
+	}
+
+	It("should do nothing if the ShootRestore has already finished", func() {
+		shootRestore.Status.Phase = operationsv1alpha1.ShootRestoreSucceeded
+		Expect(gardenClient.Status().Update(ctx, shootRestore)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shootRestore), shootRestore)).To(Succeed())
+		Expect(shootRestore.Status.Phase).To(Equal(operationsv1alpha1.ShootRestoreSucceeded))
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	Context("preflight checks", func() {
+		JustBeforeEach(func() {
+			Expect(gardenClient.Create(ctx, backupEntry)).To(Succeed())
+		})
+
+		Context("ShootRestore without target time", func() {
+			BeforeEach(func() {
+				shootRestore.Spec.TargetTime = nil
+			})
+
+			It("should fail", func() {
+				Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+				expectPhase(operationsv1alpha1.ShootRestoreFailed, Equal("No target time is set"))
+				Expect(recorder.Events).To(Receive(ContainSubstring("No target time is set")))
+			})
+		})
+
+		It("should fail if the shoot is hibernated", func() {
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			shoot.Status.IsHibernated = true
+			Expect(gardenClient.Update(ctx, shoot)).To(Succeed())
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+			expectPhase(operationsv1alpha1.ShootRestoreFailed, Equal("Shoot is hibernated"))
+			Expect(shootRestore.Status.CompletionTime).To(PointTo(Equal(metav1.NewTime(fakeClock.Now()))))
+			Expect(recorder.Events).To(Receive(ContainSubstring("Shoot is hibernated")))
+		})
+
+		Context("seed without backup", func() {
+			BeforeEach(func() {
+				seed.Spec.Backup = nil
+			})
+
+			It("should fail", func() {
+				Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+				expectPhase(operationsv1alpha1.ShootRestoreFailed, Equal(`Seed "seed" has no backup configured`))
+			})
+		})
+
+		It("should wait if the shoot is processing", func() {
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			shoot.Status.LastOperation.State = gardencorev1beta1.LastOperationStateProcessing
+			Expect(gardenClient.Update(ctx, shoot)).To(Succeed())
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: 30 * time.Second}))
+
+			expectPhase(operationsv1alpha1.ShootRestorePending, Equal("Waiting for the current operation of the shoot to finish"))
+			Expect(shootRestore.Status.StartTime).To(BeNil())
+		})
+	})
+
+	It("should fail if the BackupEntry does not exist", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		expectPhase(operationsv1alpha1.ShootRestoreFailed, Equal(`BackupEntry "shoot--project--shoot--1234" of shoot does not exist`))
+	})
+})
@@ -30,5 +30,6 @@ func GetFeatures() []featuregate.Feature {
 		features.PrometheusHealthChecks,
 		features.RemoveVali,
 		features.DisableNginxIngressInSeed,
+		features.ShootReconcileCheckpoints,
 	}
 }
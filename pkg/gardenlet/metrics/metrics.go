@@ -29,4 +29,41 @@ var (
 			"hibernated",
 		},
 	)
+
+	// BackupValidationRestorable defines the gauge backup_validation_restorable.
+	BackupValidationRestorable = factory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "backup_validation_restorable",
+			Help:      "Whether the latest backup of a BackupEntry could be restored during its last validation (1) or not (0).",
+		},
+		[]string{
+			"namespace",
+			"name",
+		},
+	)
+	// BackupValidationTimestampSeconds defines the gauge backup_validation_timestamp_seconds.
+	BackupValidationTimestampSeconds = factory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "backup_validation_timestamp_seconds",
+			Help:      "Unix timestamp of the last validation of the backup of a BackupEntry.",
+		},
+		[]string{
+			"namespace",
+			"name",
+		},
+	)
+	// BackupValidationDurationSeconds defines the histogram backup_validation_duration_seconds.
+	BackupValidationDurationSeconds = factory.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: Namespace,
+			Name:      "backup_validation_duration_seconds",
+			Help:      "Duration of backup validations in seconds.",
+			Buckets:   prometheus.LinearBuckets(60, 120, 10),
+		},
+		[]string{
+			"result",
+		},
+	)
 )
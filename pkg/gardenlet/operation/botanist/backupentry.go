@@ -79,10 +79,17 @@ func (b *Botanist) SourceBackupEntry() corebackupentry.Interface {
 }
 
 // DeploySourceBackupEntry deploys the source BackupEntry and sets its bucketName to be equal to the bucketName of the shoot's original
-// BackupEntry if the source BackupEntry doesn't already exist.
+// BackupEntry if the source BackupEntry doesn't already exist. When the shoot is cloned from a source shoot, the bucketName is set to
+// the bucketName of the source shoot's BackupEntry instead.
 func (b *Botanist) DeploySourceBackupEntry(ctx context.Context) error {
 	bucketName := b.Shoot.Components.BackupEntry.GetActualBucketName()
-	if _, err := b.Shoot.Components.SourceBackupEntry.Get(ctx); err == nil {
+	if b.IsCloningFromSourceShoot() {
+		sourceShootBackupEntry, err := b.GetSourceShootBackupEntry(ctx)
+		if err != nil {
+			return fmt.Errorf("failed retrieving BackupEntry of source shoot: %w", err)
+		}
+		bucketName = sourceShootBackupEntry.Spec.BucketName
+	} else if _, err := b.Shoot.Components.SourceBackupEntry.Get(ctx); err == nil {
 		bucketName = b.Shoot.Components.SourceBackupEntry.GetActualBucketName()
 	} else if client.IgnoreNotFound(err) != nil {
 		return err
@@ -10,9 +10,13 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
 	mockbackupentry "github.com/gardener/gardener/pkg/component/garden/backupentry/mock"
 	"github.com/gardener/gardener/pkg/gardenlet/operation"
 	. "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
@@ -27,17 +31,20 @@ var _ = Describe("BackupEntry", func() {
 		ctx  = context.TODO()
 
 		botanist          *Botanist
+		backupEntry       *mockbackupentry.MockInterface
 		sourceBackupEntry *mockbackupentry.MockInterface
 	)
 
 	BeforeEach(func() {
 		ctrl = gomock.NewController(GinkgoT())
 
+		backupEntry = mockbackupentry.NewMockInterface(ctrl)
 		sourceBackupEntry = mockbackupentry.NewMockInterface(ctrl)
 		botanist = &Botanist{
 			Operation: &operation.Operation{
 				Shoot: &shootpkg.Shoot{
 					Components: &shootpkg.Components{
+						BackupEntry:       backupEntry,
 						SourceBackupEntry: sourceBackupEntry,
 					},
 				},
@@ -73,6 +80,48 @@ var _ = Describe("BackupEntry", func() {
 		ctrl.Finish()
 	})
 
+	Describe("#DeploySourceBackupEntry", func() {
+		It("should use the bucket of the shoot's BackupEntry if the source BackupEntry does not exist", func() {
+			backupEntry.EXPECT().GetActualBucketName().Return("old-seed")
+			sourceBackupEntry.EXPECT().Get(ctx).Return(nil, apierrors.NewNotFound(schema.GroupResource{}, "source-backupentry"))
+			sourceBackupEntry.EXPECT().SetBucketName("old-seed")
+			sourceBackupEntry.EXPECT().Deploy(ctx)
+
+			Expect(botanist.DeploySourceBackupEntry(ctx)).To(Succeed())
+		})
+
+		Context("shoot is cloned from a source shoot", func() {
+			BeforeEach(func() {
+				botanist.Shoot.GetInfo().Spec.Source = &gardencorev1beta1.ShootSource{Namespace: "garden-prod", Name: "source"}
+				botanist.Shoot.GetInfo().Status.LastOperation.Type = gardencorev1beta1.LastOperationTypeCreate
+				botanist.GardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+			})
+
+			It("should use the bucket of the source shoot's BackupEntry", func() {
+				Expect(botanist.GardenClient.Create(ctx, &gardencorev1beta1.BackupEntry{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "shoot--prod--source--1234",
+						Namespace:       "garden-prod",
+						OwnerReferences: []metav1.OwnerReference{{APIVersion: "core.gardener.cloud/v1beta1", Kind: "Shoot", Name: "source", UID: "1234"}},
+					},
+					Spec: gardencorev1beta1.BackupEntrySpec{BucketName: "source-seed"},
+				})).To(Succeed())
+
+				backupEntry.EXPECT().GetActualBucketName().Return("seed")
+				sourceBackupEntry.EXPECT().SetBucketName("source-seed")
+				sourceBackupEntry.EXPECT().Deploy(ctx)
+
+				Expect(botanist.DeploySourceBackupEntry(ctx)).To(Succeed())
+			})
+
+			It("should fail if the source shoot has no BackupEntry", func() {
+				backupEntry.EXPECT().GetActualBucketName().Return("seed")
+
+				Expect(botanist.DeploySourceBackupEntry(ctx)).To(MatchError(ContainSubstring("no BackupEntry found for shoot garden-prod/source")))
+			})
+		})
+	})
+
 	Describe("#DestroySourceBackupEntry", func() {
 		It("should set force-deletion annotation and destroy the SourceBackupEntry component", func() {
 			sourceBackupEntry.EXPECT().SetForceDeletionAnnotation(ctx)
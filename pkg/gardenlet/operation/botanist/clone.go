@@ -0,0 +1,211 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	"github.com/gardener/gardener/pkg/utils/gardener/shootstate"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
+)
+
+// SecretNameSourceShootETCDEncryptionKey is the name of the secret which contains the ETCD encryption key taken over
+// from the source shoot.
+const SecretNameSourceShootETCDEncryptionKey = "source-shoot-etcd-encryption-key" // #nosec G101 -- No credential.
+
+// IsCloningFromSourceShoot returns true when the shoot references a source shoot and is still being created, i.e., when
+// its etcd has to be seeded from the backup of the source shoot.
+func (b *Botanist) IsCloningFromSourceShoot() bool {
+	return b.Shoot.GetInfo().Spec.Source != nil &&
+		v1beta1helper.ShootHasOperationType(b.Shoot.GetInfo().Status.LastOperation, gardencorev1beta1.LastOperationTypeCreate)
+}
+
+// GetSourceShootBackupEntry returns the BackupEntry of the shoot referenced in `.spec.source`.
+func (b *Botanist) GetSourceShootBackupEntry(ctx context.Context) (*gardencorev1beta1.BackupEntry, error) {
+	source := b.Shoot.GetInfo().Spec.Source
+	if source == nil {
+		return nil, fmt.Errorf("shoot does not reference a source shoot")
+	}
+
+	return gardenerutils.GetBackupEntryOfShoot(ctx, b.GardenClient, source.Namespace, source.Name)
+}
+
+// DeploySourceShootETCDEncryptionKey takes over the ETCD encryption key from the ShootState of the source shoot. The
+// etcd is restored from the backup of the source shoot, i.e., its resources are encrypted with the key of the source
+// shoot. Hence, the secrets manager must use the data of this key when it generates the ETCD encryption key of the shoot
+// while the kube-apiserver is deployed. Nothing is done if the ETCD encryption key of the shoot was already generated.
+func (b *Botanist) DeploySourceShootETCDEncryptionKey(ctx context.Context) error {
+	var (
+		c      = b.SeedClientSet.Client()
+		source = b.Shoot.GetInfo().Spec.Source
+	)
+
+	secretList := &corev1.SecretList{}
+	if err := c.List(ctx, secretList, client.InNamespace(b.Shoot.ControlPlaneNamespace), client.MatchingLabels{
+		secretsmanager.LabelKeyName:      v1beta1constants.SecretNameETCDEncryptionKey,
+		secretsmanager.LabelKeyManagedBy: secretsmanager.LabelValueSecretsManager,
+	}); err != nil {
+		return fmt.Errorf("failed listing ETCD encryption key secrets: %w", err)
+	}
+	if len(secretList.Items) > 0 {
+		return nil
+	}
+
+	sourceShootState := &gardencorev1beta1.ShootState{}
+	if err := b.GardenClient.Get(ctx, client.ObjectKey{Namespace: source.Namespace, Name: source.Name}, sourceShootState); err != nil {
+		return fmt.Errorf("failed reading ShootState of source shoot %s/%s: %w", source.Namespace, source.Name, err)
+	}
+
+	data, err := currentETCDEncryptionKeyData(sourceShootState)
+	if err != nil {
+		return fmt.Errorf("failed reading ETCD encryption key from ShootState of source shoot %s/%s: %w", source.Namespace, source.Name, err)
+	}
+
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: SecretNameSourceShootETCDEncryptionKey, Namespace: b.Shoot.ControlPlaneNamespace}}
+	_, err = controllerutils.GetAndCreateOrMergePatch(ctx, c, secret, func() error {
+		metav1.SetMetaDataLabel(&secret.ObjectMeta, secretsmanager.LabelKeyUseDataForName, v1beta1constants.SecretNameETCDEncryptionKey)
+		secret.Type = corev1.SecretTypeOpaque
+		secret.Data = data
+		return nil
+	})
+	return err
+}
+
+// DestroySourceShootETCDEncryptionKey deletes the ETCD encryption key taken over from the source shoot. It must be
+// deleted after the ETCD encryption key of the shoot was generated, otherwise its data would be reused when the key is
+// rotated.
+func (b *Botanist) DestroySourceShootETCDEncryptionKey(ctx context.Context) error {
+	return kubernetesutils.DeleteObject(ctx, b.SeedClientSet.Client(), &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: SecretNameSourceShootETCDEncryptionKey, Namespace: b.Shoot.ControlPlaneNamespace}})
+}
+
+// currentETCDEncryptionKeyData returns the data of the most recently issued ETCD encryption key in the given ShootState.
+func currentETCDEncryptionKeyData(shootState *gardencorev1beta1.ShootState) (map[string][]byte, error) {
+	var (
+		current         *gardencorev1beta1.GardenerResourceData
+		currentIssuedAt int64
+	)
+
+	for _, entry := range shootState.Spec.Gardener {
+		if entry.Type != v1beta1constants.DataTypeSecret ||
+			entry.Labels[secretsmanager.LabelKeyName] != v1beta1constants.SecretNameETCDEncryptionKey ||
+			entry.Labels[secretsmanager.LabelKeyManagedBy] != secretsmanager.LabelValueSecretsManager {
+			continue
+		}
+
+		issuedAt, err := strconv.ParseInt(entry.Labels[secretsmanager.LabelKeyIssuedAtTime], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed parsing %s label of %s: %w", secretsmanager.LabelKeyIssuedAtTime, entry.Name, err)
+		}
+
+		if current == nil || issuedAt > currentIssuedAt {
+			current, currentIssuedAt = entry.DeepCopy(), issuedAt
+		}
+	}
+
+	if current == nil {
+		return nil, fmt.Errorf("no ETCD encryption key found")
+	}
+
+	var secretState shootstate.SecretState
+	if err := json.Unmarshal(current.Data.Raw, &secretState); err != nil {
+		return nil, fmt.Errorf("failed unmarshalling data of %s: %w", current.Name, err)
+	}
+	if len(secretState.Data) == 0 {
+		return nil, fmt.Errorf("%s has no data", current.Name)
+	}
+
+	return secretState.Data, nil
+}
+
+// ResetNodeSpecificState removes the node-specific state which was restored from the etcd backup of the source shoot.
+// Only objects created before the shoot itself are considered, hence objects belonging to the shoot's own nodes are
+// never touched. Nodes and VolumeAttachments of the source shoot are deleted. PersistentVolumes are deleted as well, but
+// only after their reclaim policy has been set to `Retain`, so that the volumes of the source shoot are never released.
+// The bound PersistentVolumeClaims become `Lost` and must be recreated by the owner of the cloned shoot.
+func (b *Botanist) ResetNodeSpecificState(ctx context.Context) error {
+	var (
+		c              = b.ShootClientSet.Client()
+		shootCreation  = b.Shoot.GetInfo().CreationTimestamp
+		restoredObject = func(obj client.Object) bool {
+			creationTimestamp := obj.GetCreationTimestamp()
+			return creationTimestamp.Before(&shootCreation)
+		}
+	)
+
+	nodeList := &corev1.NodeList{}
+	if err := c.List(ctx, nodeList); err != nil {
+		return fmt.Errorf("failed listing nodes: %w", err)
+	}
+	for _, node := range nodeList.Items {
+		if !restoredObject(&node) {
+			continue
+		}
+		b.Logger.Info("Deleting node restored from source shoot", "node", client.ObjectKeyFromObject(&node))
+		if err := kubernetesutils.DeleteObject(ctx, c, &node); err != nil {
+			return fmt.Errorf("failed deleting node %s: %w", node.Name, err)
+		}
+	}
+
+	volumeAttachmentList := &storagev1.VolumeAttachmentList{}
+	if err := c.List(ctx, volumeAttachmentList); err != nil {
+		return fmt.Errorf("failed listing volume attachments: %w", err)
+	}
+	for _, volumeAttachment := range volumeAttachmentList.Items {
+		if !restoredObject(&volumeAttachment) {
+			continue
+		}
+		// The finalizers are removed first, otherwise the CSI attacher would try to detach the volume from the node of
+		// the source shoot.
+		b.Logger.Info("Deleting volume attachment restored from source shoot", "volumeAttachment", client.ObjectKeyFromObject(&volumeAttachment))
+		if err := controllerutils.RemoveAllFinalizers(ctx, c, &volumeAttachment); err != nil {
+			return fmt.Errorf("failed removing finalizers from volume attachment %s: %w", volumeAttachment.Name, err)
+		}
+		if err := kubernetesutils.DeleteObject(ctx, c, &volumeAttachment); err != nil {
+			return fmt.Errorf("failed deleting volume attachment %s: %w", volumeAttachment.Name, err)
+		}
+	}
+
+	persistentVolumeList := &corev1.PersistentVolumeList{}
+	if err := c.List(ctx, persistentVolumeList); err != nil {
+		return fmt.Errorf("failed listing persistent volumes: %w", err)
+	}
+	for _, persistentVolume := range persistentVolumeList.Items {
+		if !restoredObject(&persistentVolume) {
+			continue
+		}
+
+		if persistentVolume.Spec.PersistentVolumeReclaimPolicy != corev1.PersistentVolumeReclaimRetain {
+			patch := client.MergeFrom(persistentVolume.DeepCopy())
+			persistentVolume.Spec.PersistentVolumeReclaimPolicy = corev1.PersistentVolumeReclaimRetain
+			if err := c.Patch(ctx, &persistentVolume, patch); client.IgnoreNotFound(err) != nil {
+				return fmt.Errorf("failed setting reclaim policy of persistent volume %s to %s: %w", persistentVolume.Name, corev1.PersistentVolumeReclaimRetain, err)
+			}
+		}
+
+		b.Logger.Info("Deleting persistent volume restored from source shoot", "persistentVolume", client.ObjectKeyFromObject(&persistentVolume))
+		if err := kubernetesutils.DeleteObject(ctx, c, &persistentVolume); err != nil {
+			return fmt.Errorf("failed deleting persistent volume %s: %w", persistentVolume.Name, err)
+		}
+		if err := controllerutils.RemoveAllFinalizers(ctx, c, &persistentVolume); err != nil {
+			return fmt.Errorf("failed removing finalizers from persistent volume %s: %w", persistentVolume.Name, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,222 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	"github.com/gardener/gardener/pkg/gardenlet/operation"
+	. "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
+	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Clone", func() {
+	var (
+		ctx = context.Background()
+
+		botanist *Botanist
+
+		shootCreation  = metav1.NewTime(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+		beforeCreation = metav1.NewTime(shootCreation.Add(-time.Hour))
+		afterCreation  = metav1.NewTime(shootCreation.Add(time.Hour))
+	)
+
+	BeforeEach(func() {
+		botanist = &Botanist{
+			Operation: &operation.Operation{
+				Logger: logr.Discard(),
+				Shoot:  &shootpkg.Shoot{},
+			},
+		}
+		botanist.Shoot.SetInfo(&gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "clone", Namespace: "garden-staging", CreationTimestamp: shootCreation},
+			Spec:       gardencorev1beta1.ShootSpec{Source: &gardencorev1beta1.ShootSource{Namespace: "garden-prod", Name: "source"}},
+			Status: gardencorev1beta1.ShootStatus{
+				LastOperation: &gardencorev1beta1.LastOperation{Type: gardencorev1beta1.LastOperationTypeCreate},
+			},
+		})
+	})
+
+	Describe("#IsCloningFromSourceShoot", func() {
+		It("should return true while the shoot is created", func() {
+			Expect(botanist.IsCloningFromSourceShoot()).To(BeTrue())
+		})
+
+		It("should return false if the shoot has been created", func() {
+			botanist.Shoot.GetInfo().Status.LastOperation.Type = gardencorev1beta1.LastOperationTypeReconcile
+			Expect(botanist.IsCloningFromSourceShoot()).To(BeFalse())
+		})
+
+		It("should return false if the shoot does not reference a source shoot", func() {
+			botanist.Shoot.GetInfo().Spec.Source = nil
+			Expect(botanist.IsCloningFromSourceShoot()).To(BeFalse())
+		})
+	})
+
+	Context("ETCD encryption key of source shoot", func() {
+		var (
+			gardenClient client.Client
+			seedClient   client.Client
+
+			sourceShootState *gardencorev1beta1.ShootState
+		)
+
+		BeforeEach(func() {
+			gardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+			seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+			botanist.GardenClient = gardenClient
+			botanist.SeedClientSet = fakekubernetes.NewClientSetBuilder().WithClient(seedClient).Build()
+			botanist.Shoot.ControlPlaneNamespace = "shoot--staging--clone"
+
+			sourceShootState = &gardencorev1beta1.ShootState{
+				ObjectMeta: metav1.ObjectMeta{Name: "source", Namespace: "garden-prod"},
+				Spec: gardencorev1beta1.ShootStateSpec{
+					Gardener: []gardencorev1beta1.GardenerResourceData{
+						{
+							Name:   "kube-apiserver-etcd-encryption-key-old",
+							Type:   "secret",
+							Labels: map[string]string{"name": "kube-apiserver-etcd-encryption-key", "managed-by": "secrets-manager", "issued-at-time": "1000"},
+							Data:   runtime.RawExtension{Raw: []byte(`{"data":{"key":"b2xk","secret":"b2xk"}}`)},
+						},
+						{
+							Name:   "kube-apiserver-etcd-encryption-key-current",
+							Type:   "secret",
+							Labels: map[string]string{"name": "kube-apiserver-etcd-encryption-key", "managed-by": "secrets-manager", "issued-at-time": "2000"},
+							Data:   runtime.RawExtension{Raw: []byte(`{"data":{"key":"Y3VycmVudA==","secret":"Y3VycmVudA=="}}`)},
+						},
+						{
+							Name:   "ca",
+							Type:   "secret",
+							Labels: map[string]string{"name": "ca", "managed-by": "secrets-manager", "issued-at-time": "3000"},
+							Data:   runtime.RawExtension{Raw: []byte(`{"data":{"ca.crt":"Y2E="}}`)},
+						},
+					},
+				},
+			}
+		})
+
+		Describe("#DeploySourceShootETCDEncryptionKey", func() {
+			It("should take over the current ETCD encryption key of the source shoot", func() {
+				Expect(gardenClient.Create(ctx, sourceShootState)).To(Succeed())
+
+				Expect(botanist.DeploySourceShootETCDEncryptionKey(ctx)).To(Succeed())
+
+				secret := &corev1.Secret{}
+				Expect(seedClient.Get(ctx, client.ObjectKey{Namespace: "shoot--staging--clone", Name: SecretNameSourceShootETCDEncryptionKey}, secret)).To(Succeed())
+				Expect(secret.Labels).To(HaveKeyWithValue("secrets-manager-use-data-for-name", "kube-apiserver-etcd-encryption-key"))
+				Expect(secret.Data).To(Equal(map[string][]byte{"key": []byte("current"), "secret": []byte("current")}))
+			})
+
+			It("should do nothing if the ETCD encryption key of the shoot was already generated", func() {
+				Expect(seedClient.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+					Name:      "kube-apiserver-etcd-encryption-key-abcd",
+					Namespace: "shoot--staging--clone",
+					Labels:    map[string]string{"name": "kube-apiserver-etcd-encryption-key", "managed-by": "secrets-manager"},
+				}})).To(Succeed())
+
+				Expect(botanist.DeploySourceShootETCDEncryptionKey(ctx)).To(Succeed())
+
+				Expect(seedClient.Get(ctx, client.ObjectKey{Namespace: "shoot--staging--clone", Name: SecretNameSourceShootETCDEncryptionKey}, &corev1.Secret{})).To(BeNotFoundError())
+			})
+
+			It("should fail if the ShootState of the source shoot does not exist", func() {
+				Expect(botanist.DeploySourceShootETCDEncryptionKey(ctx)).To(MatchError(ContainSubstring("failed reading ShootState of source shoot garden-prod/source")))
+			})
+
+			It("should fail if the ShootState of the source shoot does not contain an ETCD encryption key", func() {
+				sourceShootState.Spec.Gardener = sourceShootState.Spec.Gardener[2:]
+				Expect(gardenClient.Create(ctx, sourceShootState)).To(Succeed())
+
+				Expect(botanist.DeploySourceShootETCDEncryptionKey(ctx)).To(MatchError(ContainSubstring("no ETCD encryption key found")))
+			})
+		})
+
+		Describe("#DestroySourceShootETCDEncryptionKey", func() {
+			It("should delete the ETCD encryption key taken over from the source shoot", func() {
+				Expect(gardenClient.Create(ctx, sourceShootState)).To(Succeed())
+				Expect(botanist.DeploySourceShootETCDEncryptionKey(ctx)).To(Succeed())
+
+				Expect(botanist.DestroySourceShootETCDEncryptionKey(ctx)).To(Succeed())
+				Expect(seedClient.Get(ctx, client.ObjectKey{Namespace: "shoot--staging--clone", Name: SecretNameSourceShootETCDEncryptionKey}, &corev1.Secret{})).To(BeNotFoundError())
+			})
+
+			It("should succeed if the secret does not exist", func() {
+				Expect(botanist.DestroySourceShootETCDEncryptionKey(ctx)).To(Succeed())
+			})
+		})
+	})
+
+	Describe("#ResetNodeSpecificState", func() {
+		var (
+			shootClient client.Client
+
+			restoredNode, node                         *corev1.Node
+			restoredVolumeAttachment, volumeAttachment *storagev1.VolumeAttachment
+			restoredPersistentVolume, persistentVolume *corev1.PersistentVolume
+		)
+
+		BeforeEach(func() {
+			restoredNode = &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "restored", CreationTimestamp: beforeCreation}}
+			node = &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "new", CreationTimestamp: afterCreation}}
+			restoredVolumeAttachment = &storagev1.VolumeAttachment{ObjectMeta: metav1.ObjectMeta{
+				Name:              "restored",
+				CreationTimestamp: beforeCreation,
+				Finalizers:        []string{"external-attacher/csi-driver"},
+			}}
+			volumeAttachment = &storagev1.VolumeAttachment{ObjectMeta: metav1.ObjectMeta{
+				Name:              "new",
+				CreationTimestamp: afterCreation,
+				Finalizers:        []string{"external-attacher/csi-driver"},
+			}}
+			restoredPersistentVolume = &corev1.PersistentVolume{
+				ObjectMeta: metav1.ObjectMeta{Name: "restored", CreationTimestamp: beforeCreation, Finalizers: []string{"kubernetes.io/pv-protection"}},
+				Spec:       corev1.PersistentVolumeSpec{PersistentVolumeReclaimPolicy: corev1.PersistentVolumeReclaimDelete},
+			}
+			persistentVolume = &corev1.PersistentVolume{
+				ObjectMeta: metav1.ObjectMeta{Name: "new", CreationTimestamp: afterCreation, Finalizers: []string{"kubernetes.io/pv-protection"}},
+				Spec:       corev1.PersistentVolumeSpec{PersistentVolumeReclaimPolicy: corev1.PersistentVolumeReclaimDelete},
+			}
+
+			shootClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).WithObjects(
+				restoredNode, node,
+				restoredVolumeAttachment, volumeAttachment,
+				restoredPersistentVolume, persistentVolume,
+			).Build()
+			botanist.ShootClientSet = fakekubernetes.NewClientSetBuilder().WithClient(shootClient).Build()
+		})
+
+		It("should only remove the objects restored from the source shoot", func() {
+			Expect(botanist.ResetNodeSpecificState(ctx)).To(Succeed())
+
+			Expect(shootClient.Get(ctx, client.ObjectKeyFromObject(restoredNode), &corev1.Node{})).To(BeNotFoundError())
+			Expect(shootClient.Get(ctx, client.ObjectKeyFromObject(restoredVolumeAttachment), &storagev1.VolumeAttachment{})).To(BeNotFoundError())
+			Expect(shootClient.Get(ctx, client.ObjectKeyFromObject(restoredPersistentVolume), &corev1.PersistentVolume{})).To(BeNotFoundError())
+
+			Expect(shootClient.Get(ctx, client.ObjectKeyFromObject(node), &corev1.Node{})).To(Succeed())
+			Expect(shootClient.Get(ctx, client.ObjectKeyFromObject(volumeAttachment), &storagev1.VolumeAttachment{})).To(Succeed())
+			Expect(shootClient.Get(ctx, client.ObjectKeyFromObject(persistentVolume), &corev1.PersistentVolume{})).To(Succeed())
+		})
+
+		It("should succeed if the node-specific state has already been reset", func() {
+			Expect(botanist.ResetNodeSpecificState(ctx)).To(Succeed())
+			Expect(botanist.ResetNodeSpecificState(ctx)).To(Succeed())
+		})
+	})
+})
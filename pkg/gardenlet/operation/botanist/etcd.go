@@ -6,6 +6,7 @@ package botanist
 
 import (
 	"context"
+	"fmt"
 
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -94,6 +95,11 @@ func (b *Botanist) DeployEtcd(ctx context.Context) error {
 			return err
 		}
 
+		maxFullSnapshots, err := b.maxFullSnapshots(ctx)
+		if err != nil {
+			return err
+		}
+
 		var (
 			backupLeaderElection         *gardenletconfigv1alpha1.ETCDBackupLeaderElection
 			deltaSnapshotRetentionPeriod *metav1.Duration
@@ -111,6 +117,7 @@ func (b *Botanist) DeployEtcd(ctx context.Context) error {
 			FullSnapshotSchedule:         snapshotSchedule,
 			LeaderElection:               backupLeaderElection,
 			DeltaSnapshotRetentionPeriod: deltaSnapshotRetentionPeriod,
+			MaxFullSnapshots:             maxFullSnapshots,
 		})
 	}
 
@@ -132,6 +139,20 @@ func (b *Botanist) DeployEtcd(ctx context.Context) error {
 	return b.deployOrRestoreEtcd(ctx)
 }
 
+// maxFullSnapshots returns the maximum number of full snapshots configured in the retention policy of the BackupBucket
+// which stores the backups of the shoot.
+func (b *Botanist) maxFullSnapshots(ctx context.Context) (*int32, error) {
+	backupBucket := &gardencorev1beta1.BackupBucket{}
+	if err := b.GardenClient.Get(ctx, client.ObjectKey{Name: b.Shoot.Components.BackupEntry.GetActualBucketName()}, backupBucket); err != nil {
+		return nil, fmt.Errorf("failed reading BackupBucket: %w", err)
+	}
+
+	if backupBucket.Spec.Retention == nil {
+		return nil, nil
+	}
+	return backupBucket.Spec.Retention.MaxFullSnapshots, nil
+}
+
 // WaitUntilEtcdsReady waits until both etcd-main and etcd-events are ready.
 func (b *Botanist) WaitUntilEtcdsReady(ctx context.Context) error {
 	return flow.Parallel(
@@ -36,6 +36,7 @@ import (
 	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
 	"github.com/gardener/gardener/pkg/component/etcd/etcd"
 	mocketcd "github.com/gardener/gardener/pkg/component/etcd/etcd/mock"
+	mockbackupentry "github.com/gardener/gardener/pkg/component/garden/backupentry/mock"
 	"github.com/gardener/gardener/pkg/gardenlet/operation"
 	. "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
 	seedpkg "github.com/gardener/gardener/pkg/gardenlet/operation/seed"
@@ -281,6 +282,9 @@ var _ = Describe("Etcd", func() {
 
 		Context("w/ backup", func() {
 			var (
+				fakeGardenClient client.Client
+				backupBucket     *gardencorev1beta1.BackupBucket
+
 				backupProvider = "prov"
 				bucketName     = "container"
 				backupSecret   = &corev1.Secret{
@@ -309,6 +313,14 @@ var _ = Describe("Etcd", func() {
 			)
 
 			BeforeEach(func() {
+				backupEntry := mockbackupentry.NewMockInterface(ctrl)
+				backupEntry.EXPECT().GetActualBucketName().Return("seed-uid").AnyTimes()
+				botanist.Shoot.Components.BackupEntry = backupEntry
+
+				backupBucket = &gardencorev1beta1.BackupBucket{ObjectMeta: metav1.ObjectMeta{Name: "seed-uid"}}
+				fakeGardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithObjects(backupBucket).Build()
+				botanist.GardenClient = fakeGardenClient
+
 				botanist.Seed.GetInfo().Spec.Backup = &gardencorev1beta1.Backup{
 					Provider: backupProvider,
 				}
@@ -337,10 +349,37 @@ var _ = Describe("Etcd", func() {
 				Expect(botanist.DeployEtcd(ctx)).To(Succeed())
 			})
 
+			It("should limit the number of full snapshots if configured in the retention policy of the backup bucket", func() {
+				backupBucket.Spec.Retention = &gardencorev1beta1.BackupRetention{MaxFullSnapshots: new(int32(7))}
+				Expect(fakeGardenClient.Update(ctx, backupBucket)).To(Succeed())
+				Expect(fakeClient.Create(ctx, backupSecret.DeepCopy())).To(Succeed())
+
+				etcdMain.EXPECT().SetBackupConfig(&etcd.BackupConfig{
+					Provider:             backupProvider,
+					SecretRefName:        "etcd-backup",
+					Prefix:               namespace + "--" + string(shootUID),
+					Container:            bucketName,
+					FullSnapshotSchedule: "1 12 * * *",
+					LeaderElection:       backupLeaderElectionConfig,
+					MaxFullSnapshots:     new(int32(7)),
+				})
+				etcdMain.EXPECT().Deploy(ctx)
+				etcdEvents.EXPECT().Deploy(ctx)
+
+				Expect(botanist.DeployEtcd(ctx)).To(Succeed())
+			})
+
 			It("should fail when reading the backup secret fails", func() {
 				Expect(botanist.DeployEtcd(ctx)).To(MatchError(ContainSubstring("secrets \"etcd-backup\" not found")))
 			})
 
+			It("should fail when reading the backup bucket fails", func() {
+				Expect(fakeGardenClient.Delete(ctx, backupBucket)).To(Succeed())
+				Expect(fakeClient.Create(ctx, backupSecret.DeepCopy())).To(Succeed())
+
+				Expect(botanist.DeployEtcd(ctx)).To(MatchError(ContainSubstring("failed reading BackupBucket")))
+			})
+
 			It("should fail when the backup schedule cannot be determined", func() {
 				botanist.Shoot.GetInfo().Spec.Maintenance.TimeWindow = &gardencorev1beta1.MaintenanceTimeWindow{
 					Begin: "foobar",
@@ -41,6 +41,7 @@ func (b *Botanist) DefaultEtcdCopyBackupsTask() etcdcopybackupstask.Interface {
 }
 
 // DeployEtcdCopyBackupsTask sets the target and destination object stores of the EtcdCopyBackupsTask resource and deploys it.
+// When the shoot is cloned from a source shoot, the backups of the source shoot's etcd are used as source.
 func (b *Botanist) DeployEtcdCopyBackupsTask(ctx context.Context) error {
 	if err := b.Shoot.Components.ControlPlane.EtcdCopyBackupsTask.Destroy(ctx); err != nil {
 		return err
@@ -64,6 +65,18 @@ func (b *Botanist) DeployEtcdCopyBackupsTask(ctx context.Context) error {
 		return err
 	}
 
+	// When the shoot is cloned from a source shoot, the backups are copied from the source shoot's BackupEntry. The etcd
+	// of the source shoot keeps running, hence there is no final snapshot to wait for.
+	sourcePrefix := b.Shoot.BackupEntryName
+	if b.IsCloningFromSourceShoot() {
+		sourceShootBackupEntry, err := b.GetSourceShootBackupEntry(ctx)
+		if err != nil {
+			return fmt.Errorf("failed retrieving BackupEntry of source shoot: %w", err)
+		}
+		sourcePrefix = sourceShootBackupEntry.Name
+		b.Shoot.Components.ControlPlane.EtcdCopyBackupsTask.SetWaitForFinalSnapshot(nil)
+	}
+
 	sourceProvider := druidcorev1alpha1.StorageProvider(sourceBackupEntry.Spec.Type)
 	provider := druidcorev1alpha1.StorageProvider(b.Seed.GetInfo().Spec.Backup.Provider)
 	sourceContainer := string(sourceSecret.Data[v1beta1constants.DataKeyBackupBucketName])
@@ -72,7 +85,7 @@ func (b *Botanist) DeployEtcdCopyBackupsTask(ctx context.Context) error {
 	b.Shoot.Components.ControlPlane.EtcdCopyBackupsTask.SetSourceStore(druidcorev1alpha1.StoreSpec{
 		Provider:  &sourceProvider,
 		SecretRef: &corev1.SecretReference{Name: sourceSecret.Name},
-		Prefix:    fmt.Sprintf("%s/etcd-%s", b.Shoot.BackupEntryName, v1beta1constants.ETCDRoleMain),
+		Prefix:    fmt.Sprintf("%s/etcd-%s", sourcePrefix, v1beta1constants.ETCDRoleMain),
 		Container: &sourceContainer,
 	})
 	b.Shoot.Components.ControlPlane.EtcdCopyBackupsTask.SetTargetStore(druidcorev1alpha1.StoreSpec{
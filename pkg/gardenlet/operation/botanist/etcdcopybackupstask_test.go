@@ -170,6 +170,34 @@ var _ = Describe("EtcdCopyBackupsTask", func() {
 			Expect(botanist.DeployEtcdCopyBackupsTask(ctx)).To(Succeed())
 		})
 
+		It("should copy the backups of the source shoot if the shoot is cloned", func() {
+			botanist.Shoot.GetInfo().Spec.Source = &gardencorev1beta1.ShootSource{Namespace: "garden-prod", Name: "source"}
+			botanist.Shoot.GetInfo().Status.LastOperation = &gardencorev1beta1.LastOperation{Type: gardencorev1beta1.LastOperationTypeCreate}
+			botanist.GardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithObjects(&gardencorev1beta1.BackupEntry{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            "shoot--prod--source--1234",
+					Namespace:       "garden-prod",
+					OwnerReferences: []metav1.OwnerReference{{APIVersion: "core.gardener.cloud/v1beta1", Kind: "Shoot", Name: "source", UID: "1234"}},
+				},
+			}).Build()
+
+			Expect(fakeClient.Create(ctx, sourceBackupEntry)).To(Succeed())
+			Expect(fakeClient.Create(ctx, sourceEtcdBackupSecret)).To(Succeed())
+			Expect(fakeClient.Create(ctx, etcdBackupSecret)).To(Succeed())
+
+			etcdCopyBackupsTask.EXPECT().Destroy(ctx)
+			etcdCopyBackupsTask.EXPECT().WaitCleanup(ctx)
+			etcdCopyBackupsTask.EXPECT().SetWaitForFinalSnapshot(nil)
+			etcdCopyBackupsTask.EXPECT().SetSourceStore(gomock.AssignableToTypeOf(druidcorev1alpha1.StoreSpec{})).Do(func(store druidcorev1alpha1.StoreSpec) {
+				Expect(store.Prefix).To(Equal("shoot--prod--source--1234/etcd-main"))
+			})
+			etcdCopyBackupsTask.EXPECT().SetTargetStore(gomock.AssignableToTypeOf(druidcorev1alpha1.StoreSpec{})).Do(func(store druidcorev1alpha1.StoreSpec) {
+				Expect(store.Prefix).To(Equal(backupEntryName + "/etcd-main"))
+			})
+			etcdCopyBackupsTask.EXPECT().Deploy(ctx)
+			Expect(botanist.DeployEtcdCopyBackupsTask(ctx)).To(Succeed())
+		})
+
 		It("should return an error if removal of old EtcdCopyBackupsTask resource fails", func() {
 			etcdCopyBackupsTask.EXPECT().Destroy(ctx).Return(fakeErr)
 			Expect(botanist.DeployEtcdCopyBackupsTask(ctx)).To(HaveOccurred())
@@ -69,9 +69,25 @@ func (b *Botanist) runParallelTaskForEachComponent(ctx context.Context, componen
 	return flow.Parallel(fns...)(ctx)
 }
 
-// IsCopyOfBackupsRequired check if etcd backups need to be copied between seeds.
+// IsCopyOfBackupsRequired check if etcd backups need to be copied between seeds, or from the backup of the source shoot
+// when the shoot is cloned from another shoot.
 func (b *Botanist) IsCopyOfBackupsRequired(ctx context.Context) (bool, error) {
-	if b.Seed.GetInfo().Spec.Backup == nil || !b.IsRestorePhase() {
+	if b.Seed.GetInfo().Spec.Backup == nil {
+		return false, nil
+	}
+
+	if b.IsCloningFromSourceShoot() {
+		// Backups only have to be copied as long as the etcd-main Etcd resource has not been created yet. Afterwards, the
+		// etcd has been restored from the copied backups and is backed up to the shoot's own BackupEntry.
+		if _, err := b.Shoot.Components.ControlPlane.EtcdMain.Get(ctx); client.IgnoreNotFound(err) != nil {
+			return false, err
+		} else if err == nil {
+			return false, nil
+		}
+		return true, nil
+	}
+
+	if !b.IsRestorePhase() {
 		return false, nil
 	}
 
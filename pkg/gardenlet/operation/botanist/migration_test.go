@@ -333,6 +333,34 @@ var _ = Describe("migration", func() {
 				Expect(copyRequired).To(BeTrue())
 			})
 		})
+
+		Context("Shoot is cloned from a source shoot", func() {
+			BeforeEach(func() {
+				botanist.Shoot.GetInfo().Spec.Source = &gardencorev1beta1.ShootSource{Namespace: "garden-prod", Name: "source"}
+				botanist.Shoot.GetInfo().Status.LastOperation.Type = gardencorev1beta1.LastOperationTypeCreate
+			})
+
+			It("should return true if etcd main resource has not been deployed yet", func() {
+				etcdMain.EXPECT().Get(ctx).Return(nil, apierrors.NewNotFound(schema.GroupResource{}, "etcd-main"))
+				copyRequired, err := botanist.IsCopyOfBackupsRequired(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(copyRequired).To(BeTrue())
+			})
+
+			It("should return false if etcd main resource has been deployed", func() {
+				etcdMain.EXPECT().Get(ctx)
+				copyRequired, err := botanist.IsCopyOfBackupsRequired(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(copyRequired).To(BeFalse())
+			})
+
+			It("should return false if the shoot has already been created", func() {
+				botanist.Shoot.GetInfo().Status.LastOperation.Type = gardencorev1beta1.LastOperationTypeReconcile
+				copyRequired, err := botanist.IsCopyOfBackupsRequired(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(copyRequired).To(BeFalse())
+			})
+		})
 	})
 
 	Describe("#IsRestorePhase", func() {
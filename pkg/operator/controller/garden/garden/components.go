@@ -1214,6 +1214,7 @@ func (r *Reconciler) newGardenerAPIServer(ctx context.Context, garden *operatorv
 		workloadIdentityTokenIssuer,
 		goAwayChance,
 		targetVersion,
+		gardenerSchedulerStrategy(garden),
 	)
 }
 
@@ -1250,8 +1251,9 @@ func (r *Reconciler) newGardenerControllerManager(garden *operatorv1alpha1.Garde
 	image.WithOptionalTag(version.Get().GitVersion)
 
 	values := gardenercontrollermanager.Values{
-		Image:    image.String(),
-		LogLevel: logger.InfoLevel,
+		Image:                  image.String(),
+		LogLevel:               logger.InfoLevel,
+		ShootSchedulerStrategy: gardenerSchedulerStrategy(garden),
 	}
 
 	if config := garden.Spec.VirtualCluster.Gardener.ControllerManager; config != nil {
@@ -1281,7 +1283,7 @@ func (r *Reconciler) newGardenerScheduler(garden *operatorv1alpha1.Garden, secre
 	values := gardenerscheduler.Values{
 		Image:    image.String(),
 		LogLevel: logger.InfoLevel,
-		Strategy: schedulerconfigv1alpha1.Default,
+		Strategy: gardenerSchedulerStrategy(garden),
 	}
 
 	if config := garden.Spec.VirtualCluster.Gardener.Scheduler; config != nil {
@@ -1289,14 +1291,20 @@ func (r *Reconciler) newGardenerScheduler(garden *operatorv1alpha1.Garden, secre
 		if config.LogLevel != nil {
 			values.LogLevel = *config.LogLevel
 		}
-		if config.Strategy != nil {
-			values.Strategy = schedulerconfigv1alpha1.CandidateDeterminationStrategy(*config.Strategy)
-		}
 	}
 
 	return gardenerscheduler.New(r.RuntimeClientSet.Client(), r.GardenNamespace, secretsManager, values), nil
 }
 
+// gardenerSchedulerStrategy returns the candidate determination strategy of the gardener-scheduler. The gardener-apiserver
+// and the gardener-controller-manager are configured with the same strategy, so that they make the same decisions.
+func gardenerSchedulerStrategy(garden *operatorv1alpha1.Garden) schedulerconfigv1alpha1.CandidateDeterminationStrategy {
+	if config := garden.Spec.VirtualCluster.Gardener.Scheduler; config != nil && config.Strategy != nil {
+		return schedulerconfigv1alpha1.CandidateDeterminationStrategy(*config.Strategy)
+	}
+	return schedulerconfigv1alpha1.Default
+}
+
 func (r *Reconciler) newGardenerDashboard(
 	garden *operatorv1alpha1.Garden,
 	secretsManager secretsmanager.Interface,
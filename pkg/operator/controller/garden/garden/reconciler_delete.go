@@ -12,6 +12,7 @@ import (
 
 	"github.com/Masterminds/semver/v3"
 	"github.com/go-logr/logr"
+	"go.opentelemetry.io/otel/attribute"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -45,6 +46,7 @@ import (
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 	"github.com/gardener/gardener/pkg/utils/managedresources"
 	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
+	"github.com/gardener/gardener/pkg/utils/tracing"
 )
 
 func (r *Reconciler) delete(
@@ -501,6 +503,8 @@ func (r *Reconciler) delete(
 	if err := g.Compile().Run(ctx, flow.Opts{
 		Log:              log,
 		ProgressReporter: r.reportProgress(log, gardenCopy, true),
+		TrackingKey:      garden.Name,
+		SpanAttributes:   []attribute.KeyValue{tracing.AttributeKeyGardenName.String(garden.Name)},
 	}); err != nil {
 		return reconcilerutils.ReconcileErr(flow.Errors(err))
 	}
@@ -18,6 +18,7 @@ import (
 	"github.com/go-logr/logr"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"go.opentelemetry.io/otel/attribute"
 	istionetworkingv1beta1 "istio.io/client-go/pkg/apis/networking/v1beta1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -79,6 +80,7 @@ import (
 	"github.com/gardener/gardener/pkg/utils/retry"
 	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
 	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
+	"github.com/gardener/gardener/pkg/utils/tracing"
 )
 
 func (r *Reconciler) reconcile(
@@ -715,6 +717,8 @@ func (r *Reconciler) reconcile(
 	if err := g.Compile().Run(ctx, flow.Opts{
 		Log:              log,
 		ProgressReporter: r.reportProgress(log, gardenCopy, true),
+		TrackingKey:      garden.Name,
+		SpanAttributes:   []attribute.KeyValue{tracing.AttributeKeyGardenName.String(garden.Name)},
 	}); err != nil {
 		return flow.Errors(err)
 	}
@@ -847,6 +851,8 @@ func (r *Reconciler) runRuntimeSetupFlow(ctx context.Context, log logr.Logger, g
 	if err := g.Compile().Run(ctx, flow.Opts{
 		Log:              log,
 		ProgressReporter: r.reportProgress(log, garden.DeepCopy(), false),
+		TrackingKey:      garden.Name,
+		SpanAttributes:   []attribute.KeyValue{tracing.AttributeKeyGardenName.String(garden.Name)},
 	}); err != nil {
 		return flow.Errors(err)
 	}
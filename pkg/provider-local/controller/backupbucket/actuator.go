@@ -12,6 +12,7 @@ import (
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
@@ -25,12 +26,14 @@ type actuator struct {
 	backupbucket.Actuator
 
 	client      client.Client
+	clock       clock.Clock
 	bbDirectory string
 }
 
 func newActuator(mgr manager.Manager, bbDirectory string) backupbucket.Actuator {
 	return &actuator{
 		client:      mgr.GetClient(),
+		clock:       clock.RealClock{},
 		bbDirectory: bbDirectory,
 	}
 }
@@ -51,6 +54,12 @@ func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, backupBucket
 		return err
 	}
 
+	if lifecycle := backupBucket.Spec.Lifecycle; lifecycle != nil && lifecycle.ColdStorageTransitionPeriod != nil {
+		if err := transitionToColdStorage(log, a.clock, filePath, lifecycle.ColdStorageTransitionPeriod.Duration); err != nil {
+			return err
+		}
+	}
+
 	if backupBucket.Status.GeneratedSecretRef == nil {
 		if err := a.createBackupBucketGeneratedSecret(ctx, backupBucket); err != nil {
 			return err
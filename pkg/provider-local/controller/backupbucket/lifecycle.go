@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backupbucket
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/go-logr/logr"
+	"k8s.io/utils/clock"
+)
+
+// coldStorageFileMode is the file mode of objects which were transitioned to the cold storage.
+const coldStorageFileMode os.FileMode = 0444
+
+// transitionToColdStorage emulates the transition of the objects in the given bucket directory which are older than the
+// given period to a cold storage class. The local file system does not have storage classes, hence such objects are
+// made read-only instead. They can still be read and deleted, e.g., by the garbage collection of etcd-backup-restore.
+func transitionToColdStorage(log logr.Logger, clock clock.Clock, bucketPath string, period time.Duration) error {
+	var transitioned int
+
+	if err := filepath.WalkDir(bucketPath, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			// Objects might be garbage collected concurrently.
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if entry.IsDir() {
+			return nil
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if info.Mode().Perm() == coldStorageFileMode || clock.Since(info.ModTime()) < period {
+			return nil
+		}
+
+		if err := os.Chmod(path, coldStorageFileMode); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+		transitioned++
+		return nil
+	}); err != nil {
+		return fmt.Errorf("failed transitioning objects to cold storage: %w", err)
+	}
+
+	if transitioned > 0 {
+		log.Info("Transitioned objects to cold storage", "path", bucketPath, "count", transitioned)
+	}
+	return nil
+}
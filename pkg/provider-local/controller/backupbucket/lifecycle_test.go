@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backupbucket
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	testclock "k8s.io/utils/clock/testing"
+)
+
+var _ = Describe("Lifecycle", func() {
+	Describe("#transitionToColdStorage", func() {
+		var (
+			bucketPath string
+			fakeClock  *testclock.FakeClock
+
+			oldObject string
+			newObject string
+		)
+
+		BeforeEach(func() {
+			bucketPath = GinkgoT().TempDir()
+			fakeClock = testclock.NewFakeClock(time.Now())
+
+			Expect(os.MkdirAll(filepath.Join(bucketPath, "shoot--foo--bar--1234", "etcd-main", "v2"), 0755)).To(Succeed())
+
+			oldObject = filepath.Join(bucketPath, "shoot--foo--bar--1234", "etcd-main", "v2", "Full-00000000-00000001-1")
+			Expect(os.WriteFile(oldObject, []byte("old"), 0644)).To(Succeed())
+			Expect(os.Chtimes(oldObject, fakeClock.Now().Add(-48*time.Hour), fakeClock.Now().Add(-48*time.Hour))).To(Succeed())
+
+			newObject = filepath.Join(bucketPath, "shoot--foo--bar--1234", "etcd-main", "v2", "Incr-00000002-00000003-2")
+			Expect(os.WriteFile(newObject, []byte("new"), 0644)).To(Succeed())
+			Expect(os.Chtimes(newObject, fakeClock.Now().Add(-time.Hour), fakeClock.Now().Add(-time.Hour))).To(Succeed())
+		})
+
+		fileMode := func(path string) os.FileMode {
+			info, err := os.Stat(path)
+			Expect(err).NotTo(HaveOccurred())
+			return info.Mode().Perm()
+		}
+
+		It("should transition objects older than the period to cold storage", func() {
+			Expect(transitionToColdStorage(logr.Discard(), fakeClock, bucketPath, 24*time.Hour)).To(Succeed())
+
+			Expect(fileMode(oldObject)).To(Equal(coldStorageFileMode))
+			Expect(fileMode(newObject)).To(Equal(os.FileMode(0644)))
+		})
+
+		It("should keep transitioned objects readable and deletable", func() {
+			Expect(transitionToColdStorage(logr.Discard(), fakeClock, bucketPath, 24*time.Hour)).To(Succeed())
+
+			Expect(os.ReadFile(oldObject)).To(Equal([]byte("old")))
+			Expect(os.Remove(oldObject)).To(Succeed())
+		})
+
+		It("should not fail if the bucket directory does not exist", func() {
+			Expect(transitionToColdStorage(logr.Discard(), fakeClock, filepath.Join(bucketPath, "missing"), 24*time.Hour)).To(Succeed())
+		})
+	})
+})
@@ -6,15 +6,21 @@ package backupentry
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"io/fs"
 	"os"
 	"path/filepath"
 	"strings"
 
 	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	"github.com/gardener/gardener/extensions/pkg/controller/backupentry/genericactuator"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/provider-local/controller/backupoptions"
@@ -22,13 +28,17 @@ import (
 
 type actuator struct {
 	client             client.Client
+	clock              clock.Clock
 	containerMountPath string
 	backBucketPath     string
 }
 
+var _ genericactuator.BackupEntryUsageReporter = (*actuator)(nil)
+
 func newActuator(mgr manager.Manager, containerMountPath, backupBucketPath string) genericactuator.BackupEntryDelegate {
 	return &actuator{
 		client:             mgr.GetClient(),
+		clock:              clock.RealClock{},
 		containerMountPath: containerMountPath,
 		backBucketPath:     backupBucketPath,
 	}
@@ -39,9 +49,50 @@ func (a *actuator) GetETCDSecretData(_ context.Context, _ logr.Logger, _ *extens
 	return backupSecretData, nil
 }
 
+func (a *actuator) GetUsage(_ context.Context, _ logr.Logger, be *extensionsv1alpha1.BackupEntry) (*gardencorev1beta1.BackupEntryUsage, error) {
+	usage := &gardencorev1beta1.BackupEntryUsage{LastUpdateTime: metav1.NewTime(a.clock.Now())}
+
+	if err := filepath.WalkDir(a.entryPath(be), func(_ string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			// The directory does not exist before the first backup was taken, and objects might be garbage collected
+			// concurrently.
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if entry.IsDir() {
+			return nil
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+
+		usage.SizeBytes += info.Size()
+		usage.ObjectCount++
+		if usage.LastBackupTime == nil || info.ModTime().After(usage.LastBackupTime.Time) {
+			usage.LastBackupTime = &metav1.Time{Time: info.ModTime()}
+		}
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("failed determining usage of backup entry: %w", err)
+	}
+
+	return usage, nil
+}
+
 func (a *actuator) Delete(_ context.Context, log logr.Logger, be *extensionsv1alpha1.BackupEntry) error {
-	entryName := strings.TrimPrefix(be.Name, v1beta1constants.BackupSourcePrefix+"-")
-	path := filepath.Join(a.backBucketPath, be.Spec.BucketName, entryName)
+	path := a.entryPath(be)
 	log.Info("Deleting directory", "path", path)
 	return os.RemoveAll(path)
 }
+
+func (a *actuator) entryPath(be *extensionsv1alpha1.BackupEntry) string {
+	entryName := strings.TrimPrefix(be.Name, v1beta1constants.BackupSourcePrefix+"-")
+	return filepath.Join(a.backBucketPath, be.Spec.BucketName, entryName)
+}
@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backupentry
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+var _ = Describe("Actuator", func() {
+	var (
+		ctx = context.Background()
+
+		backupBucketPath string
+		fakeClock        *testclock.FakeClock
+		a                *actuator
+
+		backupEntry *extensionsv1alpha1.BackupEntry
+	)
+
+	BeforeEach(func() {
+		backupBucketPath = GinkgoT().TempDir()
+		fakeClock = testclock.NewFakeClock(time.Now())
+		a = &actuator{clock: fakeClock, backBucketPath: backupBucketPath}
+
+		backupEntry = &extensionsv1alpha1.BackupEntry{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot--foo--bar--1234"},
+			Spec:       extensionsv1alpha1.BackupEntrySpec{BucketName: "bucket"},
+		}
+	})
+
+	Describe("#GetUsage", func() {
+		It("should return an empty usage if no backups were taken yet", func() {
+			Expect(a.GetUsage(ctx, logr.Discard(), backupEntry)).To(Equal(&gardencorev1beta1.BackupEntryUsage{
+				LastUpdateTime: metav1.NewTime(fakeClock.Now()),
+			}))
+		})
+
+		It("should sum up the size and the number of objects of the backup entry", func() {
+			entryPath := filepath.Join(backupBucketPath, "bucket", "shoot--foo--bar--1234", "etcd-main", "v2")
+			Expect(os.MkdirAll(entryPath, 0755)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(entryPath, "Full-00000000-00000001-1"), []byte("full"), 0644)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(entryPath, "Incr-00000002-00000003-2"), []byte("incremental"), 0644)).To(Succeed())
+			Expect(os.Chtimes(filepath.Join(entryPath, "Full-00000000-00000001-1"), time.Time{}, time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC))).To(Succeed())
+			Expect(os.Chtimes(filepath.Join(entryPath, "Incr-00000002-00000003-2"), time.Time{}, time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC))).To(Succeed())
+			newest, err := os.Stat(filepath.Join(entryPath, "Incr-00000002-00000003-2"))
+			Expect(err).NotTo(HaveOccurred())
+
+			otherEntryPath := filepath.Join(backupBucketPath, "bucket", "shoot--foo--baz--5678")
+			Expect(os.MkdirAll(otherEntryPath, 0755)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(otherEntryPath, "Full-00000000-00000001-1"), []byte("other"), 0644)).To(Succeed())
+
+			Expect(a.GetUsage(ctx, logr.Discard(), backupEntry)).To(Equal(&gardencorev1beta1.BackupEntryUsage{
+				SizeBytes:      15,
+				ObjectCount:    2,
+				LastUpdateTime: metav1.NewTime(fakeClock.Now()),
+				LastBackupTime: &metav1.Time{Time: newest.ModTime()},
+			}))
+		})
+	})
+})
@@ -5,6 +5,8 @@
 package shoot
 
 import (
+	"fmt"
+
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
@@ -35,6 +37,13 @@ func (r *Reconciler) AddToManager(mgr manager.Manager) error {
 	if r.GardenNamespace == "" {
 		r.GardenNamespace = v1beta1constants.GardenNamespace
 	}
+	if r.Framework == nil {
+		fw, err := NewFramework(r.Config)
+		if err != nil {
+			return fmt.Errorf("failed creating scheduler framework: %w", err)
+		}
+		r.Framework = fw
+	}
 
 	return builder.
 		ControllerManagedBy(mgr).
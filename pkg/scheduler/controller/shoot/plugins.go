@@ -0,0 +1,397 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/yaml"
+
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/scheduler/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/scheduler/framework"
+)
+
+const (
+	// PluginNameSeedReadiness is the name of the filter plugin which only keeps seeds that are not being deleted,
+	// visible, not drained and ready.
+	PluginNameSeedReadiness = "SeedReadiness"
+	// PluginNameCloudProfileSeedSelector is the name of the filter plugin which only keeps seeds matching the seed
+	// selector of the cloud profile.
+	PluginNameCloudProfileSeedSelector = "CloudProfileSeedSelector"
+	// PluginNameShootSeedSelector is the name of the filter plugin which only keeps seeds matching the seed selector of
+	// the shoot.
+	PluginNameShootSeedSelector = "ShootSeedSelector"
+	// PluginNameProvider is the name of the filter plugin which only keeps seeds with a matching provider type.
+	PluginNameProvider = "Provider"
+	// PluginNameZonalControlPlane is the name of the filter plugin which only keeps seeds with at least three zones for
+	// shoots with failure tolerance type 'zone'.
+	PluginNameZonalControlPlane = "ZonalControlPlane"
+	// PluginNameZoneSelection is the name of the filter plugin which respects the zone selection mode of seeds.
+	PluginNameZoneSelection = "ZoneSelection"
+	// PluginNameAccessRestrictions is the name of the filter plugin which only keeps seeds supporting the access
+	// restrictions of the shoot.
+	PluginNameAccessRestrictions = "AccessRestrictions"
+	// PluginNameDomain is the name of the filter plugin which only keeps seeds supporting the domain of the shoot.
+	PluginNameDomain = "Domain"
+	// PluginNameShootReconciliations is the name of the filter plugin which filters out seeds with disabled shoot
+	// reconciliations.
+	PluginNameShootReconciliations = "ShootReconciliations"
+	// PluginNameCandidates is the name of the filter plugin which only keeps seeds with disjoint networks, tolerated
+	// taints and enough capacity for shoots.
+	PluginNameCandidates = "Candidates"
+	// PluginNameShootAffinity is the name of the filter plugin which only keeps seeds satisfying the shoot affinity
+	// and anti-affinity terms of the shoot.
+	PluginNameShootAffinity = "ShootAffinity"
+	// PluginNameSameRegion is the name of the plugin implementing the SameRegion strategy. As filter plugin, it only
+	// keeps seeds in the same region as the shoot. As score plugin, it prefers such seeds.
+	PluginNameSameRegion = string(schedulerconfigv1alpha1.SameRegion)
+	// PluginNameMinimalDistance is the name of the plugin implementing the MinimalDistance strategy. As filter plugin,
+	// it only keeps the seeds with the minimal distance to the shoot's region. As score plugin, it scores seeds the
+	// higher the closer they are.
+	PluginNameMinimalDistance = string(schedulerconfigv1alpha1.MinimalDistance)
+	// PluginNameLeastShootsDeployed is the name of the score plugin which prefers seeds with the least number of shoots.
+	PluginNameLeastShootsDeployed = "LeastShootsDeployed"
+	// PluginNameSeedUtilization is the name of the plugin considering the utilization reported by the seeds. As filter
+	// plugin, it filters out seeds exceeding the configured utilization thresholds. As score plugin, it prefers seeds
+	// with the most headroom.
+	PluginNameSeedUtilization = "SeedUtilization"
+)
+
+// utilizationResources are the seed resources which are considered for computing the headroom of a seed.
+var utilizationResources = []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory, gardencorev1beta1.ResourceEtcdVolume}
+
+// NewRegistry returns the registry containing all built-in plugins.
+func NewRegistry(config *schedulerconfigv1alpha1.ShootSchedulerConfiguration) framework.Registry {
+	return framework.Registry{
+		PluginNameSeedReadiness: newFilterPluginFactory(PluginNameSeedReadiness, func(_ *framework.CycleState, _ *gardencorev1beta1.Shoot, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+			return filterUsableSeeds(seeds)
+		}),
+		PluginNameCloudProfileSeedSelector: newFilterPluginFactory(PluginNameCloudProfileSeedSelector, func(state *framework.CycleState, _ *gardencorev1beta1.Shoot, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+			return filterSeedsMatchingLabelSelector(seeds, state.CloudProfile.Spec.SeedSelector, "CloudProfile")
+		}),
+		PluginNameShootSeedSelector: newFilterPluginFactory(PluginNameShootSeedSelector, func(_ *framework.CycleState, shoot *gardencorev1beta1.Shoot, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+			return filterSeedsMatchingLabelSelector(seeds, shoot.Spec.SeedSelector, "Shoot")
+		}),
+		PluginNameProvider: newFilterPluginFactory(PluginNameProvider, func(state *framework.CycleState, shoot *gardencorev1beta1.Shoot, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+			return filterSeedsMatchingProviders(state.CloudProfile, shoot, seeds)
+		}),
+		PluginNameZonalControlPlane: newFilterPluginFactory(PluginNameZonalControlPlane, func(_ *framework.CycleState, shoot *gardencorev1beta1.Shoot, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+			return filterSeedsForZonalShootControlPlanes(seeds, shoot)
+		}),
+		PluginNameZoneSelection: newFilterPluginFactory(PluginNameZoneSelection, func(_ *framework.CycleState, shoot *gardencorev1beta1.Shoot, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+			return filterSeedsForZoneSelection(seeds, shoot)
+		}),
+		PluginNameAccessRestrictions: newFilterPluginFactory(PluginNameAccessRestrictions, func(_ *framework.CycleState, shoot *gardencorev1beta1.Shoot, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+			return filterSeedsForAccessRestrictions(seeds, shoot)
+		}),
+		PluginNameDomain: newFilterPluginFactory(PluginNameDomain, func(state *framework.CycleState, shoot *gardencorev1beta1.Shoot, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+			return filterSeedsMatchingDomain(seeds, shoot, state.Project.Name)
+		}),
+		PluginNameShootReconciliations: newFilterPluginFactory(PluginNameShootReconciliations, func(_ *framework.CycleState, _ *gardencorev1beta1.Shoot, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+			return filterSeedsWithDisabledShootReconciliations(seeds)
+		}),
+		PluginNameCandidates: newFilterPluginFactory(PluginNameCandidates, func(state *framework.CycleState, shoot *gardencorev1beta1.Shoot, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+			return filterCandidates(state, shoot, seeds)
+		}),
+		PluginNameShootAffinity: newFilterPluginFactory(PluginNameShootAffinity, func(state *framework.CycleState, shoot *gardencorev1beta1.Shoot, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+			return filterSeedsForShootAffinity(state, shoot, seeds)
+		}),
+		PluginNameSameRegion:          func() (framework.Plugin, error) { return &sameRegion{}, nil },
+		PluginNameMinimalDistance:     func() (framework.Plugin, error) { return &minimalDistance{}, nil },
+		PluginNameLeastShootsDeployed: func() (framework.Plugin, error) { return &leastShootsDeployed{}, nil },
+		PluginNameSeedUtilization: func() (framework.Plugin, error) {
+			return &seedUtilization{thresholds: config.UtilizationThresholds}, nil
+		},
+	}
+}
+
+// NewFramework creates a new scheduler framework for the given configuration. The default filter plugins consist of
+// all built-in filters followed by the plugin implementing the configured strategy. The default score plugins prefer
+// seeds with the most headroom and, with a lower weight, seeds with the least number of shoots.
+func NewFramework(config *schedulerconfigv1alpha1.ShootSchedulerConfiguration) (*framework.Framework, error) {
+	var (
+		filterPlugins = defaultFilterPlugins(config.Strategy)
+		scorePlugins  = defaultScorePlugins()
+	)
+
+	if config.Plugins != nil {
+		filterPlugins = framework.MergePluginSet(filterPlugins, config.Plugins.Filter)
+		scorePlugins = framework.MergePluginSet(scorePlugins, config.Plugins.Score)
+	}
+
+	return framework.New(NewRegistry(config), filterPlugins, scorePlugins)
+}
+
+func defaultFilterPlugins(strategy schedulerconfigv1alpha1.CandidateDeterminationStrategy) []schedulerconfigv1alpha1.Plugin {
+	return []schedulerconfigv1alpha1.Plugin{
+		{Name: PluginNameSeedReadiness},
+		{Name: PluginNameCloudProfileSeedSelector},
+		{Name: PluginNameShootSeedSelector},
+		{Name: PluginNameProvider},
+		{Name: PluginNameZonalControlPlane},
+		{Name: PluginNameZoneSelection},
+		{Name: PluginNameAccessRestrictions},
+		{Name: PluginNameDomain},
+		{Name: PluginNameShootReconciliations},
+		{Name: PluginNameCandidates},
+		{Name: PluginNameShootAffinity},
+		{Name: PluginNameSeedUtilization},
+		{Name: string(strategy)},
+	}
+}
+
+func defaultScorePlugins() []schedulerconfigv1alpha1.Plugin {
+	return []schedulerconfigv1alpha1.Plugin{
+		{Name: PluginNameSeedUtilization, Weight: new(int32(2))},
+		{Name: PluginNameLeastShootsDeployed},
+	}
+}
+
+type filterFunc func(state *framework.CycleState, shoot *gardencorev1beta1.Shoot, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error)
+
+// filterPlugin adapts a filter function to the FilterPlugin interface.
+type filterPlugin struct {
+	name   string
+	filter filterFunc
+}
+
+func newFilterPluginFactory(name string, filter filterFunc) framework.PluginFactory {
+	return func() (framework.Plugin, error) {
+		return &filterPlugin{name: name, filter: filter}, nil
+	}
+}
+
+func (p *filterPlugin) Name() string {
+	return p.name
+}
+
+func (p *filterPlugin) Filter(_ context.Context, state *framework.CycleState, shoot *gardencorev1beta1.Shoot, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+	return p.filter(state, shoot, seeds)
+}
+
+// sameRegion implements the SameRegion strategy.
+type sameRegion struct{}
+
+func (p *sameRegion) Name() string {
+	return PluginNameSameRegion
+}
+
+func (p *sameRegion) Filter(_ context.Context, state *framework.CycleState, shoot *gardencorev1beta1.Shoot, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+	return applyStrategy(state.Log, shoot, seeds, schedulerconfigv1alpha1.SameRegion, state.RegionConfig)
+}
+
+func (p *sameRegion) Score(_ context.Context, _ *framework.CycleState, shoot *gardencorev1beta1.Shoot, seed *gardencorev1beta1.Seed) (int64, error) {
+	if seed.Spec.Provider.Type == shoot.Spec.Provider.Type && seed.Spec.Provider.Region == shoot.Spec.Region {
+		return framework.MaxSeedScore, nil
+	}
+	return framework.MinSeedScore, nil
+}
+
+func (p *sameRegion) ScoreExtensions() framework.ScoreExtensions {
+	return nil
+}
+
+// minimalDistance implements the MinimalDistance strategy.
+type minimalDistance struct{}
+
+func (p *minimalDistance) Name() string {
+	return PluginNameMinimalDistance
+}
+
+func (p *minimalDistance) Filter(_ context.Context, state *framework.CycleState, shoot *gardencorev1beta1.Shoot, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+	return applyStrategy(state.Log, shoot, seeds, schedulerconfigv1alpha1.MinimalDistance, state.RegionConfig)
+}
+
+// Score returns the raw distance between the seed's and the shoot's region. If the region config contains distances
+// for the shoot's region, they are used. Seeds whose region is not contained get a distance which is larger than all
+// configured ones. Otherwise, the Levenshtein distance is used.
+func (p *minimalDistance) Score(_ context.Context, state *framework.CycleState, shoot *gardencorev1beta1.Shoot, seed *gardencorev1beta1.Seed) (int64, error) {
+	if state.RegionConfig != nil && state.RegionConfig.Data[shoot.Spec.Region] != "" {
+		regionConfigData := make(map[string]int)
+		if err := yaml.Unmarshal([]byte(state.RegionConfig.Data[shoot.Spec.Region]), &regionConfigData); err != nil {
+			return 0, fmt.Errorf("wrong format in region ConfigMap %s/%s, Region %q: %w", state.RegionConfig.Namespace, state.RegionConfig.Name, shoot.Spec.Region, err)
+		}
+		if _, ok := regionConfigData[shoot.Spec.Region]; !ok {
+			regionConfigData[shoot.Spec.Region] = 0
+		}
+
+		if dist, ok := regionConfigData[seed.Spec.Provider.Region]; ok {
+			return int64(dist), nil
+		}
+
+		maxDistance := 0
+		for _, dist := range regionConfigData {
+			maxDistance = max(maxDistance, dist)
+		}
+		return int64(maxDistance) + 1, nil
+	}
+
+	dist := distance(seed.Spec.Provider.Region, shoot.Spec.Region)
+	if seed.Spec.Provider.Type != shoot.Spec.Provider.Type {
+		dist += 2
+	}
+	return int64(dist), nil
+}
+
+func (p *minimalDistance) ScoreExtensions() framework.ScoreExtensions {
+	return p
+}
+
+// NormalizeScore maps the smallest distance to the maximum score and the largest distance to the minimum score.
+func (p *minimalDistance) NormalizeScore(_ context.Context, _ *framework.CycleState, _ *gardencorev1beta1.Shoot, scores framework.SeedScoreList) error {
+	normalizeInverse(scores)
+	return nil
+}
+
+// leastShootsDeployed prefers seeds with the least number of shoots.
+type leastShootsDeployed struct{}
+
+func (p *leastShootsDeployed) Name() string {
+	return PluginNameLeastShootsDeployed
+}
+
+func (p *leastShootsDeployed) Score(_ context.Context, state *framework.CycleState, _ *gardencorev1beta1.Shoot, seed *gardencorev1beta1.Seed) (int64, error) {
+	return int64(state.SeedUsage[seed.Name]), nil
+}
+
+func (p *leastShootsDeployed) ScoreExtensions() framework.ScoreExtensions {
+	return p
+}
+
+// NormalizeScore maps the smallest number of shoots to the maximum score and the largest number to the minimum score.
+func (p *leastShootsDeployed) NormalizeScore(_ context.Context, _ *framework.CycleState, _ *gardencorev1beta1.Shoot, scores framework.SeedScoreList) error {
+	normalizeInverse(scores)
+	return nil
+}
+
+// seedUtilization considers the resource utilization reported in the status of the seeds.
+type seedUtilization struct {
+	thresholds []schedulerconfigv1alpha1.SeedUtilizationThreshold
+}
+
+func (p *seedUtilization) Name() string {
+	return PluginNameSeedUtilization
+}
+
+// Filter filters out seeds whose utilization of a resource exceeds the configured threshold. Seeds which do not report
+// their utilization are kept.
+func (p *seedUtilization) Filter(_ context.Context, state *framework.CycleState, _ *gardencorev1beta1.Shoot, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+	if len(p.thresholds) == 0 {
+		return seeds, nil
+	}
+
+	var (
+		candidates    []gardencorev1beta1.Seed
+		seedNameToErr = make(map[string]error)
+	)
+
+	for _, seed := range seeds {
+		if err := p.checkThresholds(seed.Status.Utilization); err != nil {
+			seedNameToErr[seed.Name] = err
+			continue
+		}
+		candidates = append(candidates, seed)
+	}
+
+	for _, seedName := range slices.Sorted(maps.Keys(seedNameToErr)) {
+		state.RejectSeed(PluginNameSeedUtilization, seedName, seedNameToErr[seedName].Error())
+	}
+
+	if candidates == nil {
+		return nil, fmt.Errorf("0/%d seed cluster candidate(s) are below the utilization thresholds: %v", len(seeds), errorMapToString(seedNameToErr))
+	}
+	return candidates, nil
+}
+
+func (p *seedUtilization) checkThresholds(utilization *gardencorev1beta1.SeedUtilization) error {
+	var errs []error
+
+	for _, threshold := range p.thresholds {
+		ratio, ok := utilizationRatio(utilization, threshold.Resource)
+		if !ok {
+			continue
+		}
+
+		if percentage := ratio * 100; percentage > float64(threshold.Percentage) {
+			errs = append(errs, fmt.Errorf("%s utilization of %.0f%% exceeds the threshold of %d%%", threshold.Resource, percentage, threshold.Percentage))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Score returns the headroom of the seed in percent, i.e., the free share of its most utilized resource. Seeds which
+// do not report their utilization get the minimum score.
+func (p *seedUtilization) Score(_ context.Context, _ *framework.CycleState, _ *gardencorev1beta1.Shoot, seed *gardencorev1beta1.Seed) (int64, error) {
+	var (
+		headroom = 1.0
+		found    bool
+	)
+
+	for _, resourceName := range utilizationResources {
+		ratio, ok := utilizationRatio(seed.Status.Utilization, resourceName)
+		if !ok {
+			continue
+		}
+		found = true
+		headroom = min(headroom, 1-ratio)
+	}
+
+	if !found {
+		return framework.MinSeedScore, nil
+	}
+
+	return max(framework.MinSeedScore, int64(headroom*float64(framework.MaxSeedScore))), nil
+}
+
+func (p *seedUtilization) ScoreExtensions() framework.ScoreExtensions {
+	return nil
+}
+
+// utilizationRatio returns the share of the allocatable resource which is consumed by shoot control planes. The
+// consumption is the maximum of the requests and the actual usage. The second return value is false if the seed does
+// not report the allocatable quantity of the resource.
+func utilizationRatio(utilization *gardencorev1beta1.SeedUtilization, resourceName corev1.ResourceName) (float64, bool) {
+	if utilization == nil {
+		return 0, false
+	}
+
+	allocatable, ok := utilization.Allocatable[resourceName]
+	if !ok || allocatable.IsZero() {
+		return 0, false
+	}
+
+	consumed := utilization.Requests[resourceName]
+	if usage, ok := utilization.Usage[resourceName]; ok && usage.Cmp(consumed) > 0 {
+		consumed = usage
+	}
+
+	return consumed.AsApproximateFloat64() / allocatable.AsApproximateFloat64(), true
+}
+
+// normalizeInverse linearly maps the given raw scores into the range [MinSeedScore, MaxSeedScore] such that the
+// smallest raw score gets the maximum score.
+func normalizeInverse(scores framework.SeedScoreList) {
+	var minScore, maxScore int64 = math.MaxInt64, math.MinInt64
+	for _, score := range scores {
+		minScore = min(minScore, score.Score)
+		maxScore = max(maxScore, score.Score)
+	}
+
+	for i := range scores {
+		if maxScore == minScore {
+			scores[i].Score = framework.MaxSeedScore
+			continue
+		}
+		scores[i].Score = framework.MinSeedScore + (maxScore-scores[i].Score)*(framework.MaxSeedScore-framework.MinSeedScore)/(maxScore-minScore)
+	}
+}
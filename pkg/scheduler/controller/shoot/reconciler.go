@@ -30,6 +30,7 @@ import (
 	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/scheduler/v1alpha1"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/scheduler/framework"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	cidrvalidation "github.com/gardener/gardener/pkg/utils/validation/cidr"
 )
@@ -40,6 +41,9 @@ type Reconciler struct {
 	Config          *schedulerconfigv1alpha1.ShootSchedulerConfiguration
 	GardenNamespace string
 	Recorder        events.EventRecorder
+	// Framework runs the filter and score plugins. If it is nil, a new framework is created for every scheduling
+	// cycle based on Config.
+	Framework *framework.Framework
 }
 
 // Reconcile schedules shoots to seeds.
@@ -150,83 +154,70 @@ func (r *Reconciler) DetermineSeed(
 		return nil, err
 	}
 
-	filteredSeeds, err := filterUsableSeeds(seedList.Items)
-	if err != nil {
-		return nil, err
-	}
-	filteredSeeds, err = filterSeedsMatchingLabelSelector(filteredSeeds, cloudProfile.Spec.SeedSelector, "CloudProfile")
-	if err != nil {
-		return nil, err
-	}
-	filteredSeeds, err = filterSeedsMatchingLabelSelector(filteredSeeds, shoot.Spec.SeedSelector, "Shoot")
-	if err != nil {
-		return nil, err
-	}
-	filteredSeeds, err = filterSeedsMatchingProviders(cloudProfile, shoot, filteredSeeds)
-	if err != nil {
-		return nil, err
-	}
-	filteredSeeds, err = filterSeedsForZonalShootControlPlanes(filteredSeeds, shoot)
-	if err != nil {
-		return nil, err
-	}
-	filteredSeeds, err = filterSeedsForZoneSelection(filteredSeeds, shoot)
-	if err != nil {
-		return nil, err
-	}
-	filteredSeeds, err = filterSeedsForAccessRestrictions(filteredSeeds, shoot)
-	if err != nil {
-		return nil, err
-	}
-	filteredSeeds, err = filterSeedsMatchingDomain(filteredSeeds, shoot, project.Name)
-	if err != nil {
-		return nil, err
-	}
-	filteredSeeds, err = filterSeedsWithDisabledShootReconciliations(filteredSeeds)
-	if err != nil {
-		return nil, err
-	}
-	filteredSeeds, err = filterCandidates(shoot, shootList, filteredSeeds)
-	if err != nil {
-		return nil, err
+	fw := r.Framework
+	if fw == nil {
+		if fw, err = NewFramework(r.Config); err != nil {
+			return nil, err
+		}
 	}
-	filteredSeeds, err = applyStrategy(log, shoot, filteredSeeds, r.Config.Strategy, regionConfig)
-	if err != nil {
-		return nil, err
+
+	state := &framework.CycleState{
+		Log:          log,
+		CloudProfile: cloudProfile,
+		Project:      project,
+		RegionConfig: regionConfig,
+		Shoots:       shootList,
+		SeedUsage:    v1beta1helper.CalculateSeedUsage(shootList),
 	}
-	return getSeedWithLeastShootsDeployed(filteredSeeds, shootList)
+
+	return fw.Schedule(ctx, state, shoot, seedList.Items)
 }
 
 func (r *Reconciler) getRegionConfigMap(ctx context.Context, log logr.Logger, cloudProfile *gardencorev1beta1.CloudProfile) (*corev1.ConfigMap, error) {
+	return GetRegionConfig(ctx, log, r.Client, r.GardenNamespace, cloudProfile.Name)
+}
+
+// GetRegionConfig lists the scheduler region configs in the given namespace and returns the one for the given cloud
+// profile (or nil if there is none).
+func GetRegionConfig(ctx context.Context, log logr.Logger, reader client.Reader, namespace, cloudProfileName string) (*corev1.ConfigMap, error) {
 	regionConfigList := &corev1.ConfigMapList{}
-	if err := r.Client.List(ctx, regionConfigList, client.InNamespace(r.GardenNamespace), client.MatchingLabels{v1beta1constants.SchedulingPurpose: v1beta1constants.SchedulingPurposeRegionConfig}); err != nil {
+	if err := reader.List(ctx, regionConfigList, client.InNamespace(namespace), client.MatchingLabels{v1beta1constants.SchedulingPurpose: v1beta1constants.SchedulingPurposeRegionConfig}); err != nil {
 		return nil, err
 	}
 
+	return FindRegionConfig(log, regionConfigList.Items, cloudProfileName), nil
+}
+
+// FindRegionConfig returns the scheduler region config for the given cloud profile from the given list of config maps
+// (or nil if there is none). The config maps are expected to be labeled with the region config scheduling purpose.
+func FindRegionConfig(log logr.Logger, configMaps []corev1.ConfigMap, cloudProfileName string) *corev1.ConfigMap {
 	var regionConfig *corev1.ConfigMap
-	for _, regionConf := range regionConfigList.Items {
+	for _, regionConf := range configMaps {
 		profileNames := strings.SplitSeq(regionConf.Annotations[v1beta1constants.AnnotationSchedulingCloudProfiles], ",")
 		for name := range profileNames {
-			if name != cloudProfile.Name {
+			if name != cloudProfileName {
 				continue
 			}
 			if regionConfig == nil {
 				regionConfig = regionConf.DeepCopy()
 			} else {
-				log.Info("Duplicate scheduler region config found", "configMap", client.ObjectKeyFromObject(&regionConf), "cloudProfileName", cloudProfile.Name, "chosenConfigMap", client.ObjectKeyFromObject(regionConfig))
+				log.Info("Duplicate scheduler region config found", "configMap", client.ObjectKeyFromObject(&regionConf), "cloudProfileName", cloudProfileName, "chosenConfigMap", client.ObjectKeyFromObject(regionConfig))
 			}
 			break
 		}
 	}
 
 	if regionConfig == nil {
-		log.Info("No region config found", "cloudProfileName", cloudProfile.Name)
+		log.Info("No region config found", "cloudProfileName", cloudProfileName)
 	}
-	return regionConfig, nil
+	return regionConfig
 }
 
 func isUsableSeed(seed *gardencorev1beta1.Seed) bool {
-	return seed.DeletionTimestamp == nil && seed.Spec.Settings.Scheduling.Visible && verifySeedReadiness(seed)
+	return seed.DeletionTimestamp == nil &&
+		seed.Spec.Settings.Scheduling.Visible &&
+		!v1beta1helper.HasSeedDrainAnnotation(seed) &&
+		verifySeedReadiness(seed)
 }
 
 func filterUsableSeeds(seedList []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
@@ -239,7 +230,7 @@ func filterUsableSeeds(seedList []gardencorev1beta1.Seed) ([]gardencorev1beta1.S
 	}
 
 	if len(matchingSeeds) == 0 {
-		return nil, fmt.Errorf("none of the %d seeds is valid for scheduling (not deleting, visible and ready)", len(seedList))
+		return nil, fmt.Errorf("none of the %d seeds is valid for scheduling (not deleting, visible, not drained and ready)", len(seedList))
 	}
 	return matchingSeeds, nil
 }
@@ -463,11 +454,10 @@ func applyStrategy(log logr.Logger, shoot *gardencorev1beta1.Shoot, seedList []g
 	return candidates, nil
 }
 
-func filterCandidates(shoot *gardencorev1beta1.Shoot, shootList []*gardencorev1beta1.Shoot, seedList []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+func filterCandidates(state *framework.CycleState, shoot *gardencorev1beta1.Shoot, seedList []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
 	var (
 		candidates    []gardencorev1beta1.Seed
 		seedNameToErr = make(map[string]error)
-		seedUsage     = v1beta1helper.CalculateSeedUsage(shootList)
 	)
 
 	for _, seed := range seedList {
@@ -483,7 +473,7 @@ func filterCandidates(shoot *gardencorev1beta1.Shoot, shootList []*gardencorev1b
 			continue
 		}
 
-		if allocatableShoots, ok := seed.Status.Allocatable[gardencorev1beta1.ResourceShoots]; ok && int64(seedUsage[seed.Name]) >= allocatableShoots.Value() {
+		if allocatableShoots, ok := seed.Status.Allocatable[gardencorev1beta1.ResourceShoots]; ok && int64(state.SeedUsage[seed.Name]) >= allocatableShoots.Value() {
 			seedNameToErr[seed.Name] = errors.New("seed does not have available capacity for shoots")
 			continue
 		}
@@ -491,28 +481,57 @@ func filterCandidates(shoot *gardencorev1beta1.Shoot, shootList []*gardencorev1b
 		candidates = append(candidates, seed)
 	}
 
+	for _, seedName := range slices.Sorted(maps.Keys(seedNameToErr)) {
+		state.RejectSeed(PluginNameCandidates, seedName, seedNameToErr[seedName].Error())
+	}
+
 	if candidates == nil {
 		return nil, fmt.Errorf("0/%d seed cluster candidate(s) are eligible for scheduling: %v", len(seedList), errorMapToString(seedNameToErr))
 	}
 	return candidates, nil
 }
 
-// getSeedWithLeastShootsDeployed finds the best candidate (i.e. the one managing the smallest number of shoots right now).
-func getSeedWithLeastShootsDeployed(seedList []gardencorev1beta1.Seed, shootList []*gardencorev1beta1.Shoot) (*gardencorev1beta1.Seed, error) {
+// filterSeedsForShootAffinity filters seeds which satisfy the shoot affinity and anti-affinity terms, i.e., it only
+// keeps the seeds hosting the shoots the given shoot wants to be co-located with and drops the seeds hosting shoots it
+// must not share a seed with.
+func filterSeedsForShootAffinity(state *framework.CycleState, shoot *gardencorev1beta1.Shoot, seedList []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+	satisfyingSeedNames, err := v1beta1helper.SeedNamesSatisfyingShootAffinity(shoot, state.Shoots)
+	if err != nil {
+		return nil, err
+	}
+
+	violatingSeedNames, err := v1beta1helper.SeedNamesViolatingShootAntiAffinity(shoot, state.Shoots)
+	if err != nil {
+		return nil, err
+	}
+
 	var (
-		bestCandidate gardencorev1beta1.Seed
-		min           *int
-		seedUsage     = v1beta1helper.CalculateSeedUsage(shootList)
+		candidates    []gardencorev1beta1.Seed
+		seedNameToErr = make(map[string]error)
 	)
 
 	for _, seed := range seedList {
-		if numberOfManagedShoots := seedUsage[seed.Name]; min == nil || numberOfManagedShoots < *min {
-			bestCandidate = seed
-			min = &numberOfManagedShoots
+		if satisfyingSeedNames != nil && !satisfyingSeedNames.Has(seed.Name) {
+			seedNameToErr[seed.Name] = errors.New("seed does not host the shoots selected by the shoot affinity")
+			continue
+		}
+
+		if violatingSeedNames.Has(seed.Name) {
+			seedNameToErr[seed.Name] = errors.New("seed hosts shoots selected by the shoot anti-affinity")
+			continue
 		}
+
+		candidates = append(candidates, seed)
+	}
+
+	for _, seedName := range slices.Sorted(maps.Keys(seedNameToErr)) {
+		state.RejectSeed(PluginNameShootAffinity, seedName, seedNameToErr[seedName].Error())
 	}
 
-	return &bestCandidate, nil
+	if candidates == nil {
+		return nil, fmt.Errorf("0/%d seed cluster candidate(s) satisfy the shoot affinity: %v", len(seedList), errorMapToString(seedNameToErr))
+	}
+	return candidates, nil
 }
 
 func matchProvider(seedProviderType, shootProviderType string, enabledProviderTypes []string) bool {
@@ -896,6 +896,18 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(bestSeed).To(BeNil())
 		})
 
+		It("should fail because it cannot find a seed cluster due to it being drained", func() {
+			metav1.SetMetaDataAnnotation(&seed.ObjectMeta, "seed.gardener.cloud/drain", "true")
+
+			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, project)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
+
+			bestSeed, err := reconciler.DetermineSeed(ctx, log, shoot)
+			Expect(err).To(MatchError(ContainSubstring("none of the 1 seeds is valid for scheduling")))
+			Expect(bestSeed).To(BeNil())
+		})
+
 		It("should fail because it cannot find a seed cluster due to invisibility", func() {
 			seed.Spec.Settings = &gardencorev1beta1.SeedSettings{
 				Scheduling: &gardencorev1beta1.SeedSettingScheduling{
@@ -913,6 +925,243 @@ var _ = Describe("Scheduler_Control", func() {
 		})
 	})
 
+	Context("SEED DETERMINATION - Shoot does not reference a Seed - find an adequate one using configured plugins", func() {
+		var nearSeed, farSeed *gardencorev1beta1.Seed
+
+		BeforeEach(func() {
+			shoot = shootBase.DeepCopy()
+			cloudProfile = cloudProfileBase.DeepCopy()
+			project = projectBase.DeepCopy()
+			schedulerConfiguration = *schedulerConfigurationBase.DeepCopy()
+
+			nearSeed = seedBase.DeepCopy()
+			nearSeed.Name = "seed-near"
+			farSeed = seedBase.DeepCopy()
+			farSeed.Name = "seed-far"
+			farSeed.Spec.Provider.Region = "other-region"
+
+			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, project)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, nearSeed)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, farSeed)).To(Succeed())
+
+			for i := range 2 {
+				otherShoot := shootBase.DeepCopy()
+				otherShoot.Name = fmt.Sprintf("other-shoot-%d", i)
+				otherShoot.Spec.SeedName = &nearSeed.Name
+				Expect(fakeGardenClient.Create(ctx, otherShoot)).To(Succeed())
+			}
+		})
+
+		It("should only consider seeds in the same region by default", func() {
+			bestSeed, err := reconciler.DetermineSeed(ctx, log, shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(nearSeed.Name))
+		})
+
+		It("should pick the seed with the least shoots if the strategy filter is disabled", func() {
+			schedulerConfiguration.Schedulers.Shoot.Plugins = &schedulerconfigv1alpha1.Plugins{
+				Filter: schedulerconfigv1alpha1.PluginSet{
+					Disabled: []schedulerconfigv1alpha1.Plugin{{Name: PluginNameSameRegion}},
+				},
+			}
+
+			bestSeed, err := reconciler.DetermineSeed(ctx, log, shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(farSeed.Name))
+		})
+
+		It("should pick the seed with the highest weighted score", func() {
+			schedulerConfiguration.Schedulers.Shoot.Plugins = &schedulerconfigv1alpha1.Plugins{
+				Filter: schedulerconfigv1alpha1.PluginSet{
+					Disabled: []schedulerconfigv1alpha1.Plugin{{Name: PluginNameSameRegion}},
+				},
+				Score: schedulerconfigv1alpha1.PluginSet{
+					Enabled: []schedulerconfigv1alpha1.Plugin{{Name: PluginNameMinimalDistance, Weight: new(int32(2))}},
+				},
+			}
+
+			bestSeed, err := reconciler.DetermineSeed(ctx, log, shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(nearSeed.Name))
+		})
+
+		It("should fail if an unknown plugin is configured", func() {
+			schedulerConfiguration.Schedulers.Shoot.Plugins = &schedulerconfigv1alpha1.Plugins{
+				Score: schedulerconfigv1alpha1.PluginSet{
+					Enabled: []schedulerconfigv1alpha1.Plugin{{Name: "Foo"}},
+				},
+			}
+
+			bestSeed, err := reconciler.DetermineSeed(ctx, log, shoot)
+			Expect(err).To(MatchError(ContainSubstring(`plugin "Foo" is not registered`)))
+			Expect(bestSeed).To(BeNil())
+		})
+	})
+
+	Context("SEED DETERMINATION - Shoot does not reference a Seed - find an adequate one using the seed utilization", func() {
+		var idleSeed, busySeed *gardencorev1beta1.Seed
+
+		newUtilization := func(cpuRequests, memoryRequests string) *gardencorev1beta1.SeedUtilization {
+			return &gardencorev1beta1.SeedUtilization{
+				Allocatable: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("10"),
+					corev1.ResourceMemory: resource.MustParse("100Gi"),
+				},
+				Requests: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse(cpuRequests),
+					corev1.ResourceMemory: resource.MustParse(memoryRequests),
+				},
+			}
+		}
+
+		BeforeEach(func() {
+			shoot = shootBase.DeepCopy()
+			cloudProfile = cloudProfileBase.DeepCopy()
+			project = projectBase.DeepCopy()
+			schedulerConfiguration = *schedulerConfigurationBase.DeepCopy()
+
+			// idleSeed hosts more shoots, but they are small and leave 80% headroom
+			idleSeed = seedBase.DeepCopy()
+			idleSeed.Name = "seed-idle"
+			idleSeed.Status.Utilization = newUtilization("2", "20Gi")
+			// busySeed hosts no shoots yet, but it is almost fully utilized by other control planes
+			busySeed = seedBase.DeepCopy()
+			busySeed.Name = "seed-busy"
+			busySeed.Status.Utilization = newUtilization("9", "10Gi")
+
+			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, project)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, idleSeed)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, busySeed)).To(Succeed())
+
+			for i := range 2 {
+				otherShoot := shootBase.DeepCopy()
+				otherShoot.Name = fmt.Sprintf("other-shoot-%d", i)
+				otherShoot.Spec.SeedName = &idleSeed.Name
+				Expect(fakeGardenClient.Create(ctx, otherShoot)).To(Succeed())
+			}
+		})
+
+		It("should pick the seed with the most headroom", func() {
+			bestSeed, err := reconciler.DetermineSeed(ctx, log, shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(idleSeed.Name))
+		})
+
+		It("should consider the actual usage if it exceeds the requests", func() {
+			idleSeed.Status.Utilization.Usage = corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("9.5")}
+			Expect(fakeGardenClient.Update(ctx, idleSeed)).To(Succeed())
+
+			bestSeed, err := reconciler.DetermineSeed(ctx, log, shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(busySeed.Name))
+		})
+
+		It("should skip seeds exceeding the utilization thresholds", func() {
+			schedulerConfiguration.Schedulers.Shoot.UtilizationThresholds = []schedulerconfigv1alpha1.SeedUtilizationThreshold{
+				{Resource: corev1.ResourceMemory, Percentage: 15},
+			}
+
+			bestSeed, err := reconciler.DetermineSeed(ctx, log, shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(busySeed.Name))
+		})
+
+		It("should fail if all seeds exceed the utilization thresholds", func() {
+			schedulerConfiguration.Schedulers.Shoot.UtilizationThresholds = []schedulerconfigv1alpha1.SeedUtilizationThreshold{
+				{Resource: corev1.ResourceCPU, Percentage: 50},
+				{Resource: corev1.ResourceMemory, Percentage: 15},
+			}
+
+			bestSeed, err := reconciler.DetermineSeed(ctx, log, shoot)
+			Expect(err).To(MatchError(And(
+				ContainSubstring("0/2 seed cluster candidate(s) are below the utilization thresholds"),
+				ContainSubstring("seed-busy => cpu utilization of 90% exceeds the threshold of 50%"),
+				ContainSubstring("seed-idle => memory utilization of 20% exceeds the threshold of 15%"),
+			)))
+			Expect(bestSeed).To(BeNil())
+		})
+	})
+
+	Context("SEED DETERMINATION - Shoot does not reference a Seed - find an adequate one using the shoot affinity", func() {
+		var seedA, seedB *gardencorev1beta1.Seed
+
+		BeforeEach(func() {
+			shoot = shootBase.DeepCopy()
+			shoot.Labels = map[string]string{"app": "db"}
+			cloudProfile = cloudProfileBase.DeepCopy()
+			project = projectBase.DeepCopy()
+			schedulerConfiguration = *schedulerConfigurationBase.DeepCopy()
+
+			seedA = seedBase.DeepCopy()
+			seedA.Name = "seed-a"
+			seedB = seedBase.DeepCopy()
+			seedB.Name = "seed-b"
+
+			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, project)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seedA)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seedB)).To(Succeed())
+
+			// seed-a hosts less shoots and is hence preferred if the shoot affinity does not constrain the placement
+			dbShoot := shootBase.DeepCopy()
+			dbShoot.Name = "db-replica"
+			dbShoot.Labels = map[string]string{"app": "db"}
+			dbShoot.Spec.SeedName = &seedA.Name
+			Expect(fakeGardenClient.Create(ctx, dbShoot)).To(Succeed())
+
+			for i := range 2 {
+				webShoot := shootBase.DeepCopy()
+				webShoot.Name = fmt.Sprintf("web-%d", i)
+				webShoot.Labels = map[string]string{"app": "web"}
+				webShoot.Spec.SeedName = &seedB.Name
+				Expect(fakeGardenClient.Create(ctx, webShoot)).To(Succeed())
+			}
+		})
+
+		It("should pick the seed with the least shoots if no affinity is configured", func() {
+			bestSeed, err := reconciler.DetermineSeed(ctx, log, shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(seedA.Name))
+		})
+
+		It("should pick the seed hosting the shoots selected by the affinity", func() {
+			shoot.Spec.Affinity = &gardencorev1beta1.Affinity{ShootAffinity: []gardencorev1beta1.ShootAffinityTerm{
+				{LabelSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}}},
+			}}
+
+			bestSeed, err := reconciler.DetermineSeed(ctx, log, shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(seedB.Name))
+		})
+
+		It("should not pick the seed hosting the shoots selected by the anti-affinity", func() {
+			shoot.Spec.Affinity = &gardencorev1beta1.Affinity{ShootAntiAffinity: []gardencorev1beta1.ShootAffinityTerm{
+				{LabelSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}}},
+			}}
+
+			bestSeed, err := reconciler.DetermineSeed(ctx, log, shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(seedB.Name))
+		})
+
+		It("should fail if all seeds host shoots selected by the anti-affinity", func() {
+			shoot.Spec.Affinity = &gardencorev1beta1.Affinity{ShootAntiAffinity: []gardencorev1beta1.ShootAffinityTerm{
+				{LabelSelector: metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+					{Key: "app", Operator: metav1.LabelSelectorOpIn, Values: []string{"db", "web"}},
+				}}},
+			}}
+
+			bestSeed, err := reconciler.DetermineSeed(ctx, log, shoot)
+			Expect(err).To(MatchError(And(
+				ContainSubstring("0/2 seed cluster candidate(s) satisfy the shoot affinity"),
+				ContainSubstring("seed-a => seed hosts shoots selected by the shoot anti-affinity"),
+			)))
+			Expect(bestSeed).To(BeNil())
+		})
+	})
+
 	Context("#DetermineBestSeedCandidate", func() {
 		BeforeEach(func() {
 			seed = seedBase.DeepCopy()
@@ -0,0 +1,79 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+// Diagnosis records the decisions of all plugins during a scheduling cycle. It is used to explain why a shoot was (or
+// would be) scheduled onto a certain seed, or why no seed could be found.
+type Diagnosis struct {
+	// Seeds contains the result for each seed, in the same order in which the seeds were passed to the framework.
+	Seeds []SeedDiagnosis
+	// SelectedSeed is the name of the selected seed. It is empty if no seed could be selected.
+	SelectedSeed string
+
+	index map[string]int
+}
+
+// SeedDiagnosis contains the result of a scheduling cycle for a single seed.
+type SeedDiagnosis struct {
+	// Name is the name of the seed.
+	Name string
+	// RejectedBy is the name of the filter plugin which rejected the seed. It is empty if the seed passed all filters.
+	RejectedBy string
+	// Reason is the reason why the seed was rejected.
+	Reason string
+	// Scores contains the normalized scores of all score plugins. It is only set if the seed passed all filters.
+	Scores []PluginScore
+	// TotalScore is the weighted sum of all scores.
+	TotalScore int64
+}
+
+// PluginScore is the normalized score a score plugin computed for a seed.
+type PluginScore struct {
+	// Name is the name of the score plugin.
+	Name string
+	// Weight is the weight of the score plugin.
+	Weight int64
+	// Score is the normalized score.
+	Score int64
+}
+
+func (d *Diagnosis) init(seeds []gardencorev1beta1.Seed) {
+	d.Seeds = make([]SeedDiagnosis, 0, len(seeds))
+	d.SelectedSeed = ""
+	d.index = make(map[string]int, len(seeds))
+
+	for i, seed := range seeds {
+		d.Seeds = append(d.Seeds, SeedDiagnosis{Name: seed.Name})
+		d.index[seed.Name] = i
+	}
+}
+
+// reject records the rejection of a seed. Only the first rejection of a seed is kept, i.e., a more specific reason
+// recorded by the plugin itself is not overwritten by the framework.
+func (d *Diagnosis) reject(pluginName, seedName, reason string) {
+	i, ok := d.index[seedName]
+	if !ok || d.Seeds[i].RejectedBy != "" {
+		return
+	}
+
+	d.Seeds[i].RejectedBy = pluginName
+	d.Seeds[i].Reason = reason
+}
+
+func (d *Diagnosis) addScores(pluginName string, weight int64, scores SeedScoreList) {
+	for _, score := range scores {
+		i, ok := d.index[score.Name]
+		if !ok {
+			continue
+		}
+
+		d.Seeds[i].Scores = append(d.Seeds[i].Scores, PluginScore{Name: pluginName, Weight: weight, Score: score.Score})
+		d.Seeds[i].TotalScore += weight * score.Score
+	}
+}
@@ -0,0 +1,260 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/scheduler/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+// Framework runs the configured filter and score plugins in order to determine the seed for a shoot.
+type Framework struct {
+	filterPlugins []FilterPlugin
+	scorePlugins  []ScorePlugin
+	scoreWeights  map[string]int64
+}
+
+// New creates a new Framework. The plugins are instantiated via the given registry. A plugin which is configured for
+// multiple extension points is only instantiated once.
+func New(registry Registry, filterPlugins, scorePlugins []schedulerconfigv1alpha1.Plugin) (*Framework, error) {
+	var (
+		f         = &Framework{scoreWeights: make(map[string]int64, len(scorePlugins))}
+		instances = make(map[string]Plugin)
+	)
+
+	getOrCreate := func(name string) (Plugin, error) {
+		if plugin, ok := instances[name]; ok {
+			return plugin, nil
+		}
+
+		factory, ok := registry[name]
+		if !ok {
+			return nil, fmt.Errorf("plugin %q is not registered", name)
+		}
+
+		plugin, err := factory()
+		if err != nil {
+			return nil, fmt.Errorf("failed creating plugin %q: %w", name, err)
+		}
+
+		instances[name] = plugin
+		return plugin, nil
+	}
+
+	for _, p := range filterPlugins {
+		plugin, err := getOrCreate(p.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		filterPlugin, ok := plugin.(FilterPlugin)
+		if !ok {
+			return nil, fmt.Errorf("plugin %q does not implement the filter extension point", p.Name)
+		}
+		f.filterPlugins = append(f.filterPlugins, filterPlugin)
+	}
+
+	for _, p := range scorePlugins {
+		plugin, err := getOrCreate(p.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		scorePlugin, ok := plugin.(ScorePlugin)
+		if !ok {
+			return nil, fmt.Errorf("plugin %q does not implement the score extension point", p.Name)
+		}
+		if _, ok := f.scoreWeights[p.Name]; ok {
+			return nil, fmt.Errorf("score plugin %q is configured more than once", p.Name)
+		}
+
+		f.scorePlugins = append(f.scorePlugins, scorePlugin)
+		f.scoreWeights[p.Name] = int64(ptr.Deref(p.Weight, 1))
+	}
+
+	return f, nil
+}
+
+// RunFilterPlugins runs all configured filter plugins one after another. Each plugin only sees the seeds which passed
+// the previous plugins. The first error stops the filter phase.
+func (f *Framework) RunFilterPlugins(ctx context.Context, state *CycleState, shoot *gardencorev1beta1.Shoot, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+	for _, plugin := range f.filterPlugins {
+		filteredSeeds, err := plugin.Filter(ctx, state, shoot, seeds)
+		if err != nil {
+			for _, seed := range seeds {
+				state.RejectSeed(plugin.Name(), seed.Name, err.Error())
+			}
+			return nil, err
+		}
+
+		if state.Diagnosis != nil {
+			remaining := sets.New[string]()
+			for _, seed := range filteredSeeds {
+				remaining.Insert(seed.Name)
+			}
+			for _, seed := range seeds {
+				if !remaining.Has(seed.Name) {
+					state.RejectSeed(plugin.Name(), seed.Name, "seed was filtered out by plugin "+plugin.Name())
+				}
+			}
+		}
+
+		seeds = filteredSeeds
+	}
+
+	return seeds, nil
+}
+
+// RunScorePlugins runs all configured score plugins for the given seeds and returns the weighted total score of each
+// seed, in the same order as the given seeds.
+func (f *Framework) RunScorePlugins(ctx context.Context, state *CycleState, shoot *gardencorev1beta1.Shoot, seeds []gardencorev1beta1.Seed) (SeedScoreList, error) {
+	totalScores := make(SeedScoreList, len(seeds))
+	for i, seed := range seeds {
+		totalScores[i].Name = seed.Name
+	}
+
+	for _, plugin := range f.scorePlugins {
+		scores, err := f.runScorePlugin(ctx, plugin, state, shoot, seeds)
+		if err != nil {
+			return nil, err
+		}
+
+		weight := f.scoreWeights[plugin.Name()]
+		for i := range scores {
+			totalScores[i].Score += scores[i].Score * weight
+		}
+
+		if state.Diagnosis != nil {
+			state.Diagnosis.addScores(plugin.Name(), weight, scores)
+		}
+	}
+
+	return totalScores, nil
+}
+
+// Schedule runs the filter and score plugins for the given seeds and returns the seed with the highest score. If the
+// cycle state contains a Diagnosis, it is reset and filled with the decisions of all plugins.
+func (f *Framework) Schedule(ctx context.Context, state *CycleState, shoot *gardencorev1beta1.Shoot, seeds []gardencorev1beta1.Seed) (*gardencorev1beta1.Seed, error) {
+	if state.Diagnosis != nil {
+		state.Diagnosis.init(seeds)
+	}
+
+	filteredSeeds, err := f.RunFilterPlugins(ctx, state, shoot, seeds)
+	if err != nil {
+		return nil, err
+	}
+
+	scores, err := f.RunScorePlugins(ctx, state, shoot, filteredSeeds)
+	if err != nil {
+		return nil, err
+	}
+
+	seed, err := SelectSeed(filteredSeeds, scores)
+	if err != nil {
+		return nil, err
+	}
+
+	if state.Diagnosis != nil {
+		state.Diagnosis.SelectedSeed = seed.Name
+	}
+	return seed, nil
+}
+
+func (f *Framework) runScorePlugin(ctx context.Context, plugin ScorePlugin, state *CycleState, shoot *gardencorev1beta1.Shoot, seeds []gardencorev1beta1.Seed) (SeedScoreList, error) {
+	scores := make(SeedScoreList, len(seeds))
+	for i := range seeds {
+		score, err := plugin.Score(ctx, state, shoot, &seeds[i])
+		if err != nil {
+			return nil, fmt.Errorf("score plugin %q failed for seed %q: %w", plugin.Name(), seeds[i].Name, err)
+		}
+		scores[i] = SeedScore{Name: seeds[i].Name, Score: score}
+	}
+
+	if extensions := plugin.ScoreExtensions(); extensions != nil {
+		if err := extensions.NormalizeScore(ctx, state, shoot, scores); err != nil {
+			return nil, fmt.Errorf("score plugin %q failed normalizing scores: %w", plugin.Name(), err)
+		}
+	}
+
+	for _, score := range scores {
+		if score.Score < MinSeedScore || score.Score > MaxSeedScore {
+			return nil, fmt.Errorf("score plugin %q returned an invalid score %d for seed %q, it must be in the range of [%d, %d]", plugin.Name(), score.Score, score.Name, MinSeedScore, MaxSeedScore)
+		}
+	}
+
+	return scores, nil
+}
+
+// SelectSeed returns the seed with the highest total score. If multiple seeds have the same score, the first one in
+// the list wins.
+func SelectSeed(seeds []gardencorev1beta1.Seed, scores SeedScoreList) (*gardencorev1beta1.Seed, error) {
+	if len(seeds) == 0 {
+		return nil, fmt.Errorf("no seeds left for selection")
+	}
+	if len(seeds) != len(scores) {
+		return nil, fmt.Errorf("number of scores (%d) does not match number of seeds (%d)", len(scores), len(seeds))
+	}
+
+	best := 0
+	for i := range scores {
+		if scores[i].Score > scores[best].Score {
+			best = i
+		}
+	}
+
+	return &seeds[best], nil
+}
+
+// MergePluginSet returns the default plugins without the disabled ones, followed by the enabled ones. If the disabled
+// list contains '*', all default plugins are disabled. An enabled plugin which is also part of the defaults replaces
+// the default entry (e.g., to change its weight).
+func MergePluginSet(defaults []schedulerconfigv1alpha1.Plugin, set schedulerconfigv1alpha1.PluginSet) []schedulerconfigv1alpha1.Plugin {
+	var (
+		result      []schedulerconfigv1alpha1.Plugin
+		disabled    = make(map[string]struct{}, len(set.Disabled))
+		enabledIdx  = make(map[string]int, len(set.Enabled))
+		disabledAll bool
+	)
+
+	for _, p := range set.Disabled {
+		if p.Name == "*" {
+			disabledAll = true
+		}
+		disabled[p.Name] = struct{}{}
+	}
+
+	for i, p := range set.Enabled {
+		enabledIdx[p.Name] = i
+	}
+
+	replaced := make(map[string]struct{})
+	if !disabledAll {
+		for _, p := range defaults {
+			if _, ok := disabled[p.Name]; ok {
+				continue
+			}
+			if i, ok := enabledIdx[p.Name]; ok {
+				p = set.Enabled[i]
+				replaced[p.Name] = struct{}{}
+			}
+			result = append(result, p)
+		}
+	}
+
+	for _, p := range set.Enabled {
+		if _, ok := replaced[p.Name]; ok {
+			continue
+		}
+		result = append(result, p)
+	}
+
+	return result
+}
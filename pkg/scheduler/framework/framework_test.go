@@ -0,0 +1,277 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package framework_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/scheduler/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/gardener/gardener/pkg/scheduler/framework"
+)
+
+var _ = Describe("Framework", func() {
+	var (
+		ctx   = context.Background()
+		state *CycleState
+		shoot *gardencorev1beta1.Shoot
+		seeds []gardencorev1beta1.Seed
+
+		registry Registry
+	)
+
+	BeforeEach(func() {
+		state = &CycleState{SeedUsage: map[string]int{"seed-1": 3, "seed-2": 1, "seed-3": 2}}
+		shoot = &gardencorev1beta1.Shoot{}
+		seeds = []gardencorev1beta1.Seed{
+			{ObjectMeta: metav1.ObjectMeta{Name: "seed-1"}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "seed-2"}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "seed-3"}},
+		}
+
+		registry = Registry{
+			"RemoveSeed1": func() (Plugin, error) { return &removeSeedFilter{name: "RemoveSeed1", seed: "seed-1"}, nil },
+			"RemoveSeed2": func() (Plugin, error) { return &removeSeedFilter{name: "RemoveSeed2", seed: "seed-2"}, nil },
+			"Usage":       func() (Plugin, error) { return &usageScore{}, nil },
+			"Fixed": func() (Plugin, error) {
+				return &fixedScore{name: "Fixed", scores: map[string]int64{"seed-1": 100, "seed-2": 0, "seed-3": 60}}, nil
+			},
+			"Invalid": func() (Plugin, error) {
+				return &fixedScore{name: "Invalid", scores: map[string]int64{"seed-1": 101}}, nil
+			},
+			"Broken": func() (Plugin, error) { return nil, errors.New("broken") },
+		}
+	})
+
+	Describe("#New", func() {
+		It("should fail if a plugin is not registered", func() {
+			_, err := New(registry, []schedulerconfigv1alpha1.Plugin{{Name: "Foo"}}, nil)
+			Expect(err).To(MatchError(ContainSubstring(`plugin "Foo" is not registered`)))
+		})
+
+		It("should fail if a plugin cannot be created", func() {
+			_, err := New(registry, []schedulerconfigv1alpha1.Plugin{{Name: "Broken"}}, nil)
+			Expect(err).To(MatchError(ContainSubstring(`failed creating plugin "Broken"`)))
+		})
+
+		It("should fail if a plugin does not implement the filter extension point", func() {
+			_, err := New(registry, []schedulerconfigv1alpha1.Plugin{{Name: "Usage"}}, nil)
+			Expect(err).To(MatchError(ContainSubstring(`plugin "Usage" does not implement the filter extension point`)))
+		})
+
+		It("should fail if a plugin does not implement the score extension point", func() {
+			_, err := New(registry, nil, []schedulerconfigv1alpha1.Plugin{{Name: "RemoveSeed1"}})
+			Expect(err).To(MatchError(ContainSubstring(`plugin "RemoveSeed1" does not implement the score extension point`)))
+		})
+
+		It("should fail if a score plugin is configured twice", func() {
+			_, err := New(registry, nil, []schedulerconfigv1alpha1.Plugin{{Name: "Usage"}, {Name: "Usage"}})
+			Expect(err).To(MatchError(ContainSubstring(`score plugin "Usage" is configured more than once`)))
+		})
+	})
+
+	Describe("#RunFilterPlugins", func() {
+		It("should run all filter plugins in order", func() {
+			f, err := New(registry, []schedulerconfigv1alpha1.Plugin{{Name: "RemoveSeed1"}, {Name: "RemoveSeed2"}}, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			result, err := f.RunFilterPlugins(ctx, state, shoot, seeds)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(HaveLen(1))
+			Expect(result[0].Name).To(Equal("seed-3"))
+		})
+
+		It("should return the error of the first failing plugin", func() {
+			f, err := New(registry, []schedulerconfigv1alpha1.Plugin{{Name: "RemoveSeed1"}, {Name: "RemoveSeed2"}}, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = f.RunFilterPlugins(ctx, state, shoot, seeds[:2])
+			Expect(err).To(MatchError("RemoveSeed2 removed all seeds"))
+		})
+	})
+
+	Describe("#RunScorePlugins", func() {
+		It("should compute the weighted total scores", func() {
+			f, err := New(registry, nil, []schedulerconfigv1alpha1.Plugin{{Name: "Usage", Weight: new(int32(2))}, {Name: "Fixed"}})
+			Expect(err).NotTo(HaveOccurred())
+
+			scores, err := f.RunScorePlugins(ctx, state, shoot, seeds)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(scores).To(Equal(SeedScoreList{
+				{Name: "seed-1", Score: 2*0 + 100},
+				{Name: "seed-2", Score: 2*100 + 0},
+				{Name: "seed-3", Score: 2*50 + 60},
+			}))
+
+			seed, err := SelectSeed(seeds, scores)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(seed.Name).To(Equal("seed-2"))
+		})
+
+		It("should fail if a plugin returns a score out of range", func() {
+			f, err := New(registry, nil, []schedulerconfigv1alpha1.Plugin{{Name: "Invalid"}})
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = f.RunScorePlugins(ctx, state, shoot, seeds)
+			Expect(err).To(MatchError(ContainSubstring(`score plugin "Invalid" returned an invalid score 101 for seed "seed-1"`)))
+		})
+	})
+
+	Describe("#Schedule", func() {
+		It("should select the seed with the highest score", func() {
+			f, err := New(registry, []schedulerconfigv1alpha1.Plugin{{Name: "RemoveSeed2"}}, []schedulerconfigv1alpha1.Plugin{{Name: "Usage"}})
+			Expect(err).NotTo(HaveOccurred())
+
+			seed, err := f.Schedule(ctx, state, shoot, seeds)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(seed.Name).To(Equal("seed-3"))
+		})
+
+		It("should record the decisions of all plugins", func() {
+			f, err := New(registry, []schedulerconfigv1alpha1.Plugin{{Name: "RemoveSeed2"}}, []schedulerconfigv1alpha1.Plugin{{Name: "Usage", Weight: new(int32(2))}, {Name: "Fixed"}})
+			Expect(err).NotTo(HaveOccurred())
+
+			state.Diagnosis = &Diagnosis{}
+			seed, err := f.Schedule(ctx, state, shoot, seeds)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(seed.Name).To(Equal("seed-3"))
+
+			Expect(state.Diagnosis.SelectedSeed).To(Equal("seed-3"))
+			Expect(state.Diagnosis.Seeds).To(Equal([]SeedDiagnosis{
+				{
+					Name:       "seed-1",
+					Scores:     []PluginScore{{Name: "Usage", Weight: 2, Score: 0}, {Name: "Fixed", Weight: 1, Score: 100}},
+					TotalScore: 100,
+				},
+				{
+					Name:       "seed-2",
+					RejectedBy: "RemoveSeed2",
+					Reason:     "seed was filtered out by plugin RemoveSeed2",
+				},
+				{
+					Name:       "seed-3",
+					Scores:     []PluginScore{{Name: "Usage", Weight: 2, Score: 100}, {Name: "Fixed", Weight: 1, Score: 60}},
+					TotalScore: 260,
+				},
+			}))
+		})
+
+		It("should record the error of the plugin which rejected all remaining seeds", func() {
+			f, err := New(registry, []schedulerconfigv1alpha1.Plugin{{Name: "RemoveSeed1"}, {Name: "RemoveSeed2"}}, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			state.Diagnosis = &Diagnosis{}
+			_, err = f.Schedule(ctx, state, shoot, seeds[:2])
+			Expect(err).To(MatchError("RemoveSeed2 removed all seeds"))
+
+			Expect(state.Diagnosis.SelectedSeed).To(BeEmpty())
+			Expect(state.Diagnosis.Seeds).To(Equal([]SeedDiagnosis{
+				{Name: "seed-1", RejectedBy: "RemoveSeed1", Reason: "seed was filtered out by plugin RemoveSeed1"},
+				{Name: "seed-2", RejectedBy: "RemoveSeed2", Reason: "RemoveSeed2 removed all seeds"},
+			}))
+		})
+	})
+
+	Describe("#SelectSeed", func() {
+		It("should prefer the first seed in case of equal scores", func() {
+			seed, err := SelectSeed(seeds, SeedScoreList{{Name: "seed-1", Score: 5}, {Name: "seed-2", Score: 10}, {Name: "seed-3", Score: 10}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(seed.Name).To(Equal("seed-2"))
+		})
+
+		It("should fail if there are no seeds", func() {
+			_, err := SelectSeed(nil, nil)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("#MergePluginSet", func() {
+		defaults := []schedulerconfigv1alpha1.Plugin{{Name: "A"}, {Name: "B"}, {Name: "C"}}
+
+		It("should return the defaults if nothing is configured", func() {
+			Expect(MergePluginSet(defaults, schedulerconfigv1alpha1.PluginSet{})).To(Equal(defaults))
+		})
+
+		It("should remove disabled and append enabled plugins", func() {
+			Expect(MergePluginSet(defaults, schedulerconfigv1alpha1.PluginSet{
+				Enabled:  []schedulerconfigv1alpha1.Plugin{{Name: "D"}},
+				Disabled: []schedulerconfigv1alpha1.Plugin{{Name: "B"}},
+			})).To(Equal([]schedulerconfigv1alpha1.Plugin{{Name: "A"}, {Name: "C"}, {Name: "D"}}))
+		})
+
+		It("should replace default plugins which are enabled explicitly", func() {
+			Expect(MergePluginSet(defaults, schedulerconfigv1alpha1.PluginSet{
+				Enabled: []schedulerconfigv1alpha1.Plugin{{Name: "D"}, {Name: "B", Weight: new(int32(3))}},
+			})).To(Equal([]schedulerconfigv1alpha1.Plugin{{Name: "A"}, {Name: "B", Weight: new(int32(3))}, {Name: "C"}, {Name: "D"}}))
+		})
+
+		It("should disable all default plugins", func() {
+			Expect(MergePluginSet(defaults, schedulerconfigv1alpha1.PluginSet{
+				Enabled:  []schedulerconfigv1alpha1.Plugin{{Name: "C"}, {Name: "D"}},
+				Disabled: []schedulerconfigv1alpha1.Plugin{{Name: "*"}},
+			})).To(Equal([]schedulerconfigv1alpha1.Plugin{{Name: "C"}, {Name: "D"}}))
+		})
+	})
+})
+
+type removeSeedFilter struct {
+	name string
+	seed string
+}
+
+func (p *removeSeedFilter) Name() string { return p.name }
+
+func (p *removeSeedFilter) Filter(_ context.Context, _ *CycleState, _ *gardencorev1beta1.Shoot, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+	var result []gardencorev1beta1.Seed
+	for _, seed := range seeds {
+		if seed.Name != p.seed {
+			result = append(result, seed)
+		}
+	}
+	if len(result) == 0 {
+		return nil, fmt.Errorf("%s removed all seeds", p.name)
+	}
+	return result, nil
+}
+
+type usageScore struct{}
+
+func (p *usageScore) Name() string { return "Usage" }
+
+func (p *usageScore) Score(_ context.Context, state *CycleState, _ *gardencorev1beta1.Shoot, seed *gardencorev1beta1.Seed) (int64, error) {
+	return int64(state.SeedUsage[seed.Name]), nil
+}
+
+func (p *usageScore) ScoreExtensions() ScoreExtensions { return p }
+
+func (p *usageScore) NormalizeScore(_ context.Context, _ *CycleState, _ *gardencorev1beta1.Shoot, scores SeedScoreList) error {
+	var minScore, maxScore int64 = 1 << 62, 0
+	for _, score := range scores {
+		minScore, maxScore = min(minScore, score.Score), max(maxScore, score.Score)
+	}
+	for i := range scores {
+		scores[i].Score = (maxScore - scores[i].Score) * MaxSeedScore / (maxScore - minScore)
+	}
+	return nil
+}
+
+type fixedScore struct {
+	name   string
+	scores map[string]int64
+}
+
+func (p *fixedScore) Name() string { return p.name }
+
+func (p *fixedScore) Score(_ context.Context, _ *CycleState, _ *gardencorev1beta1.Shoot, seed *gardencorev1beta1.Seed) (int64, error) {
+	return p.scores[seed.Name], nil
+}
+
+func (p *fixedScore) ScoreExtensions() ScoreExtensions { return nil }
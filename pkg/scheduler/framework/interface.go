@@ -0,0 +1,96 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+const (
+	// MaxSeedScore is the maximum score a score plugin is expected to return for a seed (after normalization).
+	MaxSeedScore int64 = 100
+	// MinSeedScore is the minimum score a score plugin is expected to return for a seed (after normalization).
+	MinSeedScore int64 = 0
+)
+
+// CycleState contains the data which is computed once per scheduling cycle and shared between all plugins.
+type CycleState struct {
+	// Log is the logger of the current scheduling cycle.
+	Log logr.Logger
+	// CloudProfile is the cloud profile referenced by the shoot.
+	CloudProfile *gardencorev1beta1.CloudProfile
+	// Project is the project the shoot belongs to.
+	Project *gardencorev1beta1.Project
+	// RegionConfig is the scheduler region config for the cloud profile (can be nil).
+	RegionConfig *corev1.ConfigMap
+	// Shoots is the list of all shoots in the system.
+	Shoots []*gardencorev1beta1.Shoot
+	// SeedUsage maps seed names to the number of shoots which are currently scheduled onto them.
+	SeedUsage map[string]int
+	// Diagnosis records the decisions of the plugins (can be nil if the decisions are not of interest).
+	Diagnosis *Diagnosis
+}
+
+// RejectSeed records that the given filter plugin rejected the seed for the given reason. Filter plugins may use it to
+// provide a more specific reason than the framework can derive on its own.
+func (s *CycleState) RejectSeed(pluginName, seedName, reason string) {
+	if s.Diagnosis == nil {
+		return
+	}
+	s.Diagnosis.reject(pluginName, seedName, reason)
+}
+
+// Plugin is the parent type for all scheduler plugins.
+type Plugin interface {
+	// Name returns the name of the plugin.
+	Name() string
+}
+
+// FilterPlugin is a plugin which filters out seeds that cannot host the shoot.
+type FilterPlugin interface {
+	Plugin
+	// Filter returns the subset of the given seeds which are suitable for the shoot. Filters are allowed to consider
+	// the given seed list as a whole (e.g., to only keep the seeds with the smallest distance). An error must be
+	// returned if none of the seeds is suitable.
+	Filter(ctx context.Context, state *CycleState, shoot *gardencorev1beta1.Shoot, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error)
+}
+
+// ScorePlugin is a plugin which ranks the seeds which passed the filter phase.
+type ScorePlugin interface {
+	Plugin
+	// Score returns the raw score of the given seed for the shoot.
+	Score(ctx context.Context, state *CycleState, shoot *gardencorev1beta1.Shoot, seed *gardencorev1beta1.Seed) (int64, error)
+	// ScoreExtensions returns the ScoreExtensions of the plugin, or nil if it does not need any.
+	ScoreExtensions() ScoreExtensions
+}
+
+// ScoreExtensions is an interface for score plugins which need to post-process the raw scores of all seeds.
+type ScoreExtensions interface {
+	// NormalizeScore is called after all seeds have been scored. It must map the scores into the range
+	// [MinSeedScore, MaxSeedScore].
+	NormalizeScore(ctx context.Context, state *CycleState, shoot *gardencorev1beta1.Shoot, scores SeedScoreList) error
+}
+
+// SeedScore is the score of a seed.
+type SeedScore struct {
+	// Name is the name of the seed.
+	Name string
+	// Score is the score of the seed.
+	Score int64
+}
+
+// SeedScoreList is a list of seed scores.
+type SeedScoreList []SeedScore
+
+// PluginFactory creates a new plugin.
+type PluginFactory func() (Plugin, error)
+
+// Registry maps plugin names to their factories.
+type Registry map[string]PluginFactory
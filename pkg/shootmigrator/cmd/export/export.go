@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package export
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/shootmigrator/cmd"
+	"github.com/gardener/gardener/pkg/utils/gardener/shootstate"
+)
+
+// NewCommand creates a new cobra.Command.
+func NewCommand(globalOpts *cmd.Options) *cobra.Command {
+	opts := &Options{Options: globalOpts}
+
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export a shoot for the migration to another garden landscape",
+		Long: `Export a shoot for the migration to another garden landscape.
+The shoot must have been prepared for the export by annotating it with gardener.cloud/operation=export.
+The export contains the Shoot, its ShootState, the referenced secrets and the BackupEntry pointing to the etcd backups.
+It can be imported into another garden landscape with 'gardener-shoot-migrator import'.`,
+
+		Example: `# Export the shoot
+gardener-shoot-migrator export <shoot-name> --namespace <project-namespace> --output <path-to-export-file>`,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.ParseArgs(args); err != nil {
+				return err
+			}
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			if err := opts.Complete(); err != nil {
+				return err
+			}
+
+			return run(cmd.Context(), opts)
+		},
+	}
+
+	opts.addFlags(cmd.Flags())
+
+	return cmd
+}
+
+var (
+	// NewClientSetFromFile is an alias for cmd.NewClientSetFromFile.
+	// Exposed for unit testing.
+	NewClientSetFromFile = cmd.NewClientSetFromFile
+	// NewAferoFs is an alias for returning an afero.NewOsFs.
+	// Exposed for unit testing.
+	NewAferoFs = func() afero.Afero { return afero.Afero{Fs: afero.NewOsFs()} }
+)
+
+func run(ctx context.Context, opts *Options) error {
+	clientSet, err := NewClientSetFromFile(opts.Kubeconfig, kubernetes.GardenScheme)
+	if err != nil {
+		return fmt.Errorf("failed creating client: %w", err)
+	}
+
+	export, err := shootstate.ExportShoot(ctx, clientSet.Client(), client.ObjectKey{Namespace: opts.Namespace, Name: opts.ShootName})
+	if err != nil {
+		return fmt.Errorf("failed exporting shoot: %w", err)
+	}
+
+	exportYAML, err := yaml.Marshal(export)
+	if err != nil {
+		return fmt.Errorf("failed marshalling export: %w", err)
+	}
+
+	// The export contains the credentials of the shoot and of its backups, hence it must only be readable by the owner.
+	if err := NewAferoFs().WriteFile(opts.Output, exportYAML, 0600); err != nil {
+		return fmt.Errorf("failed writing file to %s: %w", opts.Output, err)
+	}
+
+	fmt.Fprintf(opts.Out, "Exported Shoot %s/%s to %s\n", opts.Namespace, opts.ShootName, opts.Output)
+	return nil
+}
@@ -0,0 +1,108 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package export_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/yaml"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	"github.com/gardener/gardener/pkg/shootmigrator/cmd"
+	. "github.com/gardener/gardener/pkg/shootmigrator/cmd/export"
+	"github.com/gardener/gardener/pkg/utils/gardener/shootstate"
+	"github.com/gardener/gardener/pkg/utils/test"
+	clitest "github.com/gardener/gardener/pkg/utils/test/cli"
+)
+
+var _ = Describe("Export", func() {
+	var (
+		globalOpts *cmd.Options
+		stdOut     *Buffer
+		command    *cobra.Command
+
+		fs    afero.Afero
+		shoot *gardencorev1beta1.Shoot
+	)
+
+	BeforeEach(func() {
+		globalOpts = &cmd.Options{}
+		globalOpts.IOStreams, _, stdOut, _ = clitest.NewTestIOStreams()
+		command = NewCommand(globalOpts)
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "my-shoot",
+				Namespace:   "garden-my-project",
+				UID:         "shoot-uid",
+				Annotations: map[string]string{v1beta1constants.ShootExported: "true"},
+			},
+			Status: gardencorev1beta1.ShootStatus{
+				UID: "shoot-uid",
+				LastOperation: &gardencorev1beta1.LastOperation{
+					Type:  gardencorev1beta1.LastOperationTypeMigrate,
+					State: gardencorev1beta1.LastOperationStateSucceeded,
+				},
+			},
+		}
+
+		fakeClient := fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithObjects(
+			shoot,
+			&gardencorev1beta1.ShootState{ObjectMeta: metav1.ObjectMeta{Name: shoot.Name, Namespace: shoot.Namespace}},
+			&gardencorev1beta1.BackupBucket{ObjectMeta: metav1.ObjectMeta{Name: "seed-uid"}},
+			&gardencorev1beta1.BackupEntry{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            "shoot--my-project--my-shoot--shoot-uid",
+					Namespace:       shoot.Namespace,
+					OwnerReferences: []metav1.OwnerReference{{Kind: "Shoot", Name: shoot.Name}},
+				},
+				Spec: gardencorev1beta1.BackupEntrySpec{BucketName: "seed-uid"},
+			},
+		).Build()
+		clientSet := fakekubernetes.NewClientSetBuilder().WithClient(fakeClient).Build()
+		fs = afero.Afero{Fs: afero.NewMemMapFs()}
+
+		DeferCleanup(test.WithVars(
+			&NewClientSetFromFile, func(string, *runtime.Scheme) (kubernetes.Interface, error) { return clientSet, nil },
+			&NewAferoFs, func() afero.Afero { return fs },
+		))
+	})
+
+	Describe("#RunE", func() {
+		It("should write the export to the output file", func() {
+			Expect(command.Flags().Set("kubeconfig", "some-path-to-kubeconfig")).To(Succeed())
+			Expect(command.Flags().Set("namespace", shoot.Namespace)).To(Succeed())
+			Expect(command.Flags().Set("output", "export.yaml")).To(Succeed())
+			Expect(command.RunE(command, []string{shoot.Name})).To(Succeed())
+
+			Eventually(stdOut).Should(Say("Exported Shoot garden-my-project/my-shoot to export.yaml"))
+
+			exportYAML, err := fs.ReadFile("export.yaml")
+			Expect(err).NotTo(HaveOccurred())
+
+			export := &shootstate.Export{}
+			Expect(yaml.Unmarshal(exportYAML, export)).To(Succeed())
+			Expect(export.Version).To(Equal(shootstate.ExportVersion))
+			Expect(export.Shoot.Name).To(Equal(shoot.Name))
+			Expect(export.BackupEntry.Spec.BucketName).To(Equal("seed-uid"))
+		})
+
+		It("should fail if the shoot was not exported", func() {
+			Expect(command.Flags().Set("kubeconfig", "some-path-to-kubeconfig")).To(Succeed())
+			Expect(command.Flags().Set("namespace", shoot.Namespace)).To(Succeed())
+			Expect(command.Flags().Set("output", "export.yaml")).To(Succeed())
+			Expect(command.RunE(command, []string{"other-shoot"})).To(MatchError(ContainSubstring("failed exporting shoot")))
+		})
+	})
+})
@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package export
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/pflag"
+
+	"github.com/gardener/gardener/pkg/shootmigrator/cmd"
+)
+
+// Options contains options for this command.
+type Options struct {
+	*cmd.Options
+
+	// Kubeconfig is the path to the kubeconfig file pointing to the garden cluster.
+	Kubeconfig string
+	// Namespace is the namespace of the shoot.
+	Namespace string
+	// ShootName is the name of the shoot.
+	ShootName string
+	// Output is the path to the file the export is written to.
+	Output string
+}
+
+// ParseArgs parses the arguments to the options.
+func (o *Options) ParseArgs(args []string) error {
+	cmd.DefaultKubeconfig(&o.Kubeconfig)
+
+	if len(args) > 0 {
+		o.ShootName = strings.TrimSpace(args[0])
+	}
+
+	return nil
+}
+
+// Validate validates the options.
+func (o *Options) Validate() error {
+	if len(o.Kubeconfig) == 0 {
+		return fmt.Errorf("must provide a path to a garden cluster kubeconfig")
+	}
+
+	if len(o.Namespace) == 0 {
+		return fmt.Errorf("must provide the namespace of the shoot")
+	}
+
+	if len(o.ShootName) == 0 {
+		return fmt.Errorf("must provide the name of the shoot")
+	}
+
+	if len(o.Output) == 0 {
+		return fmt.Errorf("must provide a path to the output file")
+	}
+
+	return nil
+}
+
+// Complete completes the options.
+func (o *Options) Complete() error { return nil }
+
+func (o *Options) addFlags(fs *pflag.FlagSet) {
+	fs.StringVarP(&o.Kubeconfig, "kubeconfig", "k", "", "Path to the kubeconfig file pointing to the garden cluster")
+	fs.StringVarP(&o.Namespace, "namespace", "n", "", "Namespace of the shoot")
+	fs.StringVarP(&o.Output, "output", "o", "", "Path to the file the export is written to")
+}
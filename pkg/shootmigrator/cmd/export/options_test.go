@@ -0,0 +1,83 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package export_test
+
+import (
+	"os"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener/pkg/shootmigrator/cmd/export"
+)
+
+var _ = Describe("Options", func() {
+	var (
+		options *Options
+	)
+
+	BeforeEach(func() {
+		options = &Options{}
+	})
+
+	Describe("#ParseArgs", func() {
+		It("should set the kubeconfig", func() {
+			Expect(os.Setenv("KUBECONFIG", "kubeconfig")).To(Succeed())
+
+			Expect(options.ParseArgs(nil)).To(Succeed())
+
+			Expect(options.Kubeconfig).To(Equal("kubeconfig"))
+		})
+
+		It("should set the shoot name", func() {
+			Expect(options.ParseArgs([]string{"my-shoot"})).To(Succeed())
+
+			Expect(options.ShootName).To(Equal("my-shoot"))
+		})
+	})
+
+	Describe("#Validate", func() {
+		BeforeEach(func() {
+			options.Kubeconfig = "some-path-to-kubeconfig"
+			options.Namespace = "garden-my-project"
+			options.ShootName = "my-shoot"
+			options.Output = "some-path-to-output-file"
+		})
+
+		It("should pass for valid options", func() {
+			Expect(options.Validate()).To(Succeed())
+		})
+
+		It("should fail because kubeconfig path is not set", func() {
+			options.Kubeconfig = ""
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring("must provide a path to a garden cluster kubeconfig")))
+		})
+
+		It("should fail because namespace is not set", func() {
+			options.Namespace = ""
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring("must provide the namespace of the shoot")))
+		})
+
+		It("should fail because shoot name is not set", func() {
+			options.ShootName = ""
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring("must provide the name of the shoot")))
+		})
+
+		It("should fail because output path is not set", func() {
+			options.Output = ""
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring("must provide a path to the output file")))
+		})
+	})
+
+	Describe("#Complete", func() {
+		It("should return nil", func() {
+			Expect(options.Complete()).To(Succeed())
+		})
+	})
+})
@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package importcmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/shootmigrator/cmd"
+	"github.com/gardener/gardener/pkg/utils/gardener/shootstate"
+)
+
+// NewCommand creates a new cobra.Command.
+func NewCommand(globalOpts *cmd.Options) *cobra.Command {
+	opts := &Options{Options: globalOpts}
+
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Import a shoot exported from another garden landscape",
+		Long: `Import a shoot exported from another garden landscape with 'gardener-shoot-migrator export'.
+The Shoot, its ShootState, the referenced secrets and the BackupEntry are created in the garden cluster and the shoot is
+scheduled to the given seed. The gardenlet of the seed restores the control plane of the shoot from the ShootState and
+the etcd backups.`,
+
+		Example: `# Import the shoot
+gardener-shoot-migrator import <path-to-export-file> --seed <seed-name>`,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.ParseArgs(args); err != nil {
+				return err
+			}
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			if err := opts.Complete(); err != nil {
+				return err
+			}
+
+			return run(cmd.Context(), opts)
+		},
+	}
+
+	opts.addFlags(cmd.Flags())
+
+	return cmd
+}
+
+var (
+	// NewClientSetFromFile is an alias for cmd.NewClientSetFromFile.
+	// Exposed for unit testing.
+	NewClientSetFromFile = cmd.NewClientSetFromFile
+	// NewAferoFs is an alias for returning an afero.NewOsFs.
+	// Exposed for unit testing.
+	NewAferoFs = func() afero.Afero { return afero.Afero{Fs: afero.NewOsFs()} }
+)
+
+func run(ctx context.Context, opts *Options) error {
+	exportYAML, err := NewAferoFs().ReadFile(opts.ExportFile)
+	if err != nil {
+		return fmt.Errorf("failed reading export from %q: %w", opts.ExportFile, err)
+	}
+
+	export := &shootstate.Export{}
+	if err := yaml.Unmarshal(exportYAML, export); err != nil {
+		return fmt.Errorf("failed unmarshalling export: %w", err)
+	}
+
+	clientSet, err := NewClientSetFromFile(opts.Kubeconfig, kubernetes.GardenScheme)
+	if err != nil {
+		return fmt.Errorf("failed creating client: %w", err)
+	}
+
+	if err := shootstate.ImportShoot(ctx, clientSet.Client(), export, opts.SeedName); err != nil {
+		return fmt.Errorf("failed importing shoot: %w", err)
+	}
+
+	fmt.Fprintf(opts.Out, "Imported Shoot %s/%s, it is now restored on seed %s\n", export.Shoot.Namespace, export.Shoot.Name, opts.SeedName)
+	return nil
+}
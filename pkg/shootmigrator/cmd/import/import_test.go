@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package importcmd_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/yaml"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	"github.com/gardener/gardener/pkg/shootmigrator/cmd"
+	. "github.com/gardener/gardener/pkg/shootmigrator/cmd/import"
+	"github.com/gardener/gardener/pkg/utils/gardener/shootstate"
+	"github.com/gardener/gardener/pkg/utils/test"
+	clitest "github.com/gardener/gardener/pkg/utils/test/cli"
+)
+
+var _ = Describe("Import", func() {
+	var (
+		ctx = context.Background()
+
+		globalOpts *cmd.Options
+		stdOut     *Buffer
+		command    *cobra.Command
+
+		fs         afero.Afero
+		fakeClient client.Client
+		export     *shootstate.Export
+	)
+
+	BeforeEach(func() {
+		globalOpts = &cmd.Options{}
+		globalOpts.IOStreams, _, stdOut, _ = clitest.NewTestIOStreams()
+		command = NewCommand(globalOpts)
+
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithStatusSubresource(&gardencorev1beta1.Shoot{}).Build()
+		clientSet := fakekubernetes.NewClientSetBuilder().WithClient(fakeClient).Build()
+		fs = afero.Afero{Fs: afero.NewMemMapFs()}
+
+		DeferCleanup(test.WithVars(
+			&NewClientSetFromFile, func(string, *runtime.Scheme) (kubernetes.Interface, error) { return clientSet, nil },
+			&NewAferoFs, func() afero.Afero { return fs },
+		))
+
+		export = &shootstate.Export{
+			Version: shootstate.ExportVersion,
+			Shoot: &gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-shoot", Namespace: "garden-my-project"},
+				Status:     gardencorev1beta1.ShootStatus{UID: "shoot-uid"},
+			},
+			ShootState:   &gardencorev1beta1.ShootState{ObjectMeta: metav1.ObjectMeta{Name: "my-shoot", Namespace: "garden-my-project"}},
+			BackupBucket: &gardencorev1beta1.BackupBucket{ObjectMeta: metav1.ObjectMeta{Name: "seed-uid"}},
+			BackupEntry: &gardencorev1beta1.BackupEntry{
+				ObjectMeta: metav1.ObjectMeta{Name: "shoot--my-project--my-shoot--shoot-uid", Namespace: "garden-my-project"},
+				Spec:       gardencorev1beta1.BackupEntrySpec{BucketName: "seed-uid"},
+			},
+		}
+	})
+
+	Describe("#RunE", func() {
+		It("should import the shoot", func() {
+			exportYAML, err := yaml.Marshal(export)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fs.WriteFile("export.yaml", exportYAML, 0600)).To(Succeed())
+
+			Expect(command.Flags().Set("kubeconfig", "some-path-to-kubeconfig")).To(Succeed())
+			Expect(command.Flags().Set("seed", "new-seed")).To(Succeed())
+			Expect(command.RunE(command, []string{"export.yaml"})).To(Succeed())
+
+			Eventually(stdOut).Should(Say("Imported Shoot garden-my-project/my-shoot, it is now restored on seed new-seed"))
+
+			shoot := &gardencorev1beta1.Shoot{}
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(export.Shoot), shoot)).To(Succeed())
+			Expect(shoot.Spec.SeedName).To(Equal(new("new-seed")))
+			Expect(shoot.Status.UID).To(Equal(export.Shoot.Status.UID))
+		})
+
+		It("should fail if the export file does not exist", func() {
+			Expect(command.Flags().Set("kubeconfig", "some-path-to-kubeconfig")).To(Succeed())
+			Expect(command.Flags().Set("seed", "new-seed")).To(Succeed())
+			Expect(command.RunE(command, []string{"export.yaml"})).To(MatchError(ContainSubstring("failed reading export")))
+		})
+	})
+})
@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package importcmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/pflag"
+
+	"github.com/gardener/gardener/pkg/shootmigrator/cmd"
+)
+
+// Options contains options for this command.
+type Options struct {
+	*cmd.Options
+
+	// Kubeconfig is the path to the kubeconfig file pointing to the garden cluster.
+	Kubeconfig string
+	// ExportFile is the path to the file written by 'gardener-shoot-migrator export'.
+	ExportFile string
+	// SeedName is the name of the seed the shoot is restored on.
+	SeedName string
+}
+
+// ParseArgs parses the arguments to the options.
+func (o *Options) ParseArgs(args []string) error {
+	cmd.DefaultKubeconfig(&o.Kubeconfig)
+
+	if len(args) > 0 {
+		o.ExportFile = strings.TrimSpace(args[0])
+	}
+
+	return nil
+}
+
+// Validate validates the options.
+func (o *Options) Validate() error {
+	if len(o.Kubeconfig) == 0 {
+		return fmt.Errorf("must provide a path to a garden cluster kubeconfig")
+	}
+
+	if len(o.ExportFile) == 0 {
+		return fmt.Errorf("must provide a path to the export file")
+	}
+
+	if len(o.SeedName) == 0 {
+		return fmt.Errorf("must provide the name of the seed")
+	}
+
+	return nil
+}
+
+// Complete completes the options.
+func (o *Options) Complete() error { return nil }
+
+func (o *Options) addFlags(fs *pflag.FlagSet) {
+	fs.StringVarP(&o.Kubeconfig, "kubeconfig", "k", "", "Path to the kubeconfig file pointing to the garden cluster")
+	fs.StringVar(&o.SeedName, "seed", "", "Name of the seed the shoot is restored on")
+}
@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package importcmd_test
+
+import (
+	"os"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener/pkg/shootmigrator/cmd/import"
+)
+
+var _ = Describe("Options", func() {
+	var (
+		options *Options
+	)
+
+	BeforeEach(func() {
+		options = &Options{}
+	})
+
+	Describe("#ParseArgs", func() {
+		It("should set the kubeconfig", func() {
+			Expect(os.Setenv("KUBECONFIG", "kubeconfig")).To(Succeed())
+
+			Expect(options.ParseArgs(nil)).To(Succeed())
+
+			Expect(options.Kubeconfig).To(Equal("kubeconfig"))
+		})
+
+		It("should set the export file", func() {
+			Expect(options.ParseArgs([]string{"foo/export.yaml"})).To(Succeed())
+
+			Expect(options.ExportFile).To(Equal("foo/export.yaml"))
+		})
+	})
+
+	Describe("#Validate", func() {
+		BeforeEach(func() {
+			options.Kubeconfig = "some-path-to-kubeconfig"
+			options.ExportFile = "some-path-to-export-file"
+			options.SeedName = "some-seed"
+		})
+
+		It("should pass for valid options", func() {
+			Expect(options.Validate()).To(Succeed())
+		})
+
+		It("should fail because kubeconfig path is not set", func() {
+			options.Kubeconfig = ""
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring("must provide a path to a garden cluster kubeconfig")))
+		})
+
+		It("should fail because export file path is not set", func() {
+			options.ExportFile = ""
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring("must provide a path to the export file")))
+		})
+
+		It("should fail because seed name is not set", func() {
+			options.SeedName = ""
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring("must provide the name of the seed")))
+		})
+	})
+
+	Describe("#Complete", func() {
+		It("should return nil", func() {
+			Expect(options.Complete()).To(Succeed())
+		})
+	})
+})
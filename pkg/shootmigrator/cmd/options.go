@@ -0,0 +1,36 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"os"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+)
+
+// Options contains persistent options for all commands.
+type Options struct {
+	genericiooptions.IOStreams
+}
+
+// DefaultKubeconfig defaults the given kubeconfig path to the value of the KUBECONFIG environment variable if it is
+// not set.
+func DefaultKubeconfig(kubeconfig *string) {
+	if *kubeconfig == "" {
+		*kubeconfig = os.Getenv("KUBECONFIG")
+	}
+}
+
+// NewClientSetFromFile creates a new client set for the cluster the given kubeconfig file points to.
+func NewClientSetFromFile(kubeconfigPath string, scheme *runtime.Scheme) (kubernetes.Interface, error) {
+	return kubernetes.NewClientFromFile("", kubeconfigPath,
+		kubernetes.WithClientOptions(client.Options{Scheme: scheme}),
+		kubernetes.WithDisabledCachedClient(),
+	)
+}
@@ -0,0 +1,47 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd_test
+
+import (
+	"os"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener/pkg/shootmigrator/cmd"
+)
+
+var _ = Describe("Options", func() {
+	Describe("#DefaultKubeconfig", func() {
+		var kubeconfig string
+
+		BeforeEach(func() {
+			kubeconfig = ""
+			DeferCleanup(func() { os.Setenv("KUBECONFIG", "") })
+		})
+
+		It("should do nothing when the value is already set", func() {
+			os.Setenv("KUBECONFIG", "bar")
+			kubeconfig = "foo"
+
+			DefaultKubeconfig(&kubeconfig)
+			Expect(kubeconfig).To(Equal("foo"))
+		})
+
+		It("should use the KUBECONFIG env variable when set", func() {
+			os.Setenv("KUBECONFIG", "bar")
+
+			DefaultKubeconfig(&kubeconfig)
+			Expect(kubeconfig).To(Equal("bar"))
+		})
+
+		It("should leave the value empty if the KUBECONFIG env variable is not set", func() {
+			os.Setenv("KUBECONFIG", "")
+
+			DefaultKubeconfig(&kubeconfig)
+			Expect(kubeconfig).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package flow
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// checkpointDataKeyRevision is the key in the checkpoint ConfigMap containing the revision the checkpoint belongs to.
+	checkpointDataKeyRevision = "revision"
+	// checkpointDataKeyTasks is the key in the checkpoint ConfigMap containing the IDs of the succeeded tasks.
+	checkpointDataKeyTasks = "succeededTasks"
+)
+
+// Checkpointer persists the resumable tasks which succeeded during a Flow execution. If the execution is interrupted
+// or fails, a subsequent execution skips these tasks.
+type Checkpointer interface {
+	// Load returns the IDs of the tasks which succeeded in previous executions.
+	Load(ctx context.Context) (TaskIDs, error)
+	// Save persists the IDs of the tasks which succeeded so far.
+	Save(ctx context.Context, succeeded TaskIDs) error
+	// Reset removes the checkpoint. It is called after the Flow completed successfully.
+	Reset(ctx context.Context) error
+}
+
+// NewConfigMapCheckpointer returns a Checkpointer which persists the succeeded tasks in the ConfigMap with the given
+// key. The checkpoint is only considered if it was saved with the same revision, e.g., a combination of the generation
+// of the reconciled object and the type of the operation.
+func NewConfigMapCheckpointer(c client.Client, key client.ObjectKey, revision string) Checkpointer {
+	return &configMapCheckpointer{client: c, key: key, revision: revision}
+}
+
+type configMapCheckpointer struct {
+	client   client.Client
+	key      client.ObjectKey
+	revision string
+}
+
+func (c *configMapCheckpointer) Load(ctx context.Context) (TaskIDs, error) {
+	configMap := &corev1.ConfigMap{}
+	if err := c.client.Get(ctx, c.key, configMap); err != nil {
+		if apierrors.IsNotFound(err) {
+			return NewTaskIDs(), nil
+		}
+		return nil, fmt.Errorf("failed reading checkpoint ConfigMap %s: %w", c.key, err)
+	}
+
+	if configMap.Data[checkpointDataKeyRevision] != c.revision {
+		return NewTaskIDs(), nil
+	}
+
+	var taskIDs TaskIDSlice
+	if err := json.Unmarshal([]byte(configMap.Data[checkpointDataKeyTasks]), &taskIDs); err != nil {
+		return nil, fmt.Errorf("failed decoding checkpoint ConfigMap %s: %w", c.key, err)
+	}
+
+	return NewTaskIDs(taskIDs), nil
+}
+
+func (c *configMapCheckpointer) Save(ctx context.Context, succeeded TaskIDs) error {
+	taskIDs, err := json.Marshal(succeeded.List())
+	if err != nil {
+		return fmt.Errorf("failed encoding checkpoint: %w", err)
+	}
+
+	configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: c.key.Name, Namespace: c.key.Namespace}}
+	if err := c.client.Get(ctx, c.key, configMap); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed reading checkpoint ConfigMap %s: %w", c.key, err)
+		}
+
+		configMap.Data = map[string]string{checkpointDataKeyRevision: c.revision, checkpointDataKeyTasks: string(taskIDs)}
+		return c.client.Create(ctx, configMap)
+	}
+
+	configMap.Data = map[string]string{checkpointDataKeyRevision: c.revision, checkpointDataKeyTasks: string(taskIDs)}
+	return c.client.Update(ctx, configMap)
+}
+
+func (c *configMapCheckpointer) Reset(ctx context.Context) error {
+	return client.IgnoreNotFound(c.client.Delete(ctx, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: c.key.Name, Namespace: c.key.Namespace}}))
+}
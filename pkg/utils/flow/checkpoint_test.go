@@ -0,0 +1,63 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package flow_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/gardener/gardener/pkg/utils/flow"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Checkpoint", func() {
+	var (
+		ctx        = context.Background()
+		fakeClient client.Client
+		key        = client.ObjectKey{Namespace: "shoot--foo--bar", Name: "checkpoint"}
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().Build()
+	})
+
+	Describe("#NewConfigMapCheckpointer", func() {
+		It("should return an empty checkpoint if the ConfigMap does not exist", func() {
+			Expect(NewConfigMapCheckpointer(fakeClient, key, "1").Load(ctx)).To(BeEmpty())
+		})
+
+		It("should save and load the checkpoint", func() {
+			checkpointer := NewConfigMapCheckpointer(fakeClient, key, "1")
+			Expect(checkpointer.Save(ctx, NewTaskIDs(TaskID("a")))).To(Succeed())
+			Expect(checkpointer.Save(ctx, NewTaskIDs(TaskID("a"), TaskID("b")))).To(Succeed())
+
+			Expect(checkpointer.Load(ctx)).To(Equal(NewTaskIDs(TaskID("a"), TaskID("b"))))
+
+			configMap := &corev1.ConfigMap{}
+			Expect(fakeClient.Get(ctx, key, configMap)).To(Succeed())
+			Expect(configMap.Data).To(Equal(map[string]string{"revision": "1", "succeededTasks": `["a","b"]`}))
+		})
+
+		It("should ignore a checkpoint of a different revision", func() {
+			Expect(NewConfigMapCheckpointer(fakeClient, key, "1").Save(ctx, NewTaskIDs(TaskID("a")))).To(Succeed())
+
+			Expect(NewConfigMapCheckpointer(fakeClient, key, "2").Load(ctx)).To(BeEmpty())
+		})
+
+		It("should remove the checkpoint on reset", func() {
+			checkpointer := NewConfigMapCheckpointer(fakeClient, key, "1")
+			Expect(checkpointer.Reset(ctx)).To(Succeed())
+			Expect(checkpointer.Save(ctx, NewTaskIDs(TaskID("a")))).To(Succeed())
+			Expect(checkpointer.Reset(ctx)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, key, &corev1.ConfigMap{})).To(BeNotFoundError())
+		})
+	})
+})
@@ -8,16 +8,22 @@ package flow
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/hashicorp/go-multierror"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/clock"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
 	"github.com/gardener/gardener/pkg/utils"
 	errorsutils "github.com/gardener/gardener/pkg/utils/errors"
+	"github.com/gardener/gardener/pkg/utils/tracing"
 )
 
 const (
@@ -71,16 +77,26 @@ func (f *Flow) Len() int {
 // node is a compiled Task that contains the triggered Tasks, the
 // number of triggers the node itself requires and its payload function.
 type node struct {
-	targetIDs TaskIDs
-	required  int
-	fn        TaskFn
-	skip      bool
+	targetIDs     TaskIDs
+	dependencyIDs TaskIDs
+	required      int
+	fn            TaskFn
+	skip          bool
+	resumable     bool
+	timeout       time.Duration
+	retry         *RetryPolicy
+	skipInDryRun  bool
 }
 
 func (n *node) String() string {
 	return fmt.Sprintf("node{targets=%s, required=%d}", n.targetIDs.List(), n.required)
 }
 
+// skipped returns whether the node is skipped in an execution with the given dry-run setting.
+func (n *node) skipped(dryRun bool) bool {
+	return n.skip || (dryRun && n.skipInDryRun)
+}
+
 // addTargets adds the given TaskIDs as targets to the node.
 func (n *node) addTargets(taskIDs ...TaskID) {
 	if n.targetIDs == nil {
@@ -102,6 +118,23 @@ type Opts struct {
 	ErrorCleaner func(ctx context.Context, taskID string)
 	// ErrorContext is used to store any error related context.
 	ErrorContext *errorsutils.ErrorContext
+	// Checkpointer is used to persist the resumable tasks which succeeded, so that they are skipped when the flow is run
+	// again before it has completed successfully.
+	Checkpointer Checkpointer
+	// TaskTimeout limits the duration of tasks which do not specify a timeout themselves. If it is zero, such tasks
+	// are not limited.
+	TaskTimeout time.Duration
+	// MaxParallelism limits the number of tasks which run at the same time. If it is zero, all tasks run as soon as
+	// their dependencies have completed.
+	MaxParallelism int
+	// Tracker is used to expose the state of the execution while it is running. If it is nil, DefaultTracker is used.
+	Tracker *Tracker
+	// TrackingKey identifies the execution in the Tracker, e.g., the key of the reconciled object.
+	TrackingKey string
+	// SpanAttributes are added to the span of the execution, e.g., to identify the reconciled object.
+	SpanAttributes []attribute.KeyValue
+	// DryRun skips all tasks which are marked with Task.SkipInDryRun in addition to the tasks which are skipped anyway.
+	DryRun bool
 }
 
 // Run starts an execution of a Flow.
@@ -111,9 +144,10 @@ func (f *Flow) Run(ctx context.Context, opts Opts) error {
 }
 
 type nodeResult struct {
-	TaskID  TaskID
-	Error   error
-	skipped bool
+	TaskID       TaskID
+	Error        error
+	skipped      bool
+	checkpointed bool
 
 	delay    time.Duration
 	duration time.Duration
@@ -167,7 +201,7 @@ func newExecution(flow *Flow, opts Opts) *execution {
 	all := NewTaskIDs()
 
 	for name, task := range flow.nodes {
-		if !task.skip {
+		if !task.skipped(opts.DryRun) {
 			all.Insert(name)
 		}
 	}
@@ -177,6 +211,11 @@ func newExecution(flow *Flow, opts Opts) *execution {
 		log = opts.Log.WithValues(logKeyFlow, flow.name)
 	}
 
+	tracker := DefaultTracker
+	if opts.Tracker != nil {
+		tracker = opts.Tracker
+	}
+
 	return &execution{
 		flow,
 		InitialStats(flow.name, all),
@@ -185,6 +224,19 @@ func newExecution(flow *Flow, opts Opts) *execution {
 		opts.ProgressReporter,
 		opts.ErrorCleaner,
 		opts.ErrorContext,
+		opts.Checkpointer,
+		NewTaskIDs(),
+		NewTaskIDs(),
+		opts.TaskTimeout,
+		opts.MaxParallelism,
+		nil,
+		tracker,
+		opts.TrackingKey,
+		0,
+		opts.SpanAttributes,
+		opts.DryRun,
+		make(map[TaskID]time.Time),
+		make(map[TaskID]time.Duration),
 		make(chan *nodeResult),
 		make(map[TaskID]int),
 	}
@@ -200,6 +252,19 @@ type execution struct {
 	progressReporter ProgressReporter
 	errorCleaner     ErrorCleaner
 	errorContext     *errorsutils.ErrorContext
+	checkpointer     Checkpointer
+	checkpoint       TaskIDs
+	executed         TaskIDs
+	taskTimeout      time.Duration
+	maxParallelism   int
+	queue            []TaskID
+	tracker          *Tracker
+	trackingKey      string
+	trackingID       uint64
+	spanAttributes   []attribute.KeyValue
+	dryRun           bool
+	taskStartTimes   map[TaskID]time.Time
+	taskDurations    map[TaskID]time.Duration
 
 	done          chan *nodeResult
 	triggerCounts map[TaskID]int
@@ -210,7 +275,7 @@ func (e *execution) runNode(ctx context.Context, id TaskID) {
 	taskStartDelay := e.flow.clock.Now().UTC().Sub(e.flow.start.UTC())
 
 	node := e.flow.nodes[id]
-	if node.skip {
+	if node.skipped(e.dryRun) {
 		log.V(1).Info("Skipped")
 		e.stats.Skipped.Insert(id)
 
@@ -221,12 +286,41 @@ func (e *execution) runNode(ctx context.Context, id TaskID) {
 		return
 	}
 
+	if e.maxParallelism > 0 {
+		e.queue = append(e.queue, id)
+		e.runQueued(ctx)
+		return
+	}
+
+	e.startNode(ctx, id)
+}
+
+func (e *execution) startNode(ctx context.Context, id TaskID) {
+	log := e.log.WithValues(logKeyTask, id)
+	taskStartDelay := e.flow.clock.Now().UTC().Sub(e.flow.start.UTC())
+
+	node := e.flow.nodes[id]
 	if e.errorContext != nil {
 		e.errorContext.AddErrorID(string(id))
 	}
 
 	e.stats.Pending.Delete(id)
 	e.stats.Running.Insert(id)
+	e.taskStartTimes[id] = e.flow.clock.Now().UTC()
+
+	if node.resumable && e.checkpoint.Has(id) {
+		if !e.anyExecuted(node.dependencyIDs) {
+			log.Info("Succeeded in previous run, skipping")
+
+			go func() {
+				e.done <- &nodeResult{TaskID: id, Error: nil, checkpointed: true, delay: taskStartDelay}
+			}()
+
+			return
+		}
+
+		log.Info("Succeeded in previous run, but dependencies were executed again, running it again")
+	}
 
 	go func() {
 		defer func() {
@@ -239,16 +333,17 @@ func (e *execution) runNode(ctx context.Context, id TaskID) {
 
 		start := e.flow.clock.Now().UTC()
 		log.V(1).Info("Started")
-		taskCtx := ctx
-		if rr, ok := e.progressReporter.(RetryReporter); ok {
-			taskCtx = withRetryReporter(ctx, id, rr)
-		}
-		err := node.fn(taskCtx)
+		taskCtx, span := tracing.Tracer().Start(ctx, string(id), trace.WithAttributes(tracing.AttributeKeyFlowTask.String(string(id))))
+		defer span.End()
+		taskCtx = withRetryReporter(taskCtx, id, e)
+		err := e.executeTask(taskCtx, id, node)
 		duration := e.flow.clock.Now().UTC().Sub(start)
 		log.V(1).Info("Finished", "duration", duration)
 
 		if err != nil {
 			log.Error(err, "Error")
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
 			err = fmt.Errorf("task %q failed: %w", id, err)
 		} else {
 			log.Info("Succeeded")
@@ -258,6 +353,41 @@ func (e *execution) runNode(ctx context.Context, id TaskID) {
 	}()
 }
 
+// executeTask runs the payload function of the given node with respect to its retry policy and timeout.
+func (e *execution) executeTask(ctx context.Context, id TaskID, node *node) error {
+	fn := node.fn
+	if node.retry != nil {
+		fn = fn.RetryWithPolicy(*node.retry)
+	}
+
+	timeout := node.timeout
+	if timeout == 0 {
+		timeout = e.taskTimeout
+	}
+	if timeout == 0 {
+		return fn(ctx)
+	}
+
+	taskCtx, cancel := ContextWithTimeout(ctx, timeout)
+	defer cancel()
+
+	err := fn(taskCtx)
+	if err != nil && ctx.Err() == nil && errors.Is(taskCtx.Err(), context.DeadlineExceeded) {
+		e.reportTaskTimeout(id)
+		return fmt.Errorf("timed out after %s: %w", timeout, err)
+	}
+	return err
+}
+
+// runQueued starts queued tasks in the order they were triggered until the maximum parallelism is reached.
+func (e *execution) runQueued(ctx context.Context) {
+	for len(e.queue) > 0 && e.stats.Running.Len() < e.maxParallelism {
+		id := e.queue[0]
+		e.queue = e.queue[1:]
+		e.startNode(ctx, id)
+	}
+}
+
 func (e *execution) updateSuccess(id TaskID) {
 	e.stats.Running.Delete(id)
 	e.stats.Succeeded.Insert(id)
@@ -285,15 +415,65 @@ func (e *execution) cleanErrors(ctx context.Context, taskID TaskID) {
 }
 
 func (e *execution) reportProgress(ctx context.Context) {
+	e.tracker.update(e.trackingID, e.executionState())
+
 	if e.progressReporter != nil {
 		e.progressReporter.Report(ctx, e.stats.Copy())
 	}
 }
 
+// executionState returns the current state of the execution for the Tracker.
+func (e *execution) executionState() ExecutionState {
+	state := ExecutionState{
+		FlowName:  e.flow.name,
+		Key:       e.trackingKey,
+		StartTime: e.flow.start.UTC(),
+	}
+	if e.stats.All.Len() > 0 {
+		state.ProgressPercent = e.stats.ProgressPercent()
+	}
+
+	ids := make(TaskIDs, len(e.flow.nodes))
+	for id := range e.flow.nodes {
+		ids.Insert(id)
+	}
+
+	for _, id := range ids.List() {
+		taskState := TaskState{ID: id}
+
+		switch {
+		case e.flow.nodes[id].skipped(e.dryRun):
+			taskState.Phase = TaskPhaseSkipped
+		case e.stats.Running.Has(id):
+			taskState.Phase = TaskPhaseRunning
+		case e.stats.Succeeded.Has(id):
+			taskState.Phase = TaskPhaseSucceeded
+		case e.stats.Failed.Has(id):
+			taskState.Phase = TaskPhaseFailed
+		default:
+			taskState.Phase = TaskPhasePending
+		}
+
+		if startTime, ok := e.taskStartTimes[id]; ok {
+			taskState.StartTime = &startTime
+		}
+		if duration, ok := e.taskDurations[id]; ok {
+			taskState.Duration = &metav1.Duration{Duration: duration}
+		}
+
+		state.Tasks = append(state.Tasks, taskState)
+	}
+
+	return state
+}
+
 func (e *execution) run(ctx context.Context) error {
 	e.flow.start = e.flow.clock.Now()
 	defer close(e.done)
 
+	ctx, span := tracing.Tracer().Start(ctx, e.flow.name, trace.WithAttributes(append([]attribute.KeyValue{tracing.AttributeKeyFlowName.String(e.flow.name)}, e.spanAttributes...)...))
+	defer span.End()
+
 	if e.progressReporter != nil {
 		if err := e.progressReporter.Start(ctx); err != nil {
 			return err
@@ -301,7 +481,11 @@ func (e *execution) run(ctx context.Context) error {
 		defer e.progressReporter.Stop()
 	}
 
+	e.trackingID = e.tracker.register()
+	defer e.tracker.unregister(e.trackingID)
+
 	e.log.Info("Starting")
+	e.loadCheckpoint(ctx)
 	e.reportProgress(ctx)
 
 	var (
@@ -319,8 +503,15 @@ func (e *execution) run(ctx context.Context) error {
 	for e.stats.Running.Len() > 0 || e.stats.Skipped.Len() > 0 {
 		result := <-e.done
 		e.reportTaskMetrics(result)
+		if !result.skipped && !result.checkpointed {
+			e.taskDurations[result.TaskID] = result.duration
+		}
 		if result.skipped {
 			e.stats.Skipped.Delete(result.TaskID)
+			// Skipped tasks do not hide from their dependants that their own dependencies were executed.
+			if e.anyExecuted(e.flow.nodes[result.TaskID].dependencyIDs) {
+				e.executed.Insert(result.TaskID)
+			}
 			if cancelErr = ctx.Err(); cancelErr == nil {
 				e.processTriggers(ctx, result.TaskID)
 			}
@@ -330,6 +521,10 @@ func (e *execution) run(ctx context.Context) error {
 				e.updateFailure(result.TaskID)
 			} else {
 				e.updateSuccess(result.TaskID)
+				if !result.checkpointed {
+					e.executed.Insert(result.TaskID)
+					e.saveCheckpoint(ctx, result.TaskID)
+				}
 				if e.errorContext != nil && e.errorContext.HasLastErrorWithID(string(result.TaskID)) {
 					e.cleanErrors(ctx, result.TaskID)
 				}
@@ -339,11 +534,74 @@ func (e *execution) run(ctx context.Context) error {
 			}
 		}
 
+		if len(e.queue) > 0 {
+			if cancelErr = ctx.Err(); cancelErr == nil {
+				e.runQueued(ctx)
+			}
+		}
+
 		e.reportProgress(ctx)
 	}
 
 	e.log.Info("Finished")
-	return e.result(cancelErr)
+	if cancelErr == nil && len(e.taskErrors) == 0 {
+		e.resetCheckpoint(ctx)
+	}
+
+	if err := e.result(cancelErr); err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+	return nil
+}
+
+// anyExecuted returns whether any of the given tasks was executed in this run, i.e., it was not skipped because it
+// succeeded in a previous run.
+func (e *execution) anyExecuted(ids TaskIDs) bool {
+	for id := range ids {
+		if e.executed.Has(id) {
+			return true
+		}
+	}
+	return false
+}
+
+func (e *execution) loadCheckpoint(ctx context.Context) {
+	if e.checkpointer == nil {
+		return
+	}
+
+	checkpoint, err := e.checkpointer.Load(ctx)
+	if err != nil {
+		e.log.Error(err, "Failed loading checkpoint, running all tasks")
+		return
+	}
+
+	if checkpoint.Len() > 0 {
+		e.log.Info("Resuming from checkpoint", "succeededTasks", checkpoint.Len())
+	}
+	e.checkpoint.Insert(checkpoint)
+}
+
+func (e *execution) saveCheckpoint(ctx context.Context, id TaskID) {
+	if e.checkpointer == nil || !e.flow.nodes[id].resumable {
+		return
+	}
+
+	e.checkpoint.Insert(id)
+	if err := e.checkpointer.Save(ctx, e.checkpoint.Copy()); err != nil {
+		e.log.Error(err, "Failed saving checkpoint", logKeyTask, id)
+	}
+}
+
+func (e *execution) resetCheckpoint(ctx context.Context) {
+	if e.checkpointer == nil {
+		return
+	}
+
+	if err := e.checkpointer.Reset(ctx); err != nil {
+		e.log.Error(err, "Failed resetting checkpoint")
+	}
 }
 
 func (e *execution) result(cancelErr error) error {
@@ -368,10 +626,10 @@ func (e *execution) result(cancelErr error) error {
 func (e *execution) reportTaskMetrics(r *nodeResult) {
 	if flowTaskDelaySeconds != nil {
 		flowTaskDelaySeconds.
-			WithLabelValues(e.flow.name, string(r.TaskID), utils.IifString(r.skipped, "true", "false")).
+			WithLabelValues(e.flow.name, string(r.TaskID), utils.IifString(r.skipped || r.checkpointed, "true", "false")).
 			Observe(r.delay.Seconds())
 	}
-	if flowTaskDurationSeconds != nil && !r.skipped {
+	if flowTaskDurationSeconds != nil && !r.skipped && !r.checkpointed {
 		flowTaskDurationSeconds.WithLabelValues(e.flow.name, string(r.TaskID)).Observe(r.duration.Seconds())
 	}
 	if flowTaskResults != nil {
@@ -379,6 +637,24 @@ func (e *execution) reportTaskMetrics(r *nodeResult) {
 	}
 }
 
+// ReportRetry implements RetryReporter. It counts the retry in the flow metrics and forwards it to the progress
+// reporter if it is a RetryReporter.
+func (e *execution) ReportRetry(ctx context.Context, id TaskID, err error) {
+	if flowTaskRetries != nil {
+		flowTaskRetries.WithLabelValues(e.flow.name, string(id)).Inc()
+	}
+
+	if rr, ok := e.progressReporter.(RetryReporter); ok {
+		rr.ReportRetry(ctx, id, err)
+	}
+}
+
+func (e *execution) reportTaskTimeout(id TaskID) {
+	if flowTaskTimeouts != nil {
+		flowTaskTimeouts.WithLabelValues(e.flow.name, string(id)).Inc()
+	}
+}
+
 func (e *execution) reportFlowMetrics() {
 	if flowDurationSeconds != nil {
 		flowDurationSeconds.WithLabelValues(e.flow.name).Observe(e.flow.clock.Now().UTC().Sub(e.flow.start.UTC()).Seconds())
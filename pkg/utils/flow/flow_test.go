@@ -9,11 +9,21 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
 	"go.uber.org/goleak"
 	"go.uber.org/mock/gomock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	errorsutils "github.com/gardener/gardener/pkg/utils/errors"
 	"github.com/gardener/gardener/pkg/utils/flow"
@@ -48,6 +58,27 @@ func (a *AtomicStringList) Values() []string {
 	return out
 }
 
+type retryRecorder struct {
+	lock    sync.Mutex
+	reports []string
+}
+
+func (r *retryRecorder) Start(context.Context) error         { return nil }
+func (r *retryRecorder) Stop()                               {}
+func (r *retryRecorder) Report(context.Context, *flow.Stats) {}
+
+func (r *retryRecorder) ReportRetry(_ context.Context, id flow.TaskID, err error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.reports = append(r.reports, fmt.Sprintf("%s: %v", id, err))
+}
+
+func (r *retryRecorder) retries() []string {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.reports
+}
+
 var _ = Describe("Flow", func() {
 	canceledCtx, cancel := context.WithCancel(context.Background())
 	cancel()
@@ -187,6 +218,335 @@ var _ = Describe("Flow", func() {
 		})
 	})
 
+	Describe("#Run with timeouts, retries and limited parallelism", func() {
+		It("should fail tasks exceeding their timeout", func() {
+			var (
+				g = flow.NewGraph("foo")
+				_ = g.Add(flow.Task{Name: "x", Fn: func(ctx context.Context) error {
+					<-ctx.Done()
+					return ctx.Err()
+				}, Timeout: 10 * time.Millisecond})
+				f = g.Compile()
+			)
+
+			err := f.Run(ctx, flow.Opts{})
+			Expect(err).To(MatchError(ContainSubstring("task \"x\" failed: timed out after 10ms")))
+			Expect(flow.WasCanceled(err)).To(BeFalse())
+		})
+
+		It("should apply the default task timeout", func() {
+			var (
+				g = flow.NewGraph("foo")
+				_ = g.Add(flow.Task{Name: "x", Fn: func(ctx context.Context) error {
+					<-ctx.Done()
+					return ctx.Err()
+				}})
+				f = g.Compile()
+			)
+
+			Expect(f.Run(ctx, flow.Opts{TaskTimeout: 10 * time.Millisecond})).To(MatchError(ContainSubstring("timed out after 10ms")))
+		})
+
+		It("should retry failing tasks and report the retries", func() {
+			var (
+				attempts int
+				reporter = &retryRecorder{}
+
+				g = flow.NewGraph("foo")
+				_ = g.Add(flow.Task{Name: "x", Fn: func(_ context.Context) error {
+					attempts++
+					if attempts < 3 {
+						return fmt.Errorf("attempt %d failed", attempts)
+					}
+					return nil
+				}, Retry: &flow.RetryPolicy{Interval: time.Millisecond}})
+				f = g.Compile()
+			)
+
+			Expect(f.Run(ctx, flow.Opts{ProgressReporter: reporter})).To(Succeed())
+			Expect(attempts).To(Equal(3))
+			Expect(reporter.retries()).To(Equal([]string{"x: attempt 1 failed", "x: attempt 2 failed"}))
+		})
+
+		It("should stop retrying once the timeout expired", func() {
+			var (
+				attempts int
+
+				g = flow.NewGraph("foo")
+				_ = g.Add(flow.Task{Name: "x", Fn: func(_ context.Context) error {
+					attempts++
+					return errors.New("fail")
+				}, Timeout: 20 * time.Millisecond, Retry: &flow.RetryPolicy{Interval: time.Millisecond}})
+				f = g.Compile()
+			)
+
+			Expect(f.Run(ctx, flow.Opts{})).To(MatchError(And(ContainSubstring("timed out after 20ms"), ContainSubstring("last error: fail"))))
+			Expect(attempts).To(BeNumerically(">", 1))
+		})
+
+		It("should give up once the maximum number of attempts is reached", func() {
+			var (
+				attempts int
+				reporter = &retryRecorder{}
+
+				g = flow.NewGraph("foo")
+				_ = g.Add(flow.Task{Name: "x", Fn: func(_ context.Context) error {
+					attempts++
+					return errors.New("fail")
+				}, Retry: &flow.RetryPolicy{Interval: time.Millisecond, MaxAttempts: 3}})
+				f = g.Compile()
+			)
+
+			Expect(f.Run(ctx, flow.Opts{ProgressReporter: reporter})).To(MatchError(ContainSubstring("giving up after 3 attempt(s): fail")))
+			Expect(attempts).To(Equal(3))
+			Expect(reporter.retries()).To(Equal([]string{"x: fail", "x: fail"}))
+		})
+
+		It("should increase the interval between the attempts", func() {
+			var (
+				attemptTimes []time.Time
+
+				g = flow.NewGraph("foo")
+				_ = g.Add(flow.Task{Name: "x", Fn: func(_ context.Context) error {
+					attemptTimes = append(attemptTimes, time.Now())
+					if len(attemptTimes) < 3 {
+						return errors.New("fail")
+					}
+					return nil
+				}, Retry: &flow.RetryPolicy{Interval: 5 * time.Millisecond, Factor: 4}})
+				f = g.Compile()
+			)
+
+			Expect(f.Run(ctx, flow.Opts{})).To(Succeed())
+			Expect(attemptTimes).To(HaveLen(3))
+			Expect(attemptTimes[1].Sub(attemptTimes[0])).To(BeNumerically(">=", 5*time.Millisecond))
+			Expect(attemptTimes[2].Sub(attemptTimes[1])).To(BeNumerically(">=", 20*time.Millisecond))
+		})
+
+		It("should not run more tasks in parallel than allowed", func() {
+			var (
+				lock             sync.Mutex
+				running, maximum int
+
+				fn = func(_ context.Context) error {
+					lock.Lock()
+					running++
+					maximum = max(maximum, running)
+					lock.Unlock()
+
+					time.Sleep(5 * time.Millisecond)
+
+					lock.Lock()
+					running--
+					lock.Unlock()
+					return nil
+				}
+
+				g  = flow.NewGraph("foo")
+				x1 = g.Add(flow.Task{Name: "x1", Fn: fn})
+				x2 = g.Add(flow.Task{Name: "x2", Fn: fn})
+				x3 = g.Add(flow.Task{Name: "x3", Fn: fn})
+				_  = g.Add(flow.Task{Name: "y1", Fn: fn, Dependencies: flow.NewTaskIDs(x1, x2, x3)})
+				_  = g.Add(flow.Task{Name: "y2", Fn: fn, Dependencies: flow.NewTaskIDs(x1, x2, x3)})
+				_  = g.Add(flow.Task{Name: "y3", Fn: fn, Dependencies: flow.NewTaskIDs(x1, x2, x3)})
+				f  = g.Compile()
+			)
+
+			Expect(f.Run(ctx, flow.Opts{MaxParallelism: 2})).To(Succeed())
+			Expect(maximum).To(Equal(2))
+		})
+	})
+
+	Describe("#Run with checkpoints", func() {
+		var (
+			list         *AtomicStringList
+			checkpointer flow.Checkpointer
+			failY        bool
+
+			mkListAppender = func(value string) flow.TaskFn {
+				return func(_ context.Context) error {
+					if value == "y" && failY {
+						return errors.New("y failed")
+					}
+					list.Append(value)
+					return nil
+				}
+			}
+			mkFlow = func() *flow.Flow {
+				var (
+					g = flow.NewGraph("foo")
+					x = g.Add(flow.Task{Name: "x", Fn: mkListAppender("x"), Resumable: true})
+					w = g.Add(flow.Task{Name: "w", Fn: mkListAppender("w")})
+					_ = g.Add(flow.Task{Name: "y", Fn: mkListAppender("y"), Resumable: true, Dependencies: flow.NewTaskIDs(x, w)})
+				)
+				return g.Compile()
+			}
+		)
+
+		BeforeEach(func() {
+			list = NewAtomicStringList()
+			checkpointer = flow.NewConfigMapCheckpointer(fakeclient.NewClientBuilder().Build(), client.ObjectKey{Namespace: "shoot--foo--bar", Name: "checkpoint"}, "1")
+			failY = false
+		})
+
+		It("should skip resumable tasks which succeeded in a previous run", func() {
+			failY = true
+			Expect(mkFlow().Run(ctx, flow.Opts{Checkpointer: checkpointer})).NotTo(Succeed())
+			Expect(list.Values()).To(ConsistOf("x", "w"))
+			Expect(checkpointer.Load(ctx)).To(Equal(flow.NewTaskIDs(flow.TaskID("x"))))
+
+			failY = false
+			list = NewAtomicStringList()
+			Expect(mkFlow().Run(ctx, flow.Opts{Checkpointer: checkpointer})).To(Succeed())
+			Expect(list.Values()).To(ConsistOf("w", "y"))
+		})
+
+		It("should reset the checkpoint after the flow succeeded", func() {
+			Expect(mkFlow().Run(ctx, flow.Opts{Checkpointer: checkpointer})).To(Succeed())
+			Expect(checkpointer.Load(ctx)).To(BeEmpty())
+
+			list = NewAtomicStringList()
+			Expect(mkFlow().Run(ctx, flow.Opts{Checkpointer: checkpointer})).To(Succeed())
+			Expect(list.Values()).To(ConsistOf("x", "w", "y"))
+		})
+
+		Context("dependencies of resumable tasks", func() {
+			var (
+				failZ bool
+
+				mkFlow = func() *flow.Flow {
+					appender := func(value string) flow.TaskFn {
+						return func(_ context.Context) error {
+							if value == "z" && failZ {
+								return errors.New("z failed")
+							}
+							list.Append(value)
+							return nil
+						}
+					}
+
+					var (
+						g = flow.NewGraph("foo")
+						// deploy is run again in every run, waitDeploy must be run again as well.
+						deploy     = g.Add(flow.Task{Name: "deploy", Fn: appender("deploy")})
+						waitDeploy = g.Add(flow.Task{Name: "wait-deploy", Fn: appender("wait-deploy"), Resumable: true, Dependencies: flow.NewTaskIDs(deploy)})
+						// skipped does not hide that deploy was run again from waitSkipped.
+						skipped     = g.Add(flow.Task{Name: "skipped", Fn: appender("skipped"), SkipIf: true, Dependencies: flow.NewTaskIDs(deploy)})
+						waitSkipped = g.Add(flow.Task{Name: "wait-skipped", Fn: appender("wait-skipped"), Resumable: true, Dependencies: flow.NewTaskIDs(skipped)})
+						// waitResumable only depends on resumable tasks, hence it can be skipped.
+						resumable     = g.Add(flow.Task{Name: "resumable", Fn: appender("resumable"), Resumable: true})
+						waitResumable = g.Add(flow.Task{Name: "wait-resumable", Fn: appender("wait-resumable"), Resumable: true, Dependencies: flow.NewTaskIDs(resumable)})
+						_             = g.Add(flow.Task{Name: "z", Fn: appender("z"), Dependencies: flow.NewTaskIDs(waitDeploy, waitSkipped, waitResumable)})
+					)
+					return g.Compile()
+				}
+			)
+
+			BeforeEach(func() {
+				failZ = true
+				Expect(mkFlow().Run(ctx, flow.Opts{Checkpointer: checkpointer})).NotTo(Succeed())
+				Expect(list.Values()).To(ConsistOf("deploy", "wait-deploy", "wait-skipped", "resumable", "wait-resumable"))
+				Expect(checkpointer.Load(ctx)).To(Equal(flow.NewTaskIDs(flow.TaskID("wait-deploy"), flow.TaskID("wait-skipped"), flow.TaskID("resumable"), flow.TaskID("wait-resumable"))))
+
+				failZ = false
+				list = NewAtomicStringList()
+			})
+
+			It("should not skip resumable tasks if one of their dependencies was executed again", func() {
+				Expect(mkFlow().Run(ctx, flow.Opts{Checkpointer: checkpointer})).To(Succeed())
+				Expect(list.Values()).To(ConsistOf("deploy", "wait-deploy", "wait-skipped", "z"))
+			})
+		})
+	})
+
+	Describe("#Run in dry-run mode", func() {
+		var (
+			list *AtomicStringList
+			f    *flow.Flow
+		)
+
+		BeforeEach(func() {
+			list = NewAtomicStringList()
+			mkListAppender := func(value string) flow.TaskFn {
+				return func(_ context.Context) error {
+					list.Append(value)
+					return nil
+				}
+			}
+
+			var (
+				g = flow.NewGraph("foo")
+				x = g.Add(flow.Task{Name: "x", Fn: mkListAppender("x")})
+				w = g.Add(flow.Task{Name: "w", Fn: mkListAppender("w"), SkipInDryRun: true, Dependencies: flow.NewTaskIDs(x)})
+				_ = g.Add(flow.Task{Name: "y", Fn: mkListAppender("y"), Dependencies: flow.NewTaskIDs(w)})
+			)
+			f = g.Compile()
+		})
+
+		It("should run all tasks if dry-run is disabled", func() {
+			Expect(f.Run(ctx, flow.Opts{})).To(Succeed())
+			Expect(list.Values()).To(HaveExactElements("x", "w", "y"))
+		})
+
+		It("should skip the tasks marked to be skipped in dry-run mode", func() {
+			Expect(f.Run(ctx, flow.Opts{DryRun: true})).To(Succeed())
+			Expect(list.Values()).To(HaveExactElements("x", "y"))
+		})
+	})
+
+	Describe("#Run with tracing", func() {
+		var spanRecorder *tracetest.SpanRecorder
+
+		BeforeEach(func() {
+			spanRecorder = tracetest.NewSpanRecorder()
+			otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spanRecorder)))
+			DeferCleanup(func() { otel.SetTracerProvider(noop.NewTracerProvider()) })
+		})
+
+		It("should emit a span for the flow and for each task", func() {
+			var (
+				g = flow.NewGraph("foo")
+				x = g.Add(flow.Task{Name: "x", Fn: func(context.Context) error { return nil }})
+				_ = g.Add(flow.Task{Name: "y", Fn: func(context.Context) error { return errors.New("y failed") }, Dependencies: flow.NewTaskIDs(x)})
+				_ = g.Add(flow.Task{Name: "z", SkipIf: true})
+				f = g.Compile()
+			)
+
+			Expect(f.Run(ctx, flow.Opts{SpanAttributes: []attribute.KeyValue{attribute.String("foo", "bar")}})).NotTo(Succeed())
+
+			spans := spanRecorder.Ended()
+			Expect(spans).To(HaveLen(3))
+
+			spansByName := make(map[string]sdktrace.ReadOnlySpan, len(spans))
+			for _, span := range spans {
+				spansByName[span.Name()] = span
+			}
+
+			flowSpan := spansByName["foo"]
+			Expect(flowSpan.Status().Code).To(Equal(codes.Error))
+			Expect(flowSpan.Attributes()).To(ContainElements(attribute.String("gardener.flow.name", "foo"), attribute.String("foo", "bar")))
+
+			Expect(spansByName["x"].Parent().SpanID()).To(Equal(flowSpan.SpanContext().SpanID()))
+			Expect(spansByName["x"].Status().Code).To(Equal(codes.Unset))
+			Expect(spansByName["y"].Parent().SpanID()).To(Equal(flowSpan.SpanContext().SpanID()))
+			Expect(spansByName["y"].Status()).To(Equal(sdktrace.Status{Code: codes.Error, Description: "y failed"}))
+		})
+
+		It("should pass the span of the task to its function", func() {
+			var taskSpanContext trace.SpanContext
+
+			g := flow.NewGraph("foo")
+			g.Add(flow.Task{Name: "x", Fn: func(ctx context.Context) error {
+				taskSpanContext = trace.SpanContextFromContext(ctx)
+				return nil
+			}})
+
+			Expect(g.Compile().Run(ctx, flow.Opts{})).To(Succeed())
+			Expect(taskSpanContext.IsValid()).To(BeTrue())
+			Expect(spanRecorder.Ended()).To(ContainElement(HaveField("SpanContext()", Equal(taskSpanContext))))
+		})
+	})
+
 	Describe("#Sequential", func() {
 		It("should run the given functions in sequence", func() {
 			var (
@@ -6,6 +6,7 @@ package flow
 
 import (
 	"fmt"
+	"time"
 
 	"k8s.io/utils/clock"
 )
@@ -17,6 +18,19 @@ type Task struct {
 	Fn           TaskFn
 	SkipIf       bool
 	Dependencies TaskIDs
+	// Resumable marks the Task as safe to be skipped when the Flow is resumed from a checkpoint in which the Task has
+	// already succeeded (see Opts.Checkpointer). The Task is only skipped if none of its dependencies was executed again
+	// in the resumed run, e.g., a Task waiting for a component is not skipped if the component was deployed again. Only
+	// mark tasks as resumable which do not initialize any state that is consumed by other tasks.
+	Resumable bool
+	// Timeout limits the duration of the Task including all retries. If it is zero, Opts.TaskTimeout is used.
+	Timeout time.Duration
+	// Retry configures how the Task is retried if it fails. The Task is retried until it succeeds, the maximum number of
+	// attempts is reached, or its timeout expires. If it is nil, the Task is not retried.
+	Retry *RetryPolicy
+	// SkipInDryRun marks the Task to be skipped when the Flow is run with Opts.DryRun, e.g., because it waits for
+	// objects which are not actually applied in a dry-run.
+	SkipInDryRun bool
 }
 
 // Spec returns the TaskSpec of a task.
@@ -25,6 +39,10 @@ func (t *Task) Spec() *TaskSpec {
 		t.Fn,
 		t.SkipIf,
 		t.Dependencies.Copy(),
+		t.Resumable,
+		t.Timeout,
+		t.Retry,
+		t.SkipInDryRun,
 	}
 }
 
@@ -34,6 +52,10 @@ type TaskSpec struct {
 	Fn           TaskFn
 	Skip         bool
 	Dependencies TaskIDs
+	Resumable    bool
+	Timeout      time.Duration
+	Retry        *RetryPolicy
+	SkipInDryRun bool
 }
 
 // Tasks is a mapping from TaskID to TaskSpec.
@@ -89,8 +111,13 @@ func (g *Graph) Compile() *Flow {
 		}
 
 		node := nodes.getOrCreate(taskName)
+		node.dependencyIDs = taskSpec.Dependencies
 		node.fn = taskSpec.Fn
 		node.skip = taskSpec.Skip
+		node.resumable = taskSpec.Resumable
+		node.timeout = taskSpec.Timeout
+		node.retry = taskSpec.Retry
+		node.skipInDryRun = taskSpec.SkipInDryRun
 		node.required = taskSpec.Dependencies.Len()
 	}
 
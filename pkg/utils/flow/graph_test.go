@@ -28,4 +28,47 @@ var _ = Describe("Graph", func() {
 			}).To(Panic())
 		})
 	})
+
+	Context("rendering", func() {
+		var graph *flow.Graph
+
+		BeforeEach(func() {
+			graph = flow.NewGraph("foo")
+
+			var (
+				x = graph.Add(flow.Task{Name: "x"})
+				y = graph.Add(flow.Task{Name: `y "quoted"`, SkipIf: true})
+			)
+			graph.Add(flow.Task{Name: "z", Dependencies: flow.NewTaskIDs(x, y)})
+		})
+
+		Describe("#DOT", func() {
+			It("should render the graph in the DOT language", func() {
+				Expect(graph.DOT()).To(Equal(`digraph "foo" {
+  "x";
+  "y \"quoted\"" [style=dashed];
+  "z";
+  "x" -> "z";
+  "y \"quoted\"" -> "z";
+}
+`))
+			})
+		})
+
+		Describe("#Mermaid", func() {
+			It("should render the graph as Mermaid flowchart", func() {
+				Expect(graph.Mermaid()).To(Equal(`---
+title: foo
+---
+flowchart TD
+  t0["x"]
+  t1["y #quot;quoted#quot;"]:::skipped
+  t2["z"]
+  t0 --> t2
+  t1 --> t2
+  classDef skipped stroke-dasharray: 5 5
+`))
+			})
+		})
+	})
 })
@@ -15,6 +15,8 @@ var (
 	flowTaskDelaySeconds    *prometheus.HistogramVec
 	flowTaskDurationSeconds *prometheus.HistogramVec
 	flowTaskResults         *prometheus.CounterVec
+	flowTaskRetries         *prometheus.CounterVec
+	flowTaskTimeouts        *prometheus.CounterVec
 	flowDurationSeconds     *prometheus.HistogramVec
 )
 
@@ -69,6 +71,30 @@ func RegisterMetrics(r prometheus.Registerer) {
 		},
 	)
 
+	flowTaskRetries = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "task_retries_total",
+			Help:      "Number of retries of flow tasks.",
+		},
+		[]string{
+			"flow",
+			"task_id",
+		},
+	)
+
+	flowTaskTimeouts = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "task_timeouts_total",
+			Help:      "Number of flow tasks which exceeded their timeout.",
+		},
+		[]string{
+			"flow",
+			"task_id",
+		},
+	)
+
 	flowDurationSeconds = factory.NewHistogramVec(
 		prometheus.HistogramOpts{
 			Namespace: metricsNamespace,
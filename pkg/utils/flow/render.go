@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package flow
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DOT renders the graph in the DOT language of Graphviz. Tasks which are skipped are drawn with dashed lines.
+func (g *Graph) DOT() string {
+	var (
+		out   strings.Builder
+		quote = func(s string) string { return `"` + strings.ReplaceAll(s, `"`, `\"`) + `"` }
+	)
+
+	fmt.Fprintf(&out, "digraph %s {\n", quote(g.name))
+	for _, id := range g.taskIDs() {
+		if g.tasks[id].Skip {
+			fmt.Fprintf(&out, "  %s [style=dashed];\n", quote(string(id)))
+			continue
+		}
+		fmt.Fprintf(&out, "  %s;\n", quote(string(id)))
+	}
+	for _, id := range g.taskIDs() {
+		for _, dependencyID := range g.tasks[id].Dependencies.List() {
+			fmt.Fprintf(&out, "  %s -> %s;\n", quote(string(dependencyID)), quote(string(id)))
+		}
+	}
+	out.WriteString("}\n")
+
+	return out.String()
+}
+
+// Mermaid renders the graph as Mermaid flowchart. Tasks which are skipped are drawn with dashed lines.
+func (g *Graph) Mermaid() string {
+	var (
+		out         strings.Builder
+		ids         = g.taskIDs()
+		nodeIDs     = make(map[TaskID]string, len(ids))
+		skipped     bool
+		escapeLabel = func(s string) string { return strings.ReplaceAll(s, `"`, "#quot;") }
+	)
+
+	fmt.Fprintf(&out, "---\ntitle: %s\n---\nflowchart TD\n", g.name)
+	for i, id := range ids {
+		nodeIDs[id] = fmt.Sprintf("t%d", i)
+
+		if g.tasks[id].Skip {
+			skipped = true
+			fmt.Fprintf(&out, "  %s[\"%s\"]:::skipped\n", nodeIDs[id], escapeLabel(string(id)))
+			continue
+		}
+		fmt.Fprintf(&out, "  %s[\"%s\"]\n", nodeIDs[id], escapeLabel(string(id)))
+	}
+	for _, id := range ids {
+		for _, dependencyID := range g.tasks[id].Dependencies.List() {
+			fmt.Fprintf(&out, "  %s --> %s\n", nodeIDs[dependencyID], nodeIDs[id])
+		}
+	}
+	if skipped {
+		out.WriteString("  classDef skipped stroke-dasharray: 5 5\n")
+	}
+
+	return out.String()
+}
+
+// taskIDs returns the IDs of all tasks of the graph in a stable order.
+func (g *Graph) taskIDs() TaskIDSlice {
+	ids := make(TaskIDs, len(g.tasks))
+	for id := range g.tasks {
+		ids.Insert(id)
+	}
+	return ids.List()
+}
@@ -0,0 +1,45 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package flow
+
+import (
+	"time"
+)
+
+// RetryPolicy configures how a failing task is retried.
+type RetryPolicy struct {
+	// Interval is the duration to wait after the first failed attempt.
+	Interval time.Duration
+	// Factor is multiplied with the interval after each failed attempt. Values less than or equal to 1 keep the
+	// interval constant.
+	Factor float64
+	// MaxInterval caps the interval between two attempts. If it is zero, the interval is not capped.
+	MaxInterval time.Duration
+	// MaxAttempts limits the number of attempts. If it is zero, the task is retried until its context is done, e.g.,
+	// because the task timeout expired.
+	MaxAttempts int
+}
+
+// IntervalAfter returns the duration to wait after the given number of failed attempts.
+func (p RetryPolicy) IntervalAfter(failedAttempts int) time.Duration {
+	interval := p.Interval
+	for i := 1; i < failedAttempts && p.Factor > 1; i++ {
+		if p.MaxInterval > 0 && interval >= p.MaxInterval {
+			break
+		}
+
+		next := time.Duration(float64(interval) * p.Factor)
+		if next < interval {
+			// The interval overflowed, keep the largest one.
+			break
+		}
+		interval = next
+	}
+
+	if p.MaxInterval > 0 && interval > p.MaxInterval {
+		interval = p.MaxInterval
+	}
+	return interval
+}
@@ -0,0 +1,48 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package flow_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/gardener/pkg/utils/flow"
+)
+
+var _ = Describe("RetryPolicy", func() {
+	Describe("#IntervalAfter", func() {
+		It("should keep the interval constant without a factor", func() {
+			policy := flow.RetryPolicy{Interval: time.Second}
+
+			Expect(policy.IntervalAfter(1)).To(Equal(time.Second))
+			Expect(policy.IntervalAfter(5)).To(Equal(time.Second))
+		})
+
+		It("should grow the interval by the factor after each failed attempt", func() {
+			policy := flow.RetryPolicy{Interval: time.Second, Factor: 2}
+
+			Expect(policy.IntervalAfter(1)).To(Equal(time.Second))
+			Expect(policy.IntervalAfter(2)).To(Equal(2 * time.Second))
+			Expect(policy.IntervalAfter(3)).To(Equal(4 * time.Second))
+			Expect(policy.IntervalAfter(4)).To(Equal(8 * time.Second))
+		})
+
+		It("should cap the interval at the maximum interval", func() {
+			policy := flow.RetryPolicy{Interval: time.Second, Factor: 3, MaxInterval: 5 * time.Second}
+
+			Expect(policy.IntervalAfter(2)).To(Equal(3 * time.Second))
+			Expect(policy.IntervalAfter(3)).To(Equal(5 * time.Second))
+			Expect(policy.IntervalAfter(100)).To(Equal(5 * time.Second))
+		})
+
+		It("should not overflow for many failed attempts", func() {
+			policy := flow.RetryPolicy{Interval: time.Second, Factor: 10}
+
+			Expect(policy.IntervalAfter(1000)).To(BeNumerically(">", 0))
+		})
+	})
+})
@@ -6,6 +6,7 @@ package flow
 
 import (
 	"context"
+	"fmt"
 	"sync"
 	"time"
 
@@ -59,16 +60,33 @@ func ReportRetry(ctx context.Context, err error) {
 	v.reporter.ReportRetry(ctx, v.id, err)
 }
 
-// RetryUntilTimeout returns a TaskFn that is retried until the timeout is reached.
+// Retry returns a TaskFn that is retried with a fixed interval until it succeeds or the context is done.
 // On each failed attempt, ReportRetry is called automatically so that progress reporters
 // can surface the last error without any additional wiring.
-func (t TaskFn) RetryUntilTimeout(interval, timeout time.Duration) TaskFn {
+func (t TaskFn) Retry(interval time.Duration) TaskFn {
+	return t.RetryWithPolicy(RetryPolicy{Interval: interval})
+}
+
+// RetryWithPolicy returns a TaskFn that is retried according to the given policy until it succeeds, the maximum number
+// of attempts is reached, or the context is done.
+// On each failed attempt which is retried, ReportRetry is called automatically so that progress reporters
+// can surface the last error without any additional wiring.
+func (t TaskFn) RetryWithPolicy(policy RetryPolicy) TaskFn {
 	return func(ctx context.Context) error {
-		ctx, cancel := context.WithTimeout(ctx, timeout)
-		defer cancel()
+		var (
+			failedAttempts int
+			waitFunc       = func(ctx context.Context) (context.Context, context.CancelFunc) {
+				return context.WithTimeout(ctx, policy.IntervalAfter(failedAttempts))
+			}
+		)
 
-		return retry.Until(ctx, interval, func(ctx context.Context) (done bool, err error) {
+		return retry.UntilFor(ctx, waitFunc, retry.NewLastErrorAggregator(), func(ctx context.Context) (done bool, err error) {
 			if err := t(ctx); err != nil {
+				failedAttempts++
+				if policy.MaxAttempts > 0 && failedAttempts >= policy.MaxAttempts {
+					return retry.SevereError(fmt.Errorf("giving up after %d attempt(s): %w", failedAttempts, err))
+				}
+
 				ReportRetry(ctx, err)
 				return retry.MinorError(err)
 			}
@@ -77,6 +95,13 @@ func (t TaskFn) RetryUntilTimeout(interval, timeout time.Duration) TaskFn {
 	}
 }
 
+// RetryUntilTimeout returns a TaskFn that is retried until the timeout is reached.
+// On each failed attempt, ReportRetry is called automatically so that progress reporters
+// can surface the last error without any additional wiring.
+func (t TaskFn) RetryUntilTimeout(interval, timeout time.Duration) TaskFn {
+	return t.Retry(interval).Timeout(timeout)
+}
+
 // ToRecoverFn converts the TaskFn to a RecoverFn that ignores the incoming error.
 func (t TaskFn) ToRecoverFn() RecoverFn {
 	return func(ctx context.Context, _ error) error {
@@ -8,6 +8,7 @@ import (
 	"context"
 	"errors"
 	"sync"
+	"time"
 
 	"github.com/hashicorp/go-multierror"
 	. "github.com/onsi/ginkgo/v2"
@@ -83,6 +84,33 @@ var _ = Describe("task functions", func() {
 		})
 	})
 
+	Describe("#RetryWithPolicy", func() {
+		It("should retry until the function succeeds", func() {
+			var attempts int
+			fn := flow.TaskFn(func(_ context.Context) error {
+				attempts++
+				if attempts < 3 {
+					return errors.New("fail")
+				}
+				return nil
+			}).RetryWithPolicy(flow.RetryPolicy{Interval: time.Millisecond, MaxAttempts: 3})
+
+			Expect(fn(context.Background())).To(Succeed())
+			Expect(attempts).To(Equal(3))
+		})
+
+		It("should stop after the maximum number of attempts", func() {
+			var attempts int
+			fn := flow.TaskFn(func(_ context.Context) error {
+				attempts++
+				return errors.New("fail")
+			}).RetryWithPolicy(flow.RetryPolicy{Interval: time.Millisecond, MaxAttempts: 2})
+
+			Expect(fn(context.Background())).To(MatchError(ContainSubstring("giving up after 2 attempt(s): fail")))
+			Expect(attempts).To(Equal(2))
+		})
+	})
+
 	Describe("#ParallelExitOnError", func() {
 		It("should execute the functions in parallel", func() {
 			var (
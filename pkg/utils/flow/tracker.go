@@ -0,0 +1,129 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package flow
+
+import (
+	"cmp"
+	"encoding/json"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TaskPhase is the phase of a task in a running Flow execution.
+type TaskPhase string
+
+const (
+	// TaskPhasePending is the phase of tasks which have not been started yet.
+	TaskPhasePending TaskPhase = "Pending"
+	// TaskPhaseRunning is the phase of tasks which are currently running.
+	TaskPhaseRunning TaskPhase = "Running"
+	// TaskPhaseSucceeded is the phase of tasks which have completed successfully.
+	TaskPhaseSucceeded TaskPhase = "Succeeded"
+	// TaskPhaseFailed is the phase of tasks which have failed.
+	TaskPhaseFailed TaskPhase = "Failed"
+	// TaskPhaseSkipped is the phase of tasks which are skipped.
+	TaskPhaseSkipped TaskPhase = "Skipped"
+)
+
+// ExecutionState is the state of a running Flow execution.
+type ExecutionState struct {
+	// FlowName is the name of the Flow.
+	FlowName string `json:"flowName"`
+	// Key identifies the execution, see Opts.TrackingKey.
+	Key string `json:"key,omitempty"`
+	// StartTime is the time when the execution was started.
+	StartTime time.Time `json:"startTime"`
+	// ProgressPercent is the progress of the execution in percent.
+	ProgressPercent int32 `json:"progressPercent"`
+	// Tasks are the states of the tasks of the Flow, sorted by their IDs.
+	Tasks []TaskState `json:"tasks"`
+}
+
+// TaskState is the state of a task in a running Flow execution.
+type TaskState struct {
+	// ID is the ID of the task.
+	ID TaskID `json:"id"`
+	// Phase is the phase of the task.
+	Phase TaskPhase `json:"phase"`
+	// StartTime is the time when the task was started.
+	StartTime *time.Time `json:"startTime,omitempty"`
+	// Duration is the duration of the task. It is only set for tasks which have completed.
+	Duration *metav1.Duration `json:"duration,omitempty"`
+}
+
+// DefaultTracker is the Tracker which is used by Flow executions if Opts.Tracker is not set.
+var DefaultTracker = NewTracker()
+
+// Tracker keeps track of the state of running Flow executions. It serves them as JSON via HTTP, so that it can be used
+// as debugging endpoint.
+type Tracker struct {
+	lock       sync.RWMutex
+	nextID     uint64
+	executions map[uint64]ExecutionState
+}
+
+// NewTracker returns a new Tracker.
+func NewTracker() *Tracker {
+	return &Tracker{executions: make(map[uint64]ExecutionState)}
+}
+
+// List returns the states of all running Flow executions sorted by flow name, key and start time.
+func (t *Tracker) List() []ExecutionState {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	states := make([]ExecutionState, 0, len(t.executions))
+	for _, state := range t.executions {
+		states = append(states, state)
+	}
+
+	slices.SortFunc(states, func(a, b ExecutionState) int {
+		return cmp.Or(
+			cmp.Compare(a.FlowName, b.FlowName),
+			cmp.Compare(a.Key, b.Key),
+			a.StartTime.Compare(b.StartTime),
+		)
+	})
+
+	return states
+}
+
+// ServeHTTP writes the states of all running Flow executions as JSON.
+func (t *Tracker) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	data, err := json.MarshalIndent(t.List(), "", "  ")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(data)
+}
+
+func (t *Tracker) register() uint64 {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.nextID++
+	return t.nextID
+}
+
+func (t *Tracker) update(id uint64, state ExecutionState) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.executions[id] = state
+}
+
+func (t *Tracker) unregister(id uint64) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	delete(t.executions, id)
+}
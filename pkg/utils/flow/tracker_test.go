@@ -0,0 +1,96 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package flow_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/gardener/pkg/utils/flow"
+)
+
+var _ = Describe("Tracker", func() {
+	var (
+		ctx     = context.Background()
+		tracker *flow.Tracker
+
+		release chan struct{}
+		done    chan error
+	)
+
+	BeforeEach(func() {
+		tracker = flow.NewTracker()
+		release = make(chan struct{})
+		done = make(chan error)
+
+		var (
+			g = flow.NewGraph("foo")
+			x = g.Add(flow.Task{Name: "x", Fn: func(context.Context) error { return nil }})
+			y = g.Add(flow.Task{Name: "y", Fn: func(context.Context) error { <-release; return nil }, Dependencies: flow.NewTaskIDs(x)})
+			_ = g.Add(flow.Task{Name: "z", Fn: func(context.Context) error { return nil }, Dependencies: flow.NewTaskIDs(y)})
+			_ = g.Add(flow.Task{Name: "w", SkipIf: true})
+			f = g.Compile()
+		)
+
+		go func() {
+			done <- f.Run(ctx, flow.Opts{Tracker: tracker, TrackingKey: "garden-foo/bar"})
+		}()
+	})
+
+	AfterEach(func() {
+		select {
+		case <-release:
+		default:
+			close(release)
+		}
+		Eventually(done).Should(Receive(BeNil()))
+	})
+
+	Describe("#List", func() {
+		It("should return the state of running executions", func() {
+			Eventually(func(g Gomega) {
+				states := tracker.List()
+				g.Expect(states).To(HaveLen(1))
+				g.Expect(states[0].FlowName).To(Equal("foo"))
+				g.Expect(states[0].Key).To(Equal("garden-foo/bar"))
+				g.Expect(states[0].ProgressPercent).To(Equal(int32(33)))
+				g.Expect(states[0].Tasks).To(HaveExactElements(
+					And(HaveField("ID", flow.TaskID("w")), HaveField("Phase", flow.TaskPhaseSkipped), HaveField("StartTime", BeNil())),
+					And(HaveField("ID", flow.TaskID("x")), HaveField("Phase", flow.TaskPhaseSucceeded), HaveField("StartTime", Not(BeNil())), HaveField("Duration", Not(BeNil()))),
+					And(HaveField("ID", flow.TaskID("y")), HaveField("Phase", flow.TaskPhaseRunning), HaveField("StartTime", Not(BeNil())), HaveField("Duration", BeNil())),
+					And(HaveField("ID", flow.TaskID("z")), HaveField("Phase", flow.TaskPhasePending), HaveField("StartTime", BeNil())),
+				))
+			}).Should(Succeed())
+		})
+
+		It("should remove executions once they have finished", func() {
+			Eventually(tracker.List).Should(HaveLen(1))
+
+			close(release)
+			Eventually(tracker.List).Should(BeEmpty())
+		})
+	})
+
+	Describe("#ServeHTTP", func() {
+		It("should serve the state of running executions as JSON", func() {
+			Eventually(tracker.List).Should(HaveLen(1))
+
+			recorder := httptest.NewRecorder()
+			tracker.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/debug/flows", nil))
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(recorder.Header().Get("Content-Type")).To(Equal("application/json"))
+
+			var states []flow.ExecutionState
+			Expect(json.Unmarshal(recorder.Body.Bytes(), &states)).To(Succeed())
+			Expect(states).To(ConsistOf(HaveField("Key", "garden-foo/bar")))
+		})
+	})
+})
@@ -5,7 +5,9 @@
 package gardener
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"strings"
 
 	"k8s.io/apimachinery/pkg/types"
@@ -57,3 +59,24 @@ func GetBackupEntrySeedNames(obj client.Object) (*string, *string) {
 	}
 	return backupEntry.Spec.SeedName, backupEntry.Status.SeedName
 }
+
+// GetBackupEntryOfShoot returns the BackupEntry of the Shoot with the given namespace and name. BackupEntries which are
+// being deleted (e.g., belonging to a previous Shoot with the same name) and source BackupEntries are not considered.
+func GetBackupEntryOfShoot(ctx context.Context, reader client.Reader, namespace, shootName string) (*gardencorev1beta1.BackupEntry, error) {
+	backupEntryList := &gardencorev1beta1.BackupEntryList{}
+	if err := reader.List(ctx, backupEntryList, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed listing BackupEntries in namespace %s: %w", namespace, err)
+	}
+
+	for _, backupEntry := range backupEntryList.Items {
+		if backupEntry.DeletionTimestamp != nil || strings.HasPrefix(backupEntry.Name, v1beta1constants.BackupSourcePrefix+"-") {
+			continue
+		}
+
+		if GetShootNameFromOwnerReferences(&backupEntry) == shootName {
+			return &backupEntry, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no BackupEntry found for shoot %s/%s", namespace, shootName)
+}
@@ -5,12 +5,18 @@
 package gardener_test
 
 import (
+	"context"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
 	. "github.com/gardener/gardener/pkg/utils/gardener"
 )
 
@@ -81,4 +87,41 @@ var _ = Describe("BackupEntry", func() {
 			Expect(statusSeedName).To(Equal(new("status")))
 		})
 	})
+
+	Describe("#GetBackupEntryOfShoot", func() {
+		var (
+			ctx        = context.TODO()
+			fakeClient client.Client
+		)
+
+		BeforeEach(func() {
+			fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+		})
+
+		newBackupEntry := func(name, shootName string) *gardencorev1beta1.BackupEntry {
+			return &gardencorev1beta1.BackupEntry{ObjectMeta: metav1.ObjectMeta{
+				Name:            name,
+				Namespace:       "garden-foo",
+				OwnerReferences: []metav1.OwnerReference{{APIVersion: "core.gardener.cloud/v1beta1", Kind: "Shoot", Name: shootName, UID: "1234"}},
+			}}
+		}
+
+		It("should return the BackupEntry owned by the shoot", func() {
+			Expect(fakeClient.Create(ctx, newBackupEntry(sourceBackupEntryName, "bar"))).To(Succeed())
+			Expect(fakeClient.Create(ctx, newBackupEntry("shoot--foo--baz--1234", "baz"))).To(Succeed())
+			Expect(fakeClient.Create(ctx, newBackupEntry(backupEntryName, "bar"))).To(Succeed())
+
+			Expect(GetBackupEntryOfShoot(ctx, fakeClient, "garden-foo", "bar")).To(HaveField("Name", backupEntryName))
+		})
+
+		It("should ignore BackupEntries which are being deleted", func() {
+			backupEntry := newBackupEntry(backupEntryName, "bar")
+			backupEntry.Finalizers = []string{"gardener"}
+			Expect(fakeClient.Create(ctx, backupEntry)).To(Succeed())
+			Expect(fakeClient.Delete(ctx, backupEntry)).To(Succeed())
+
+			_, err := GetBackupEntryOfShoot(ctx, fakeClient, "garden-foo", "bar")
+			Expect(err).To(MatchError("no BackupEntry found for shoot garden-foo/bar"))
+		})
+	})
 })
@@ -218,6 +218,8 @@ const (
 	ShootProjectConfigMapSuffixCACluster = "ca-cluster"
 	// ShootProjectConfigMapSuffixCAKubelet is a constant for a shoot project secret with suffix 'ca-kubelet'.
 	ShootProjectConfigMapSuffixCAKubelet = "ca-kubelet"
+	// ShootProjectConfigMapSuffixPlan is a constant for a shoot project config map with suffix 'plan'.
+	ShootProjectConfigMapSuffixPlan = "plan"
 )
 
 // GetShootProjectSecretSuffixes returns the list of shoot-related project secret suffixes.
@@ -242,6 +244,7 @@ func GetShootProjectConfigMapSuffixes() []string {
 	return []string{
 		ShootProjectConfigMapSuffixCACluster,
 		ShootProjectConfigMapSuffixCAKubelet,
+		ShootProjectConfigMapSuffixPlan,
 	}
 }
 
@@ -427,6 +427,7 @@ var _ = Describe("Shoot", func() {
 		Entry("wrong suffix delimiter", "foo:kubeconfig", "", false),
 		Entry("ca-cluster suffix", "baz.ca-cluster", "baz", true),
 		Entry("ca-kubelet suffix", "baz.ca-kubelet", "baz", true),
+		Entry("plan suffix", "baz.plan", "baz", true),
 	)
 
 	Describe("#NewShootAccessSecret", func() {
@@ -0,0 +1,235 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shootstate
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	securityv1alpha1 "github.com/gardener/gardener/pkg/apis/security/v1alpha1"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+// ExportVersion is the version of the export format written by ExportShoot.
+const ExportVersion = "v1"
+
+// Export contains all resources which are required to import a shoot into another garden landscape and to restore it
+// there from its ShootState and etcd backups.
+type Export struct {
+	// Version is the version of the export format.
+	Version string `json:"version"`
+	// Shoot is the exported shoot including its status.
+	Shoot *gardencorev1beta1.Shoot `json:"shoot"`
+	// ShootState is the ShootState persisted while the shoot was exported.
+	ShootState *gardencorev1beta1.ShootState `json:"shootState"`
+	// SecretBinding is the SecretBinding referenced by the shoot, if any.
+	SecretBinding *gardencorev1beta1.SecretBinding `json:"secretBinding,omitempty"`
+	// CredentialsBinding is the CredentialsBinding referenced by the shoot, if any.
+	CredentialsBinding *securityv1alpha1.CredentialsBinding `json:"credentialsBinding,omitempty"`
+	// BackupEntry is the BackupEntry of the shoot pointing to the bucket containing the etcd backups.
+	BackupEntry *gardencorev1beta1.BackupEntry `json:"backupEntry"`
+	// BackupBucket is the BackupBucket referenced by the BackupEntry.
+	BackupBucket *gardencorev1beta1.BackupBucket `json:"backupBucket"`
+	// Secrets are the secrets referenced by the binding, by the BackupBucket and in the shoot's `.spec.resources`.
+	Secrets []corev1.Secret `json:"secrets,omitempty"`
+}
+
+// ExportShoot reads all resources required for importing the given shoot into another garden landscape. The shoot must
+// have been exported with the `export` operation before, i.e., its control plane must have been removed from the seed
+// and its ShootState must have been persisted.
+func ExportShoot(ctx context.Context, reader client.Reader, key client.ObjectKey) (*Export, error) {
+	shoot := &gardencorev1beta1.Shoot{}
+	if err := reader.Get(ctx, key, shoot); err != nil {
+		return nil, fmt.Errorf("failed reading shoot %s: %w", key, err)
+	}
+
+	if !v1beta1helper.IsShootExported(shoot) ||
+		!v1beta1helper.ShootHasOperationType(shoot.Status.LastOperation, gardencorev1beta1.LastOperationTypeMigrate) ||
+		shoot.Status.LastOperation.State != gardencorev1beta1.LastOperationStateSucceeded {
+		return nil, fmt.Errorf("shoot %s was not exported, annotate it with %s=%s and wait until the operation succeeded", key, v1beta1constants.GardenerOperation, v1beta1constants.ShootOperationExport)
+	}
+
+	export := &Export{Version: ExportVersion, Shoot: shoot, ShootState: &gardencorev1beta1.ShootState{}}
+
+	if err := reader.Get(ctx, key, export.ShootState); err != nil {
+		return nil, fmt.Errorf("failed reading ShootState %s: %w", key, err)
+	}
+
+	var secretRefs []corev1.SecretReference
+
+	switch {
+	case shoot.Spec.SecretBindingName != nil:
+		export.SecretBinding = &gardencorev1beta1.SecretBinding{}
+		if err := reader.Get(ctx, client.ObjectKey{Namespace: shoot.Namespace, Name: *shoot.Spec.SecretBindingName}, export.SecretBinding); err != nil {
+			return nil, fmt.Errorf("failed reading SecretBinding %s: %w", *shoot.Spec.SecretBindingName, err)
+		}
+		secretRefs = append(secretRefs, export.SecretBinding.SecretRef)
+
+	case shoot.Spec.CredentialsBindingName != nil:
+		export.CredentialsBinding = &securityv1alpha1.CredentialsBinding{}
+		if err := reader.Get(ctx, client.ObjectKey{Namespace: shoot.Namespace, Name: *shoot.Spec.CredentialsBindingName}, export.CredentialsBinding); err != nil {
+			return nil, fmt.Errorf("failed reading CredentialsBinding %s: %w", *shoot.Spec.CredentialsBindingName, err)
+		}
+		secretRef, err := secretReferenceFromObjectReference(export.CredentialsBinding.CredentialsRef)
+		if err != nil {
+			return nil, fmt.Errorf("unsupported credentials of CredentialsBinding %s: %w", export.CredentialsBinding.Name, err)
+		}
+		secretRefs = append(secretRefs, secretRef)
+	}
+
+	for _, resource := range shoot.Spec.Resources {
+		if resource.ResourceRef.APIVersion == "v1" && resource.ResourceRef.Kind == "Secret" {
+			secretRefs = append(secretRefs, corev1.SecretReference{Namespace: shoot.Namespace, Name: resource.ResourceRef.Name})
+		}
+	}
+
+	backupEntry, err := gardenerutils.GetBackupEntryOfShoot(ctx, reader, shoot.Namespace, shoot.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed reading BackupEntry of shoot %s: %w", key, err)
+	}
+	export.BackupEntry = backupEntry
+
+	export.BackupBucket = &gardencorev1beta1.BackupBucket{}
+	if err := reader.Get(ctx, client.ObjectKey{Name: backupEntry.Spec.BucketName}, export.BackupBucket); err != nil {
+		return nil, fmt.Errorf("failed reading BackupBucket %s: %w", backupEntry.Spec.BucketName, err)
+	}
+	if export.BackupBucket.Spec.CredentialsRef != nil {
+		secretRef, err := secretReferenceFromObjectReference(*export.BackupBucket.Spec.CredentialsRef)
+		if err != nil {
+			return nil, fmt.Errorf("unsupported credentials of BackupBucket %s: %w", export.BackupBucket.Name, err)
+		}
+		secretRefs = append(secretRefs, secretRef)
+	}
+
+	for _, secretRef := range secretRefs {
+		secret := corev1.Secret{}
+		if err := reader.Get(ctx, client.ObjectKey{Namespace: secretRef.Namespace, Name: secretRef.Name}, &secret); err != nil {
+			return nil, fmt.Errorf("failed reading secret %s/%s: %w", secretRef.Namespace, secretRef.Name, err)
+		}
+		resetObject(&secret)
+		export.Secrets = append(export.Secrets, secret)
+	}
+
+	for _, obj := range []client.Object{export.Shoot, export.ShootState, export.BackupEntry, export.BackupBucket} {
+		resetObject(obj)
+	}
+	if export.SecretBinding != nil {
+		resetObject(export.SecretBinding)
+	}
+	if export.CredentialsBinding != nil {
+		resetObject(export.CredentialsBinding)
+	}
+	export.BackupEntry.Status = gardencorev1beta1.BackupEntryStatus{}
+	export.BackupBucket.Status = gardencorev1beta1.BackupBucketStatus{}
+
+	return export, nil
+}
+
+// ImportShoot creates the resources of the given export in the garden cluster and schedules the shoot to the given
+// seed. The shoot is created with the status it had when it was exported, i.e., the gardenlet restores its control plane
+// from the imported ShootState and copies the etcd backups from the bucket of the exported BackupEntry.
+// The BackupBucket is imported with the imported annotation since its bucket is still managed by the source garden, i.e.,
+// it is not reconciled and deleting it does not delete the bucket.
+// Resources which already exist are not changed, hence the import can be retried.
+func ImportShoot(ctx context.Context, c client.Client, export *Export, seedName string) error {
+	if export.Version != ExportVersion {
+		return fmt.Errorf("unsupported export version %q, expected %q", export.Version, ExportVersion)
+	}
+	if export.Shoot == nil || export.ShootState == nil || export.BackupEntry == nil || export.BackupBucket == nil {
+		return fmt.Errorf("export is incomplete, it must contain the Shoot, ShootState, BackupEntry and BackupBucket")
+	}
+
+	var objects []client.Object
+	for i := range export.Secrets {
+		objects = append(objects, export.Secrets[i].DeepCopy())
+	}
+	if export.SecretBinding != nil {
+		objects = append(objects, export.SecretBinding.DeepCopy())
+	}
+	if export.CredentialsBinding != nil {
+		objects = append(objects, export.CredentialsBinding.DeepCopy())
+	}
+
+	backupBucket := export.BackupBucket.DeepCopy()
+	backupBucket.Spec.SeedName = &seedName
+	metav1.SetMetaDataAnnotation(&backupBucket.ObjectMeta, v1beta1constants.BackupBucketImported, "true")
+	backupEntry := export.BackupEntry.DeepCopy()
+	backupEntry.Spec.SeedName = &seedName
+	objects = append(objects, backupBucket, backupEntry, export.ShootState.DeepCopy())
+
+	for _, obj := range objects {
+		if err := c.Create(ctx, obj); client.IgnoreAlreadyExists(err) != nil {
+			return fmt.Errorf("failed creating %T %s: %w", obj, client.ObjectKeyFromObject(obj), err)
+		}
+	}
+
+	// The shoot is created with the exported annotation so that the gardenlet does not act on it before its status has
+	// been restored.
+	shoot := export.Shoot.DeepCopy()
+	shoot.Status = gardencorev1beta1.ShootStatus{}
+	shoot.Spec.SeedName = &seedName
+	metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.ShootExported, "true")
+	if err := c.Create(ctx, shoot); err != nil {
+		if !apierrors.IsAlreadyExists(err) {
+			return fmt.Errorf("failed creating shoot %s: %w", client.ObjectKeyFromObject(shoot), err)
+		}
+		if err := c.Get(ctx, client.ObjectKeyFromObject(shoot), shoot); err != nil {
+			return fmt.Errorf("failed reading shoot %s: %w", client.ObjectKeyFromObject(shoot), err)
+		}
+	}
+
+	if !v1beta1helper.IsShootExported(shoot) {
+		// The shoot was already handed over to the gardenlet by a previous import.
+		return nil
+	}
+
+	statusPatch := client.MergeFrom(shoot.DeepCopy())
+	shoot.Status = *export.Shoot.Status.DeepCopy()
+	shoot.Status.SeedName = &seedName
+	shoot.Status.ObservedGeneration = 0
+	shoot.Status.Conditions = nil
+	shoot.Status.Constraints = nil
+	shoot.Status.LastErrors = nil
+	if err := c.Status().Patch(ctx, shoot, statusPatch); err != nil {
+		return fmt.Errorf("failed restoring status of shoot %s: %w", client.ObjectKeyFromObject(shoot), err)
+	}
+
+	// Removing the exported annotation and requesting a reconciliation increases the generation of the shoot. Since its
+	// last operation is a succeeded migration, the gardenlet of the seed restores the shoot.
+	patch := client.MergeFrom(shoot.DeepCopy())
+	delete(shoot.Annotations, v1beta1constants.ShootExported)
+	metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationReconcile)
+	if err := c.Patch(ctx, shoot, patch); err != nil {
+		return fmt.Errorf("failed triggering restoration of shoot %s: %w", client.ObjectKeyFromObject(shoot), err)
+	}
+
+	return nil
+}
+
+func secretReferenceFromObjectReference(ref corev1.ObjectReference) (corev1.SecretReference, error) {
+	if ref.APIVersion != "v1" || ref.Kind != "Secret" {
+		return corev1.SecretReference{}, fmt.Errorf("only secrets can be exported, got %s %s", ref.APIVersion, ref.Kind)
+	}
+	return corev1.SecretReference{Namespace: ref.Namespace, Name: ref.Name}, nil
+}
+
+func resetObject(obj client.Object) {
+	obj.SetCreationTimestamp(metav1.Time{})
+	obj.SetFinalizers(nil)
+	obj.SetGeneration(0)
+	obj.SetOwnerReferences(nil)
+	obj.SetManagedFields(nil)
+	obj.SetResourceVersion("")
+	obj.SetSelfLink("")
+	obj.SetUID("")
+}
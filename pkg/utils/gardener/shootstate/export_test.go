@@ -0,0 +1,226 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shootstate_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/utils/gardener/shootstate"
+)
+
+var _ = Describe("Export", func() {
+	var (
+		ctx = context.TODO()
+
+		sourceClient client.Client
+		targetClient client.Client
+
+		shoot              *gardencorev1beta1.Shoot
+		shootState         *gardencorev1beta1.ShootState
+		secretBinding      *gardencorev1beta1.SecretBinding
+		credentialsSecret  *corev1.Secret
+		resourceSecret     *corev1.Secret
+		backupEntry        *gardencorev1beta1.BackupEntry
+		backupBucket       *gardencorev1beta1.BackupBucket
+		backupBucketSecret *corev1.Secret
+	)
+
+	BeforeEach(func() {
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "my-shoot",
+				Namespace:   "garden-my-project",
+				UID:         "shoot-uid",
+				Annotations: map[string]string{v1beta1constants.ShootExported: "true"},
+			},
+			Spec: gardencorev1beta1.ShootSpec{
+				SeedName:          new("old-seed"),
+				SecretBindingName: new("my-binding"),
+				Resources: []gardencorev1beta1.NamedResourceReference{{
+					Name:        "resource",
+					ResourceRef: autoscalingv1.CrossVersionObjectReference{APIVersion: "v1", Kind: "Secret", Name: "my-resource"},
+				}},
+			},
+			Status: gardencorev1beta1.ShootStatus{
+				UID:         "shoot-uid",
+				TechnicalID: "shoot--my-project--my-shoot",
+				SeedName:    new("old-seed"),
+				LastOperation: &gardencorev1beta1.LastOperation{
+					Type:  gardencorev1beta1.LastOperationTypeMigrate,
+					State: gardencorev1beta1.LastOperationStateSucceeded,
+				},
+				Conditions: []gardencorev1beta1.Condition{{Type: gardencorev1beta1.ShootAPIServerAvailable}},
+			},
+		}
+		shootState = &gardencorev1beta1.ShootState{
+			ObjectMeta: metav1.ObjectMeta{Name: shoot.Name, Namespace: shoot.Namespace},
+			Spec: gardencorev1beta1.ShootStateSpec{
+				Gardener: []gardencorev1beta1.GardenerResourceData{{Name: "ca", Type: "secret"}},
+			},
+		}
+		credentialsSecret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-credentials", Namespace: shoot.Namespace},
+			Data:       map[string][]byte{"key": []byte("value")},
+		}
+		secretBinding = &gardencorev1beta1.SecretBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-binding", Namespace: shoot.Namespace},
+			SecretRef:  corev1.SecretReference{Name: credentialsSecret.Name, Namespace: credentialsSecret.Namespace},
+		}
+		resourceSecret = &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "my-resource", Namespace: shoot.Namespace}}
+		backupBucketSecret = &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "backup", Namespace: "garden"}}
+		backupBucket = &gardencorev1beta1.BackupBucket{
+			ObjectMeta: metav1.ObjectMeta{Name: "old-seed-uid"},
+			Spec: gardencorev1beta1.BackupBucketSpec{
+				Provider:       gardencorev1beta1.BackupBucketProvider{Type: "local", Region: "local"},
+				SeedName:       new("old-seed"),
+				CredentialsRef: &corev1.ObjectReference{APIVersion: "v1", Kind: "Secret", Name: backupBucketSecret.Name, Namespace: backupBucketSecret.Namespace},
+			},
+		}
+		backupEntry = &gardencorev1beta1.BackupEntry{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "shoot--my-project--my-shoot--shoot-uid",
+				Namespace: shoot.Namespace,
+				OwnerReferences: []metav1.OwnerReference{{
+					APIVersion: "core.gardener.cloud/v1beta1",
+					Kind:       "Shoot",
+					Name:       shoot.Name,
+					UID:        shoot.UID,
+				}},
+			},
+			Spec: gardencorev1beta1.BackupEntrySpec{
+				BucketName: backupBucket.Name,
+				SeedName:   new("old-seed"),
+			},
+		}
+
+		sourceClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithObjects(
+			shoot, shootState, secretBinding, credentialsSecret, resourceSecret, backupEntry, backupBucket, backupBucketSecret,
+		).WithStatusSubresource(&gardencorev1beta1.Shoot{}).Build()
+		targetClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithStatusSubresource(&gardencorev1beta1.Shoot{}).Build()
+	})
+
+	Describe("#ExportShoot", func() {
+		It("should export all resources required for importing the shoot", func() {
+			export, err := ExportShoot(ctx, sourceClient, client.ObjectKeyFromObject(shoot))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(export.Version).To(Equal(ExportVersion))
+			Expect(export.Shoot.Name).To(Equal(shoot.Name))
+			Expect(export.Shoot.UID).To(BeEmpty())
+			Expect(export.Shoot.ResourceVersion).To(BeEmpty())
+			Expect(export.Shoot.Status.UID).To(Equal(shoot.Status.UID))
+			Expect(export.ShootState.Spec).To(Equal(shootState.Spec))
+			Expect(export.SecretBinding.Name).To(Equal(secretBinding.Name))
+			Expect(export.CredentialsBinding).To(BeNil())
+			Expect(export.BackupEntry.Name).To(Equal(backupEntry.Name))
+			Expect(export.BackupEntry.OwnerReferences).To(BeEmpty())
+			Expect(export.BackupBucket.Name).To(Equal(backupBucket.Name))
+
+			var secrets []string
+			for _, secret := range export.Secrets {
+				secrets = append(secrets, secret.Namespace+"/"+secret.Name)
+			}
+			Expect(secrets).To(ConsistOf(
+				"garden-my-project/my-credentials",
+				"garden-my-project/my-resource",
+				"garden/backup",
+			))
+		})
+
+		It("should fail if the shoot was not exported", func() {
+			patch := client.MergeFrom(shoot.DeepCopy())
+			delete(shoot.Annotations, v1beta1constants.ShootExported)
+			Expect(sourceClient.Patch(ctx, shoot, patch)).To(Succeed())
+
+			_, err := ExportShoot(ctx, sourceClient, client.ObjectKeyFromObject(shoot))
+			Expect(err).To(MatchError(ContainSubstring("was not exported")))
+		})
+
+		It("should fail if the export operation did not succeed yet", func() {
+			patch := client.MergeFrom(shoot.DeepCopy())
+			shoot.Status.LastOperation.State = gardencorev1beta1.LastOperationStateProcessing
+			Expect(sourceClient.Status().Patch(ctx, shoot, patch)).To(Succeed())
+
+			_, err := ExportShoot(ctx, sourceClient, client.ObjectKeyFromObject(shoot))
+			Expect(err).To(MatchError(ContainSubstring("was not exported")))
+		})
+
+		It("should fail if the BackupBucket uses credentials other than secrets", func() {
+			patch := client.MergeFrom(backupBucket.DeepCopy())
+			backupBucket.Spec.CredentialsRef = &corev1.ObjectReference{APIVersion: "security.gardener.cloud/v1alpha1", Kind: "WorkloadIdentity", Name: "backup", Namespace: "garden"}
+			Expect(sourceClient.Patch(ctx, backupBucket, patch)).To(Succeed())
+
+			_, err := ExportShoot(ctx, sourceClient, client.ObjectKeyFromObject(shoot))
+			Expect(err).To(MatchError(ContainSubstring("only secrets can be exported")))
+		})
+	})
+
+	Describe("#ImportShoot", func() {
+		var export *Export
+
+		BeforeEach(func() {
+			var err error
+			export, err = ExportShoot(ctx, sourceClient, client.ObjectKeyFromObject(shoot))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should create all resources and trigger the restoration of the shoot", func() {
+			Expect(ImportShoot(ctx, targetClient, export, "new-seed")).To(Succeed())
+
+			importedShoot := &gardencorev1beta1.Shoot{}
+			Expect(targetClient.Get(ctx, client.ObjectKeyFromObject(shoot), importedShoot)).To(Succeed())
+			Expect(importedShoot.Annotations).NotTo(HaveKey(v1beta1constants.ShootExported))
+			Expect(importedShoot.Annotations).To(HaveKeyWithValue(v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationReconcile))
+			Expect(importedShoot.Spec.SeedName).To(PointTo(Equal("new-seed")))
+			Expect(importedShoot.Status.SeedName).To(PointTo(Equal("new-seed")))
+			Expect(importedShoot.Status.UID).To(Equal(shoot.Status.UID))
+			Expect(importedShoot.Status.TechnicalID).To(Equal(shoot.Status.TechnicalID))
+			Expect(importedShoot.Status.LastOperation.Type).To(Equal(gardencorev1beta1.LastOperationTypeMigrate))
+			Expect(importedShoot.Status.LastOperation.State).To(Equal(gardencorev1beta1.LastOperationStateSucceeded))
+			Expect(importedShoot.Status.Conditions).To(BeEmpty())
+
+			importedBackupEntry := &gardencorev1beta1.BackupEntry{}
+			Expect(targetClient.Get(ctx, client.ObjectKeyFromObject(backupEntry), importedBackupEntry)).To(Succeed())
+			Expect(importedBackupEntry.Spec.BucketName).To(Equal(backupBucket.Name))
+			Expect(importedBackupEntry.Spec.SeedName).To(PointTo(Equal("new-seed")))
+
+			importedBackupBucket := &gardencorev1beta1.BackupBucket{}
+			Expect(targetClient.Get(ctx, client.ObjectKeyFromObject(backupBucket), importedBackupBucket)).To(Succeed())
+			Expect(importedBackupBucket.Spec.SeedName).To(PointTo(Equal("new-seed")))
+			Expect(importedBackupBucket.Annotations).To(HaveKeyWithValue("backupbucket.gardener.cloud/imported", "true"))
+
+			importedShootState := &gardencorev1beta1.ShootState{}
+			Expect(targetClient.Get(ctx, client.ObjectKeyFromObject(shootState), importedShootState)).To(Succeed())
+			Expect(importedShootState.Spec).To(Equal(shootState.Spec))
+
+			Expect(targetClient.Get(ctx, client.ObjectKeyFromObject(secretBinding), &gardencorev1beta1.SecretBinding{})).To(Succeed())
+			Expect(targetClient.Get(ctx, client.ObjectKeyFromObject(credentialsSecret), &corev1.Secret{})).To(Succeed())
+			Expect(targetClient.Get(ctx, client.ObjectKeyFromObject(resourceSecret), &corev1.Secret{})).To(Succeed())
+			Expect(targetClient.Get(ctx, client.ObjectKeyFromObject(backupBucketSecret), &corev1.Secret{})).To(Succeed())
+		})
+
+		It("should succeed if the import is retried", func() {
+			Expect(ImportShoot(ctx, targetClient, export, "new-seed")).To(Succeed())
+			Expect(ImportShoot(ctx, targetClient, export, "new-seed")).To(Succeed())
+		})
+
+		It("should fail for an unsupported export version", func() {
+			export.Version = "v0"
+			Expect(ImportShoot(ctx, targetClient, export, "new-seed")).To(MatchError(ContainSubstring("unsupported export version")))
+		})
+	})
+})
@@ -244,6 +244,12 @@ func (g *graph) HandleShootCreateOrUpdate(ctx context.Context, shoot *gardencore
 	shootStateVertex := g.getOrCreateVertex(VertexTypeShootState, shoot.Namespace, shoot.Name)
 	g.addEdge(shootStateVertex, shootVertex)
 
+	// Shoots cloned from a source shoot take over the ETCD encryption key from the ShootState of the source shoot.
+	if shoot.Spec.Source != nil {
+		sourceShootStateVertex := g.getOrCreateVertex(VertexTypeShootState, shoot.Spec.Source.Namespace, shoot.Spec.Source.Name)
+		g.addEdge(sourceShootStateVertex, shootVertex)
+	}
+
 	if v1beta1helper.HasManagedIssuer(shoot) {
 		namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: shoot.Namespace}}
 		if err := g.client.Get(ctx, client.ObjectKeyFromObject(namespace), namespace); err == nil {
@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package graph
+
+import (
+	"context"
+	"time"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	toolscache "k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+
+	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
+)
+
+func (g *graph) setupShootRestoreWatch(_ context.Context, informer cache.Informer) error {
+	_, err := informer.AddEventHandler(toolscache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj any) {
+			shootRestore, ok := obj.(*operationsv1alpha1.ShootRestore)
+			if !ok {
+				return
+			}
+			g.handleShootRestoreCreateOrUpdate(shootRestore)
+		},
+
+		UpdateFunc: func(oldObj, newObj any) {
+			oldShootRestore, ok := oldObj.(*operationsv1alpha1.ShootRestore)
+			if !ok {
+				return
+			}
+
+			newShootRestore, ok := newObj.(*operationsv1alpha1.ShootRestore)
+			if !ok {
+				return
+			}
+
+			if !apiequality.Semantic.DeepEqual(oldShootRestore.Spec.SeedName, newShootRestore.Spec.SeedName) {
+				g.handleShootRestoreCreateOrUpdate(newShootRestore)
+			}
+		},
+
+		DeleteFunc: func(obj any) {
+			if tombstone, ok := obj.(toolscache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			shootRestore, ok := obj.(*operationsv1alpha1.ShootRestore)
+			if !ok {
+				return
+			}
+			g.handleShootRestoreDelete(shootRestore)
+		},
+	})
+	return err
+}
+
+func (g *graph) handleShootRestoreCreateOrUpdate(shootRestore *operationsv1alpha1.ShootRestore) {
+	start := time.Now()
+	defer func() {
+		metricUpdateDuration.WithLabelValues("ShootRestore", "CreateOrUpdate").Observe(time.Since(start).Seconds())
+	}()
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	g.deleteAllOutgoingEdges(VertexTypeShootRestore, shootRestore.Namespace, shootRestore.Name, VertexTypeSeed)
+
+	if shootRestore.Spec.SeedName != nil {
+		var (
+			shootRestoreVertex = g.getOrCreateVertex(VertexTypeShootRestore, shootRestore.Namespace, shootRestore.Name)
+			seedVertex         = g.getOrCreateVertex(VertexTypeSeed, "", *shootRestore.Spec.SeedName)
+		)
+		g.addEdge(shootRestoreVertex, seedVertex)
+	}
+}
+
+func (g *graph) handleShootRestoreDelete(shootRestore *operationsv1alpha1.ShootRestore) {
+	start := time.Now()
+	defer func() {
+		metricUpdateDuration.WithLabelValues("ShootRestore", "Delete").Observe(time.Since(start).Seconds())
+	}()
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	g.deleteVertex(VertexTypeShootRestore, shootRestore.Namespace, shootRestore.Name)
+}
@@ -53,6 +53,7 @@ var _ = Describe("graph for seeds", func() {
 		fakeInformerBackupBucket              *controllertest.FakeInformer
 		fakeInformerBackupEntry               *controllertest.FakeInformer
 		fakeInformerBastion                   *controllertest.FakeInformer
+		fakeInformerShootRestore              *controllertest.FakeInformer
 		fakeInformerSecretBinding             *controllertest.FakeInformer
 		fakeInformerCredentialsBinding        *controllertest.FakeInformer
 		fakeInformerControllerInstallation    *controllertest.FakeInformer
@@ -112,6 +113,8 @@ var _ = Describe("graph for seeds", func() {
 
 		bastion1 *operationsv1alpha1.Bastion
 
+		shootRestore1 *operationsv1alpha1.ShootRestore
+
 		secretBinding1          *gardencorev1beta1.SecretBinding
 		secretBinding1SecretRef = corev1.SecretReference{Namespace: "foobar", Name: "bazfoo"}
 
@@ -154,6 +157,7 @@ var _ = Describe("graph for seeds", func() {
 		fakeInformerBackupBucket = &controllertest.FakeInformer{}
 		fakeInformerBackupEntry = &controllertest.FakeInformer{}
 		fakeInformerBastion = &controllertest.FakeInformer{}
+		fakeInformerShootRestore = &controllertest.FakeInformer{}
 		fakeInformerSecretBinding = &controllertest.FakeInformer{}
 		fakeInformerCredentialsBinding = &controllertest.FakeInformer{}
 		fakeInformerControllerInstallation = &controllertest.FakeInformer{}
@@ -172,6 +176,7 @@ var _ = Describe("graph for seeds", func() {
 				gardencorev1beta1.SchemeGroupVersion.WithKind("BackupBucket"):           fakeInformerBackupBucket,
 				gardencorev1beta1.SchemeGroupVersion.WithKind("BackupEntry"):            fakeInformerBackupEntry,
 				operationsv1alpha1.SchemeGroupVersion.WithKind("Bastion"):               fakeInformerBastion,
+				operationsv1alpha1.SchemeGroupVersion.WithKind("ShootRestore"):          fakeInformerShootRestore,
 				gardencorev1beta1.SchemeGroupVersion.WithKind("SecretBinding"):          fakeInformerSecretBinding,
 				gardencorev1beta1.SchemeGroupVersion.WithKind("ControllerInstallation"): fakeInformerControllerInstallation,
 				gardencorev1.SchemeGroupVersion.WithKind("ControllerDeployment"):        fakeInformerControllerDeployment,
@@ -316,6 +321,13 @@ var _ = Describe("graph for seeds", func() {
 			},
 		}
 
+		shootRestore1 = &operationsv1alpha1.ShootRestore{
+			ObjectMeta: metav1.ObjectMeta{Name: "shootrestore1", Namespace: "shootrestore1namespace"},
+			Spec: operationsv1alpha1.ShootRestoreSpec{
+				SeedName: &seed1.Name,
+			},
+		}
+
 		secretBinding1 = &gardencorev1beta1.SecretBinding{
 			ObjectMeta: metav1.ObjectMeta{Name: "secretbinding1", Namespace: "sb1namespace"},
 			SecretRef:  secretBinding1SecretRef,
@@ -1262,6 +1274,21 @@ yO57qEcJqG1cB7iSchFuCSTuDBbZlN0fXgn4YjiWZyb4l3BDp3rm4iJImA==
 		Expect(graph.HasPathFrom(VertexTypeCredentialsBinding, shoot1.Namespace, *shoot1.Spec.CredentialsBindingName, VertexTypeShoot, shoot1.Namespace, shoot1.Name)).To(BeTrue())
 	})
 
+	It("should add an edge from the ShootState of the source shoot for cloned gardencorev1beta1.Shoots", func() {
+		shoot1.Spec.Source = &gardencorev1beta1.ShootSource{Namespace: "source-namespace", Name: "source"}
+
+		By("Add")
+		fakeInformerShoot.Add(shoot1)
+		Expect(graph.HasPathFrom(VertexTypeShootState, shoot1.Namespace, shoot1.Name, VertexTypeShoot, shoot1.Namespace, shoot1.Name)).To(BeTrue())
+		Expect(graph.HasPathFrom(VertexTypeShootState, "source-namespace", "source", VertexTypeShoot, shoot1.Namespace, shoot1.Name)).To(BeTrue())
+		Expect(graph.HasPathFrom(VertexTypeShootState, "source-namespace", "source", VertexTypeSeed, "", seed1.Name)).To(BeTrue())
+
+		By("Delete")
+		fakeInformerShoot.Delete(shoot1)
+		Expect(graph.HasPathFrom(VertexTypeShootState, "source-namespace", "source", VertexTypeShoot, shoot1.Namespace, shoot1.Name)).To(BeFalse())
+		Expect(graph.HasPathFrom(VertexTypeShootState, "source-namespace", "source", VertexTypeSeed, "", seed1.Name)).To(BeFalse())
+	})
+
 	It("should behave as expected for gardencorev1beta1.Project", func() {
 		By("Add")
 		fakeInformerProject.Add(project1)
@@ -1476,6 +1503,37 @@ yO57qEcJqG1cB7iSchFuCSTuDBbZlN0fXgn4YjiWZyb4l3BDp3rm4iJImA==
 		Expect(graph.HasPathFrom(VertexTypeBastion, bastion1.Namespace, bastion1.Name, VertexTypeSeed, "", *bastion1.Spec.SeedName)).To(BeFalse())
 	})
 
+	It("should behave as expected for operationsv1alpha1.ShootRestore", func() {
+		By("Add")
+		fakeInformerShootRestore.Add(shootRestore1)
+		Expect(graph.graph.Nodes().Len()).To(Equal(2))
+		Expect(graph.graph.Edges().Len()).To(Equal(1))
+		Expect(graph.HasPathFrom(VertexTypeShootRestore, shootRestore1.Namespace, shootRestore1.Name, VertexTypeSeed, "", *shootRestore1.Spec.SeedName)).To(BeTrue())
+
+		By("Update (irrelevant change)")
+		shootRestore1Copy := shootRestore1.DeepCopy()
+		shootRestore1.Labels = map[string]string{"foo": "bar"}
+		fakeInformerShootRestore.Update(shootRestore1Copy, shootRestore1)
+		Expect(graph.graph.Nodes().Len()).To(Equal(2))
+		Expect(graph.graph.Edges().Len()).To(Equal(1))
+		Expect(graph.HasPathFrom(VertexTypeShootRestore, shootRestore1.Namespace, shootRestore1.Name, VertexTypeSeed, "", *shootRestore1.Spec.SeedName)).To(BeTrue())
+
+		By("Update (seed name)")
+		shootRestore1Copy = shootRestore1.DeepCopy()
+		shootRestore1.Spec.SeedName = new("newseed")
+		fakeInformerShootRestore.Update(shootRestore1Copy, shootRestore1)
+		Expect(graph.graph.Nodes().Len()).To(Equal(2))
+		Expect(graph.graph.Edges().Len()).To(Equal(1))
+		Expect(graph.HasPathFrom(VertexTypeShootRestore, shootRestore1.Namespace, shootRestore1.Name, VertexTypeSeed, "", *shootRestore1Copy.Spec.SeedName)).To(BeFalse())
+		Expect(graph.HasPathFrom(VertexTypeShootRestore, shootRestore1.Namespace, shootRestore1.Name, VertexTypeSeed, "", *shootRestore1.Spec.SeedName)).To(BeTrue())
+
+		By("Delete")
+		fakeInformerShootRestore.Delete(shootRestore1)
+		Expect(graph.graph.Nodes().Len()).To(BeZero())
+		Expect(graph.graph.Edges().Len()).To(BeZero())
+		Expect(graph.HasPathFrom(VertexTypeShootRestore, shootRestore1.Namespace, shootRestore1.Name, VertexTypeSeed, "", *shootRestore1.Spec.SeedName)).To(BeFalse())
+	})
+
 	It("should behave as expected for gardencorev1beta1.SecretBinding", func() {
 		By("Add")
 		fakeInformerSecretBinding.Add(secretBinding1)
@@ -74,6 +74,8 @@ const (
 	VertexTypeCredentialsBinding
 	// VertexTypeWorkloadIdentity is a constant for a 'WorkloadIdentity' vertex.
 	VertexTypeWorkloadIdentity
+	// VertexTypeShootRestore is a constant for a 'ShootRestore' vertex.
+	VertexTypeShootRestore
 )
 
 // KindObject contains the object kind and a function for creating a new client.Object.
@@ -108,6 +110,7 @@ var VertexTypes = map[VertexType]KindObject{
 	VertexTypeSeed:                      {Kind: "Seed", NewObjectFunc: func() client.Object { return &gardencorev1beta1.Seed{} }},
 	VertexTypeServiceAccount:            {Kind: "ServiceAccount", NewObjectFunc: func() client.Object { return &corev1.ServiceAccount{} }},
 	VertexTypeShoot:                     {Kind: "Shoot", NewObjectFunc: func() client.Object { return &gardencorev1beta1.Shoot{} }},
+	VertexTypeShootRestore:              {Kind: "ShootRestore", NewObjectFunc: func() client.Object { return &operationsv1alpha1.ShootRestore{} }},
 	VertexTypeShootState:                {Kind: "ShootState", NewObjectFunc: func() client.Object { return &gardencorev1beta1.ShootState{} }},
 	VertexTypeWorkloadIdentity:          {Kind: "WorkloadIdentity", NewObjectFunc: func() client.Object { return &securityv1alpha1.WorkloadIdentity{} }},
 }
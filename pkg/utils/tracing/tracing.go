@@ -0,0 +1,122 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+)
+
+const (
+	instrumentationName = "github.com/gardener/gardener"
+
+	// AttributeKeyFlowName is the span attribute key for the name of a flow.
+	AttributeKeyFlowName = attribute.Key("gardener.flow.name")
+	// AttributeKeyFlowTask is the span attribute key for the ID of a flow task.
+	AttributeKeyFlowTask = attribute.Key("gardener.flow.task")
+	// AttributeKeyShootNamespace is the span attribute key for the namespace of a shoot.
+	AttributeKeyShootNamespace = attribute.Key("gardener.shoot.namespace")
+	// AttributeKeyShootName is the span attribute key for the name of a shoot.
+	AttributeKeyShootName = attribute.Key("gardener.shoot.name")
+	// AttributeKeySeedName is the span attribute key for the name of a seed.
+	AttributeKeySeedName = attribute.Key("gardener.seed.name")
+	// AttributeKeyGardenName is the span attribute key for the name of a garden.
+	AttributeKeyGardenName = attribute.Key("gardener.garden.name")
+)
+
+// propagator is used to propagate the trace context via annotations. It is not taken from the global configuration so
+// that the format of the annotation is stable.
+var propagator = propagation.TraceContext{}
+
+// Tracer returns the tracer for Gardener components. It uses the global tracer provider which does not record any spans
+// unless it was configured with Setup.
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// Setup configures the global tracer provider to export spans via OTLP/gRPC to the given endpoint. The returned
+// function flushes the remaining spans and shuts down the exporter.
+func Setup(ctx context.Context, serviceName, endpoint string, insecure bool) (func(context.Context) error, error) {
+	opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(endpoint)}
+	if insecure {
+		opts = append(opts, otlptracegrpc.WithInsecure())
+	}
+
+	exporter, err := otlptracegrpc.New(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating OTLP trace exporter: %w", err)
+	}
+
+	tracerProvider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewSchemaless(attribute.String("service.name", serviceName))),
+	)
+
+	otel.SetTracerProvider(tracerProvider)
+	otel.SetTextMapPropagator(propagator)
+
+	return tracerProvider.Shutdown, nil
+}
+
+// InjectIntoAnnotations writes the trace context of the span in the given context to the annotations of the given
+// object. If the context does not contain a valid span, a previously written trace context is removed, so that
+// extension controllers do not continue an outdated trace.
+func InjectIntoAnnotations(ctx context.Context, obj metav1.Object) {
+	carrier := propagation.MapCarrier{}
+	propagator.Inject(ctx, carrier)
+
+	annotations := obj.GetAnnotations()
+	if traceParent := carrier.Get("traceparent"); traceParent != "" {
+		if annotations == nil {
+			annotations = make(map[string]string, 1)
+		}
+		annotations[v1beta1constants.GardenerTraceParent] = traceParent
+		obj.SetAnnotations(annotations)
+		return
+	}
+
+	if _, ok := annotations[v1beta1constants.GardenerTraceParent]; ok {
+		delete(annotations, v1beta1constants.GardenerTraceParent)
+		obj.SetAnnotations(annotations)
+	}
+}
+
+// ContextFromAnnotations returns a copy of the given context which contains the remote span context stored in the
+// annotations of the given object, see InjectIntoAnnotations. Spans started from the returned context continue the
+// trace of the operation which requested the reconciliation of the object.
+func ContextFromAnnotations(ctx context.Context, obj metav1.Object) context.Context {
+	traceParent, ok := obj.GetAnnotations()[v1beta1constants.GardenerTraceParent]
+	if !ok {
+		return ctx
+	}
+
+	return propagator.Extract(ctx, propagation.MapCarrier{"traceparent": traceParent})
+}
+
+// ShootAttributes returns the span attributes identifying the given shoot and the seed it is scheduled to.
+func ShootAttributes(shoot *gardencorev1beta1.Shoot) []attribute.KeyValue {
+	attributes := []attribute.KeyValue{
+		AttributeKeyShootNamespace.String(shoot.Namespace),
+		AttributeKeyShootName.String(shoot.Name),
+	}
+
+	if shoot.Spec.SeedName != nil {
+		attributes = append(attributes, AttributeKeySeedName.String(*shoot.Spec.SeedName))
+	}
+
+	return attributes
+}
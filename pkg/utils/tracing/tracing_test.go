@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/gardener/gardener/pkg/utils/tracing"
+)
+
+var _ = Describe("Tracing", func() {
+	var (
+		ctx  context.Context
+		span trace.Span
+		obj  *metav1.ObjectMeta
+	)
+
+	BeforeEach(func() {
+		ctx, span = sdktrace.NewTracerProvider().Tracer("test").Start(context.Background(), "test")
+		DeferCleanup(func() { span.End() })
+
+		obj = &metav1.ObjectMeta{Name: "foo", Namespace: "bar"}
+	})
+
+	Describe("#InjectIntoAnnotations", func() {
+		It("should write the trace context to the annotations", func() {
+			InjectIntoAnnotations(ctx, obj)
+
+			Expect(obj.Annotations).To(HaveKeyWithValue("gardener.cloud/traceparent", "00-"+span.SpanContext().TraceID().String()+"-"+span.SpanContext().SpanID().String()+"-01"))
+		})
+
+		It("should remove an outdated trace context if the context does not contain a span", func() {
+			obj.Annotations = map[string]string{"gardener.cloud/traceparent": "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", "foo": "bar"}
+
+			InjectIntoAnnotations(context.Background(), obj)
+
+			Expect(obj.Annotations).To(Equal(map[string]string{"foo": "bar"}))
+		})
+
+		It("should not add annotations if the context does not contain a span", func() {
+			InjectIntoAnnotations(context.Background(), obj)
+
+			Expect(obj.Annotations).To(BeNil())
+		})
+	})
+
+	Describe("#ContextFromAnnotations", func() {
+		It("should return a context containing the propagated trace context", func() {
+			InjectIntoAnnotations(ctx, obj)
+
+			spanContext := trace.SpanContextFromContext(ContextFromAnnotations(context.Background(), obj))
+			Expect(spanContext.IsRemote()).To(BeTrue())
+			Expect(spanContext.TraceID()).To(Equal(span.SpanContext().TraceID()))
+			Expect(spanContext.SpanID()).To(Equal(span.SpanContext().SpanID()))
+		})
+
+		It("should return the given context if the object does not contain a trace context", func() {
+			Expect(ContextFromAnnotations(ctx, obj)).To(BeIdenticalTo(ctx))
+		})
+	})
+
+	Describe("#ShootAttributes", func() {
+		It("should return the attributes of the shoot and its seed", func() {
+			shoot := &gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "garden-bar"},
+				Spec:       gardencorev1beta1.ShootSpec{SeedName: new("seed")},
+			}
+
+			Expect(ShootAttributes(shoot)).To(ConsistOf(
+				attribute.String("gardener.shoot.namespace", "garden-bar"),
+				attribute.String("gardener.shoot.name", "foo"),
+				attribute.String("gardener.seed.name", "seed"),
+			))
+		})
+
+		It("should omit the seed if the shoot is not scheduled", func() {
+			shoot := &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "garden-bar"}}
+
+			Expect(ShootAttributes(shoot)).To(HaveLen(2))
+		})
+	})
+})
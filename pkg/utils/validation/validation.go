@@ -6,9 +6,12 @@ package validation
 
 import (
 	"fmt"
+	"net"
+	"strconv"
 	"strings"
 	"unicode"
 
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
@@ -61,3 +64,28 @@ func ValidateFreeFormText(text string, fldPath *field.Path) field.ErrorList {
 
 	return allErrs
 }
+
+// ValidateHostPort checks that the given address is of the form `<host>:<port>` with a non-empty host and a valid port
+// number.
+func ValidateHostPort(address string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(address) == 0 {
+		return append(allErrs, field.Required(fldPath, "must provide an address"))
+	}
+
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return append(allErrs, field.Invalid(fldPath, address, fmt.Sprintf("must be of the form <host>:<port>: %v", err)))
+	}
+
+	if len(host) == 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath, address, "host must not be empty"))
+	}
+
+	if portNumber, err := strconv.Atoi(port); err != nil || len(validation.IsValidPortNum(portNumber)) > 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath, address, "port must be a number between 1 and 65535"))
+	}
+
+	return allErrs
+}
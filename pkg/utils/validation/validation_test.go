@@ -7,6 +7,7 @@ package validation_test
 import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
 	gomegatypes "github.com/onsi/gomega/types"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
@@ -30,4 +31,18 @@ var _ = Describe("Validation", func() {
 		Entry("should reject emojis", "Hello😊World", Not(BeEmpty())),
 		Entry("should reject symbols", "Hello©®™✓World", Not(BeEmpty())),
 	)
+
+	DescribeTable("#ValidateHostPort",
+		func(address string, matcher gomegatypes.GomegaMatcher) {
+			Expect(ValidateHostPort(address, field.NewPath("endpoint"))).To(matcher)
+		},
+		Entry("should allow host names", "otel-collector.observability.svc:4317", BeEmpty()),
+		Entry("should allow IPv4 addresses", "10.0.0.1:4317", BeEmpty()),
+		Entry("should allow IPv6 addresses", "[::1]:4317", BeEmpty()),
+		Entry("should reject empty addresses", "", ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeRequired), "Field": Equal("endpoint")})))),
+		Entry("should reject addresses without port", "otel-collector", ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("endpoint")})))),
+		Entry("should reject addresses without host", ":4317", ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Detail": Equal("host must not be empty")})))),
+		Entry("should reject invalid ports", "otel-collector:foo", ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Detail": Equal("port must be a number between 1 and 65535")})))),
+		Entry("should reject out of range ports", "otel-collector:70000", ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Detail": Equal("port must be a number between 1 and 65535")})))),
+	)
 })
@@ -23,6 +23,7 @@ import (
 
 	"github.com/gardener/gardener/pkg/api/core/helper"
 	"github.com/gardener/gardener/pkg/apis/core"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	admissioninitializer "github.com/gardener/gardener/pkg/apiserver/admission/initializer"
 	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/externalversions"
 	plugin "github.com/gardener/gardener/plugin/pkg"
@@ -49,6 +50,9 @@ const (
 	// CustomVerbShootMarkSelfHosted is a constant for the custom verb that allows setting the
 	// `.spec.provider.workers[].controlPlane` field in the `Shoot` spec which marks it as 'self-hosted shoot cluster'.
 	CustomVerbShootMarkSelfHosted = "mark-self-hosted"
+	// CustomVerbShootMarkExported is a constant for the custom verb that allows setting or removing the
+	// `shoot.gardener.cloud/exported` annotation which marks a `Shoot` as exported to another garden landscape.
+	CustomVerbShootMarkExported = "mark-exported"
 )
 
 // Register registers a plugin.
@@ -233,7 +237,13 @@ func (c *CustomVerbAuthorizer) admitShoots(ctx context.Context, a admission.Attr
 	}
 
 	if mustCheckIfShootIsSelfHosted(oldObj, obj) {
-		return c.authorize(ctx, a, CustomVerbShootMarkSelfHosted, "modify .spec.provider.workers[].controlPlane")
+		if err := c.authorize(ctx, a, CustomVerbShootMarkSelfHosted, "modify .spec.provider.workers[].controlPlane"); err != nil {
+			return err
+		}
+	}
+
+	if mustCheckIfShootIsExported(oldObj, obj) {
+		return c.authorize(ctx, a, CustomVerbShootMarkExported, "modify the "+v1beta1constants.ShootExported+" annotation")
 	}
 
 	return nil
@@ -406,3 +416,9 @@ func mustCheckProviderConfig(oldProviderConfig, providerConfig *runtime.RawExten
 func mustCheckIfShootIsSelfHosted(oldShoot, shoot *core.Shoot) bool {
 	return !apiequality.Semantic.DeepEqual(helper.ControlPlaneWorkerPoolForShoot(oldShoot.Spec.Provider.Workers), helper.ControlPlaneWorkerPoolForShoot(shoot.Spec.Provider.Workers))
 }
+
+func mustCheckIfShootIsExported(oldShoot, shoot *core.Shoot) bool {
+	oldValue, oldExported := oldShoot.Annotations[v1beta1constants.ShootExported]
+	value, exported := shoot.Annotations[v1beta1constants.ShootExported]
+	return oldExported != exported || oldValue != value
+}
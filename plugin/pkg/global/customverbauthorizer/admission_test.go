@@ -886,6 +886,81 @@ var _ = Describe("customverbauthorizer", func() {
 					})
 				})
 			})
+
+			Context("mark exported", func() {
+				BeforeEach(func() {
+					authorizeAttributes.Verb = "mark-exported"
+				})
+
+				It("should always allow updating a shoot without changing the exported annotation", func() {
+					metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "shoot.gardener.cloud/exported", "true")
+					oldShoot := shoot.DeepCopy()
+					metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "foo", "bar")
+
+					attrs = admission.NewAttributesRecord(shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+					Expect(admissionHandler.Validate(ctx, attrs, nil)).To(Succeed())
+				})
+
+				Describe("permissions granted", func() {
+					BeforeEach(func() {
+						auth.EXPECT().Authorize(ctx, authorizeAttributes).Return(authorizer.DecisionAllow, "", nil)
+					})
+
+					It("should allow creating an exported shoot", func() {
+						metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "shoot.gardener.cloud/exported", "true")
+
+						attrs = admission.NewAttributesRecord(shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+						Expect(admissionHandler.Validate(ctx, attrs, nil)).To(Succeed())
+					})
+
+					It("should allow marking an existing shoot as exported", func() {
+						oldShoot := shoot.DeepCopy()
+						metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "shoot.gardener.cloud/exported", "true")
+
+						attrs = admission.NewAttributesRecord(shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+						Expect(admissionHandler.Validate(ctx, attrs, nil)).To(Succeed())
+					})
+
+					It("should allow removing the exported annotation", func() {
+						metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "shoot.gardener.cloud/exported", "true")
+						oldShoot := shoot.DeepCopy()
+						delete(shoot.Annotations, "shoot.gardener.cloud/exported")
+
+						attrs = admission.NewAttributesRecord(shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+						Expect(admissionHandler.Validate(ctx, attrs, nil)).To(Succeed())
+					})
+				})
+
+				Describe("permissions not granted", func() {
+					BeforeEach(func() {
+						auth.EXPECT().Authorize(ctx, authorizeAttributes).Return(authorizer.DecisionDeny, "", nil)
+					})
+
+					It("should forbid creating an exported shoot", func() {
+						metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "shoot.gardener.cloud/exported", "true")
+
+						attrs = admission.NewAttributesRecord(shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+						Expect(admissionHandler.Validate(ctx, attrs, nil)).NotTo(Succeed())
+					})
+
+					It("should forbid marking an existing shoot as exported", func() {
+						oldShoot := shoot.DeepCopy()
+						metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "shoot.gardener.cloud/exported", "true")
+
+						attrs = admission.NewAttributesRecord(shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+						Expect(admissionHandler.Validate(ctx, attrs, nil)).NotTo(Succeed())
+					})
+
+					It("should forbid removing the exported annotation", func() {
+						metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "shoot.gardener.cloud/exported", "true")
+						oldShoot := shoot.DeepCopy()
+						delete(shoot.Annotations, "shoot.gardener.cloud/exported")
+
+						attrs = admission.NewAttributesRecord(shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+						Expect(admissionHandler.Validate(ctx, attrs, nil)).NotTo(Succeed())
+					})
+				})
+			})
 		})
 	})
 
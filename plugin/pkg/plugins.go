@@ -63,6 +63,8 @@ const (
 	PluginNameShootMutator = "ShootMutator"
 	// PluginNameShootVPAEnabledByDefault is the name of the ShootVPAEnabledByDefault admission plugin.
 	PluginNameShootVPAEnabledByDefault = "ShootVPAEnabledByDefault"
+	// PluginNameShootRestore is the name of the ShootRestore admission plugin.
+	PluginNameShootRestore = "ShootRestore"
 	// PluginNameShootResourceReservation is the name of the ShootResourceReservation admission plugin.
 	PluginNameShootResourceReservation = "ShootResourceReservation"
 	// PluginNameBackupBucketValidator is the name of the BackupBucketValidator admission plugin.
@@ -98,6 +100,7 @@ func AllOrderedPluginNames() []string {
 		PluginNameManagedSeed,                       // ManagedSeed
 		PluginNameManagedSeedShoot,                  // ManagedSeedShoot
 		PluginNameBastion,                           // Bastion
+		PluginNameShootRestore,                      // ShootRestore
 		PluginNameBackupBucketValidator,             // BackupBucketValidator
 
 		// new admission plugins should generally be inserted above here
@@ -141,6 +144,7 @@ func DefaultOnPlugins() sets.Set[string] {
 		PluginNameManagedSeed,                     // ManagedSeed
 		PluginNameManagedSeedShoot,                // ManagedSeedShoot
 		PluginNameBastion,                         // Bastion
+		PluginNameShootRestore,                    // ShootRestore
 		PluginNameBackupBucketValidator,           // BackupBucketValidator
 		mutatingwebhook.PluginName,                // MutatingAdmissionWebhook
 		validatingwebhook.PluginName,              // ValidatingAdmissionWebhook
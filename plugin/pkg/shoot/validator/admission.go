@@ -344,6 +344,9 @@ func (v *ValidateShoot) Validate(ctx context.Context, a admission.Attributes, _
 	if err := validationContext.validateCredentialsBindingChange(ctx, a, v.authorizer, v.credentialsBindingLister); err != nil {
 		return err
 	}
+	if err := validationContext.validateSource(ctx, a, v.authorizer, v.shootLister, v.secretBindingLister, v.credentialsBindingLister); err != nil {
+		return err
+	}
 
 	allErrs = append(allErrs, validationContext.validateAPIVersionForRawExtensions()...)
 	allErrs = append(allErrs, validationContext.validateShootNetworks(a, helper.IsWorkerless(shoot))...)
@@ -513,6 +516,14 @@ func (c *validationContext) validateScheduling(ctx context.Context, a admission.
 				}
 			}
 		}
+
+		violatesAntiAffinity, err := c.violatesShootAntiAffinity(shootLister)
+		if err != nil {
+			return apierrors.NewInternalError(err)
+		}
+		if violatesAntiAffinity {
+			return admission.NewForbidden(a, fmt.Errorf("cannot schedule shoot '%s' on seed '%s' because it hosts shoots violating the shoot anti-affinity", c.shoot.Name, c.seed.Name))
+		}
 	}
 
 	if shootIsBeingRescheduled {
@@ -588,6 +599,27 @@ func (c *validationContext) validateScheduling(ctx context.Context, a admission.
 	return nil
 }
 
+// violatesShootAntiAffinity checks whether the seed hosts shoots of the same project which are selected by the
+// anti-affinity terms of the shoot or whose anti-affinity terms select the shoot.
+func (c *validationContext) violatesShootAntiAffinity(shootLister gardencorev1beta1listers.ShootLister) (bool, error) {
+	shoots, err := shootLister.Shoots(c.shoot.Namespace).List(labels.Everything())
+	if err != nil {
+		return false, fmt.Errorf("could not list shoots in namespace %s: %w", c.shoot.Namespace, err)
+	}
+
+	shoot := &gardencorev1beta1.Shoot{}
+	if err := gardencorev1beta1.Convert_core_Shoot_To_v1beta1_Shoot(c.shoot, shoot, nil); err != nil {
+		return false, err
+	}
+
+	violatingSeedNames, err := v1beta1helper.SeedNamesViolatingShootAntiAffinity(shoot, shoots)
+	if err != nil {
+		return false, err
+	}
+
+	return violatingSeedNames.Has(c.seed.Name), nil
+}
+
 func getNumberOfShootsOnSeed(shootLister gardencorev1beta1listers.ShootLister, seedName string) (int64, error) {
 	allShoots, err := shootLister.Shoots(metav1.NamespaceAll).List(labels.Everything())
 	if err != nil {
@@ -766,6 +798,101 @@ func (c *validationContext) validateCredentialsBindingChange(
 	return nil
 }
 
+// validateSource ensures that a shoot which is created from the backup of another shoot references an existing shoot
+// of the same provider type which the user is allowed to administrate. As the state of the source cluster (e.g.
+// persistent volumes or load balancers) is copied, the new shoot must not use the same provider credentials.
+func (c *validationContext) validateSource(
+	ctx context.Context,
+	a admission.Attributes,
+	auth authorizer.Authorizer,
+	shootLister gardencorev1beta1listers.ShootLister,
+	secretBindingLister gardencorev1beta1listers.SecretBindingLister,
+	credentialsBindingLister securityv1alpha1listers.CredentialsBindingLister,
+) error {
+	if a.GetOperation() != admission.Create || c.shoot.Spec.Source == nil {
+		return nil
+	}
+
+	source := c.shoot.Spec.Source
+	sourceShoot, err := shootLister.Shoots(source.Namespace).Get(source.Name)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return admission.NewForbidden(a, fmt.Errorf("source shoot %s/%s does not exist", source.Namespace, source.Name))
+		}
+		return apierrors.NewInternalError(fmt.Errorf("could not get source shoot %s/%s: %w", source.Namespace, source.Name, err))
+	}
+
+	if sourceShoot.DeletionTimestamp != nil {
+		return admission.NewForbidden(a, fmt.Errorf("source shoot %s/%s is being deleted", source.Namespace, source.Name))
+	}
+	if len(sourceShoot.Status.TechnicalID) == 0 || len(sourceShoot.Status.UID) == 0 || sourceShoot.Spec.SeedName == nil {
+		return admission.NewForbidden(a, fmt.Errorf("source shoot %s/%s has not been created yet", source.Namespace, source.Name))
+	}
+	if sourceShoot.Spec.Provider.Type != c.shoot.Spec.Provider.Type {
+		return admission.NewForbidden(a, fmt.Errorf("provider type %q of source shoot %s/%s does not match provider type %q", sourceShoot.Spec.Provider.Type, source.Namespace, source.Name, c.shoot.Spec.Provider.Type))
+	}
+	// The ETCD encryption key of the source shoot is taken over to decrypt the restored resources. During a rotation, the
+	// resources are encrypted with different keys, hence the source shoot must not be in the middle of a rotation.
+	if phase := v1beta1helper.GetShootETCDEncryptionKeyRotationPhase(sourceShoot.Status.Credentials); len(phase) > 0 && phase != gardencorev1beta1.RotationCompleted {
+		return admission.NewForbidden(a, fmt.Errorf("ETCD encryption key of source shoot %s/%s is being rotated (phase %q)", source.Namespace, source.Name, phase))
+	}
+	// The node-specific state restored from the backup of the source shoot is reset while the shoot is created, which
+	// requires a running control plane.
+	if helper.HibernationIsEnabled(c.shoot) {
+		return admission.NewForbidden(a, fmt.Errorf("shoot cannot be created in hibernated state from source shoot %s/%s", source.Namespace, source.Name))
+	}
+
+	// Creating a shoot from the backup of another shoot exposes all of its data, hence the user must be allowed to
+	// request an admin kubeconfig for the source shoot.
+	decision, _, err := auth.Authorize(ctx, authorizer.AttributesRecord{
+		User:            a.GetUserInfo(),
+		APIGroup:        gardencorev1beta1.SchemeGroupVersion.Group,
+		Resource:        "shoots",
+		Subresource:     "adminkubeconfig",
+		Namespace:       source.Namespace,
+		Name:            source.Name,
+		Verb:            "create",
+		ResourceRequest: true,
+	})
+	if err != nil {
+		return apierrors.NewInternalError(fmt.Errorf("could not authorize access to source shoot: %+v", err.Error()))
+	}
+	if decision != authorizer.DecisionAllow {
+		return admission.NewForbidden(a, fmt.Errorf("user %q is not allowed to create an admin kubeconfig for source shoot %s/%s", a.GetUserInfo().GetName(), source.Namespace, source.Name))
+	}
+
+	var credentials, sourceCredentials string
+	if c.credentialsBinding != nil {
+		credentials = credentialsKey(c.credentialsBinding.CredentialsRef.APIVersion, c.credentialsBinding.CredentialsRef.Kind, c.credentialsBinding.CredentialsRef.Namespace, c.credentialsBinding.CredentialsRef.Name)
+	} else if c.secretBinding != nil {
+		credentials = credentialsKey(corev1.SchemeGroupVersion.String(), "Secret", c.secretBinding.SecretRef.Namespace, c.secretBinding.SecretRef.Name)
+	}
+
+	if name := sourceShoot.Spec.CredentialsBindingName; name != nil {
+		credentialsBinding, err := credentialsBindingLister.CredentialsBindings(sourceShoot.Namespace).Get(*name)
+		if err != nil {
+			return apierrors.NewInternalError(fmt.Errorf("could not get credentials binding of source shoot: %+v", err.Error()))
+		}
+		sourceCredentials = credentialsKey(credentialsBinding.CredentialsRef.APIVersion, credentialsBinding.CredentialsRef.Kind, credentialsBinding.CredentialsRef.Namespace, credentialsBinding.CredentialsRef.Name)
+	} else if name := sourceShoot.Spec.SecretBindingName; name != nil {
+		secretBinding, err := secretBindingLister.SecretBindings(sourceShoot.Namespace).Get(*name)
+		if err != nil {
+			return apierrors.NewInternalError(fmt.Errorf("could not get secret binding of source shoot: %+v", err.Error()))
+		}
+		sourceCredentials = credentialsKey(corev1.SchemeGroupVersion.String(), "Secret", secretBinding.SecretRef.Namespace, secretBinding.SecretRef.Name)
+	}
+
+	if len(credentials) > 0 && credentials == sourceCredentials {
+		return admission.NewForbidden(a, fmt.Errorf("shoot must not use the same provider credentials as source shoot %s/%s", source.Namespace, source.Name))
+	}
+
+	return nil
+}
+
+func credentialsKey(apiVersion, kind, namespace, name string) string {
+	return apiVersion + "/" + kind + "/" + namespace + "/" + name
+}
+
 func (c *validationContext) validateAdmissionPlugins(a admission.Attributes, secretLister kubecorev1listers.SecretLister) field.ErrorList {
 	var (
 		allErrs           field.ErrorList
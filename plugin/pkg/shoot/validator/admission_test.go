@@ -1437,6 +1437,141 @@ var _ = Describe("validator", func() {
 			})
 		})
 
+		Context("shoot with source", func() {
+			var (
+				oldShoot              *core.Shoot
+				sourceShoot           *gardencorev1beta1.Shoot
+				sourceCredentials     *securityv1alpha1.CredentialsBinding
+				sourceAuthorizeRecord authorizer.AttributesRecord
+			)
+
+			BeforeEach(func() {
+				auth = mockauthorizer.NewMockAuthorizer(ctrl)
+
+				oldShoot = shoot.DeepCopy()
+				oldShoot.Spec.SeedName = nil
+
+				credentialsBinding.CredentialsRef = corev1.ObjectReference{APIVersion: "v1", Kind: "Secret", Namespace: namespaceName, Name: "secret"}
+
+				sourceShoot = versionedShoot.DeepCopy()
+				sourceShoot.Namespace = "garden-other"
+				sourceShoot.Name = "source"
+				sourceShoot.Spec.DNS = &gardencorev1beta1.DNS{Domain: new("source." + baseDomain)}
+				sourceShoot.Spec.SecretBindingName = nil
+				sourceShoot.Spec.CredentialsBindingName = new("other")
+				sourceShoot.Status.TechnicalID = "shoot--other--source"
+				sourceShoot.Status.UID = "1234"
+
+				sourceCredentials = &securityv1alpha1.CredentialsBinding{
+					ObjectMeta:     metav1.ObjectMeta{Name: "other", Namespace: "garden-other"},
+					CredentialsRef: corev1.ObjectReference{APIVersion: "v1", Kind: "Secret", Namespace: "garden-other", Name: "secret"},
+				}
+
+				shoot.Spec.Source = &core.ShootSource{Namespace: "garden-other", Name: "source"}
+
+				sourceAuthorizeRecord = authorizer.AttributesRecord{
+					User:            userInfo,
+					APIGroup:        "core.gardener.cloud",
+					Resource:        "shoots",
+					Subresource:     "adminkubeconfig",
+					Namespace:       "garden-other",
+					Name:            "source",
+					Verb:            "create",
+					ResourceRequest: true,
+				}
+
+				Expect(coreInformerFactory.Core().V1beta1().Projects().Informer().GetStore().Add(&project)).To(Succeed())
+				Expect(coreInformerFactory.Core().V1beta1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)).To(Succeed())
+				Expect(coreInformerFactory.Core().V1beta1().Seeds().Informer().GetStore().Add(&seed)).To(Succeed())
+				Expect(coreInformerFactory.Core().V1beta1().SecretBindings().Informer().GetStore().Add(&secretBinding)).To(Succeed())
+				Expect(securityInformerFactory.Security().V1alpha1().CredentialsBindings().Informer().GetStore().Add(&credentialsBinding)).To(Succeed())
+				Expect(securityInformerFactory.Security().V1alpha1().CredentialsBindings().Informer().GetStore().Add(sourceCredentials)).To(Succeed())
+			})
+
+			validate := func() error {
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+				return admissionHandler.Validate(ctx, attrs, nil)
+			}
+
+			It("should allow creating the shoot if the user may administrate the source shoot", func() {
+				Expect(coreInformerFactory.Core().V1beta1().Shoots().Informer().GetStore().Add(sourceShoot)).To(Succeed())
+				auth.EXPECT().Authorize(ctx, sourceAuthorizeRecord).Return(authorizer.DecisionAllow, "", nil)
+				auth.EXPECT().Authorize(ctx, gomock.Any()).Return(authorizer.DecisionAllow, "", nil).AnyTimes()
+
+				Expect(validate()).To(Succeed())
+			})
+
+			It("should forbid creating the shoot if the user may not administrate the source shoot", func() {
+				Expect(coreInformerFactory.Core().V1beta1().Shoots().Informer().GetStore().Add(sourceShoot)).To(Succeed())
+				auth.EXPECT().Authorize(ctx, sourceAuthorizeRecord).Return(authorizer.DecisionDeny, "", nil)
+				auth.EXPECT().Authorize(ctx, gomock.Any()).Return(authorizer.DecisionAllow, "", nil).AnyTimes()
+
+				err := validate()
+				Expect(err).To(BeForbiddenError())
+				Expect(err).To(MatchError(ContainSubstring("user %q is not allowed to create an admin kubeconfig for source shoot garden-other/source", userInfo.Name)))
+			})
+
+			It("should forbid creating the shoot if the source shoot does not exist", func() {
+				auth.EXPECT().Authorize(ctx, gomock.Any()).Return(authorizer.DecisionAllow, "", nil).AnyTimes()
+
+				err := validate()
+				Expect(err).To(BeForbiddenError())
+				Expect(err).To(MatchError(ContainSubstring("source shoot garden-other/source does not exist")))
+			})
+
+			It("should forbid creating the shoot if the provider types differ", func() {
+				sourceShoot.Spec.Provider.Type = "other"
+				Expect(coreInformerFactory.Core().V1beta1().Shoots().Informer().GetStore().Add(sourceShoot)).To(Succeed())
+				auth.EXPECT().Authorize(ctx, gomock.Any()).Return(authorizer.DecisionAllow, "", nil).AnyTimes()
+
+				err := validate()
+				Expect(err).To(BeForbiddenError())
+				Expect(err).To(MatchError(ContainSubstring("does not match provider type")))
+			})
+
+			It("should forbid creating the shoot if the ETCD encryption key of the source shoot is being rotated", func() {
+				sourceShoot.Status.Credentials = &gardencorev1beta1.ShootCredentials{Rotation: &gardencorev1beta1.ShootCredentialsRotation{
+					ETCDEncryptionKey: &gardencorev1beta1.ETCDEncryptionKeyRotation{Phase: gardencorev1beta1.RotationPrepared},
+				}}
+				Expect(coreInformerFactory.Core().V1beta1().Shoots().Informer().GetStore().Add(sourceShoot)).To(Succeed())
+				auth.EXPECT().Authorize(ctx, gomock.Any()).Return(authorizer.DecisionAllow, "", nil).AnyTimes()
+
+				err := validate()
+				Expect(err).To(BeForbiddenError())
+				Expect(err).To(MatchError(ContainSubstring(`ETCD encryption key of source shoot garden-other/source is being rotated (phase "Prepared")`)))
+			})
+
+			It("should allow creating the shoot if the ETCD encryption key rotation of the source shoot is completed", func() {
+				sourceShoot.Status.Credentials = &gardencorev1beta1.ShootCredentials{Rotation: &gardencorev1beta1.ShootCredentialsRotation{
+					ETCDEncryptionKey: &gardencorev1beta1.ETCDEncryptionKeyRotation{Phase: gardencorev1beta1.RotationCompleted},
+				}}
+				Expect(coreInformerFactory.Core().V1beta1().Shoots().Informer().GetStore().Add(sourceShoot)).To(Succeed())
+				auth.EXPECT().Authorize(ctx, gomock.Any()).Return(authorizer.DecisionAllow, "", nil).AnyTimes()
+
+				Expect(validate()).To(Succeed())
+			})
+
+			It("should forbid creating the shoot in hibernated state", func() {
+				shoot.Spec.Hibernation = &core.Hibernation{Enabled: new(true)}
+				Expect(coreInformerFactory.Core().V1beta1().Shoots().Informer().GetStore().Add(sourceShoot)).To(Succeed())
+				auth.EXPECT().Authorize(ctx, gomock.Any()).Return(authorizer.DecisionAllow, "", nil).AnyTimes()
+
+				err := validate()
+				Expect(err).To(BeForbiddenError())
+				Expect(err).To(MatchError(ContainSubstring("shoot cannot be created in hibernated state from source shoot garden-other/source")))
+			})
+
+			It("should forbid creating the shoot if it uses the credentials of the source shoot", func() {
+				sourceCredentials.CredentialsRef = credentialsBinding.CredentialsRef
+				Expect(coreInformerFactory.Core().V1beta1().Shoots().Informer().GetStore().Add(sourceShoot)).To(Succeed())
+				auth.EXPECT().Authorize(ctx, gomock.Any()).Return(authorizer.DecisionAllow, "", nil).AnyTimes()
+
+				err := validate()
+				Expect(err).To(BeForbiddenError())
+				Expect(err).To(MatchError(ContainSubstring("must not use the same provider credentials as source shoot garden-other/source")))
+			})
+		})
+
 		Context("tests for region/zone updates", func() {
 			var (
 				oldShoot *core.Shoot
@@ -1851,6 +1986,58 @@ var _ = Describe("validator", func() {
 				})
 			})
 
+			Context("shoot anti-affinity", func() {
+				var otherShoot *gardencorev1beta1.Shoot
+
+				BeforeEach(func() {
+					shoot.Labels = map[string]string{"app": "db"}
+
+					otherShoot = versionedShoot.DeepCopy()
+					otherShoot.Name = "other-shoot"
+					otherShoot.Labels = map[string]string{"app": "db"}
+					otherShoot.Spec.SeedName = &seedName
+				})
+
+				It("should pass because no shoot on the seed is selected by the anti-affinity", func() {
+					otherShoot.Spec.SeedName = new("other-seed")
+					Expect(coreInformerFactory.Core().V1beta1().Shoots().Informer().GetStore().Add(otherShoot)).To(Succeed())
+
+					shoot.Spec.Affinity = &core.Affinity{ShootAntiAffinity: []core.ShootAffinityTerm{
+						{LabelSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}}},
+					}}
+
+					attrs := admission.NewAttributesRecord(&shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+					Expect(admissionHandler.Validate(ctx, attrs, nil)).To(Succeed())
+				})
+
+				It("should reject because the seed hosts a shoot selected by the anti-affinity", func() {
+					Expect(coreInformerFactory.Core().V1beta1().Shoots().Informer().GetStore().Add(otherShoot)).To(Succeed())
+
+					shoot.Spec.Affinity = &core.Affinity{ShootAntiAffinity: []core.ShootAffinityTerm{
+						{LabelSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}}},
+					}}
+
+					attrs := admission.NewAttributesRecord(&shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+					err := admissionHandler.Validate(ctx, attrs, nil)
+
+					Expect(err).To(BeForbiddenError())
+					Expect(err).To(MatchError(ContainSubstring("because it hosts shoots violating the shoot anti-affinity")))
+				})
+
+				It("should reject because the anti-affinity of a shoot on the seed selects the shoot", func() {
+					otherShoot.Spec.Affinity = &gardencorev1beta1.Affinity{ShootAntiAffinity: []gardencorev1beta1.ShootAffinityTerm{
+						{LabelSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}}},
+					}}
+					Expect(coreInformerFactory.Core().V1beta1().Shoots().Informer().GetStore().Add(otherShoot)).To(Succeed())
+
+					attrs := admission.NewAttributesRecord(&shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+					err := admissionHandler.Validate(ctx, attrs, nil)
+
+					Expect(err).To(BeForbiddenError())
+					Expect(err).To(MatchError(ContainSubstring("because it hosts shoots violating the shoot anti-affinity")))
+				})
+			})
+
 			Context("multi-zonal shoot scheduling checks on seed", func() {
 				BeforeEach(func() {
 					Expect(coreInformerFactory.Core().V1beta1().Projects().Informer().GetStore().Add(&project)).To(Succeed())
@@ -0,0 +1,174 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/admission"
+
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/apis/operations"
+	admissioninitializer "github.com/gardener/gardener/pkg/apiserver/admission/initializer"
+	gardencoreclientset "github.com/gardener/gardener/pkg/client/core/clientset/versioned"
+	"github.com/gardener/gardener/pkg/utils/kubernetes"
+	plugin "github.com/gardener/gardener/plugin/pkg"
+)
+
+// Register registers a plugin.
+func Register(plugins *admission.Plugins) {
+	plugins.Register(plugin.PluginNameShootRestore, func(_ io.Reader) (admission.Interface, error) {
+		return New()
+	})
+}
+
+// ShootRestore contains listers and admission handler.
+type ShootRestore struct {
+	*admission.Handler
+
+	coreClient gardencoreclientset.Interface
+	readyFunc  admission.ReadyFunc
+}
+
+var (
+	_ = admissioninitializer.WantsCoreClientSet(&ShootRestore{})
+
+	readyFuncs []admission.ReadyFunc
+)
+
+// New creates a new ShootRestore admission plugin.
+func New() (*ShootRestore, error) {
+	return &ShootRestore{
+		Handler: admission.NewHandler(admission.Create, admission.Update),
+	}, nil
+}
+
+// AssignReadyFunc assigns the ready function to the admission handler.
+func (v *ShootRestore) AssignReadyFunc(f admission.ReadyFunc) {
+	v.readyFunc = f
+	v.SetReadyFunc(f)
+}
+
+// SetCoreClientSet sets the garden core clientset.
+func (v *ShootRestore) SetCoreClientSet(c gardencoreclientset.Interface) {
+	v.coreClient = c
+}
+
+// ValidateInitialization checks whether the plugin was correctly initialized.
+func (v *ShootRestore) ValidateInitialization() error {
+	if v.coreClient == nil {
+		return errors.New("missing garden core client")
+	}
+	return nil
+}
+
+var _ admission.MutationInterface = (*ShootRestore)(nil)
+
+// Admit validates and if appropriate mutates the given shoot restore against the shoot that it references.
+func (v *ShootRestore) Admit(ctx context.Context, a admission.Attributes, _ admission.ObjectInterfaces) error {
+	// Wait until the caches have been synced
+	if v.readyFunc == nil {
+		v.AssignReadyFunc(func() bool {
+			for _, readyFunc := range readyFuncs {
+				if !readyFunc() {
+					return false
+				}
+			}
+			return true
+		})
+	}
+	if !v.WaitForReady() {
+		return admission.NewForbidden(a, errors.New("not yet ready to handle request"))
+	}
+
+	// Ignore all kinds other than ShootRestore
+	if a.GetKind().GroupKind() != operations.Kind("ShootRestore") {
+		return nil
+	}
+
+	// Ignore updates to status or other subresources
+	if a.GetSubresource() != "" {
+		return nil
+	}
+
+	// Convert object to ShootRestore
+	shootRestore, ok := a.GetObject().(*operations.ShootRestore)
+	if !ok {
+		return apierrors.NewBadRequest("could not convert object to ShootRestore")
+	}
+
+	// The shoot is only checked when the restore is requested, later updates (e.g. of finalizers or labels) must
+	// remain possible in any state of the shoot.
+	if a.GetOperation() != admission.Create {
+		return nil
+	}
+
+	gk := schema.GroupKind{Group: operations.GroupName, Kind: "ShootRestore"}
+
+	// ensure shoot name is specified
+	shootPath := field.NewPath("spec", "shootRef", "name")
+	if shootRestore.Spec.ShootRef.Name == "" {
+		return apierrors.NewInvalid(gk, shootRestore.Name, field.ErrorList{field.Required(shootPath, "shoot is required")})
+	}
+
+	shootName := shootRestore.Spec.ShootRef.Name
+
+	// ensure shoot exists
+	shoot, err := v.coreClient.CoreV1beta1().Shoots(shootRestore.Namespace).Get(ctx, shootName, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			fieldErr := field.Invalid(shootPath, shootName, fmt.Sprintf("shoot %s/%s not found", shootRestore.Namespace, shootName))
+			return apierrors.NewInvalid(gk, shootRestore.Name, field.ErrorList{fieldErr})
+		}
+
+		return apierrors.NewInternalError(fmt.Errorf("could not get shoot %s/%s: %v", shootRestore.Namespace, shootName, err))
+	}
+
+	// ensure shoot is alive
+	if shoot.DeletionTimestamp != nil {
+		fieldErr := field.Invalid(shootPath, shootName, "shoot is in deletion")
+		return apierrors.NewInvalid(gk, shootRestore.Name, field.ErrorList{fieldErr})
+	}
+
+	// ensure shoot is already assigned to a seed
+	if shoot.Spec.SeedName == nil || len(*shoot.Spec.SeedName) == 0 {
+		fieldErr := field.Invalid(shootPath, shootName, "shoot is not yet assigned to a seed")
+		return apierrors.NewInvalid(gk, shootRestore.Name, field.ErrorList{fieldErr})
+	}
+
+	// ensure shoot is not hibernated, its etcd is not running and cannot be restored
+	if v1beta1helper.HibernationIsEnabled(shoot) || shoot.Status.IsHibernated {
+		fieldErr := field.Invalid(shootPath, shootName, "shoot is hibernated")
+		return apierrors.NewInvalid(gk, shootRestore.Name, field.ErrorList{fieldErr})
+	}
+
+	// ensure shoot is not being migrated to another seed
+	if shoot.Status.SeedName != nil && *shoot.Status.SeedName != *shoot.Spec.SeedName {
+		fieldErr := field.Invalid(shootPath, shootName, "shoot is being migrated to another seed")
+		return apierrors.NewInvalid(gk, shootRestore.Name, field.ErrorList{fieldErr})
+	}
+
+	// update shoot restore
+	shootRestore.Spec.SeedName = shoot.Spec.SeedName
+
+	if userInfo := a.GetUserInfo(); userInfo != nil {
+		metav1.SetMetaDataAnnotation(&shootRestore.ObjectMeta, v1beta1constants.GardenCreatedBy, userInfo.GetName())
+	}
+
+	// ensure shoot restores are cleaned up when shoots are deleted
+	ownerRef := *metav1.NewControllerRef(shoot, gardencorev1beta1.SchemeGroupVersion.WithKind("Shoot"))
+	shootRestore.OwnerReferences = kubernetes.MergeOwnerReferences(shootRestore.OwnerReferences, ownerRef)
+
+	return nil
+}
@@ -0,0 +1,247 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validator_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/client-go/testing"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/apis/operations"
+	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
+	corefake "github.com/gardener/gardener/pkg/client/core/clientset/versioned/fake"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+	. "github.com/gardener/gardener/plugin/pkg/shootrestore/validator"
+)
+
+const (
+	shootRestoreName = "foo"
+	shootName        = "foo"
+	seedName         = "foo"
+	namespace        = "garden"
+	userName         = "ginkgo"
+)
+
+var _ = Describe("ShootRestore", func() {
+	Describe("#Admit", func() {
+		var (
+			shootRestore     *operations.ShootRestore
+			shoot            *gardencorev1beta1.Shoot
+			coreClient       *corefake.Clientset
+			dummyOwnerRef    *metav1.OwnerReference
+			admissionHandler *ShootRestore
+		)
+
+		BeforeEach(func() {
+			shoot = &gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      shootName,
+					Namespace: namespace,
+					UID:       "shoot-uid",
+				},
+				Spec: gardencorev1beta1.ShootSpec{
+					SeedName: new(seedName),
+				},
+				Status: gardencorev1beta1.ShootStatus{
+					SeedName: new(seedName),
+				},
+			}
+
+			dummyOwnerRef = &metav1.OwnerReference{
+				APIVersion: "v1",
+				Kind:       "ConfigMap",
+				Name:       "dummy-object",
+			}
+
+			shootRestore = &operations.ShootRestore{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            shootRestoreName,
+					Namespace:       namespace,
+					OwnerReferences: []metav1.OwnerReference{*dummyOwnerRef},
+				},
+				Spec: operations.ShootRestoreSpec{
+					ShootRef: corev1.LocalObjectReference{
+						Name: shootName,
+					},
+				},
+			}
+
+			var err error
+			admissionHandler, err = New()
+			Expect(err).ToNot(HaveOccurred())
+			admissionHandler.AssignReadyFunc(func() bool { return true })
+
+			coreClient = &corefake.Clientset{}
+			admissionHandler.SetCoreClientSet(coreClient)
+		})
+
+		It("should do nothing if the resource is not a ShootRestore", func() {
+			attrs := admission.NewAttributesRecord(nil, nil, operations.Kind("Foo").WithVersion("version"), shootRestore.Namespace, shootRestore.Name, operations.Resource("foos").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+			Expect(admissionHandler.Admit(context.TODO(), attrs, nil)).To(Succeed())
+		})
+
+		It("should mutate the ShootRestore with Shoot and creator information", func() {
+			coreClient.AddReactor("get", "shoots", func(_ testing.Action) (bool, runtime.Object, error) {
+				return true, shoot, nil
+			})
+
+			shootOwnerRef := metav1.NewControllerRef(shoot, gardencorev1beta1.SchemeGroupVersion.WithKind("Shoot"))
+
+			Expect(admissionHandler.Admit(context.TODO(), getShootRestoreAttributes(shootRestore, nil, admission.Create), nil)).To(Succeed())
+			Expect(shootRestore.Spec.SeedName).To(PointTo(Equal(seedName)))
+			Expect(shootRestore.Annotations[v1beta1constants.GardenCreatedBy]).To(Equal(userName))
+			Expect(shootRestore.OwnerReferences).To(ConsistOf(*dummyOwnerRef, *shootOwnerRef))
+		})
+
+		It("should forbid the ShootRestore creation if a Shoot name is not specified", func() {
+			shootRestore.Spec.ShootRef.Name = ""
+
+			err := admissionHandler.Admit(context.TODO(), getShootRestoreAttributes(shootRestore, nil, admission.Create), nil)
+			Expect(err).To(BeInvalidError())
+			Expect(getErrorList(err)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.shootRef.name"),
+				})),
+			))
+		})
+
+		It("should forbid the ShootRestore creation if the Shoot does not exist", func() {
+			shootRestore.Spec.ShootRef.Name = "does-not-exist"
+
+			err := admissionHandler.Admit(context.TODO(), getShootRestoreAttributes(shootRestore, nil, admission.Create), nil)
+			Expect(err).To(BeInvalidError())
+			Expect(getErrorList(err)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.shootRef.name"),
+				})),
+			))
+		})
+
+		DescribeTable("should forbid the ShootRestore creation",
+			func(mutateShoot func()) {
+				mutateShoot()
+
+				coreClient.AddReactor("get", "shoots", func(_ testing.Action) (bool, runtime.Object, error) {
+					return true, shoot, nil
+				})
+
+				err := admissionHandler.Admit(context.TODO(), getShootRestoreAttributes(shootRestore, nil, admission.Create), nil)
+				Expect(err).To(BeInvalidError())
+				Expect(getErrorList(err)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.shootRef.name"),
+					})),
+				))
+			},
+
+			Entry("if the Shoot does not specify a Seed", func() { shoot.Spec.SeedName = nil }),
+			Entry("if the Shoot is in deletion", func() { shoot.DeletionTimestamp = &metav1.Time{} }),
+			Entry("if the Shoot is hibernated", func() { shoot.Status.IsHibernated = true }),
+			Entry("if the Shoot shall be hibernated", func() {
+				shoot.Spec.Hibernation = &gardencorev1beta1.Hibernation{Enabled: new(true)}
+			}),
+			Entry("if the Shoot is being migrated", func() { shoot.Status.SeedName = new("old-seed") }),
+		)
+
+		It("should allow the ShootRestore update even if the Shoot is in deletion", func() {
+			shoot.DeletionTimestamp = &metav1.Time{}
+
+			coreClient.AddReactor("get", "shoots", func(_ testing.Action) (bool, runtime.Object, error) {
+				return true, shoot, nil
+			})
+
+			oldShootRestore := shootRestore.DeepCopy()
+			oldShootRestore.Finalizers = []string{"foo"}
+
+			Expect(admissionHandler.Admit(context.TODO(), getShootRestoreAttributes(shootRestore, oldShootRestore, admission.Update), nil)).To(Succeed())
+		})
+	})
+
+	Describe("#Register", func() {
+		It("should register the plugin", func() {
+			plugins := admission.NewPlugins()
+			Register(plugins)
+
+			registered := plugins.Registered()
+			Expect(registered).To(HaveLen(1))
+			Expect(registered).To(ContainElement("ShootRestore"))
+		})
+	})
+
+	Describe("#New", func() {
+		It("should only handle CREATE and UPDATE operations", func() {
+			admissionHandler, err := New()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(admissionHandler.Handles(admission.Create)).To(BeTrue())
+			Expect(admissionHandler.Handles(admission.Update)).To(BeTrue())
+			Expect(admissionHandler.Handles(admission.Connect)).To(BeFalse())
+			Expect(admissionHandler.Handles(admission.Delete)).To(BeFalse())
+		})
+	})
+
+	Describe("#ValidateInitialization", func() {
+		It("should fail if the required clients are not set", func() {
+			admissionHandler, _ := New()
+
+			Expect(admissionHandler.ValidateInitialization()).To(HaveOccurred())
+		})
+
+		It("should not fail if the required clients are set", func() {
+			admissionHandler, _ := New()
+			admissionHandler.SetCoreClientSet(&corefake.Clientset{})
+
+			Expect(admissionHandler.ValidateInitialization()).To(Succeed())
+		})
+	})
+})
+
+func getShootRestoreAttributes(shootRestore *operations.ShootRestore, oldShootRestore *operations.ShootRestore, op admission.Operation) admission.Attributes {
+	return admission.NewAttributesRecord(shootRestore,
+		oldShootRestore,
+		operationsv1alpha1.Kind("ShootRestore").WithVersion("v1alpha1"),
+		shootRestore.Namespace,
+		shootRestore.Name,
+		operationsv1alpha1.Resource("shootrestores").WithVersion("v1alpha1"),
+		"",
+		op,
+		&metav1.CreateOptions{},
+		false,
+		&user.DefaultInfo{
+			Name: userName,
+		},
+	)
+}
+
+func getErrorList(err error) field.ErrorList {
+	statusError, ok := err.(*apierrors.StatusError)
+	if !ok {
+		return field.ErrorList{}
+	}
+	var errs field.ErrorList
+	for _, cause := range statusError.ErrStatus.Details.Causes {
+		errs = append(errs, &field.Error{
+			Type:   field.ErrorType(cause.Type),
+			Field:  cause.Field,
+			Detail: cause.Message,
+		})
+	}
+	return errs
+}